@@ -22,7 +22,10 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"net/http"
+	"net/url"
 	"os"
+	"path/filepath"
 	"strconv"
 	"time"
 
@@ -50,6 +53,21 @@ var (
 	// ErrInvalidLeaderElectionMode indicates an invalid leader election mode.
 	ErrInvalidLeaderElectionMode = errors.New("invalid --leader-election-mode")
 
+	// ErrInvalidTargetNamespaceMode indicates an invalid default target namespace mode.
+	ErrInvalidTargetNamespaceMode = errors.New("invalid --default-target-namespace-mode")
+
+	// ErrInvalidSentinelConfigMap indicates a deletion sentinel ConfigMap that is not namespace/name.
+	ErrInvalidSentinelConfigMap = errors.New("invalid --sentinel-configmap")
+
+	// ErrInvalidSnapshotDir indicates a snapshot directory that is not a clean absolute path.
+	ErrInvalidSnapshotDir = errors.New("invalid --snapshot-dir")
+
+	// ErrInvalidOPAAllowedURL indicates an OPA allowlist entry that is not an absolute http(s) URL.
+	ErrInvalidOPAAllowedURL = errors.New("invalid --opa-allowed-urls")
+
+	// ErrInvalidResultWebhookAllowedURL indicates a result webhook allowlist entry that is not an absolute http(s) URL.
+	ErrInvalidResultWebhookAllowedURL = errors.New("invalid --result-webhook-allowed-urls")
+
 	// ErrWebhookTLSCertificatesMissing indicates that webhook TLS certificates are missing.
 	ErrWebhookTLSCertificatesMissing = errors.New("webhook TLS certificates not found")
 )
@@ -63,6 +81,9 @@ const (
 
 	// DefaultMaxConcurrentEvaluations is the default maximum number of concurrent policy evaluations.
 	DefaultMaxConcurrentEvaluations = 5
+
+	// DefaultPushTimeout bounds the final metrics push to the Pushgateway on exit.
+	DefaultPushTimeout = 10 * time.Second
 )
 
 var (
@@ -84,10 +105,36 @@ var (
 	leaderElectionLeaseName  = flag.String("leader-election-lease-name", "", "The LeaderGroup CRD name (required for zenlead mode)")
 	enableWebhook            = flag.Bool("enable-webhook", true, "Enable validating webhook server")
 	insecureWebhook          = flag.Bool("insecure-webhook", false, "Allow webhook to start without TLS (testing only, not recommended for production)")
+	policyTemplateDir        = flag.String("policy-template-dir", "", "Directory of policy templates the mutating webhook expands templated policies from (disabled if empty)")
 	gcInterval               = flag.Duration("gc-interval", 1*time.Minute, "Interval between GC evaluation runs")
 	maxDeletionsPerSecond    = flag.Int("max-deletions-per-second", 10, "Default maximum deletions per second (can be overridden per policy)")
 	batchSize                = flag.Int("batch-size", DefaultBatchSize, "Default batch size for deletions (can be overridden per policy)")
-	maxConcurrentEvaluations = flag.Int("max-concurrent-evaluations", DefaultMaxConcurrentEvaluations, "Maximum number of policies to evaluate concurrently")
+	maxConcurrentEvaluations = flag.Int("max-concurrent-evaluations", DefaultMaxConcurrentEvaluations, "Maximum number of policies to evaluate concurrently (reconcile workers)")
+	evaluationWorkers        = flag.Int("evaluation-workers", 0, "Number of workers evaluating one policy's resources concurrently, 1 to evaluate serially (default: 4)")
+	deleteBackoffInitial     = flag.Duration("delete-backoff-initial-interval", 0, "Wait before the first retry of a deletion failing with a transient API error, jittered down to half (default: 100ms)")
+	deleteBackoffMax         = flag.Duration("delete-backoff-max-interval", 0, "Maximum wait between deletion retries (default: 30s)")
+	deleteBackoffMultiplier  = flag.Float64("delete-backoff-multiplier", 0, "Factor the wait grows by on each deletion retry, at least 1 (default: 2)")
+	deleteMaxRetries         = flag.Int("delete-max-retries", -1, "Retries of a deletion failing with a transient API error after the first attempt, 0 to disable (default: 4)")
+	allowedAPIGroups         = flag.String("allowed-api-groups", "", "Comma-separated API groups policies may target, \"core\" for the core group (default: all groups)")
+	targetNamespaceMode      = flag.String("default-target-namespace-mode", "", "How an empty targetResource.namespace is resolved: cluster (all namespaces, default) or policy (the policy's namespace)")
+	countAlreadyGone         = flag.Bool("count-already-gone-separately", false, "Count resources that were already gone (NotFound) when deleted separately from deleted resources")
+	protectAnnotation        = flag.String("protect-annotation", "", "Annotation key that protects a resource from deletion by any policy when set to \"true\" (default: gc.kube-zen.io/protect)")
+	protectedNamespaces      = flag.String("protected-namespaces", "", "Comma-separated namespaces or globs (e.g. \"kube-*\") excluded from every policy regardless of its targetResource (default: none)")
+	sentinelConfigMap        = flag.String("sentinel-configmap", "", "namespace/name of a ConfigMap whose data key \"enabled\" must be \"true\" for deletions to proceed (disabled if empty)")
+	sentinelURL              = flag.String("sentinel-url", "", "URL that must answer 2xx with \"true\" or \"enabled\" for deletions to proceed (disabled if empty)")
+	sentinelInterval         = flag.Duration("sentinel-interval", 0, "Interval between deletion sentinel checks (default: 30s)")
+	enforceTargetDiscovery   = flag.Bool("enforce-target-discovery", false, "Deny policies whose target kind the cluster does not serve, instead of admitting them with a warning")
+	denyPolicyOverlap        = flag.Bool("deny-policy-overlap", false, "Deny policies whose target overlaps an existing policy's unless annotated gc.kube-zen.io/allow-overlap=true, instead of admitting them with a warning")
+	targetDiscoveryTTL       = flag.Duration("target-discovery-ttl", 0, "How long the webhook caches which kinds an API version serves (default: 5m)")
+	degradedAfterFailures    = flag.Int("degraded-after-failures", 0, "Consecutive failed evaluations after which a policy is marked Degraded (default: 1)")
+	staleAfterEmptyRuns      = flag.Int("stale-after-empty-runs", 0, "Consecutive evaluations matching no resources after which a policy is reported stale (default: 10)")
+	auditLogPath             = flag.String("audit-log-path", "", "File every deletion is appended to as a JSON line, for compliance auditing (disabled if empty)")
+	snapshotDir              = flag.String("snapshot-dir", "", "Absolute directory manifests of policies with behavior.snapshot are written to before deletion (disabled if empty)")
+	opaAllowedURLs           = flag.String("opa-allowed-urls", "", "Comma-separated OPA decision URLs opa conditions may query, compared exactly (default: none)")
+	opaIncludeData           = flag.Bool("opa-include-data", false, "Send resources to OPA with their data, stringData, and binaryData fields instead of stripping them")
+	resultWebhookAllowedURLs = flag.String("result-webhook-allowed-urls", "", "Comma-separated URLs result webhooks may be delivered to, compared exactly (default: none)")
+	enableDebugBundle        = flag.Bool("enable-debug-bundle", false, "Serve on-demand policy debug bundles on the metrics port, which is unauthenticated")
+	pushgatewayURL           = flag.String("pushgateway-url", "", "Prometheus Pushgateway URL the final metrics snapshot is pushed to on exit, for short-lived runs (disabled if empty)")
 )
 
 //nolint:gocyclo // main function complexity is acceptable for initialization logic
@@ -98,6 +145,7 @@ func main() {
 	logger = sdklog.NewLogger("zen-gc")
 	setupLog = logger.WithComponent("setup")
 	setupLog.Debug("GC Controller starting", sdklog.String("version", version), sdklog.String("commit", commit), sdklog.String("buildDate", buildDate))
+	controller.RecordBuildInfo(version, commit, buildDate)
 
 	// OpenTelemetry tracing initialization can be added here when zen-sdk/pkg/observability is available
 	// For now, continue without tracing
@@ -146,12 +194,141 @@ func main() {
 	controllerConfig.WithMaxDeletionsPerSecond(*maxDeletionsPerSecond)
 	controllerConfig.WithBatchSize(*batchSize)
 	controllerConfig.WithMaxConcurrentEvaluations(*maxConcurrentEvaluations)
+	if *evaluationWorkers > 0 {
+		controllerConfig.WithEvaluationWorkers(*evaluationWorkers)
+	}
+	if *targetNamespaceMode != "" {
+		if !config.IsValidTargetNamespaceMode(*targetNamespaceMode) {
+			setupLog.Error(fmt.Errorf("%w: %q (must be cluster or policy)", ErrInvalidTargetNamespaceMode, *targetNamespaceMode), "invalid configuration", sdklog.ErrorCode("INVALID_CONFIG"))
+			os.Exit(1)
+		}
+		controllerConfig.WithDefaultTargetNamespaceMode(*targetNamespaceMode)
+	}
+	if *allowedAPIGroups != "" {
+		controllerConfig.WithAllowedAPIGroups(config.ParseAPIGroups(*allowedAPIGroups))
+	}
+	if *countAlreadyGone {
+		controllerConfig.WithCountAlreadyGoneSeparately(true)
+	}
+	if *enableDebugBundle {
+		controllerConfig.WithDebugBundle(true)
+	}
+	if *protectAnnotation != "" {
+		controllerConfig.WithProtectAnnotation(*protectAnnotation)
+	}
+	if *protectedNamespaces != "" {
+		controllerConfig.WithProtectedNamespaces(config.ParseNamespaces(*protectedNamespaces))
+	}
+	if *sentinelConfigMap != "" || *sentinelURL != "" || *sentinelInterval > 0 {
+		sentinelCM, sentinelU, interval := controllerConfig.SentinelConfigMap, controllerConfig.SentinelURL, controllerConfig.SentinelInterval
+		if *sentinelConfigMap != "" {
+			if _, _, ok := config.ParseObjectRef(*sentinelConfigMap); !ok {
+				setupLog.Error(fmt.Errorf("%w: %q (must be namespace/name)", ErrInvalidSentinelConfigMap, *sentinelConfigMap), "invalid configuration", sdklog.ErrorCode("INVALID_CONFIG"))
+				os.Exit(1)
+			}
+			sentinelCM = *sentinelConfigMap
+		}
+		if *sentinelURL != "" {
+			sentinelU = *sentinelURL
+		}
+		if *sentinelInterval > 0 {
+			interval = *sentinelInterval
+		}
+		controllerConfig.WithSentinel(sentinelCM, sentinelU, interval)
+	}
+	if *pushgatewayURL != "" {
+		controllerConfig.WithPushgatewayURL(*pushgatewayURL)
+	}
+	if *enforceTargetDiscovery || *targetDiscoveryTTL > 0 {
+		ttl := controllerConfig.TargetDiscoveryTTL
+		if *targetDiscoveryTTL > 0 {
+			ttl = *targetDiscoveryTTL
+		}
+		controllerConfig.WithTargetDiscovery(controllerConfig.EnforceTargetDiscovery || *enforceTargetDiscovery, ttl)
+	}
+	if *denyPolicyOverlap {
+		controllerConfig.WithDenyPolicyOverlap(true)
+	}
+	if *auditLogPath != "" {
+		controllerConfig.WithAuditLogPath(*auditLogPath)
+	}
+	if *snapshotDir != "" {
+		controllerConfig.WithSnapshotDir(*snapshotDir)
+	}
+	if dir := controllerConfig.SnapshotDir; dir != "" && (!filepath.IsAbs(dir) || filepath.Clean(dir) != dir) {
+		setupLog.Error(fmt.Errorf("%w: %q (must be a clean absolute path)", ErrInvalidSnapshotDir, dir), "invalid configuration", sdklog.ErrorCode("INVALID_CONFIG"))
+		os.Exit(1)
+	}
+	if *opaAllowedURLs != "" || *opaIncludeData {
+		allowedURLs := controllerConfig.OPAAllowedURLs
+		if *opaAllowedURLs != "" {
+			allowedURLs = config.ParseURLs(*opaAllowedURLs)
+		}
+		controllerConfig.WithOPA(allowedURLs, controllerConfig.OPAIncludeData || *opaIncludeData)
+	}
+	for _, opaURL := range controllerConfig.OPAAllowedURLs {
+		if u, err := url.Parse(opaURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			setupLog.Error(fmt.Errorf("%w: %q (must be an absolute http or https URL)", ErrInvalidOPAAllowedURL, opaURL), "invalid configuration", sdklog.ErrorCode("INVALID_CONFIG"))
+			os.Exit(1)
+		}
+	}
+	if *resultWebhookAllowedURLs != "" {
+		controllerConfig.WithResultWebhookAllowedURLs(config.ParseURLs(*resultWebhookAllowedURLs))
+	}
+	for _, webhookURL := range controllerConfig.ResultWebhookAllowedURLs {
+		if u, err := url.Parse(webhookURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			setupLog.Error(fmt.Errorf("%w: %q (must be an absolute http or https URL)", ErrInvalidResultWebhookAllowedURL, webhookURL), "invalid configuration", sdklog.ErrorCode("INVALID_CONFIG"))
+			os.Exit(1)
+		}
+	}
+	if *deleteBackoffInitial > 0 || *deleteBackoffMax > 0 || *deleteBackoffMultiplier >= 1 || *deleteMaxRetries >= 0 {
+		initial, maxInterval := controllerConfig.DeleteBackoffInitialInterval, controllerConfig.DeleteBackoffMaxInterval
+		multiplier, maxRetries := controllerConfig.DeleteBackoffMultiplier, controllerConfig.DeleteMaxRetries
+		if *deleteBackoffInitial > 0 {
+			initial = *deleteBackoffInitial
+		}
+		if *deleteBackoffMax > 0 {
+			maxInterval = *deleteBackoffMax
+		}
+		if *deleteBackoffMultiplier >= 1 {
+			multiplier = *deleteBackoffMultiplier
+		}
+		if *deleteMaxRetries >= 0 {
+			maxRetries = *deleteMaxRetries
+		}
+		controllerConfig.WithDeleteBackoff(initial, maxInterval, multiplier, maxRetries)
+	}
+	if *degradedAfterFailures > 0 {
+		controllerConfig.WithDegradedAfterFailures(*degradedAfterFailures)
+	}
+	if *staleAfterEmptyRuns > 0 {
+		controllerConfig.WithStaleAfterEmptyRuns(*staleAfterEmptyRuns)
+	}
+	if controllerConfig.AllowedAPIGroups != nil {
+		setupLog.Info("Policies are limited to allowed API groups", sdklog.Strings("allowedAPIGroups", controllerConfig.AllowedAPIGroups))
+	}
+	if len(controllerConfig.OPAAllowedURLs) > 0 {
+		setupLog.Info("OPA conditions may query allowed decision URLs", sdklog.Strings("opaAllowedURLs", controllerConfig.OPAAllowedURLs), sdklog.Bool("opaIncludeData", controllerConfig.OPAIncludeData))
+	}
+	if len(controllerConfig.ResultWebhookAllowedURLs) > 0 {
+		setupLog.Info("Result webhooks may be delivered to allowed URLs", sdklog.Strings("resultWebhookAllowedURLs", controllerConfig.ResultWebhookAllowedURLs))
+	}
+	if len(controllerConfig.ProtectedNamespaces) > 0 {
+		setupLog.Info("Namespaces are protected from every policy", sdklog.Strings("protectedNamespaces", controllerConfig.ProtectedNamespaces))
+	}
+	if controllerConfig.SentinelConfigMap != "" || controllerConfig.SentinelURL != "" {
+		setupLog.Info("Deletions are suspended unless the deletion sentinel is enabled", sdklog.String("sentinelConfigMap", controllerConfig.SentinelConfigMap), sdklog.String("sentinelURL", controllerConfig.SentinelURL), sdklog.String("sentinelInterval", controllerConfig.SentinelInterval.String()))
+	}
 
 	setupLog.Info("Controller configuration",
 		sdklog.String("gcInterval", controllerConfig.GCInterval.String()),
 		sdklog.Int("maxDeletionsPerSecond", controllerConfig.MaxDeletionsPerSecond),
 		sdklog.Int("batchSize", controllerConfig.BatchSize),
-		sdklog.Int("maxConcurrentEvaluations", controllerConfig.MaxConcurrentEvaluations))
+		sdklog.Int("maxConcurrentEvaluations", controllerConfig.MaxConcurrentEvaluations),
+		sdklog.Int("evaluationWorkers", controllerConfig.EvaluationWorkers),
+		sdklog.Int("degradedAfterFailures", controllerConfig.DegradedAfterFailures),
+		sdklog.Int("staleAfterEmptyRuns", controllerConfig.StaleAfterEmptyRuns),
+		sdklog.String("defaultTargetNamespaceMode", controllerConfig.DefaultTargetNamespaceMode))
 
 	// Create status updater with configuration
 	statusUpdater := controller.NewStatusUpdaterWithConfig(dynamicClient, controllerConfig)
@@ -250,6 +427,57 @@ func main() {
 		controllerConfig,
 	)
 
+	// OPA conditions only query the allowed decision URLs
+	controller.ConfigureOPA(controllerConfig.OPAAllowedURLs, controllerConfig.OPAIncludeData)
+
+	// Result webhooks are only delivered to the allowed URLs
+	controller.ConfigureResultWebhooks(controllerConfig.ResultWebhookAllowedURLs)
+
+	// Serve protected resources reports of policies with reportProtected next to the metrics
+	if err := mgr.AddMetricsServerExtraHandler(controller.ProtectedReportPath, reconciler.GetProtectedReports()); err != nil {
+		setupLog.Error(err, "Error adding protected resources report handler", sdklog.ErrorCode("PROTECTED_REPORT_HANDLER_ERROR"))
+		os.Exit(1)
+	}
+
+	// Serve on-demand policy debug bundles next to the metrics, only when enabled since the
+	// metrics port is unauthenticated
+	if controllerConfig.DebugBundleEnabled {
+		if err := mgr.AddMetricsServerExtraHandler(controller.DebugBundlePath, reconciler.GetDebugBundleHandler()); err != nil {
+			setupLog.Error(err, "Error adding debug bundle handler", sdklog.ErrorCode("DEBUG_BUNDLE_HANDLER_ERROR"))
+			os.Exit(1)
+		}
+		setupLog.Warn("Serving policy debug bundles on the metrics port", sdklog.Operation("debug_bundle_config"), sdklog.String("path", controller.DebugBundlePath))
+	}
+
+	// Check the deletion sentinel in the background; deletions stay suspended until it passes
+	if sentinel := reconciler.GetDeletionSentinel(); sentinel != nil {
+		if err := mgr.Add(sentinel); err != nil {
+			setupLog.Error(err, "Error adding deletion sentinel", sdklog.ErrorCode("SENTINEL_SETUP_ERROR"))
+			os.Exit(1)
+		}
+	}
+
+	// Log the totals of each GC interval's policy reconciles as a single line
+	if err := mgr.Add(reconciler.GetSweepSummary()); err != nil {
+		setupLog.Error(err, "Error adding sweep summary", sdklog.ErrorCode("SWEEP_SUMMARY_SETUP_ERROR"))
+		os.Exit(1)
+	}
+
+	// Append every deletion to the audit log, written in the background and flushed on shutdown
+	if controllerConfig.AuditLogPath != "" {
+		auditSink, err := controller.NewFileAuditSink(controllerConfig.AuditLogPath, 0)
+		if err != nil {
+			setupLog.Error(err, "Error opening audit log", sdklog.ErrorCode("AUDIT_LOG_SETUP_ERROR"), sdklog.String("auditLogPath", controllerConfig.AuditLogPath))
+			os.Exit(1)
+		}
+		if err := mgr.Add(auditSink); err != nil {
+			setupLog.Error(err, "Error adding audit log writer", sdklog.ErrorCode("AUDIT_LOG_SETUP_ERROR"))
+			os.Exit(1)
+		}
+		reconciler.SetAuditSink(auditSink)
+		setupLog.Info("Deletions are appended to the audit log", sdklog.String("auditLogPath", controllerConfig.AuditLogPath))
+	}
+
 	// Create health checker with reconciler reference
 	healthChecker := controller.NewHealthChecker(reconciler)
 
@@ -288,6 +516,27 @@ func main() {
 			setupLog.Error(err, "Error creating webhook server", sdklog.ErrorCode("WEBHOOK_CREATE_ERROR"))
 			os.Exit(1)
 		}
+		webhookServer.SetDefaultTargetNamespaceMode(controllerConfig.DefaultTargetNamespaceMode)
+		webhookServer.SetAllowedAPIGroups(controllerConfig.AllowedAPIGroups)
+		webhookServer.SetAllowedOPAURLs(controllerConfig.OPAAllowedURLs)
+		webhookServer.SetAllowedResultWebhookURLs(controllerConfig.ResultWebhookAllowedURLs)
+		webhookServer.SetTargetDiscovery(gcwebhook.NewTargetDiscovery(kubeClient.Discovery(), controllerConfig.TargetDiscoveryTTL), controllerConfig.EnforceTargetDiscovery)
+		if controllerConfig.EnforceTargetDiscovery {
+			setupLog.Info("Policies whose target kind the cluster does not serve are denied", sdklog.Component("webhook"))
+		}
+		webhookServer.SetPolicyOverlapCheck(mgr.GetClient(), controllerConfig.DenyPolicyOverlap)
+		if controllerConfig.DenyPolicyOverlap {
+			setupLog.Info("Policies whose target overlaps an existing policy's are denied", sdklog.Component("webhook"))
+		}
+		if *policyTemplateDir != "" {
+			templates, err := gcwebhook.LoadPolicyTemplates(*policyTemplateDir)
+			if err != nil {
+				setupLog.Error(err, "Error loading policy templates", sdklog.ErrorCode("POLICY_TEMPLATE_LOAD_ERROR"))
+				os.Exit(1)
+			}
+			webhookServer.SetPolicyTemplates(templates)
+			setupLog.Info("Loaded policy templates", sdklog.String("directory", *policyTemplateDir), sdklog.Int("templates", len(templates)), sdklog.Component("webhook"))
+		}
 
 		// Check if TLS files exist
 		certExists := false
@@ -305,6 +554,10 @@ func main() {
 				setupLog.Error(fmt.Errorf("%w (cert: %s, key: %s). TLS is required for production. Use --insecure-webhook flag only for testing", ErrWebhookTLSCertificatesMissing, *webhookCertFile, *webhookKeyFile), "TLS certificates missing", sdklog.ErrorCode("TLS_CERT_MISSING"))
 				os.Exit(1)
 			}
+		} else if err := mgr.AddReadyzCheck("webhook-cert", func(*http.Request) error { return webhookServer.CheckCertificate() }); err != nil {
+			// The pod's readiness probe then also fails while the webhook certificate is unusable
+			setupLog.Error(err, "Error adding webhook certificate readiness check", sdklog.ErrorCode("READY_CHECK_ERROR"))
+			os.Exit(1)
 		}
 	}
 
@@ -349,6 +602,9 @@ func main() {
 	// mgr.Start() errors are typically non-fatal (e.g., context canceled on shutdown)
 	// We don't call os.Exit here to allow graceful shutdown via defer cancel()
 	setupLog.Info("Starting GC controller manager", sdklog.Operation("start"))
+	if controllerConfig.PushgatewayURL != "" {
+		defer pushFinalMetrics(controllerConfig.PushgatewayURL)
+	}
 	if err := mgr.Start(ctx); err != nil {
 		setupLog.Error(err, "Error starting manager", sdklog.ErrorCode("MANAGER_START_ERROR"))
 		// Don't call os.Exit here - let the defer cancel() run for cleanup
@@ -357,3 +613,15 @@ func main() {
 
 	setupLog.Info("GC controller shutdown complete", sdklog.Operation("shutdown"))
 }
+
+// pushFinalMetrics pushes the final metrics snapshot to the Pushgateway. A failed push is
+// logged and otherwise ignored so it never changes how the controller exits.
+func pushFinalMetrics(url string) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultPushTimeout)
+	defer cancel()
+	if err := controller.PushMetrics(ctx, url, controller.DefaultPushJob); err != nil {
+		setupLog.Warn("Failed to push metrics to Pushgateway", sdklog.String("url", url), sdklog.Error(err))
+		return
+	}
+	setupLog.Info("Pushed final metrics to Pushgateway", sdklog.String("url", url))
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package main implements gc-simulate, which evaluates a GarbageCollectionPolicy file
+// against a live cluster and prints the resources it would delete, without creating the
+// policy or deleting anything.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"k8s.io/client-go/dynamic"
+	ctrlconfig "sigs.k8s.io/controller-runtime/pkg/client/config"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/config"
+	"github.com/kube-zen/zen-gc/pkg/controller"
+	"github.com/kube-zen/zen-gc/pkg/validation"
+)
+
+func main() {
+	policyFile := flag.String("f", "", "Policy YAML file to simulate")
+	timeout := flag.Duration("timeout", 2*time.Minute, "Maximum time to spend listing and evaluating resources")
+	opaAllowedURLs := flag.String("opa-allowed-urls", "", "Comma-separated OPA decision URLs opa conditions may query; others fail closed (default: none)")
+	flag.Parse()
+
+	if *policyFile == "" {
+		fmt.Fprintln(os.Stderr, "Usage: gc-simulate -f policy.yaml [--kubeconfig path]")
+		os.Exit(2)
+	}
+	// Keep the controller's logs out of the table unless asked for
+	if os.Getenv("LOG_LEVEL") == "" {
+		_ = os.Setenv("LOG_LEVEL", "error")
+	}
+
+	data, err := os.ReadFile(*policyFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading policy: %v\n", err)
+		os.Exit(1)
+	}
+	var policy v1alpha1.GarbageCollectionPolicy
+	if err := yaml.Unmarshal(data, &policy); err != nil {
+		fmt.Fprintf(os.Stderr, "Error parsing policy: %v\n", err)
+		os.Exit(1)
+	}
+	if err := validation.ValidatePolicy(&policy); err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid policy: %v\n", err)
+		os.Exit(1)
+	}
+
+	restCfg, err := ctrlconfig.GetConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading kubeconfig: %v\n", err)
+		os.Exit(1)
+	}
+	client, err := dynamic.NewForConfig(restCfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating client: %v\n", err)
+		os.Exit(1)
+	}
+
+	controller.ConfigureOPA(config.ParseURLs(*opaAllowedURLs), false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	deletions, err := controller.SimulatePolicy(ctx, client, &policy)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error simulating policy: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(deletions) > 0 {
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+		fmt.Fprintln(w, "NAMESPACE\tNAME\tREASON")
+		for _, deletion := range deletions {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", deletion.Namespace, deletion.Name, deletion.Reason)
+		}
+		_ = w.Flush()
+		fmt.Println()
+	}
+	fmt.Printf("%d resources would be deleted by policy %s\n", len(deletions), policy.Name)
+}
@@ -0,0 +1,49 @@
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ErrUnsupportedConversionHub indicates a conversion to or from a hub type this version
+// cannot convert.
+var ErrUnsupportedConversionHub = errors.New("unsupported conversion hub")
+
+// v1alpha1 is the only served version, so it is also the conversion hub every version
+// converts through. When a newer version is added it becomes the hub, and the ConvertTo
+// and ConvertFrom methods below map v1alpha1 onto it.
+var (
+	_ conversion.Hub         = &GarbageCollectionPolicy{}
+	_ conversion.Convertible = &GarbageCollectionPolicy{}
+)
+
+// Hub marks GarbageCollectionPolicy as the conversion hub.
+func (*GarbageCollectionPolicy) Hub() {}
+
+// ConvertTo converts this policy to the hub version. While v1alpha1 is the hub this is
+// an identity conversion.
+func (p *GarbageCollectionPolicy) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*GarbageCollectionPolicy)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrUnsupportedConversionHub, dstRaw)
+	}
+	p.DeepCopyInto(dst)
+	dst.APIVersion = SchemeGroupVersion.String()
+	dst.Kind = "GarbageCollectionPolicy"
+	return nil
+}
+
+// ConvertFrom converts the hub version to this policy. While v1alpha1 is the hub this is
+// an identity conversion.
+func (p *GarbageCollectionPolicy) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*GarbageCollectionPolicy)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrUnsupportedConversionHub, srcRaw)
+	}
+	src.DeepCopyInto(p)
+	p.APIVersion = SchemeGroupVersion.String()
+	p.Kind = "GarbageCollectionPolicy"
+	return nil
+}
@@ -2,6 +2,7 @@ package v1alpha1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // +kubebuilder:object:root=true
@@ -41,19 +42,97 @@ type GarbageCollectionPolicySpec struct {
 	// Optional: Additional conditions that must be met before deletion
 	Conditions *ConditionsSpec `json:"conditions,omitempty"`
 
+	// Optional: Group matched resources by a logical key and only delete duplicates,
+	// keeping the newest resource of each group
+	Dedup *DedupSpec `json:"dedup,omitempty"`
+
+	// Optional: keep the newest resources of each group and only delete older ones,
+	// e.g. the last three Jobs of each CronJob
+	Retention *RetentionSpec `json:"retention,omitempty"`
+
 	// GC behavior configuration
 	Behavior BehaviorSpec `json:"behavior,omitempty"`
 
+	// Optional: only delete after a point in time and/or during recurring windows
+	// +optional
+	Schedule *ScheduleSpec `json:"schedule,omitempty"`
+
 	// EvaluationInterval is the interval between policy evaluations.
 	// If not specified, uses the controller's default GC interval.
 	// Format: duration string (e.g., "1m", "30s", "2h")
 	// +optional
 	EvaluationInterval *metav1.Duration `json:"evaluationInterval,omitempty"`
 
+	// Priority decides which policy claims a resource matched by several policies.
+	// A policy with higher priority may take over a claim whose deletion has not
+	// succeeded yet; otherwise the first policy to start deleting wins.
+	// Defaults to 0.
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
 	// Paused indicates whether the policy evaluation is paused.
 	// When true, the controller will skip evaluating this policy.
 	// Defaults to false.
 	Paused bool `json:"paused,omitempty"`
+
+	// ObserveWhenPaused keeps listing and counting matched and pending resources while
+	// the policy is paused, so status and metrics stay current. Nothing is deleted.
+	// Defaults to false.
+	// +optional
+	ObserveWhenPaused bool `json:"observeWhenPaused,omitempty"`
+
+	// MissingFields decides how TTL and field conditions treat fields a resource lacks:
+	// Skip (default) leaves the resource alone, Default reads the field as its zero value.
+	// +optional
+	MissingFields string `json:"missingFields,omitempty"`
+}
+
+// ScheduleSpec restricts when a policy deletes. Outside its schedule the policy is not
+// evaluated and reports the Scheduled phase.
+type ScheduleSpec struct {
+	// ActiveAfter is the time before which the policy deletes nothing
+	// +optional
+	ActiveAfter *metav1.Time `json:"activeAfter,omitempty"`
+
+	// ActiveWindows are recurring windows the policy deletes in, as "<days> <HH:MM>-<HH:MM>",
+	// e.g. "Mon-Fri 22:00-06:00" or "* 01:00-05:00". Days are "*" or comma-separated day
+	// names and ranges; a window whose end is not after its start runs past midnight into
+	// the next day. With no windows the policy deletes at any time (after activeAfter).
+	// +optional
+	ActiveWindows []string `json:"activeWindows,omitempty"`
+
+	// IANA time zone of activeWindows (default: UTC)
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// DedupSpec groups matched resources by a key computed from labels and fields.
+// The newest resource of each group is never deleted; the other members are
+// duplicates and remain subject to TTL and conditions. Resources missing any
+// key label or field are not duplicates and are never deleted.
+type DedupSpec struct {
+	// Label keys whose values form the key
+	KeyLabels []string `json:"keyLabels,omitempty"`
+
+	// Field paths whose values form the key, e.g. "spec.image"
+	KeyFields []string `json:"keyFields,omitempty"`
+
+	// Scope of a group: Namespace (default) keeps the newest per namespace and key;
+	// Cluster keeps the newest per key across all namespaces
+	Scope string `json:"scope,omitempty"`
+}
+
+// RetentionSpec keeps the newest keepLast matched resources of each group, by creation
+// time. Only the older resources may be deleted, and they remain subject to TTL and
+// conditions. Groups never span namespaces.
+type RetentionSpec struct {
+	// Number of newest resources kept per group
+	KeepLast int `json:"keepLast"`
+
+	// Field paths whose values form the group key, e.g. "metadata.labels['app']" or
+	// "metadata.ownerReferences[0].uid". Resources missing any of them share one group per
+	// namespace. With no groupBy, the matched resources of a namespace form one group.
+	GroupBy []string `json:"groupBy,omitempty"`
 }
 
 // TargetResourceSpec defines the target resource for GC.
@@ -71,8 +150,25 @@ type TargetResourceSpec struct {
 	// Optional: Label selector to filter resources
 	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
 
+	// Optional: Label selectors combined with OR; a resource matches if it satisfies any of them.
+	// Combined with labelSelector (if set) using AND.
+	LabelSelectors []metav1.LabelSelector `json:"labelSelectors,omitempty"`
+
+	// Optional: Annotation keys projected as virtual labels for labelSelector and labelSelectors
+	// matching, for resources that carry selector values in annotations. Real labels take
+	// precedence over annotations with the same key.
+	VirtualLabelAnnotations []string `json:"virtualLabelAnnotations,omitempty"`
+
 	// Optional: Field selector (for resources that support it)
 	FieldSelector *FieldSelectorSpec `json:"fieldSelector,omitempty"`
+
+	// Optional: Exact metadata.uid values; only resources with a listed UID are considered.
+	// Combined with the other selectors using AND. Empty means no restriction.
+	UIDs []string `json:"uids,omitempty"`
+
+	// Optional: Namespaces never considered, as names or simple globs (e.g., "kube-*").
+	// Applied after namespace; cluster-scoped resources are never excluded.
+	ExcludeNamespaces []string `json:"excludeNamespaces,omitempty"`
 }
 
 // FieldSelectorSpec defines field-based selection.
@@ -87,23 +183,66 @@ type TTLSpec struct {
 	// Option 1: Fixed TTL (seconds after creation)
 	SecondsAfterCreation *int64 `json:"secondsAfterCreation,omitempty"`
 
+	// Fixed TTL after creation as a duration, e.g. "72h", "30d", "2w"
+	// Alternative to SecondsAfterCreation; the two cannot both be set
+	DurationAfterCreation string `json:"durationAfterCreation,omitempty"`
+
 	// Option 2: Dynamic TTL from resource fields
 	// JSONPath to TTL field, e.g., "spec.ttlSecondsAfterCreation"
 	FieldPath string `json:"fieldPath,omitempty"`
 
-	// Option 3: Mapped TTL based on resource field values
-	// Used with fieldPath to map field values to TTL seconds
+	// Label whose value is mapped to a TTL, e.g. "environment"
+	// Alternative to FieldPath for mappings; the two cannot both be set
+	LabelKey string `json:"labelKey,omitempty"`
+
+	// Option 3: Mapped TTL based on resource field or label values
+	// Used with fieldPath or labelKey to map values to TTL seconds
 	Mappings map[string]int64 `json:"mappings,omitempty"`
 
 	// Default TTL for mappings (used when no mapping matches)
 	Default *int64 `json:"default,omitempty"`
 
 	// Option 4: Relative to another timestamp field
-	// JSONPath to timestamp field, e.g., "status.lastProcessedAt"
+	// JSONPath to timestamp field, e.g., "status.lastProcessedAt" or
+	// "status.conditions[type=Ready].lastTransitionTime"
 	RelativeTo string `json:"relativeTo,omitempty"`
 
 	// Seconds after the relativeTo timestamp
 	SecondsAfter *int64 `json:"secondsAfter,omitempty"`
+
+	// Duration after the relativeTo timestamp, e.g. "12h", "7d"
+	// Alternative to SecondsAfter; the two cannot both be set
+	DurationAfter string `json:"durationAfter,omitempty"`
+
+	// Option 5: Earliest of a creation-based and a field-based TTL
+	// Exclusive with the other options
+	Earliest *EarliestTTLSpec `json:"earliest,omitempty"`
+
+	// Option 6: TTL after creation chosen per resource between bounds read from its fields
+	// Exclusive with the other options
+	Range *RangeTTLSpec `json:"range,omitempty"`
+}
+
+// RangeTTLSpec gives each resource a TTL between a minimum and maximum read from its own
+// fields. The value is derived from a hash of the resource's UID, so it is spread across
+// the range but stable for a given resource across evaluations.
+type RangeTTLSpec struct {
+	// Field path of the minimum TTL in seconds, e.g. "spec.minTtl"
+	MinFieldPath string `json:"minFieldPath"`
+
+	// Field path of the maximum TTL in seconds, e.g. "spec.maxTtl"
+	MaxFieldPath string `json:"maxFieldPath"`
+}
+
+// EarliestTTLSpec expires a resource at whichever of its creation-based and
+// field-based expirations comes first, so it never outlives either rule.
+type EarliestTTLSpec struct {
+	// Creation-based rule: seconds after creation
+	SecondsAfterCreation *int64 `json:"secondsAfterCreation"`
+
+	// Field-based rule: fieldPath (with optional mappings/default) or relativeTo/secondsAfter.
+	// If the field-based expiration cannot be computed, only the creation-based rule applies.
+	Field *TTLSpec `json:"field"`
 }
 
 // ConditionsSpec defines additional conditions for deletion.
@@ -111,6 +250,10 @@ type ConditionsSpec struct {
 	// Only delete resources in specific phases/states
 	Phase []string `json:"phase,omitempty"`
 
+	// Only delete resources not in these phases, e.g. ["Running"]. A resource without a
+	// phase is not in any. Combined with Phase, a resource must be in Phase and not in NotPhase
+	NotPhase []string `json:"notPhase,omitempty"`
+
 	// Only delete if resource has specific labels
 	HasLabels []LabelCondition `json:"hasLabels,omitempty"`
 
@@ -119,13 +262,324 @@ type ConditionsSpec struct {
 
 	// Complex condition logic (AND)
 	And []FieldCondition `json:"and,omitempty"`
+
+	// Complex condition logic (OR): at least one field condition must match
+	Or []FieldCondition `json:"or,omitempty"`
+
+	// Only delete if at least one nested condition group matches. Each group is ANDed
+	// internally and the result is ANDed with the other fields at this level. Groups may
+	// nest up to five levels deep and cannot hold policy-level conditions such as
+	// countTrend, backupGate, metricThreshold, dataDrift, ownerChain, danglingOwner, or
+	// stuckTerminating.
+	AnyOf []ConditionsSpec `json:"anyOf,omitempty"`
+
+	// Only delete if the number of labels with a key prefix exceeds a threshold
+	LabelCount *LabelCountCondition `json:"labelCount,omitempty"`
+
+	// Only delete while the policy's matched count follows a trend across recent evaluations
+	CountTrend *CountTrendCondition `json:"countTrend,omitempty"`
+
+	// Only delete if a hash of selected spec fields is in a known-bad set
+	SpecHash *SpecHashCondition `json:"specHash,omitempty"`
+
+	// Only delete if date fields compare against the current time or date (AND)
+	Dates []DateCondition `json:"dates,omitempty"`
+
+	// Only delete if the lengths of array fields compare against values (AND)
+	ArrayLengths []ArrayLengthCondition `json:"arrayLengths,omitempty"`
+
+	// Only delete if semantic versions read from labels or fields fall within ranges (AND)
+	Semver []SemverCondition `json:"semver,omitempty"`
+
+	// Only delete resources created by their namespace's default service account
+	CreatedByDefaultServiceAccount *CreatorCondition `json:"createdByDefaultServiceAccount,omitempty"`
+
+	// Only delete resources without ownerReferences, never owned children
+	OrphansOnly bool `json:"orphansOnly,omitempty"`
+
+	// Only delete resources whose chain of controlling owners has a given depth and root kind
+	OwnerChain *OwnerChainCondition `json:"ownerChain,omitempty"`
+
+	// Only delete resources whose every ownerReference points at an owner that no longer
+	// exists, catching dependents the Kubernetes garbage collector left behind
+	DanglingOwner bool `json:"danglingOwner,omitempty"`
+
+	// Only delete resources created before the last successful backup
+	BackupGate *BackupGateCondition `json:"backupGate,omitempty"`
+
+	// Only delete while an external metric query result crosses a threshold
+	MetricThreshold *MetricThresholdCondition `json:"metricThreshold,omitempty"`
+
+	// Only delete if a JMESPath expression evaluated against the resource returns a truthy
+	// value (not null, false, "", [], or {})
+	JMESPath string `json:"jmespath,omitempty"`
+
+	// Only delete if a Lucene-style query over resource fields matches,
+	// e.g. "status.phase:Failed AND NOT metadata.labels.keep:true"
+	Query string `json:"query,omitempty"`
+
+	// Optional: Only delete if an external Open Policy Agent decision allows it
+	OPA *OPACondition `json:"opa,omitempty"`
+
+	// Only delete ConfigMaps or Secrets whose data differs from a golden version
+	DataDrift *DataDriftCondition `json:"dataDrift,omitempty"`
+
+	// Only match resources stuck terminating: deletionTimestamp set for longer than a
+	// duration while the resource is still present
+	StuckTerminating *StuckTerminatingCondition `json:"stuckTerminating,omitempty"`
+
+	// Only match resources that lack a required label key and are older than a grace
+	// period, for compliance sweeps
+	MissingLabel *MissingLabelCondition `json:"missingLabel,omitempty"`
+
+	// Only match resources that have not been modified for at least a duration, a quiet
+	// period that spares resources still being changed
+	Unchanged *UnchangedCondition `json:"unchanged,omitempty"`
+}
+
+// OPACondition gates deletion on an Open Policy Agent decision.
+// The resource is sent as "input" to the OPA Data API and deletion is only
+// allowed when the decision evaluates to true. Query failures fail closed.
+// The URL must be in the controller's --opa-allowed-urls.
+type OPACondition struct {
+	// URL of the OPA Data API decision, e.g. "http://opa.opa:8181/v1/data/gc/allow"
+	URL string `json:"url"`
+
+	// Timeout in seconds for a single decision query (default: 5)
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+
+	// How long a decision is cached for an unchanged resource (default: 30)
+	CacheTTLSeconds *int64 `json:"cacheTTLSeconds,omitempty"`
+}
+
+// LabelCountCondition matches resources carrying more labels with a key prefix
+// than a threshold, e.g. accumulated revision labels.
+type LabelCountCondition struct {
+	// Label key prefix to count, e.g. "revision.example.com/"
+	Prefix string `json:"prefix"`
+
+	// Resource matches when the number of labels with Prefix is greater than Threshold
+	Threshold *int32 `json:"threshold"`
+}
+
+// CountTrendCondition gates all deletions of a policy on how its matched resource
+// count has moved over the last Runs evaluations.
+type CountTrendCondition struct {
+	// Trend the counts must follow: Increasing, Decreasing, Stable, NonDecreasing, NonIncreasing
+	Trend string `json:"trend"`
+
+	// Number of most recent evaluations the trend is observed over (default: 3, max: 20)
+	Runs int32 `json:"runs,omitempty"`
+}
+
+// SpecHashCondition matches resources whose selected fields hash to a known-bad value,
+// e.g. resources produced by a faulty generator or template.
+type SpecHashCondition struct {
+	// Field paths to hash, in order (e.g. "spec.template", "spec.replicas")
+	Fields []string `json:"fields"`
+
+	// Known-bad SHA-256 hashes (hex, optionally prefixed with "sha256:")
+	BadHashes []string `json:"badHashes,omitempty"`
+
+	// ConfigMap in the policy's namespace listing known-bad hashes, one per line. Read once
+	// per evaluation; if it cannot be read, the evaluation fails and nothing is deleted.
+	BadHashesConfigMap *BadHashesConfigMapRef `json:"badHashesConfigMap,omitempty"`
+}
+
+// BadHashesConfigMapRef references a ConfigMap in the policy's namespace holding known-bad
+// spec hashes.
+type BadHashesConfigMapRef struct {
+	// Name of the ConfigMap
+	Name string `json:"name"`
+
+	// Data key holding the hashes. Empty reads every key.
+	Key string `json:"key,omitempty"`
+}
+
+// OwnerChainCondition matches resources by the chain of controllers above them, following
+// each object's controller ownerReference upwards, e.g. Pods created by the Jobs of a CronJob
+// (depth 2, root kind CronJob) rather than Pods owned directly by a Job.
+type OwnerChainCondition struct {
+	// Kind of the topmost owner, which must not have a controller itself, e.g. "CronJob"
+	RootKind string `json:"rootKind"`
+
+	// Number of controller hops from the resource to the root (1 = directly owned, max 5)
+	Depth int32 `json:"depth"`
+}
+
+// DataDriftCondition matches resources whose data hashes differently from a golden
+// version, e.g. drifted copies of a ConfigMap or Secret distributed to many namespaces.
+// Set exactly one of ExpectedHash or Reference.
+type DataDriftCondition struct {
+	// Expected SHA-256 of the resource's data (hex, optionally prefixed with "sha256:")
+	ExpectedHash string `json:"expectedHash,omitempty"`
+
+	// Golden object of the target kind whose data is expected. Read once per evaluation;
+	// if it cannot be read, the evaluation fails and nothing is deleted.
+	Reference *DataDriftReference `json:"reference,omitempty"`
+}
+
+// StuckTerminatingCondition matches resources whose deletion has been pending for
+// longer than a duration, typically because a finalizer's controller is gone.
+// Deleting such a resource again is a no-op; listing finalizers in RemoveFinalizers
+// lets the controller remove them so the API server can complete the deletion.
+type StuckTerminatingCondition struct {
+	// Minimum time in seconds since the resource's deletionTimestamp
+	OlderThanSeconds *int64 `json:"olderThanSeconds"`
+
+	// Finalizers that may be removed from matched resources. Finalizers not listed are
+	// left in place, so the resource stays until their owners remove them.
+	RemoveFinalizers []string `json:"removeFinalizers,omitempty"`
+}
+
+// MissingLabelCondition matches resources without a required label key once they are old
+// enough to have been labeled. A label with an empty value counts as present.
+type MissingLabelCondition struct {
+	// Required label key, e.g. "team"
+	Key string `json:"key"`
+
+	// Minimum age in seconds since creation, so new resources have time to be labeled
+	OlderThanSeconds *int64 `json:"olderThanSeconds"`
+}
+
+// UnchangedCondition matches resources whose last modification is older than a quiet
+// period. The last modification is the latest metadata.managedFields time, or the
+// timestamp at FieldPath when set.
+type UnchangedCondition struct {
+	// Minimum time in seconds since the resource was last modified
+	ForSeconds *int64 `json:"forSeconds"`
+
+	// Optional field path of an RFC3339 last-modified timestamp to use instead of
+	// metadata.managedFields, e.g. "status.lastUpdateTime"
+	FieldPath string `json:"fieldPath,omitempty"`
+}
+
+// DataDriftReference names the golden object of a DataDriftCondition.
+type DataDriftReference struct {
+	// Name of the golden object
+	Name string `json:"name"`
+
+	// Namespace of the golden object. Defaults to the policy's namespace.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// DateCondition compares a date stored in a resource field against the current
+// time or date, for dates in application-specific (non-RFC3339) formats.
+type DateCondition struct {
+	// Path to a string field holding the date, e.g. "spec.reportDate"
+	FieldPath string `json:"fieldPath"`
+
+	// Go time layout of the field, e.g. "2006-01-02" or "02/01/2006 15:04"
+	Layout string `json:"layout"`
+
+	// OlderThan matches dates before (reference - ageSeconds); NewerThan matches dates after it
+	Operator string `json:"operator"`
+
+	// Reference point: Now (default) or Today (start of the current day)
+	Reference string `json:"reference,omitempty"`
+
+	// Age in seconds subtracted from the reference (default: 0)
+	AgeSeconds *int64 `json:"ageSeconds,omitempty"`
+
+	// IANA time zone for layouts without a zone and for the start of Today (default: UTC)
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// ArrayLengthCondition compares the number of elements of an array field with a value,
+// e.g. the length of status.conditions. A missing field has length 0; a field that is
+// not an array never matches.
+type ArrayLengthCondition struct {
+	// Path to the array field, e.g. "spec.rules"
+	FieldPath string `json:"fieldPath"`
+
+	// Equals, NotEquals, GreaterThan, GreaterThanOrEqual, LessThan, or LessThanOrEqual
+	Operator string `json:"operator"`
+
+	// Length the array is compared with
+	Value int64 `json:"value"`
+}
+
+// SemverCondition reads a semantic version from a label or field and matches when it
+// satisfies a range, e.g. "<2.0.0" to delete releases older than a major version. Missing
+// values and values that are not semantic versions never match.
+type SemverCondition struct {
+	// Label holding the version, e.g. "app.kubernetes.io/version"; exactly one of
+	// labelKey and fieldPath is required
+	LabelKey string `json:"labelKey,omitempty"`
+
+	// Path to a string field holding the version, e.g. "spec.version"
+	FieldPath string `json:"fieldPath,omitempty"`
+
+	// Comparators (<, <=, >, >=, =, !=) the version must all satisfy, separated by commas
+	// or spaces, e.g. "<2.0.0" or ">=1.0.0, <2.0.0"
+	Range string `json:"range"`
+}
+
+// CreatorCondition matches resources created by the default service account of
+// their namespace ("system:serviceaccount:<namespace>:default"). Resources without
+// creator information never match.
+type CreatorCondition struct {
+	// Annotation holding the creator's username, typically set by a mutating admission
+	// policy (default: "gc.kube-zen.io/created-by")
+	CreatorAnnotation string `json:"creatorAnnotation,omitempty"`
+
+	// When the annotation is missing, use the manager of the earliest managedFields entry
+	// (requires clients to set their field manager to their username)
+	UseManagedFields bool `json:"useManagedFields,omitempty"`
+}
+
+// BackupGateCondition holds deletions of resources not yet covered by a backup.
+// The last successful backup time is read from a ConfigMap or custom resource
+// written by the backup tooling; only resources created before it are deleted.
+// If the backup status cannot be read, all deletions are held.
+type BackupGateCondition struct {
+	// API version of the backup status object (default: "v1")
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// Kind of the backup status object (default: "ConfigMap")
+	Kind string `json:"kind,omitempty"`
+
+	// Namespace of the backup status object
+	Namespace string `json:"namespace"`
+
+	// Name of the backup status object
+	Name string `json:"name"`
+
+	// Path to an RFC3339 timestamp of the last successful backup, e.g. "data.lastSuccessfulBackup"
+	FieldPath string `json:"fieldPath"`
+
+	// How long the backup status is cached (default: 60)
+	CacheTTLSeconds *int64 `json:"cacheTTLSeconds,omitempty"`
+}
+
+// MetricThresholdCondition holds all deletions of a policy until a Prometheus query
+// result crosses a threshold, e.g. only clean up caches while memory usage is above 80%.
+// The query must return a single sample. If it cannot be evaluated, all deletions are held.
+type MetricThresholdCondition struct {
+	// Base URL of the Prometheus-compatible API, e.g. "http://prometheus.monitoring:9090"
+	URL string `json:"url"`
+
+	// PromQL query returning a scalar or a single-sample vector
+	Query string `json:"query"`
+
+	// Comparison of the query result with Value: GreaterThan, GreaterThanOrEqual, LessThan, LessThanOrEqual
+	Operator string `json:"operator"`
+
+	// Threshold as a decimal number, e.g. "0.8"
+	Value string `json:"value"`
+
+	// Timeout in seconds for a single query (default: 5)
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+
+	// How long a query result is cached (default: 30)
+	CacheTTLSeconds *int64 `json:"cacheTTLSeconds,omitempty"`
 }
 
 // LabelCondition defines a label-based condition.
 type LabelCondition struct {
 	Key      string `json:"key"`
 	Value    string `json:"value,omitempty"`
-	Operator string `json:"operator,omitempty"` // Exists, Equals, In, NotIn
+	Operator string `json:"operator,omitempty"` // Exists, Equals, In, NotIn, GreaterThan, GreaterThanOrEqual, LessThan, LessThanOrEqual
 }
 
 // AnnotationCondition defines an annotation-based condition.
@@ -137,7 +591,7 @@ type AnnotationCondition struct {
 // FieldCondition defines a field-based condition.
 type FieldCondition struct {
 	FieldPath string   `json:"fieldPath"`
-	Operator  string   `json:"operator"` // Equals, NotEquals, In, NotIn, Exists
+	Operator  string   `json:"operator"` // Equals, NotEquals, In, NotIn, Exists, GreaterThan, GreaterThanOrEqual, LessThan, LessThanOrEqual
 	Value     string   `json:"value,omitempty"`
 	Values    []string `json:"values,omitempty"`
 }
@@ -147,9 +601,22 @@ type BehaviorSpec struct {
 	// Rate limiting: max deletions per second
 	MaxDeletionsPerSecond int `json:"maxDeletionsPerSecond,omitempty"`
 
+	// Optional: hard ceiling on deletions in a single evaluation run, guarding against an
+	// overly broad selector. Remaining candidates are retried in the next run. 0 means no cap.
+	MaxDeletionsPerRun int `json:"maxDeletionsPerRun,omitempty"`
+
+	// Optional: hard ceiling on the API calls (deletes, gets, patches) a single evaluation
+	// run makes, bounding its cost to the API server. Once spent the run stops and the
+	// remaining candidates are retried in the next run. 0 means no cap.
+	MaxAPICalls int `json:"maxApiCalls,omitempty"`
+
 	// Batch size: delete resources in batches
 	BatchSize int `json:"batchSize,omitempty"`
 
+	// Optional: pause between deletion batches to coarsely pace bursts of API writes, on top
+	// of the per-resource maxDeletionsPerSecond. 0 (default) means no pause.
+	BatchInterval *metav1.Duration `json:"batchInterval,omitempty"`
+
 	// Dry run: don't actually delete, just log
 	DryRun bool `json:"dryRun,omitempty"`
 
@@ -159,26 +626,197 @@ type BehaviorSpec struct {
 	// Deletion propagation policy
 	PropagationPolicy string `json:"propagationPolicy,omitempty"` // Foreground, Background, Orphan
 
+	// Optional: propagation policy per resource kind (e.g. "Job": "Foreground"), overriding
+	// PropagationPolicy for resources of that kind, including alsoDelete dependents
+	PropagationByKind map[string]string `json:"propagationByKind,omitempty"`
+
 	// Grace period in seconds before force deletion
 	GracePeriodSeconds *int64 `json:"gracePeriodSeconds,omitempty"`
+
+	// Optional: delete only the version of each resource that was evaluated. The delete carries
+	// the cached resourceVersion as a precondition, so a resource modified since it was matched
+	// is kept and evaluated again on the next run.
+	Preconditions bool `json:"preconditions,omitempty"`
+
+	// Optional: warn before deleting. A resource first found due is annotated with
+	// gc.kube-zen.io/delete-after and a PendingDeletion event, and only deleted by a later
+	// evaluation once this many seconds have passed
+	DeletionNoticeSeconds *int64 `json:"deletionNoticeSeconds,omitempty"`
+
+	// Optional: write each resource's manifest to the controller's snapshot directory before
+	// deletion, for short-term manual recovery. Requires the controller's --snapshot-dir;
+	// Secrets are never snapshotted
+	Snapshot bool `json:"snapshot,omitempty"`
+
+	// Maximum number of snapshots kept per policy; oldest are pruned first (default: 100)
+	SnapshotRetention int `json:"snapshotRetention,omitempty"`
+
+	// Optional: POST a summary of each evaluation (matched/deleted/pending/errors) to a URL
+	ResultWebhook *ResultWebhookSpec `json:"resultWebhook,omitempty"`
+
+	// Optional: deletion reasons (e.g. ttl_expired) that are allowed to delete. Resources
+	// that would be deleted for any other reason are kept as pending. Empty allows all.
+	AllowedReasons []string `json:"allowedReasons,omitempty"`
+
+	// Optional: dependents deleted explicitly before each parent, including resources in other
+	// namespaces or without ownerReferences. Namespace and selector values may reference the
+	// parent as ${parent.name} and ${parent.namespace}; an empty namespace means the parent's.
+	AlsoDelete []TargetResourceSpec `json:"alsoDelete,omitempty"`
+
+	// Optional: stamp each matched resource that is not deleted with the time and reason of
+	// the controller's latest decision. Writes are bounded and skipped in dry-run.
+	// Defaults to false.
+	AnnotateDecisions bool `json:"annotateDecisions,omitempty"`
+
+	// Optional: keep a report of the matched resources the policy deliberately did not
+	// delete (dedup keepers, disallowed reasons, held deletions) and why, served by the
+	// controller for audits. Defaults to false.
+	ReportProtected bool `json:"reportProtected,omitempty"`
+
+	// Optional: only delete resources the policy would also have deleted in its previous
+	// run, so matches that appear for a single run (e.g. from selector drift) are skipped.
+	// The first run after enabling deletes nothing. Defaults to false.
+	ConfirmDeletions bool `json:"confirmDeletions,omitempty"`
+
+	// Optional: never delete a group of matched resources below a minimum count
+	MinRemaining *MinRemainingSpec `json:"minRemaining,omitempty"`
+
+	// Optional: for cluster-wide targets, evaluate at most this many namespaces per run,
+	// rotating through all namespaces over successive runs to bound the cost of each run.
+	// 0 evaluates every namespace in every run.
+	NamespacesPerRun int `json:"namespacesPerRun,omitempty"`
+
+	// Optional: order of deletions within a run, which decides what a run capped by
+	// maxDeletionsPerRun or rate limits reclaims first. OldestFirst (default) and
+	// NewestFirst sort by creation timestamp. ReverseDependency deletes resources before
+	// the resources they reference as owners, leaves first, so cascades have nothing left
+	// to do, and is otherwise oldest first.
+	DeletionOrder string `json:"deletionOrder,omitempty"` // OldestFirst, NewestFirst, ReverseDependency
+}
+
+// MinRemainingSpec keeps a minimum number of matched resources in each group, like a
+// PodDisruptionBudget's minAvailable. Deletions that would drop a group below the minimum
+// are held, oldest resources being deleted first. Resources missing any key label or
+// field form one group of their own per scope.
+type MinRemainingSpec struct {
+	// Minimum resources kept per group: an absolute count (e.g. 3) or a percentage of the
+	// group's matched resources (e.g. "50%", rounded up)
+	Value intstr.IntOrString `json:"value"`
+
+	// Label keys whose values form the group key. With no key labels or fields, all
+	// matched resources in the scope form one group.
+	KeyLabels []string `json:"keyLabels,omitempty"`
+
+	// Field paths whose values form the group key, e.g. "spec.nodeName"
+	KeyFields []string `json:"keyFields,omitempty"`
+
+	// Scope of a group: Namespace (default) groups per namespace and key; Cluster groups
+	// per key across all namespaces
+	Scope string `json:"scope,omitempty"`
+}
+
+const (
+	// LastEvaluatedAnnotation records when the controller last decided not to delete a resource.
+	LastEvaluatedAnnotation = "gc.kube-zen.io/last-evaluated"
+
+	// LastEvaluatedReasonAnnotation records why the controller last decided not to delete a resource.
+	LastEvaluatedReasonAnnotation = "gc.kube-zen.io/last-evaluated-reason"
+
+	// DeleteAfterAnnotation records when a resource noticed for deletion may be deleted,
+	// for policies with deletionNoticeSeconds.
+	DeleteAfterAnnotation = "gc.kube-zen.io/delete-after"
+
+	// DeleteAfterPolicyAnnotation records the namespace/name of the policy that noticed a
+	// resource for deletion; a notice only counts for the policy that gave it.
+	DeleteAfterPolicyAnnotation = "gc.kube-zen.io/delete-after-policy"
+)
+
+const (
+	// ParentNamePlaceholder is replaced with the parent's name in alsoDelete specs.
+	ParentNamePlaceholder = "${parent.name}"
+
+	// ParentNamespacePlaceholder is replaced with the parent's namespace in alsoDelete specs.
+	ParentNamespacePlaceholder = "${parent.namespace}"
+)
+
+// ResultWebhookSpec configures the post-evaluation result webhook.
+// Delivery is best-effort: it never blocks evaluation and failures are only logged.
+// The URL must be in the controller's --result-webhook-allowed-urls.
+type ResultWebhookSpec struct {
+	// URL the evaluation summary is POSTed to as JSON
+	URL string `json:"url"`
+
+	// Timeout in seconds for a single delivery (default: 5)
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
 }
 
 // GarbageCollectionPolicyStatus defines the observed state of GarbageCollectionPolicy.
 type GarbageCollectionPolicyStatus struct {
-	// Policy status phase
-	Phase string `json:"phase,omitempty"` // Active, Paused, Error
+	// Policy status phase, derived from Conditions and kept for backward compatibility
+	Phase string `json:"phase,omitempty"` // Active, Paused, Pending, Error
 
 	// Statistics
 	ResourcesMatched int64 `json:"resourcesMatched,omitempty"`
 	ResourcesDeleted int64 `json:"resourcesDeleted,omitempty"`
 	ResourcesPending int64 `json:"resourcesPending,omitempty"`
 
+	// Age in seconds of the oldest matched resource that was not deleted in the last run.
+	// A growing value signals a misconfigured or blocked policy.
+	OldestPendingAgeSeconds int64 `json:"oldestPendingAgeSeconds,omitempty"`
+
 	// Last GC run timestamp
 	LastGCRun *metav1.Time `json:"lastGCRun,omitempty"`
 
 	// Next GC run timestamp
 	NextGCRun *metav1.Time `json:"nextGCRun,omitempty"`
 
-	// Conditions
+	// What the last dry-run evaluation would have deleted. Set only while behavior.dryRun is true.
+	DryRunImpact *DryRunImpact `json:"dryRunImpact,omitempty"`
+
+	// True while the observe-only annotation forces the policy into dry-run
+	ObserveOnly bool `json:"observeOnly,omitempty"`
+
+	// Ready, Progressing, and Degraded conditions, following Kubernetes conventions
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
+
+// DryRunAcknowledgedAnnotation acknowledges a policy's last observed dry-run impact.
+// Setting behavior.dryRun to false is rejected unless its value equals status.dryRunImpact.hash.
+const DryRunAcknowledgedAnnotation = "gc.kube-zen.io/dry-run-acknowledged"
+
+// ObserveOnlyAnnotation forces a policy into dry-run when set to "true", regardless of
+// behavior.dryRun, so operators can stop deletions without editing a GitOps-owned spec.
+const ObserveOnlyAnnotation = "gc.kube-zen.io/observe-only"
+
+// AllowOverlapAnnotation admits a policy whose target overlaps an existing policy's when set
+// to "true", even while the webhook denies overlapping policies, and silences the warning.
+const AllowOverlapAnnotation = "gc.kube-zen.io/allow-overlap"
+
+const (
+	// PolicyTemplateAnnotation names the policy template the webhook expands into the spec
+	// of a policy being created.
+	PolicyTemplateAnnotation = "gc.kube-zen.io/template"
+
+	// PolicyTemplateParamAnnotationPrefix prefixes the annotations that set template
+	// parameters, e.g. gc.kube-zen.io/template-param.ttl sets the ttl parameter.
+	PolicyTemplateParamAnnotationPrefix = "gc.kube-zen.io/template-param."
+)
+
+// DryRunImpact summarizes the resources a dry-run policy would have deleted.
+type DryRunImpact struct {
+	// Number of resources the last dry-run evaluation would have deleted
+	WouldDelete int64 `json:"wouldDelete"`
+
+	// Up to 10 of the resources that would have been deleted, as "namespace/name" (or "name"
+	// for cluster-scoped resources), in sorted order
+	SampleNames []string `json:"sampleNames,omitempty"`
+
+	// Hash identifying the policy generation and the set of resources that would be deleted
+	Hash string `json:"hash"`
+
+	// Policy generation the impact was observed for
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// When the impact was observed
+	ObservedAt *metav1.Time `json:"observedAt,omitempty"`
+}
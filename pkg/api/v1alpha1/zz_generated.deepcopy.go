@@ -77,7 +77,22 @@ func (in *GarbageCollectionPolicySpec) DeepCopyInto(out *GarbageCollectionPolicy
 		*out = new(ConditionsSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Dedup != nil {
+		in, out := &in.Dedup, &out.Dedup
+		*out = new(DedupSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(RetentionSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Behavior.DeepCopyInto(&out.Behavior)
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(ScheduleSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GarbageCollectionPolicySpec.
@@ -101,6 +116,11 @@ func (in *GarbageCollectionPolicyStatus) DeepCopyInto(out *GarbageCollectionPoli
 		in, out := &in.NextGCRun, &out.NextGCRun
 		*out = (*in).DeepCopy()
 	}
+	if in.DryRunImpact != nil {
+		in, out := &in.DryRunImpact, &out.DryRunImpact
+		*out = new(DryRunImpact)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -128,11 +148,33 @@ func (in *TargetResourceSpec) DeepCopyInto(out *TargetResourceSpec) {
 		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LabelSelectors != nil {
+		in, out := &in.LabelSelectors, &out.LabelSelectors
+		*out = make([]v1.LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VirtualLabelAnnotations != nil {
+		in, out := &in.VirtualLabelAnnotations, &out.VirtualLabelAnnotations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.FieldSelector != nil {
 		in, out := &in.FieldSelector, &out.FieldSelector
 		*out = new(FieldSelectorSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.UIDs != nil {
+		in, out := &in.UIDs, &out.UIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeNamespaces != nil {
+		in, out := &in.ExcludeNamespaces, &out.ExcludeNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetResourceSpec.
@@ -170,6 +212,16 @@ func (in *TTLSpec) DeepCopyInto(out *TTLSpec) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Earliest != nil {
+		in, out := &in.Earliest, &out.Earliest
+		*out = new(EarliestTTLSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Range != nil {
+		in, out := &in.Range, &out.Range
+		*out = new(RangeTTLSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TTLSpec.
@@ -190,6 +242,11 @@ func (in *ConditionsSpec) DeepCopyInto(out *ConditionsSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.NotPhase != nil {
+		in, out := &in.NotPhase, &out.NotPhase
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.HasLabels != nil {
 		in, out := &in.HasLabels, &out.HasLabels
 		*out = make([]LabelCondition, len(*in))
@@ -207,6 +264,97 @@ func (in *ConditionsSpec) DeepCopyInto(out *ConditionsSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Or != nil {
+		in, out := &in.Or, &out.Or
+		*out = make([]FieldCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AnyOf != nil {
+		in, out := &in.AnyOf, &out.AnyOf
+		*out = make([]ConditionsSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OPA != nil {
+		in, out := &in.OPA, &out.OPA
+		*out = new(OPACondition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LabelCount != nil {
+		in, out := &in.LabelCount, &out.LabelCount
+		*out = new(LabelCountCondition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CountTrend != nil {
+		in, out := &in.CountTrend, &out.CountTrend
+		*out = new(CountTrendCondition)
+		**out = **in
+	}
+	if in.SpecHash != nil {
+		in, out := &in.SpecHash, &out.SpecHash
+		*out = new(SpecHashCondition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Dates != nil {
+		in, out := &in.Dates, &out.Dates
+		*out = make([]DateCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ArrayLengths != nil {
+		in, out := &in.ArrayLengths, &out.ArrayLengths
+		*out = make([]ArrayLengthCondition, len(*in))
+		copy(*out, *in)
+	}
+	if in.Semver != nil {
+		in, out := &in.Semver, &out.Semver
+		*out = make([]SemverCondition, len(*in))
+		copy(*out, *in)
+	}
+	if in.CreatedByDefaultServiceAccount != nil {
+		in, out := &in.CreatedByDefaultServiceAccount, &out.CreatedByDefaultServiceAccount
+		*out = new(CreatorCondition)
+		**out = **in
+	}
+	if in.BackupGate != nil {
+		in, out := &in.BackupGate, &out.BackupGate
+		*out = new(BackupGateCondition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetricThreshold != nil {
+		in, out := &in.MetricThreshold, &out.MetricThreshold
+		*out = new(MetricThresholdCondition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OwnerChain != nil {
+		in, out := &in.OwnerChain, &out.OwnerChain
+		*out = new(OwnerChainCondition)
+		**out = **in
+	}
+	if in.DataDrift != nil {
+		in, out := &in.DataDrift, &out.DataDrift
+		*out = new(DataDriftCondition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StuckTerminating != nil {
+		in, out := &in.StuckTerminating, &out.StuckTerminating
+		*out = new(StuckTerminatingCondition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MissingLabel != nil {
+		in, out := &in.MissingLabel, &out.MissingLabel
+		*out = new(MissingLabelCondition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Unchanged != nil {
+		in, out := &in.Unchanged, &out.Unchanged
+		*out = new(UnchangedCondition)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionsSpec.
@@ -222,11 +370,50 @@ func (in *ConditionsSpec) DeepCopy() *ConditionsSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BehaviorSpec) DeepCopyInto(out *BehaviorSpec) {
 	*out = *in
+	if in.BatchInterval != nil {
+		in, out := &in.BatchInterval, &out.BatchInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.PropagationByKind != nil {
+		in, out := &in.PropagationByKind, &out.PropagationByKind
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.GracePeriodSeconds != nil {
 		in, out := &in.GracePeriodSeconds, &out.GracePeriodSeconds
 		*out = new(int64)
 		**out = **in
 	}
+	if in.DeletionNoticeSeconds != nil {
+		in, out := &in.DeletionNoticeSeconds, &out.DeletionNoticeSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ResultWebhook != nil {
+		in, out := &in.ResultWebhook, &out.ResultWebhook
+		*out = new(ResultWebhookSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedReasons != nil {
+		in, out := &in.AllowedReasons, &out.AllowedReasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AlsoDelete != nil {
+		in, out := &in.AlsoDelete, &out.AlsoDelete
+		*out = make([]TargetResourceSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MinRemaining != nil {
+		in, out := &in.MinRemaining, &out.MinRemaining
+		*out = new(MinRemainingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BehaviorSpec.
@@ -310,3 +497,512 @@ func (in *FieldCondition) DeepCopy() *FieldCondition {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OPACondition) DeepCopyInto(out *OPACondition) {
+	*out = *in
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CacheTTLSeconds != nil {
+		in, out := &in.CacheTTLSeconds, &out.CacheTTLSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OPACondition.
+func (in *OPACondition) DeepCopy() *OPACondition {
+	if in == nil {
+		return nil
+	}
+	out := new(OPACondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelCountCondition) DeepCopyInto(out *LabelCountCondition) {
+	*out = *in
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelCountCondition.
+func (in *LabelCountCondition) DeepCopy() *LabelCountCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelCountCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CountTrendCondition) DeepCopyInto(out *CountTrendCondition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CountTrendCondition.
+func (in *CountTrendCondition) DeepCopy() *CountTrendCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(CountTrendCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpecHashCondition) DeepCopyInto(out *SpecHashCondition) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BadHashes != nil {
+		in, out := &in.BadHashes, &out.BadHashes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BadHashesConfigMap != nil {
+		in, out := &in.BadHashesConfigMap, &out.BadHashesConfigMap
+		*out = new(BadHashesConfigMapRef)
+		**out = **in
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BadHashesConfigMapRef) DeepCopyInto(out *BadHashesConfigMapRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BadHashesConfigMapRef.
+func (in *BadHashesConfigMapRef) DeepCopy() *BadHashesConfigMapRef {
+	if in == nil {
+		return nil
+	}
+	out := new(BadHashesConfigMapRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpecHashCondition.
+func (in *SpecHashCondition) DeepCopy() *SpecHashCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(SpecHashCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResultWebhookSpec) DeepCopyInto(out *ResultWebhookSpec) {
+	*out = *in
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultWebhookSpec.
+func (in *ResultWebhookSpec) DeepCopy() *ResultWebhookSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResultWebhookSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DateCondition) DeepCopyInto(out *DateCondition) {
+	*out = *in
+	if in.AgeSeconds != nil {
+		in, out := &in.AgeSeconds, &out.AgeSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DateCondition.
+func (in *DateCondition) DeepCopy() *DateCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(DateCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CreatorCondition) DeepCopyInto(out *CreatorCondition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CreatorCondition.
+func (in *CreatorCondition) DeepCopy() *CreatorCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(CreatorCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EarliestTTLSpec) DeepCopyInto(out *EarliestTTLSpec) {
+	*out = *in
+	if in.SecondsAfterCreation != nil {
+		in, out := &in.SecondsAfterCreation, &out.SecondsAfterCreation
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Field != nil {
+		in, out := &in.Field, &out.Field
+		*out = new(TTLSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EarliestTTLSpec.
+func (in *EarliestTTLSpec) DeepCopy() *EarliestTTLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EarliestTTLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupGateCondition) DeepCopyInto(out *BackupGateCondition) {
+	*out = *in
+	if in.CacheTTLSeconds != nil {
+		in, out := &in.CacheTTLSeconds, &out.CacheTTLSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupGateCondition.
+func (in *BackupGateCondition) DeepCopy() *BackupGateCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupGateCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DedupSpec) DeepCopyInto(out *DedupSpec) {
+	*out = *in
+	if in.KeyLabels != nil {
+		in, out := &in.KeyLabels, &out.KeyLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KeyFields != nil {
+		in, out := &in.KeyFields, &out.KeyFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DedupSpec.
+func (in *DedupSpec) DeepCopy() *DedupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DedupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DryRunImpact) DeepCopyInto(out *DryRunImpact) {
+	*out = *in
+	if in.SampleNames != nil {
+		in, out := &in.SampleNames, &out.SampleNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ObservedAt != nil {
+		in, out := &in.ObservedAt, &out.ObservedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DryRunImpact.
+func (in *DryRunImpact) DeepCopy() *DryRunImpact {
+	if in == nil {
+		return nil
+	}
+	out := new(DryRunImpact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MinRemainingSpec) DeepCopyInto(out *MinRemainingSpec) {
+	*out = *in
+	out.Value = in.Value
+	if in.KeyLabels != nil {
+		in, out := &in.KeyLabels, &out.KeyLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KeyFields != nil {
+		in, out := &in.KeyFields, &out.KeyFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinRemainingSpec.
+func (in *MinRemainingSpec) DeepCopy() *MinRemainingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MinRemainingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataDriftCondition) DeepCopyInto(out *DataDriftCondition) {
+	*out = *in
+	if in.Reference != nil {
+		in, out := &in.Reference, &out.Reference
+		*out = new(DataDriftReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataDriftCondition.
+func (in *DataDriftCondition) DeepCopy() *DataDriftCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(DataDriftCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataDriftReference) DeepCopyInto(out *DataDriftReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataDriftReference.
+func (in *DataDriftReference) DeepCopy() *DataDriftReference {
+	if in == nil {
+		return nil
+	}
+	out := new(DataDriftReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StuckTerminatingCondition) DeepCopyInto(out *StuckTerminatingCondition) {
+	*out = *in
+	if in.OlderThanSeconds != nil {
+		in, out := &in.OlderThanSeconds, &out.OlderThanSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RemoveFinalizers != nil {
+		in, out := &in.RemoveFinalizers, &out.RemoveFinalizers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StuckTerminatingCondition.
+func (in *StuckTerminatingCondition) DeepCopy() *StuckTerminatingCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(StuckTerminatingCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OwnerChainCondition) DeepCopyInto(out *OwnerChainCondition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OwnerChainCondition.
+func (in *OwnerChainCondition) DeepCopy() *OwnerChainCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(OwnerChainCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RangeTTLSpec) DeepCopyInto(out *RangeTTLSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RangeTTLSpec.
+func (in *RangeTTLSpec) DeepCopy() *RangeTTLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RangeTTLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArrayLengthCondition) DeepCopyInto(out *ArrayLengthCondition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArrayLengthCondition.
+func (in *ArrayLengthCondition) DeepCopy() *ArrayLengthCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ArrayLengthCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricThresholdCondition) DeepCopyInto(out *MetricThresholdCondition) {
+	*out = *in
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CacheTTLSeconds != nil {
+		in, out := &in.CacheTTLSeconds, &out.CacheTTLSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricThresholdCondition.
+func (in *MetricThresholdCondition) DeepCopy() *MetricThresholdCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricThresholdCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MissingLabelCondition) DeepCopyInto(out *MissingLabelCondition) {
+	*out = *in
+	if in.OlderThanSeconds != nil {
+		in, out := &in.OlderThanSeconds, &out.OlderThanSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissingLabelCondition.
+func (in *MissingLabelCondition) DeepCopy() *MissingLabelCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(MissingLabelCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnchangedCondition) DeepCopyInto(out *UnchangedCondition) {
+	*out = *in
+	if in.ForSeconds != nil {
+		in, out := &in.ForSeconds, &out.ForSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnchangedCondition.
+func (in *UnchangedCondition) DeepCopy() *UnchangedCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(UnchangedCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleSpec) DeepCopyInto(out *ScheduleSpec) {
+	*out = *in
+	if in.ActiveAfter != nil {
+		in, out := &in.ActiveAfter, &out.ActiveAfter
+		*out = (*in).DeepCopy()
+	}
+	if in.ActiveWindows != nil {
+		in, out := &in.ActiveWindows, &out.ActiveWindows
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleSpec.
+func (in *ScheduleSpec) DeepCopy() *ScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SemverCondition) DeepCopyInto(out *SemverCondition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SemverCondition.
+func (in *SemverCondition) DeepCopy() *SemverCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(SemverCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetentionSpec) DeepCopyInto(out *RetentionSpec) {
+	*out = *in
+	if in.GroupBy != nil {
+		in, out := &in.GroupBy, &out.GroupBy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetentionSpec.
+func (in *RetentionSpec) DeepCopy() *RetentionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RetentionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -19,6 +19,8 @@ limitations under the License.
 package config
 
 import (
+	"strconv"
+	"strings"
 	"time"
 
 	sdkconfig "github.com/kube-zen/zen-sdk/pkg/config"
@@ -37,8 +39,129 @@ const (
 
 	// DefaultMaxConcurrentEvaluations is the default number of concurrent policy evaluations.
 	DefaultMaxConcurrentEvaluations = 5
+
+	// DefaultStatusUpdateMaxAttempts is the default number of status update attempts.
+	DefaultStatusUpdateMaxAttempts = 3
+
+	// DefaultStatusUpdateRetryDelay is the default initial delay between status update attempts.
+	DefaultStatusUpdateRetryDelay = 100 * time.Millisecond
+
+	// DefaultDeleteBackoffInitialInterval is the default wait before the first retry of a
+	// deletion failing with a transient API error.
+	DefaultDeleteBackoffInitialInterval = 100 * time.Millisecond
+
+	// DefaultDeleteBackoffMaxInterval caps the wait between deletion retries.
+	DefaultDeleteBackoffMaxInterval = 30 * time.Second
+
+	// DefaultDeleteBackoffMultiplier is the default growth factor of the wait between deletion retries.
+	DefaultDeleteBackoffMultiplier = 2.0
+
+	// DefaultDeleteMaxRetries is the default number of retries of a deletion failing with a
+	// transient API error, after the first attempt.
+	DefaultDeleteMaxRetries = 4
+
+	// DefaultErrorRateWindow is the default window the deletion error rate is measured over.
+	DefaultErrorRateWindow = 5 * time.Minute
+
+	// DefaultErrorRateMinAttempts is the default number of deletions in the window before
+	// the error rate can pause deletions.
+	DefaultErrorRateMinAttempts = 20
+
+	// TargetNamespaceModeCluster resolves an empty targetResource.namespace to all namespaces.
+	TargetNamespaceModeCluster = "cluster"
+
+	// TargetNamespaceModePolicy resolves an empty targetResource.namespace to the policy's own namespace.
+	TargetNamespaceModePolicy = "policy"
+
+	// DefaultTargetNamespaceMode is the default resolution of an empty targetResource.namespace.
+	DefaultTargetNamespaceMode = TargetNamespaceModeCluster
+
+	// DefaultEvaluationWorkers is the default number of workers evaluating one policy's resources.
+	DefaultEvaluationWorkers = 4
+
+	// DefaultProtectAnnotation is the default annotation that protects a resource from every policy.
+	DefaultProtectAnnotation = "gc.kube-zen.io/protect"
+
+	// DefaultSentinelInterval is the default interval between deletion sentinel checks.
+	DefaultSentinelInterval = 30 * time.Second
+
+	// DefaultSentinelTimeout bounds a single deletion sentinel check.
+	DefaultSentinelTimeout = 10 * time.Second
+
+	// DefaultAuditBufferSize is the number of deletion audit records queued for writing
+	// before further records are dropped.
+	DefaultAuditBufferSize = 1024
+
+	// DefaultTargetDiscoveryTTL is how long the webhook caches which kinds an API version serves.
+	DefaultTargetDiscoveryTTL = 5 * time.Minute
+
+	// DefaultDegradedAfterFailures is the default number of consecutive evaluation failures
+	// after which a policy is marked Degraded.
+	DefaultDegradedAfterFailures = 1
+
+	// DefaultStaleAfterEmptyRuns is the default number of consecutive evaluations matching
+	// no resources after which a policy is reported stale.
+	DefaultStaleAfterEmptyRuns = 10
 )
 
+// CoreAPIGroupName names the core API group, whose group name is empty, in API group lists.
+const CoreAPIGroupName = "core"
+
+// ParseAPIGroups parses a comma-separated list of API groups, e.g. "core,apps,batch".
+// CoreAPIGroupName stands for the core group. Blank entries are ignored.
+func ParseAPIGroups(list string) []string {
+	var groups []string
+	for _, group := range strings.Split(list, ",") {
+		group = strings.TrimSpace(group)
+		switch group {
+		case "":
+			continue
+		case CoreAPIGroupName:
+			group = ""
+		}
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+// ParseNamespaces parses a comma-separated list of namespace names or globs, e.g.
+// "kube-*,cert-manager". Blank entries are ignored.
+func ParseNamespaces(list string) []string {
+	var namespaces []string
+	for _, namespace := range strings.Split(list, ",") {
+		if namespace = strings.TrimSpace(namespace); namespace != "" {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	return namespaces
+}
+
+// ParseURLs parses a comma-separated list of URLs.
+func ParseURLs(list string) []string {
+	var urls []string
+	for _, u := range strings.Split(list, ",") {
+		if u = strings.TrimSpace(u); u != "" {
+			urls = append(urls, u)
+		}
+	}
+	return urls
+}
+
+// ParseObjectRef parses a "namespace/name" object reference. ok is false unless both parts
+// are non-empty.
+func ParseObjectRef(ref string) (namespace, name string, ok bool) {
+	namespace, name, found := strings.Cut(ref, "/")
+	if !found || namespace == "" || name == "" || strings.Contains(name, "/") {
+		return "", "", false
+	}
+	return namespace, name, true
+}
+
+// IsValidTargetNamespaceMode reports whether mode is a supported target namespace mode.
+func IsValidTargetNamespaceMode(mode string) bool {
+	return mode == TargetNamespaceModeCluster || mode == TargetNamespaceModePolicy
+}
+
 // ControllerConfig holds configuration for the GC controller.
 type ControllerConfig struct {
 	// GCInterval is the interval between GC evaluation runs.
@@ -55,15 +178,162 @@ type ControllerConfig struct {
 	// MaxConcurrentEvaluations is the maximum number of policies to evaluate concurrently.
 	// Defaults to 5 if not set.
 	MaxConcurrentEvaluations int
+
+	// EvaluationWorkers is the number of workers evaluating one policy's resources
+	// concurrently. Deletion stays ordered and rate-limited. 1 evaluates serially.
+	EvaluationWorkers int
+
+	// StatusUpdateMaxAttempts is the maximum number of attempts for a policy status update.
+	// Status updates are retried on conflicts and transient API errors, independently of
+	// deletion retries.
+	StatusUpdateMaxAttempts int
+
+	// StatusUpdateRetryDelay is the initial delay between status update attempts.
+	// The delay doubles on each retry.
+	StatusUpdateRetryDelay time.Duration
+
+	// DeleteBackoffInitialInterval is the wait before the first retry of a deletion failing
+	// with a transient API error (timeouts, 429, 503). Each wait is jittered down to half its
+	// value, so concurrent workers do not retry in lockstep.
+	DeleteBackoffInitialInterval time.Duration
+
+	// DeleteBackoffMaxInterval caps the wait between deletion retries.
+	DeleteBackoffMaxInterval time.Duration
+
+	// DeleteBackoffMultiplier is the factor the wait grows by on each deletion retry.
+	DeleteBackoffMultiplier float64
+
+	// DeleteMaxRetries is the number of retries of a deletion failing with a transient API
+	// error, after the first attempt. Zero disables retries.
+	DeleteMaxRetries int
+
+	// GlobalMaxDeletionsPerSecond caps deletions across all policies.
+	// Zero means no global limit.
+	GlobalMaxDeletionsPerSecond int
+
+	// NamespaceMaxDeletionsPerSecond caps deletions of resources in one namespace, across
+	// all policies.
+	// Zero means no per-namespace limit.
+	NamespaceMaxDeletionsPerSecond int
+
+	// MaxInformers caps the resource informers the controller runs. Policies beyond the cap
+	// wait in Pending until an informer is released. Zero means no limit.
+	MaxInformers int
+
+	// ErrorRateThresholdPercent pauses all deletions while the percentage of deletions failing
+	// with API server errors over ErrorRateWindow exceeds it. Zero disables the breaker.
+	ErrorRateThresholdPercent int
+
+	// ErrorRateWindow is the window the deletion error rate is measured over.
+	ErrorRateWindow time.Duration
+
+	// ErrorRateMinAttempts is the number of deletions the window needs before the error rate
+	// can pause deletions, so a handful of failures does not stop the controller.
+	ErrorRateMinAttempts int
+
+	// DefaultTargetNamespaceMode decides what an empty targetResource.namespace means:
+	// TargetNamespaceModeCluster (all namespaces) or TargetNamespaceModePolicy (the
+	// policy's namespace). Cluster-scoped kinds always resolve to all namespaces.
+	DefaultTargetNamespaceMode string
+
+	// AllowedAPIGroups limits the API groups policies may target, including alsoDelete
+	// dependents. The core group is the empty string. Nil means every group is allowed.
+	AllowedAPIGroups []string
+
+	// CountAlreadyGoneSeparately reports resources that were already gone when deleted
+	// (NotFound, e.g. removed by another actor) as already gone instead of as deleted.
+	CountAlreadyGoneSeparately bool
+
+	// ProtectAnnotation is the annotation key that, set to a true value on a resource,
+	// protects it from deletion by any policy. Empty disables protection.
+	ProtectAnnotation string
+
+	// ProtectedNamespaces are excluded from every policy regardless of its targetResource,
+	// as namespace names or simple globs (e.g., "kube-*"). Nil protects no namespace.
+	ProtectedNamespaces []string
+
+	// SentinelConfigMap is the "namespace/name" of a ConfigMap whose data key "enabled" must
+	// be "true" for deletions to proceed. Empty disables the ConfigMap sentinel.
+	SentinelConfigMap string
+
+	// SentinelURL is a URL that must answer 2xx with a body of "true" or "enabled" for
+	// deletions to proceed. Empty disables the URL sentinel.
+	SentinelURL string
+
+	// SentinelInterval is the interval between deletion sentinel checks.
+	SentinelInterval time.Duration
+
+	// PushgatewayURL is the Prometheus Pushgateway the final metrics snapshot is pushed to
+	// when the controller exits, for short-lived runs that are never scraped. Empty disables
+	// pushing.
+	PushgatewayURL string
+
+	// EnforceTargetDiscovery makes the webhook deny policies whose target kind the cluster
+	// does not serve. By default they are admitted with a warning, as discovery can be stale.
+	EnforceTargetDiscovery bool
+
+	// TargetDiscoveryTTL is how long the webhook caches which kinds an API version serves.
+	TargetDiscoveryTTL time.Duration
+
+	// DenyPolicyOverlap makes the webhook deny policies whose target overlaps an existing
+	// policy's unless they carry the allow-overlap annotation. By default they are admitted
+	// with a warning.
+	DenyPolicyOverlap bool
+
+	// DegradedAfterFailures is the number of consecutive failed evaluations after which a
+	// policy is marked Degraded, so transient API errors need not flap its conditions.
+	DegradedAfterFailures int
+
+	// AuditLogPath is a file every deletion is appended to as a JSON line. Disabled if empty.
+	AuditLogPath string
+
+	// SnapshotDir is the directory manifests of policies with snapshot enabled are written to
+	// before deletion, under <policy-namespace>/<policy-name>. Disabled if empty.
+	SnapshotDir string
+
+	// OPAAllowedURLs are the only OPA decision URLs opa conditions may query, compared
+	// exactly. Policies naming another URL are refused. Nil allows none.
+	OPAAllowedURLs []string
+
+	// OPAIncludeData sends resources to OPA with their data, stringData, and binaryData
+	// fields. By default these are stripped, so Secret contents never leave the cluster.
+	OPAIncludeData bool
+
+	// ResultWebhookAllowedURLs are the only URLs result webhooks may be delivered to,
+	// compared exactly. Policies naming another URL are refused. Nil allows none.
+	ResultWebhookAllowedURLs []string
+
+	// DebugBundleEnabled serves policy debug bundles on the metrics port. Off by default,
+	// since the metrics port is unauthenticated and a bundle lists resource names.
+	DebugBundleEnabled bool
+
+	// StaleAfterEmptyRuns is the number of consecutive evaluations matching no resources
+	// after which a policy is reported stale, since a typo'd selector fails silently.
+	StaleAfterEmptyRuns int
 }
 
 // NewControllerConfig creates a new controller config with defaults.
 func NewControllerConfig() *ControllerConfig {
 	return &ControllerConfig{
-		GCInterval:               DefaultGCInterval,
-		MaxDeletionsPerSecond:    DefaultMaxDeletionsPerSecond,
-		BatchSize:                DefaultBatchSize,
-		MaxConcurrentEvaluations: DefaultMaxConcurrentEvaluations,
+		GCInterval:                   DefaultGCInterval,
+		MaxDeletionsPerSecond:        DefaultMaxDeletionsPerSecond,
+		BatchSize:                    DefaultBatchSize,
+		MaxConcurrentEvaluations:     DefaultMaxConcurrentEvaluations,
+		EvaluationWorkers:            DefaultEvaluationWorkers,
+		StatusUpdateMaxAttempts:      DefaultStatusUpdateMaxAttempts,
+		StatusUpdateRetryDelay:       DefaultStatusUpdateRetryDelay,
+		DeleteBackoffInitialInterval: DefaultDeleteBackoffInitialInterval,
+		DeleteBackoffMaxInterval:     DefaultDeleteBackoffMaxInterval,
+		DeleteBackoffMultiplier:      DefaultDeleteBackoffMultiplier,
+		DeleteMaxRetries:             DefaultDeleteMaxRetries,
+		ErrorRateWindow:              DefaultErrorRateWindow,
+		ErrorRateMinAttempts:         DefaultErrorRateMinAttempts,
+		DefaultTargetNamespaceMode:   DefaultTargetNamespaceMode,
+		ProtectAnnotation:            DefaultProtectAnnotation,
+		SentinelInterval:             DefaultSentinelInterval,
+		TargetDiscoveryTTL:           DefaultTargetDiscoveryTTL,
+		DegradedAfterFailures:        DefaultDegradedAfterFailures,
+		StaleAfterEmptyRuns:          DefaultStaleAfterEmptyRuns,
 	}
 }
 
@@ -96,6 +366,187 @@ func (c *ControllerConfig) LoadFromEnv() error {
 		c.MaxConcurrentEvaluations = val
 	}
 
+	// GC_EVALUATION_WORKERS - integer
+	if val := validator.OptionalInt("GC_EVALUATION_WORKERS", 0); val > 0 {
+		c.EvaluationWorkers = val
+	}
+
+	// GC_STATUS_UPDATE_MAX_ATTEMPTS - integer
+	if val := validator.OptionalInt("GC_STATUS_UPDATE_MAX_ATTEMPTS", 0); val > 0 {
+		c.StatusUpdateMaxAttempts = val
+	}
+
+	// GC_STATUS_UPDATE_RETRY_DELAY - duration string (e.g., "100ms", "1s")
+	if val := validator.OptionalDuration("GC_STATUS_UPDATE_RETRY_DELAY", ""); val != "" {
+		if d, err := time.ParseDuration(val); err == nil {
+			c.StatusUpdateRetryDelay = d
+		}
+	}
+
+	// GC_DELETE_BACKOFF_INITIAL_INTERVAL - duration string (e.g., "100ms", "1s")
+	if val := validator.OptionalDuration("GC_DELETE_BACKOFF_INITIAL_INTERVAL", ""); val != "" {
+		if d, err := time.ParseDuration(val); err == nil && d > 0 {
+			c.DeleteBackoffInitialInterval = d
+		}
+	}
+
+	// GC_DELETE_BACKOFF_MAX_INTERVAL - duration string (e.g., "30s")
+	if val := validator.OptionalDuration("GC_DELETE_BACKOFF_MAX_INTERVAL", ""); val != "" {
+		if d, err := time.ParseDuration(val); err == nil && d > 0 {
+			c.DeleteBackoffMaxInterval = d
+		}
+	}
+
+	// GC_DELETE_BACKOFF_MULTIPLIER - float (at least 1)
+	if val := validator.OptionalString("GC_DELETE_BACKOFF_MULTIPLIER", ""); val != "" {
+		if f, err := strconv.ParseFloat(val, 64); err == nil && f >= 1 {
+			c.DeleteBackoffMultiplier = f
+		}
+	}
+
+	// GC_DELETE_MAX_RETRIES - integer, 0 disables retries
+	if val := validator.OptionalInt("GC_DELETE_MAX_RETRIES", -1); val >= 0 {
+		c.DeleteMaxRetries = val
+	}
+
+	// GC_GLOBAL_MAX_DELETIONS_PER_SECOND - integer
+	if val := validator.OptionalInt("GC_GLOBAL_MAX_DELETIONS_PER_SECOND", 0); val > 0 {
+		c.GlobalMaxDeletionsPerSecond = val
+	}
+
+	// GC_NAMESPACE_MAX_DELETIONS_PER_SECOND - integer
+	if val := validator.OptionalInt("GC_NAMESPACE_MAX_DELETIONS_PER_SECOND", 0); val > 0 {
+		c.NamespaceMaxDeletionsPerSecond = val
+	}
+
+	// GC_MAX_INFORMERS - integer
+	if val := validator.OptionalInt("GC_MAX_INFORMERS", 0); val > 0 {
+		c.MaxInformers = val
+	}
+
+	// GC_ERROR_RATE_THRESHOLD_PERCENT - integer (1-100)
+	if val := validator.OptionalInt("GC_ERROR_RATE_THRESHOLD_PERCENT", 0); val > 0 && val <= 100 {
+		c.ErrorRateThresholdPercent = val
+	}
+
+	// GC_ERROR_RATE_WINDOW - duration string (e.g., "5m")
+	if val := validator.OptionalDuration("GC_ERROR_RATE_WINDOW", ""); val != "" {
+		if d, err := time.ParseDuration(val); err == nil && d > 0 {
+			c.ErrorRateWindow = d
+		}
+	}
+
+	// GC_ERROR_RATE_MIN_ATTEMPTS - integer
+	if val := validator.OptionalInt("GC_ERROR_RATE_MIN_ATTEMPTS", 0); val > 0 {
+		c.ErrorRateMinAttempts = val
+	}
+
+	// GC_DEFAULT_TARGET_NAMESPACE_MODE - "cluster" or "policy"
+	if val := validator.OptionalString("GC_DEFAULT_TARGET_NAMESPACE_MODE", ""); IsValidTargetNamespaceMode(val) {
+		c.DefaultTargetNamespaceMode = val
+	}
+
+	// GC_ALLOWED_API_GROUPS - comma-separated API groups, "core" for the core group
+	if val := validator.OptionalString("GC_ALLOWED_API_GROUPS", ""); val != "" {
+		c.AllowedAPIGroups = ParseAPIGroups(val)
+	}
+
+	// GC_COUNT_ALREADY_GONE_SEPARATELY - boolean
+	if validator.OptionalBool("GC_COUNT_ALREADY_GONE_SEPARATELY", false) {
+		c.CountAlreadyGoneSeparately = true
+	}
+
+	// GC_PROTECT_ANNOTATION - annotation key
+	if val := validator.OptionalString("GC_PROTECT_ANNOTATION", ""); val != "" {
+		c.ProtectAnnotation = val
+	}
+
+	// GC_PROTECTED_NAMESPACES - comma-separated namespace names or globs
+	if val := validator.OptionalString("GC_PROTECTED_NAMESPACES", ""); val != "" {
+		c.ProtectedNamespaces = ParseNamespaces(val)
+	}
+
+	// GC_SENTINEL_CONFIGMAP - "namespace/name" of the deletion sentinel ConfigMap
+	if val := validator.OptionalString("GC_SENTINEL_CONFIGMAP", ""); val != "" {
+		if _, _, ok := ParseObjectRef(val); ok {
+			c.SentinelConfigMap = val
+		}
+	}
+
+	// GC_SENTINEL_URL - deletion sentinel URL
+	if val := validator.OptionalString("GC_SENTINEL_URL", ""); val != "" {
+		c.SentinelURL = val
+	}
+
+	// GC_SENTINEL_INTERVAL - duration string (e.g., "30s")
+	if val := validator.OptionalDuration("GC_SENTINEL_INTERVAL", ""); val != "" {
+		if d, err := time.ParseDuration(val); err == nil && d > 0 {
+			c.SentinelInterval = d
+		}
+	}
+
+	// GC_PUSHGATEWAY_URL - Pushgateway URL
+	if val := validator.OptionalString("GC_PUSHGATEWAY_URL", ""); val != "" {
+		c.PushgatewayURL = val
+	}
+
+	// GC_ENFORCE_TARGET_DISCOVERY - boolean
+	if validator.OptionalBool("GC_ENFORCE_TARGET_DISCOVERY", false) {
+		c.EnforceTargetDiscovery = true
+	}
+
+	// GC_DENY_POLICY_OVERLAP - boolean
+	if validator.OptionalBool("GC_DENY_POLICY_OVERLAP", false) {
+		c.DenyPolicyOverlap = true
+	}
+
+	// GC_AUDIT_LOG_PATH - deletion audit log file
+	if val := validator.OptionalString("GC_AUDIT_LOG_PATH", ""); val != "" {
+		c.AuditLogPath = val
+	}
+
+	// GC_SNAPSHOT_DIR - pre-deletion snapshot directory
+	if val := validator.OptionalString("GC_SNAPSHOT_DIR", ""); val != "" {
+		c.SnapshotDir = val
+	}
+
+	// GC_OPA_ALLOWED_URLS - comma-separated OPA decision URLs
+	if val := validator.OptionalString("GC_OPA_ALLOWED_URLS", ""); val != "" {
+		c.OPAAllowedURLs = ParseURLs(val)
+	}
+
+	// GC_OPA_INCLUDE_DATA - boolean
+	if validator.OptionalBool("GC_OPA_INCLUDE_DATA", false) {
+		c.OPAIncludeData = true
+	}
+
+	// GC_RESULT_WEBHOOK_ALLOWED_URLS - comma-separated result webhook URLs
+	if val := validator.OptionalString("GC_RESULT_WEBHOOK_ALLOWED_URLS", ""); val != "" {
+		c.ResultWebhookAllowedURLs = ParseURLs(val)
+	}
+
+	// GC_ENABLE_DEBUG_BUNDLE - boolean
+	if validator.OptionalBool("GC_ENABLE_DEBUG_BUNDLE", false) {
+		c.DebugBundleEnabled = true
+	}
+
+	// GC_TARGET_DISCOVERY_TTL - duration string (e.g., "5m")
+	if val := validator.OptionalDuration("GC_TARGET_DISCOVERY_TTL", ""); val != "" {
+		if d, err := time.ParseDuration(val); err == nil && d > 0 {
+			c.TargetDiscoveryTTL = d
+		}
+	}
+
+	// GC_DEGRADED_AFTER_FAILURES - integer
+	if val := validator.OptionalInt("GC_DEGRADED_AFTER_FAILURES", 0); val > 0 {
+		c.DegradedAfterFailures = val
+	}
+
+	// GC_STALE_AFTER_EMPTY_RUNS - integer
+	if val := validator.OptionalInt("GC_STALE_AFTER_EMPTY_RUNS", 0); val > 0 {
+		c.StaleAfterEmptyRuns = val
+	}
+
 	// Return validation errors if any
 	return validator.Validate()
 }
@@ -123,3 +574,154 @@ func (c *ControllerConfig) WithMaxConcurrentEvaluations(maxConcurrent int) *Cont
 	c.MaxConcurrentEvaluations = maxConcurrent
 	return c
 }
+
+// WithEvaluationWorkers sets the number of workers evaluating one policy's resources.
+func (c *ControllerConfig) WithEvaluationWorkers(workers int) *ControllerConfig {
+	c.EvaluationWorkers = workers
+	return c
+}
+
+// WithStatusUpdateRetry sets the status update retry attempts and initial delay.
+func (c *ControllerConfig) WithStatusUpdateRetry(maxAttempts int, delay time.Duration) *ControllerConfig {
+	c.StatusUpdateMaxAttempts = maxAttempts
+	c.StatusUpdateRetryDelay = delay
+	return c
+}
+
+// WithDeleteBackoff sets the deletion retry backoff: the initial and maximum wait between
+// retries, the factor the wait grows by, and the number of retries after the first attempt.
+func (c *ControllerConfig) WithDeleteBackoff(initial, maxInterval time.Duration, multiplier float64, maxRetries int) *ControllerConfig {
+	c.DeleteBackoffInitialInterval = initial
+	c.DeleteBackoffMaxInterval = maxInterval
+	c.DeleteBackoffMultiplier = multiplier
+	c.DeleteMaxRetries = maxRetries
+	return c
+}
+
+// WithHierarchicalRateLimits sets the global and per-namespace deletion rate limits.
+func (c *ControllerConfig) WithHierarchicalRateLimits(globalPerSecond, namespacePerSecond int) *ControllerConfig {
+	c.GlobalMaxDeletionsPerSecond = globalPerSecond
+	c.NamespaceMaxDeletionsPerSecond = namespacePerSecond
+	return c
+}
+
+// WithMaxInformers sets the maximum number of resource informers.
+func (c *ControllerConfig) WithMaxInformers(maxInformers int) *ControllerConfig {
+	c.MaxInformers = maxInformers
+	return c
+}
+
+// WithErrorRateBreaker sets the deletion error rate threshold, window, and minimum attempts.
+func (c *ControllerConfig) WithErrorRateBreaker(thresholdPercent int, window time.Duration, minAttempts int) *ControllerConfig {
+	c.ErrorRateThresholdPercent = thresholdPercent
+	c.ErrorRateWindow = window
+	c.ErrorRateMinAttempts = minAttempts
+	return c
+}
+
+// WithDefaultTargetNamespaceMode sets how an empty targetResource.namespace is resolved.
+func (c *ControllerConfig) WithDefaultTargetNamespaceMode(mode string) *ControllerConfig {
+	c.DefaultTargetNamespaceMode = mode
+	return c
+}
+
+// WithAllowedAPIGroups sets the API groups policies may target. Nil allows every group.
+func (c *ControllerConfig) WithAllowedAPIGroups(groups []string) *ControllerConfig {
+	c.AllowedAPIGroups = groups
+	return c
+}
+
+// WithCountAlreadyGoneSeparately sets whether resources already gone when deleted are
+// counted apart from deleted resources.
+func (c *ControllerConfig) WithCountAlreadyGoneSeparately(separate bool) *ControllerConfig {
+	c.CountAlreadyGoneSeparately = separate
+	return c
+}
+
+// WithProtectAnnotation sets the annotation key that protects a resource from deletion.
+func (c *ControllerConfig) WithProtectAnnotation(key string) *ControllerConfig {
+	c.ProtectAnnotation = key
+	return c
+}
+
+// WithProtectedNamespaces sets the namespaces excluded from every policy.
+func (c *ControllerConfig) WithProtectedNamespaces(namespaces []string) *ControllerConfig {
+	c.ProtectedNamespaces = namespaces
+	return c
+}
+
+// WithSentinel sets the deletion sentinel ConfigMap ("namespace/name"), URL, and check
+// interval. An empty ConfigMap or URL disables that sentinel.
+func (c *ControllerConfig) WithSentinel(configMap, url string, interval time.Duration) *ControllerConfig {
+	c.SentinelConfigMap = configMap
+	c.SentinelURL = url
+	c.SentinelInterval = interval
+	return c
+}
+
+// WithPushgatewayURL sets the Pushgateway the final metrics snapshot is pushed to.
+func (c *ControllerConfig) WithPushgatewayURL(url string) *ControllerConfig {
+	c.PushgatewayURL = url
+	return c
+}
+
+// WithTargetDiscovery sets whether the webhook denies, rather than warns about, policies
+// whose target kind the cluster does not serve, and how long discovery results are cached.
+func (c *ControllerConfig) WithTargetDiscovery(enforce bool, ttl time.Duration) *ControllerConfig {
+	c.EnforceTargetDiscovery = enforce
+	c.TargetDiscoveryTTL = ttl
+	return c
+}
+
+// WithDenyPolicyOverlap sets whether the webhook denies, rather than warns about, policies
+// whose target overlaps an existing policy's.
+func (c *ControllerConfig) WithDenyPolicyOverlap(deny bool) *ControllerConfig {
+	c.DenyPolicyOverlap = deny
+	return c
+}
+
+// WithAuditLogPath sets the file every deletion is appended to as a JSON line.
+func (c *ControllerConfig) WithAuditLogPath(path string) *ControllerConfig {
+	c.AuditLogPath = path
+	return c
+}
+
+// WithSnapshotDir sets the directory manifests are snapshotted to before deletion.
+func (c *ControllerConfig) WithSnapshotDir(dir string) *ControllerConfig {
+	c.SnapshotDir = dir
+	return c
+}
+
+// WithOPA sets the OPA decision URLs opa conditions may query and whether resources are
+// sent to OPA with their data.
+func (c *ControllerConfig) WithOPA(allowedURLs []string, includeData bool) *ControllerConfig {
+	c.OPAAllowedURLs = allowedURLs
+	c.OPAIncludeData = includeData
+	return c
+}
+
+// WithResultWebhookAllowedURLs sets the URLs result webhooks may be delivered to.
+func (c *ControllerConfig) WithResultWebhookAllowedURLs(urls []string) *ControllerConfig {
+	c.ResultWebhookAllowedURLs = urls
+	return c
+}
+
+// WithDebugBundle sets whether policy debug bundles are served on the metrics port.
+func (c *ControllerConfig) WithDebugBundle(enabled bool) *ControllerConfig {
+	c.DebugBundleEnabled = enabled
+	return c
+}
+
+// WithDegradedAfterFailures sets the number of consecutive failed evaluations after which a
+// policy is marked Degraded.
+func (c *ControllerConfig) WithDegradedAfterFailures(failures int) *ControllerConfig {
+	c.DegradedAfterFailures = failures
+	return c
+}
+
+// WithStaleAfterEmptyRuns sets the number of consecutive evaluations matching no resources
+// after which a policy is reported stale.
+func (c *ControllerConfig) WithStaleAfterEmptyRuns(runs int) *ControllerConfig {
+	c.StaleAfterEmptyRuns = runs
+	return c
+}
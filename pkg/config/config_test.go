@@ -18,6 +18,7 @@ package config
 
 import (
 	"os"
+	"reflect"
 	"testing"
 	"time"
 )
@@ -155,3 +156,430 @@ func TestControllerConfig_WithMaxConcurrentEvaluations(t *testing.T) {
 		t.Errorf("Expected MaxConcurrentEvaluations=%d, got %d", maxConcurrent, cfg.MaxConcurrentEvaluations)
 	}
 }
+
+func TestControllerConfig_LoadFromEnv_EvaluationWorkers(t *testing.T) {
+	cfg := NewControllerConfig()
+	if cfg.EvaluationWorkers != DefaultEvaluationWorkers {
+		t.Errorf("Expected %d evaluation workers by default, got %d", DefaultEvaluationWorkers, cfg.EvaluationWorkers)
+	}
+
+	t.Setenv("GC_EVALUATION_WORKERS", "16")
+	if err := cfg.LoadFromEnv(); err != nil {
+		t.Fatalf("LoadFromEnv() returned error: %v", err)
+	}
+	if cfg.EvaluationWorkers != 16 {
+		t.Errorf("Expected 16 evaluation workers from GC_EVALUATION_WORKERS, got %d", cfg.EvaluationWorkers)
+	}
+
+	cfg.WithEvaluationWorkers(1)
+	if cfg.EvaluationWorkers != 1 {
+		t.Errorf("Expected WithEvaluationWorkers(1) to evaluate serially, got %d", cfg.EvaluationWorkers)
+	}
+}
+
+func TestControllerConfig_WithStatusUpdateRetry(t *testing.T) {
+	cfg := NewControllerConfig()
+	if cfg.StatusUpdateMaxAttempts != DefaultStatusUpdateMaxAttempts {
+		t.Errorf("Expected default StatusUpdateMaxAttempts=%d, got %d", DefaultStatusUpdateMaxAttempts, cfg.StatusUpdateMaxAttempts)
+	}
+
+	cfg.WithStatusUpdateRetry(5, 250*time.Millisecond)
+	if cfg.StatusUpdateMaxAttempts != 5 {
+		t.Errorf("Expected StatusUpdateMaxAttempts=5, got %d", cfg.StatusUpdateMaxAttempts)
+	}
+	if cfg.StatusUpdateRetryDelay != 250*time.Millisecond {
+		t.Errorf("Expected StatusUpdateRetryDelay=250ms, got %v", cfg.StatusUpdateRetryDelay)
+	}
+}
+
+func TestControllerConfig_WithHierarchicalRateLimits(t *testing.T) {
+	cfg := NewControllerConfig()
+	if cfg.GlobalMaxDeletionsPerSecond != 0 || cfg.NamespaceMaxDeletionsPerSecond != 0 {
+		t.Errorf("Expected no global or namespace limits by default, got %d/%d", cfg.GlobalMaxDeletionsPerSecond, cfg.NamespaceMaxDeletionsPerSecond)
+	}
+
+	cfg.WithHierarchicalRateLimits(100, 20)
+	if cfg.GlobalMaxDeletionsPerSecond != 100 {
+		t.Errorf("Expected GlobalMaxDeletionsPerSecond=100, got %d", cfg.GlobalMaxDeletionsPerSecond)
+	}
+	if cfg.NamespaceMaxDeletionsPerSecond != 20 {
+		t.Errorf("Expected NamespaceMaxDeletionsPerSecond=20, got %d", cfg.NamespaceMaxDeletionsPerSecond)
+	}
+}
+
+func TestControllerConfig_WithMaxInformers(t *testing.T) {
+	cfg := NewControllerConfig()
+	if cfg.MaxInformers != 0 {
+		t.Errorf("Expected no informer limit by default, got %d", cfg.MaxInformers)
+	}
+
+	cfg.WithMaxInformers(25)
+	if cfg.MaxInformers != 25 {
+		t.Errorf("Expected MaxInformers=25, got %d", cfg.MaxInformers)
+	}
+}
+
+func TestControllerConfig_WithErrorRateBreaker(t *testing.T) {
+	cfg := NewControllerConfig()
+	if cfg.ErrorRateThresholdPercent != 0 {
+		t.Errorf("Expected the error rate breaker disabled by default, got threshold %d", cfg.ErrorRateThresholdPercent)
+	}
+
+	cfg.WithErrorRateBreaker(50, time.Minute, 10)
+	if cfg.ErrorRateThresholdPercent != 50 || cfg.ErrorRateWindow != time.Minute || cfg.ErrorRateMinAttempts != 10 {
+		t.Errorf("Expected threshold=50 window=1m minAttempts=10, got %d %v %d", cfg.ErrorRateThresholdPercent, cfg.ErrorRateWindow, cfg.ErrorRateMinAttempts)
+	}
+}
+
+func TestControllerConfig_WithDefaultTargetNamespaceMode(t *testing.T) {
+	cfg := NewControllerConfig()
+	if cfg.DefaultTargetNamespaceMode != TargetNamespaceModeCluster {
+		t.Errorf("Expected default target namespace mode %q, got %q", TargetNamespaceModeCluster, cfg.DefaultTargetNamespaceMode)
+	}
+
+	cfg.WithDefaultTargetNamespaceMode(TargetNamespaceModePolicy)
+	if cfg.DefaultTargetNamespaceMode != TargetNamespaceModePolicy {
+		t.Errorf("Expected target namespace mode %q, got %q", TargetNamespaceModePolicy, cfg.DefaultTargetNamespaceMode)
+	}
+}
+
+func TestControllerConfig_LoadFromEnv_DefaultTargetNamespaceMode(t *testing.T) {
+	t.Setenv("GC_DEFAULT_TARGET_NAMESPACE_MODE", "policy")
+	cfg := NewControllerConfig()
+	if err := cfg.LoadFromEnv(); err != nil {
+		t.Fatalf("LoadFromEnv() returned error: %v", err)
+	}
+	if cfg.DefaultTargetNamespaceMode != TargetNamespaceModePolicy {
+		t.Errorf("Expected target namespace mode %q, got %q", TargetNamespaceModePolicy, cfg.DefaultTargetNamespaceMode)
+	}
+
+	t.Setenv("GC_DEFAULT_TARGET_NAMESPACE_MODE", "namespace")
+	cfg = NewControllerConfig()
+	if err := cfg.LoadFromEnv(); err != nil {
+		t.Fatalf("LoadFromEnv() returned error: %v", err)
+	}
+	if cfg.DefaultTargetNamespaceMode != TargetNamespaceModeCluster {
+		t.Errorf("Expected an unknown mode to keep %q, got %q", TargetNamespaceModeCluster, cfg.DefaultTargetNamespaceMode)
+	}
+}
+
+func TestParseAPIGroups(t *testing.T) {
+	got := ParseAPIGroups(" core, apps,,batch ")
+	want := []string{"", "apps", "batch"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseAPIGroups() = %q, want %q", got, want)
+	}
+	if got := ParseAPIGroups(""); got != nil {
+		t.Errorf("ParseAPIGroups(\"\") = %q, want nil", got)
+	}
+}
+
+func TestControllerConfig_LoadFromEnv_AllowedAPIGroups(t *testing.T) {
+	cfg := NewControllerConfig()
+	if cfg.AllowedAPIGroups != nil {
+		t.Errorf("Expected every API group to be allowed by default, got %q", cfg.AllowedAPIGroups)
+	}
+
+	t.Setenv("GC_ALLOWED_API_GROUPS", "batch,apps")
+	if err := cfg.LoadFromEnv(); err != nil {
+		t.Fatalf("LoadFromEnv() returned error: %v", err)
+	}
+	if want := []string{"batch", "apps"}; !reflect.DeepEqual(cfg.AllowedAPIGroups, want) {
+		t.Errorf("Expected allowed API groups %q, got %q", want, cfg.AllowedAPIGroups)
+	}
+
+	cfg.WithAllowedAPIGroups(nil)
+	if cfg.AllowedAPIGroups != nil {
+		t.Errorf("Expected WithAllowedAPIGroups(nil) to allow every group, got %q", cfg.AllowedAPIGroups)
+	}
+}
+
+func TestControllerConfig_LoadFromEnv_CountAlreadyGoneSeparately(t *testing.T) {
+	cfg := NewControllerConfig()
+	if cfg.CountAlreadyGoneSeparately {
+		t.Error("Expected already gone resources to count as deleted by default")
+	}
+
+	t.Setenv("GC_COUNT_ALREADY_GONE_SEPARATELY", "true")
+	if err := cfg.LoadFromEnv(); err != nil {
+		t.Fatalf("LoadFromEnv() returned error: %v", err)
+	}
+	if !cfg.CountAlreadyGoneSeparately {
+		t.Error("Expected CountAlreadyGoneSeparately=true from GC_COUNT_ALREADY_GONE_SEPARATELY")
+	}
+
+	cfg.WithCountAlreadyGoneSeparately(false)
+	if cfg.CountAlreadyGoneSeparately {
+		t.Error("Expected WithCountAlreadyGoneSeparately(false) to count already gone resources as deleted")
+	}
+}
+
+func TestControllerConfig_LoadFromEnv_ProtectAnnotation(t *testing.T) {
+	cfg := NewControllerConfig()
+	if cfg.ProtectAnnotation != DefaultProtectAnnotation {
+		t.Errorf("Expected protect annotation %q by default, got %q", DefaultProtectAnnotation, cfg.ProtectAnnotation)
+	}
+
+	t.Setenv("GC_PROTECT_ANNOTATION", "example.com/keep")
+	if err := cfg.LoadFromEnv(); err != nil {
+		t.Fatalf("LoadFromEnv() returned error: %v", err)
+	}
+	if cfg.ProtectAnnotation != "example.com/keep" {
+		t.Errorf("Expected protect annotation %q, got %q", "example.com/keep", cfg.ProtectAnnotation)
+	}
+
+	cfg.WithProtectAnnotation("")
+	if cfg.ProtectAnnotation != "" {
+		t.Errorf("Expected WithProtectAnnotation(\"\") to disable protection, got %q", cfg.ProtectAnnotation)
+	}
+}
+
+func TestParseNamespaces(t *testing.T) {
+	got := ParseNamespaces(" kube-*, cert-manager,, ")
+	want := []string{"kube-*", "cert-manager"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseNamespaces() = %q, want %q", got, want)
+	}
+	if got := ParseNamespaces(""); got != nil {
+		t.Errorf("ParseNamespaces(\"\") = %q, want nil", got)
+	}
+}
+
+func TestControllerConfig_LoadFromEnv_ProtectedNamespaces(t *testing.T) {
+	cfg := NewControllerConfig()
+	if cfg.ProtectedNamespaces != nil {
+		t.Errorf("Expected no protected namespaces by default, got %q", cfg.ProtectedNamespaces)
+	}
+
+	t.Setenv("GC_PROTECTED_NAMESPACES", "kube-*,cert-manager")
+	if err := cfg.LoadFromEnv(); err != nil {
+		t.Fatalf("LoadFromEnv() returned error: %v", err)
+	}
+	if want := []string{"kube-*", "cert-manager"}; !reflect.DeepEqual(cfg.ProtectedNamespaces, want) {
+		t.Errorf("Expected protected namespaces %q, got %q", want, cfg.ProtectedNamespaces)
+	}
+
+	cfg.WithProtectedNamespaces(nil)
+	if cfg.ProtectedNamespaces != nil {
+		t.Errorf("Expected WithProtectedNamespaces(nil) to protect no namespace, got %q", cfg.ProtectedNamespaces)
+	}
+}
+
+func TestControllerConfig_LoadFromEnv_PushgatewayURL(t *testing.T) {
+	cfg := NewControllerConfig()
+	if cfg.PushgatewayURL != "" {
+		t.Errorf("Expected no Pushgateway by default, got %q", cfg.PushgatewayURL)
+	}
+
+	t.Setenv("GC_PUSHGATEWAY_URL", "http://pushgateway:9091")
+	if err := cfg.LoadFromEnv(); err != nil {
+		t.Fatalf("LoadFromEnv() returned error: %v", err)
+	}
+	if cfg.PushgatewayURL != "http://pushgateway:9091" {
+		t.Errorf("Expected Pushgateway URL from GC_PUSHGATEWAY_URL, got %q", cfg.PushgatewayURL)
+	}
+
+	cfg.WithPushgatewayURL("")
+	if cfg.PushgatewayURL != "" {
+		t.Error("Expected WithPushgatewayURL(\"\") to disable pushing")
+	}
+}
+
+func TestParseObjectRef(t *testing.T) {
+	tests := []struct {
+		ref       string
+		namespace string
+		name      string
+		ok        bool
+	}{
+		{ref: "gc-system/gc-sentinel", namespace: "gc-system", name: "gc-sentinel", ok: true},
+		{ref: "gc-sentinel"},
+		{ref: "/gc-sentinel"},
+		{ref: "gc-system/"},
+		{ref: "a/b/c"},
+	}
+	for _, tt := range tests {
+		namespace, name, ok := ParseObjectRef(tt.ref)
+		if namespace != tt.namespace || name != tt.name || ok != tt.ok {
+			t.Errorf("ParseObjectRef(%q) = %q, %q, %v, want %q, %q, %v", tt.ref, namespace, name, ok, tt.namespace, tt.name, tt.ok)
+		}
+	}
+}
+
+func TestControllerConfig_LoadFromEnv_Sentinel(t *testing.T) {
+	cfg := NewControllerConfig()
+	if cfg.SentinelConfigMap != "" || cfg.SentinelURL != "" || cfg.SentinelInterval != DefaultSentinelInterval {
+		t.Errorf("Expected no sentinel and the default interval by default, got %+v", cfg)
+	}
+
+	t.Setenv("GC_SENTINEL_CONFIGMAP", "gc-system/gc-sentinel")
+	t.Setenv("GC_SENTINEL_URL", "http://sentinel:8080/enabled")
+	t.Setenv("GC_SENTINEL_INTERVAL", "10s")
+	if err := cfg.LoadFromEnv(); err != nil {
+		t.Fatalf("LoadFromEnv() returned error: %v", err)
+	}
+	if cfg.SentinelConfigMap != "gc-system/gc-sentinel" || cfg.SentinelURL != "http://sentinel:8080/enabled" || cfg.SentinelInterval != 10*time.Second {
+		t.Errorf("Expected sentinel from environment, got %q, %q, %v", cfg.SentinelConfigMap, cfg.SentinelURL, cfg.SentinelInterval)
+	}
+
+	t.Setenv("GC_SENTINEL_CONFIGMAP", "gc-sentinel")
+	cfg = NewControllerConfig()
+	if err := cfg.LoadFromEnv(); err != nil {
+		t.Fatalf("LoadFromEnv() returned error: %v", err)
+	}
+	if cfg.SentinelConfigMap != "" {
+		t.Errorf("Expected a ConfigMap reference without a namespace to be ignored, got %q", cfg.SentinelConfigMap)
+	}
+}
+
+func TestControllerConfig_LoadFromEnv_TargetDiscovery(t *testing.T) {
+	cfg := NewControllerConfig()
+	if cfg.EnforceTargetDiscovery || cfg.TargetDiscoveryTTL != DefaultTargetDiscoveryTTL {
+		t.Errorf("Expected warn-only target discovery with the default TTL by default, got %v, %v", cfg.EnforceTargetDiscovery, cfg.TargetDiscoveryTTL)
+	}
+
+	t.Setenv("GC_ENFORCE_TARGET_DISCOVERY", "true")
+	t.Setenv("GC_TARGET_DISCOVERY_TTL", "1m")
+	if err := cfg.LoadFromEnv(); err != nil {
+		t.Fatalf("LoadFromEnv() returned error: %v", err)
+	}
+	if !cfg.EnforceTargetDiscovery || cfg.TargetDiscoveryTTL != time.Minute {
+		t.Errorf("Expected enforced target discovery with a 1m TTL from environment, got %v, %v", cfg.EnforceTargetDiscovery, cfg.TargetDiscoveryTTL)
+	}
+}
+
+func TestControllerConfig_LoadFromEnv_DegradedAfterFailures(t *testing.T) {
+	cfg := NewControllerConfig()
+	if cfg.DegradedAfterFailures != DefaultDegradedAfterFailures {
+		t.Errorf("Expected DegradedAfterFailures %d by default, got %d", DefaultDegradedAfterFailures, cfg.DegradedAfterFailures)
+	}
+
+	t.Setenv("GC_DEGRADED_AFTER_FAILURES", "3")
+	if err := cfg.LoadFromEnv(); err != nil {
+		t.Fatalf("LoadFromEnv() returned error: %v", err)
+	}
+	if cfg.DegradedAfterFailures != 3 {
+		t.Errorf("Expected DegradedAfterFailures 3 from environment, got %d", cfg.DegradedAfterFailures)
+	}
+}
+
+func TestControllerConfig_LoadFromEnv_StaleAfterEmptyRuns(t *testing.T) {
+	cfg := NewControllerConfig()
+	if cfg.StaleAfterEmptyRuns != DefaultStaleAfterEmptyRuns {
+		t.Errorf("Expected StaleAfterEmptyRuns %d by default, got %d", DefaultStaleAfterEmptyRuns, cfg.StaleAfterEmptyRuns)
+	}
+
+	t.Setenv("GC_STALE_AFTER_EMPTY_RUNS", "4")
+	if err := cfg.LoadFromEnv(); err != nil {
+		t.Fatalf("LoadFromEnv() returned error: %v", err)
+	}
+	if cfg.StaleAfterEmptyRuns != 4 {
+		t.Errorf("Expected StaleAfterEmptyRuns 4 from environment, got %d", cfg.StaleAfterEmptyRuns)
+	}
+}
+
+func TestControllerConfig_LoadFromEnv_DenyPolicyOverlap(t *testing.T) {
+	cfg := NewControllerConfig()
+	if cfg.DenyPolicyOverlap {
+		t.Error("Expected overlapping policies to be warned about, not denied, by default")
+	}
+
+	t.Setenv("GC_DENY_POLICY_OVERLAP", "true")
+	if err := cfg.LoadFromEnv(); err != nil {
+		t.Fatalf("LoadFromEnv() returned error: %v", err)
+	}
+	if !cfg.DenyPolicyOverlap {
+		t.Error("Expected overlapping policies to be denied from environment")
+	}
+}
+
+func TestControllerConfig_LoadFromEnv_AuditLogPath(t *testing.T) {
+	cfg := NewControllerConfig()
+	if cfg.AuditLogPath != "" {
+		t.Errorf("Expected the audit log to be disabled by default, got %q", cfg.AuditLogPath)
+	}
+
+	t.Setenv("GC_AUDIT_LOG_PATH", "/var/log/zen-gc/audit.jsonl")
+	if err := cfg.LoadFromEnv(); err != nil {
+		t.Fatalf("LoadFromEnv() returned error: %v", err)
+	}
+	if cfg.AuditLogPath != "/var/log/zen-gc/audit.jsonl" {
+		t.Errorf("Expected AuditLogPath from environment, got %q", cfg.AuditLogPath)
+	}
+}
+
+func TestControllerConfig_LoadFromEnv_OPA(t *testing.T) {
+	cfg := NewControllerConfig()
+	if cfg.OPAAllowedURLs != nil || cfg.OPAIncludeData {
+		t.Errorf("Expected no OPA URLs and no data sent by default, got %q, %v", cfg.OPAAllowedURLs, cfg.OPAIncludeData)
+	}
+
+	t.Setenv("GC_OPA_ALLOWED_URLS", " http://opa.opa:8181/v1/data/gc/allow,, http://opa.opa:8181/v1/data/gc/secrets ")
+	t.Setenv("GC_OPA_INCLUDE_DATA", "true")
+	if err := cfg.LoadFromEnv(); err != nil {
+		t.Fatalf("LoadFromEnv() returned error: %v", err)
+	}
+	if want := []string{"http://opa.opa:8181/v1/data/gc/allow", "http://opa.opa:8181/v1/data/gc/secrets"}; !reflect.DeepEqual(cfg.OPAAllowedURLs, want) {
+		t.Errorf("Expected OPA URLs %q, got %q", want, cfg.OPAAllowedURLs)
+	}
+	if !cfg.OPAIncludeData {
+		t.Error("Expected OPAIncludeData from environment")
+	}
+}
+
+func TestControllerConfig_LoadFromEnv_ResultWebhookAllowedURLs(t *testing.T) {
+	cfg := NewControllerConfig()
+	if cfg.ResultWebhookAllowedURLs != nil {
+		t.Errorf("Expected no result webhook URLs by default, got %q", cfg.ResultWebhookAllowedURLs)
+	}
+
+	t.Setenv("GC_RESULT_WEBHOOK_ALLOWED_URLS", "https://hooks.example.com/gc")
+	if err := cfg.LoadFromEnv(); err != nil {
+		t.Fatalf("LoadFromEnv() returned error: %v", err)
+	}
+	if want := []string{"https://hooks.example.com/gc"}; !reflect.DeepEqual(cfg.ResultWebhookAllowedURLs, want) {
+		t.Errorf("Expected result webhook URLs %q, got %q", want, cfg.ResultWebhookAllowedURLs)
+	}
+}
+
+func TestControllerConfig_LoadFromEnv_DebugBundle(t *testing.T) {
+	cfg := NewControllerConfig()
+	if cfg.DebugBundleEnabled {
+		t.Error("Expected debug bundles to be disabled by default")
+	}
+
+	t.Setenv("GC_ENABLE_DEBUG_BUNDLE", "true")
+	if err := cfg.LoadFromEnv(); err != nil {
+		t.Fatalf("LoadFromEnv() returned error: %v", err)
+	}
+	if !cfg.DebugBundleEnabled {
+		t.Error("Expected DebugBundleEnabled from environment")
+	}
+}
+
+func TestControllerConfig_LoadFromEnv_DeleteBackoff(t *testing.T) {
+	cfg := NewControllerConfig()
+	if cfg.DeleteMaxRetries != DefaultDeleteMaxRetries {
+		t.Errorf("Expected DeleteMaxRetries %d by default, got %d", DefaultDeleteMaxRetries, cfg.DeleteMaxRetries)
+	}
+
+	t.Setenv("GC_DELETE_BACKOFF_INITIAL_INTERVAL", "250ms")
+	t.Setenv("GC_DELETE_BACKOFF_MAX_INTERVAL", "10s")
+	t.Setenv("GC_DELETE_BACKOFF_MULTIPLIER", "1.5")
+	t.Setenv("GC_DELETE_MAX_RETRIES", "0")
+	if err := cfg.LoadFromEnv(); err != nil {
+		t.Fatalf("LoadFromEnv() returned error: %v", err)
+	}
+	if cfg.DeleteBackoffInitialInterval != 250*time.Millisecond {
+		t.Errorf("Expected DeleteBackoffInitialInterval 250ms from environment, got %v", cfg.DeleteBackoffInitialInterval)
+	}
+	if cfg.DeleteBackoffMaxInterval != 10*time.Second {
+		t.Errorf("Expected DeleteBackoffMaxInterval 10s from environment, got %v", cfg.DeleteBackoffMaxInterval)
+	}
+	if cfg.DeleteBackoffMultiplier != 1.5 {
+		t.Errorf("Expected DeleteBackoffMultiplier 1.5 from environment, got %v", cfg.DeleteBackoffMultiplier)
+	}
+	if cfg.DeleteMaxRetries != 0 {
+		t.Errorf("Expected retries disabled from environment, got %d", cfg.DeleteMaxRetries)
+	}
+}
@@ -31,7 +31,8 @@ import (
 
 // Static errors for adapters.
 var (
-	errInformerStoreNil = errors.New("informer store is nil")
+	errInformerStoreNil      = errors.New("informer store is nil")
+	errPolicyListingRequired = errors.New("resources can only be listed for a policy")
 )
 
 // InformerStoreResourceLister adapts a cache.Store to ResourceLister interface.
@@ -47,7 +48,17 @@ func NewInformerStoreResourceLister(store cache.Store) ResourceLister {
 
 // ListResources lists all resources from the store.
 func (l *InformerStoreResourceLister) ListResources(ctx context.Context, gvr schema.GroupVersionResource, namespace string) ([]*unstructured.Unstructured, error) {
-	items := l.store.List()
+	return filterStoreObjects(l.store.List(), namespace), nil
+}
+
+// ListPolicyResources lists the policy's candidate resources, using the informer's
+// indexes when the store has them.
+func (l *InformerStoreResourceLister) ListPolicyResources(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy) ([]*unstructured.Unstructured, error) {
+	return filterStoreObjects(policyCandidatesShared(l.store, policy), policy.Spec.TargetResource.Namespace), nil
+}
+
+// filterStoreObjects converts store objects to unstructured resources in the namespace.
+func filterStoreObjects(items []interface{}, namespace string) []*unstructured.Unstructured {
 	resources := make([]*unstructured.Unstructured, 0, len(items))
 
 	for _, obj := range items {
@@ -64,7 +75,7 @@ func (l *InformerStoreResourceLister) ListResources(ctx context.Context, gvr sch
 		resources = append(resources, resource)
 	}
 
-	return resources, nil
+	return resources
 }
 
 // GCPolicyReconcilerAdapter adapts GCPolicyReconciler to provide interfaces for PolicyEvaluationService.
@@ -78,9 +89,41 @@ func NewGCPolicyReconcilerAdapter(reconciler *GCPolicyReconciler) *GCPolicyRecon
 	return &GCPolicyReconcilerAdapter{reconciler: reconciler}
 }
 
-// GetResourceListerForPolicy creates a ResourceLister from the policy's informer.
+// GetResourceListerForPolicy creates a ResourceLister backed by the reconciler's informers,
+// ensuring the policy's informer exists. The lister resolves each policy's informer when it
+// lists, so it keeps working after an informer is recreated for a changed spec.
 func (a *GCPolicyReconcilerAdapter) GetResourceListerForPolicy(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy) (ResourceLister, error) {
-	informer, err := a.reconciler.getOrCreateResourceInformer(ctx, policy)
+	lister := &policyInformerResourceLister{reconciler: a.reconciler}
+	if _, err := lister.policyStore(ctx, policy); err != nil {
+		return nil, err
+	}
+	return lister, nil
+}
+
+// policyInformerResourceLister lists a policy's resources from the policy's current
+// informer. Binding a lister to one informer's store would keep reading a stopped store
+// once the informer is torn down for a spec change.
+type policyInformerResourceLister struct {
+	reconciler *GCPolicyReconciler
+}
+
+// ListResources returns an error: which informer to list from depends on the policy.
+func (l *policyInformerResourceLister) ListResources(ctx context.Context, gvr schema.GroupVersionResource, namespace string) ([]*unstructured.Unstructured, error) {
+	return nil, fmt.Errorf("%w: %s", errPolicyListingRequired, gvr.String())
+}
+
+// ListPolicyResources lists the policy's candidate resources from its current informer.
+func (l *policyInformerResourceLister) ListPolicyResources(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy) ([]*unstructured.Unstructured, error) {
+	store, err := l.policyStore(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+	return (&InformerStoreResourceLister{store: store}).ListPolicyResources(ctx, policy)
+}
+
+// policyStore returns the store of the policy's informer, creating the informer if needed.
+func (l *policyInformerResourceLister) policyStore(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy) (cache.Store, error) {
+	informer, err := l.reconciler.getOrCreateResourceInformer(ctx, policy)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get resource informer: %w", err)
 	}
@@ -88,7 +131,7 @@ func (a *GCPolicyReconcilerAdapter) GetResourceListerForPolicy(ctx context.Conte
 	if store == nil {
 		return nil, fmt.Errorf("%w for policy %s/%s", errInformerStoreNil, policy.Namespace, policy.Name)
 	}
-	return NewInformerStoreResourceLister(store), nil
+	return store, nil
 }
 
 // GetSelectorMatcher returns a SelectorMatcher using GCPolicyReconciler's implementation.
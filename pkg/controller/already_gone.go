@@ -0,0 +1,35 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+)
+
+// isResourceAlreadyGone reports whether a deletion found the resource already gone.
+func isResourceAlreadyGone(err error) bool {
+	return errors.Is(err, ErrResourceAlreadyGone)
+}
+
+// alreadyGoneAsDeletedShared treats a resource that was already gone as deleted, unless
+// the controller counts already gone resources separately.
+func alreadyGoneAsDeletedShared(err error, countSeparately bool) error {
+	if !countSeparately && isResourceAlreadyGone(err) {
+		return nil
+	}
+	return err
+}
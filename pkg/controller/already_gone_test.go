@@ -0,0 +1,98 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/config"
+	"github.com/kube-zen/zen-sdk/pkg/gc/ratelimiter"
+)
+
+func TestAlreadyGoneAsDeletedShared(t *testing.T) {
+	alreadyGone := fmt.Errorf("%w: not found", ErrResourceAlreadyGone)
+
+	if err := alreadyGoneAsDeletedShared(alreadyGone, false); err != nil {
+		t.Errorf("alreadyGoneAsDeletedShared() = %v, want nil when not counted separately", err)
+	}
+	if err := alreadyGoneAsDeletedShared(alreadyGone, true); !errors.Is(err, ErrResourceAlreadyGone) {
+		t.Errorf("alreadyGoneAsDeletedShared() = %v, want ErrResourceAlreadyGone when counted separately", err)
+	}
+	if err := alreadyGoneAsDeletedShared(errFakeDeleteFailed, false); !errors.Is(err, errFakeDeleteFailed) {
+		t.Errorf("alreadyGoneAsDeletedShared() = %v, want other errors unchanged", err)
+	}
+}
+
+func TestDeleteResourceWithBackoff_NotFoundCountedSeparately(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add scheme: %v", err)
+	}
+	dynamicClient := fake.NewSimpleDynamicClient(scheme)
+	reconciler := NewGCPolicyReconcilerWithRESTMapper(
+		clientfake.NewClientBuilder().WithScheme(scheme).Build(),
+		scheme,
+		dynamicClient,
+		nil,
+		NewStatusUpdater(dynamicClient),
+		NewEventRecorder(nil),
+		config.NewControllerConfig().WithCountAlreadyGoneSeparately(true),
+	)
+
+	policy := newTestPolicy("policy")
+	resource := newTestConfigMap("default", "non-existent", time.Now())
+
+	err := reconciler.DeleteResourceWithBackoff(context.Background(), resource, policy, ratelimiter.NewRateLimiter(10))
+	if !errors.Is(err, ErrResourceAlreadyGone) {
+		t.Errorf("DeleteResourceWithBackoff() = %v, want ErrResourceAlreadyGone", err)
+	}
+}
+
+func TestDeleteBatch_AlreadyGoneCountedSeparately(t *testing.T) {
+	coordinator := NewDeletionCoordinator()
+	deleter := &fakeBatchDeleter{coordinator: coordinator, deletedBy: map[string][]string{}, err: fmt.Errorf("%w: not found", ErrResourceAlreadyGone)}
+	limiter := ratelimiter.NewRateLimiter(1000)
+	policy := newTestPolicy("already-gone")
+	batch := []*unstructured.Unstructured{newTestConfigMap("default", "a", time.Now()), newTestConfigMap("default", "b", time.Now())}
+
+	alreadyGone := gcResourcesAlreadyGoneTotal.WithLabelValues("default", "already-gone", "v1", "ConfigMap")
+	before := testutil.ToFloat64(alreadyGone)
+
+	deleted, errs := deleteBatchShared(context.Background(), batch, policy, limiter, map[string]string{}, deleter)
+	if deleted != 0 || len(errs) != 0 {
+		t.Fatalf("deleted=%d errs=%v, want already gone resources neither deleted nor failed", deleted, errs)
+	}
+	if got := testutil.ToFloat64(alreadyGone) - before; got != 2 {
+		t.Errorf("gc_resources_already_gone_total increased by %v, want 2", got)
+	}
+
+	// Already gone resources are settled, not left as pending claims
+	if owner, ok := coordinator.ClaimedBy("a-uid"); !ok || owner.Name != "already-gone" {
+		t.Errorf("resource a claimed by %v (ok=%v), want a completed claim by already-gone", owner, ok)
+	}
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+// ErrAPICallBudgetExhausted indicates an evaluation used up its policy's maxApiCalls.
+var ErrAPICallBudgetExhausted = errors.New("API call budget exhausted")
+
+// apiCallBudgetKey is the context key of an evaluation's API call budget.
+type apiCallBudgetKey struct{}
+
+// apiCallBudget is the number of API calls an evaluation may still make. It is shared by
+// the evaluation's goroutines.
+type apiCallBudget struct {
+	remaining atomic.Int64
+}
+
+// withAPICallBudgetShared returns a context carrying a fresh API call budget for one
+// evaluation of the policy, or ctx unchanged if the policy sets no maxApiCalls.
+func withAPICallBudgetShared(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy) context.Context {
+	if policy.Spec.Behavior.MaxAPICalls <= 0 {
+		return ctx
+	}
+	budget := &apiCallBudget{}
+	budget.remaining.Store(int64(policy.Spec.Behavior.MaxAPICalls))
+	return context.WithValue(ctx, apiCallBudgetKey{}, budget)
+}
+
+// spendAPICall takes one call from the context's API call budget, returning
+// ErrAPICallBudgetExhausted if none is left. Contexts without a budget are unlimited.
+func spendAPICall(ctx context.Context) error {
+	budget, ok := ctx.Value(apiCallBudgetKey{}).(*apiCallBudget)
+	if !ok {
+		return nil
+	}
+	if budget.remaining.Add(-1) < 0 {
+		return fmt.Errorf("%w", ErrAPICallBudgetExhausted)
+	}
+	return nil
+}
+
+// apiCallBudgetExhausted reports whether the context's API call budget has no calls left.
+func apiCallBudgetExhausted(ctx context.Context) bool {
+	budget, ok := ctx.Value(apiCallBudgetKey{}).(*apiCallBudget)
+	return ok && budget.remaining.Load() <= 0
+}
+
+// isAPICallBudgetExhausted reports whether err is due to a spent API call budget.
+func isAPICallBudgetExhausted(err error) bool {
+	return errors.Is(err, ErrAPICallBudgetExhausted)
+}
+
+// recordAPICallBudgetExhaustedShared reports that a run stopped at the policy's maxApiCalls
+// budget, leaving deferred candidates for the next run.
+func recordAPICallBudgetExhaustedShared(eventRecorder *EventRecorder, policy *v1alpha1.GarbageCollectionPolicy, deferred int64) {
+	recordAPICallBudgetExhausted(policy.Namespace, policy.Name)
+	sdklog.NewLogger("zen-gc").Info("API call budget exhausted, deferring remaining deletions", sdklog.Operation("delete_batch"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Int("max_api_calls", policy.Spec.Behavior.MaxAPICalls), sdklog.Int64("deferred", deferred))
+	if eventRecorder != nil {
+		eventRecorder.RecordAPICallBudgetExhausted(policy, deferred)
+	}
+}
+
+// budgetedDynamicClient is a dynamic client that charges every call to the API call budget
+// of its context, failing with ErrAPICallBudgetExhausted once it is spent. Calls with a
+// context that carries no budget, such as those of informers, are passed through.
+type budgetedDynamicClient struct {
+	dynamic.Interface
+}
+
+// newBudgetedDynamicClient wraps client so its calls are charged to evaluation budgets.
+func newBudgetedDynamicClient(client dynamic.Interface) dynamic.Interface {
+	if client == nil {
+		return nil
+	}
+	return budgetedDynamicClient{Interface: client}
+}
+
+// Resource returns a budgeted client for the resource.
+func (c budgetedDynamicClient) Resource(resource schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	client := c.Interface.Resource(resource)
+	return budgetedNamespaceableResource{budgetedResource: budgetedResource{client: client}, namespaceable: client}
+}
+
+// budgetedNamespaceableResource is a budgeted client for a resource across namespaces.
+type budgetedNamespaceableResource struct {
+	budgetedResource
+	namespaceable dynamic.NamespaceableResourceInterface
+}
+
+// Namespace returns a budgeted client for the resource in one namespace.
+func (c budgetedNamespaceableResource) Namespace(namespace string) dynamic.ResourceInterface {
+	return budgetedResource{client: c.namespaceable.Namespace(namespace)}
+}
+
+// budgetedResource charges each call to the budget of its context before making it.
+type budgetedResource struct {
+	client dynamic.ResourceInterface
+}
+
+func (c budgetedResource) Create(ctx context.Context, obj *unstructured.Unstructured, options metav1.CreateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	if err := spendAPICall(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.Create(ctx, obj, options, subresources...)
+}
+
+func (c budgetedResource) Update(ctx context.Context, obj *unstructured.Unstructured, options metav1.UpdateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	if err := spendAPICall(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.Update(ctx, obj, options, subresources...)
+}
+
+func (c budgetedResource) UpdateStatus(ctx context.Context, obj *unstructured.Unstructured, options metav1.UpdateOptions) (*unstructured.Unstructured, error) {
+	if err := spendAPICall(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.UpdateStatus(ctx, obj, options)
+}
+
+func (c budgetedResource) Delete(ctx context.Context, name string, options metav1.DeleteOptions, subresources ...string) error {
+	if err := spendAPICall(ctx); err != nil {
+		return err
+	}
+	return c.client.Delete(ctx, name, options, subresources...)
+}
+
+func (c budgetedResource) DeleteCollection(ctx context.Context, options metav1.DeleteOptions, listOptions metav1.ListOptions) error {
+	if err := spendAPICall(ctx); err != nil {
+		return err
+	}
+	return c.client.DeleteCollection(ctx, options, listOptions)
+}
+
+func (c budgetedResource) Get(ctx context.Context, name string, options metav1.GetOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	if err := spendAPICall(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.Get(ctx, name, options, subresources...)
+}
+
+func (c budgetedResource) List(ctx context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	if err := spendAPICall(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.List(ctx, opts)
+}
+
+func (c budgetedResource) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	if err := spendAPICall(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.Watch(ctx, opts)
+}
+
+func (c budgetedResource) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, options metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	if err := spendAPICall(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.Patch(ctx, name, pt, data, options, subresources...)
+}
+
+func (c budgetedResource) Apply(ctx context.Context, name string, obj *unstructured.Unstructured, options metav1.ApplyOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	if err := spendAPICall(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.Apply(ctx, name, obj, options, subresources...)
+}
+
+func (c budgetedResource) ApplyStatus(ctx context.Context, name string, obj *unstructured.Unstructured, options metav1.ApplyOptions) (*unstructured.Unstructured, error) {
+	if err := spendAPICall(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.ApplyStatus(ctx, name, obj, options)
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-sdk/pkg/gc/ratelimiter"
+)
+
+func TestBudgetedDynamicClient_CapsCalls(t *testing.T) {
+	fakeClient := fake.NewSimpleDynamicClient(runtime.NewScheme(), newTestConfigMap("default", "cm", time.Time{}))
+	client := newBudgetedDynamicClient(fakeClient)
+	policy := &v1alpha1.GarbageCollectionPolicy{
+		Spec: v1alpha1.GarbageCollectionPolicySpec{Behavior: v1alpha1.BehaviorSpec{MaxAPICalls: 3}},
+	}
+	ctx := withAPICallBudgetShared(context.Background(), policy)
+
+	succeeded, exhausted := 0, 0
+	for range 5 {
+		_, err := client.Resource(configMapGVR).Namespace("default").Get(ctx, "cm", metav1.GetOptions{})
+		switch {
+		case err == nil:
+			succeeded++
+		case errors.Is(err, ErrAPICallBudgetExhausted):
+			exhausted++
+		default:
+			t.Fatalf("Get() returned unexpected error: %v", err)
+		}
+	}
+	if succeeded != 3 || exhausted != 2 {
+		t.Errorf("succeeded=%d exhausted=%d, want 3 calls within the budget and 2 refused", succeeded, exhausted)
+	}
+	if n := len(fakeClient.Actions()); n != 3 {
+		t.Errorf("%d calls reached the API server, want 3", n)
+	}
+	if !apiCallBudgetExhausted(ctx) {
+		t.Error("expected the budget to be reported exhausted")
+	}
+
+	// Calls outside an evaluation, such as those of informers, are not budgeted
+	for range 5 {
+		if _, err := client.Resource(configMapGVR).List(context.Background(), metav1.ListOptions{}); err != nil {
+			t.Fatalf("List() without a budget returned error: %v", err)
+		}
+	}
+}
+
+func TestWithAPICallBudgetShared_NoCap(t *testing.T) {
+	ctx := withAPICallBudgetShared(context.Background(), &v1alpha1.GarbageCollectionPolicy{})
+	for range 10 {
+		if err := spendAPICall(ctx); err != nil {
+			t.Fatalf("spendAPICall() without maxApiCalls returned error: %v", err)
+		}
+	}
+	if apiCallBudgetExhausted(ctx) {
+		t.Error("a policy without maxApiCalls should never exhaust its budget")
+	}
+}
+
+func TestDeleteBatchShared_APICallBudgetDefersUnreached(t *testing.T) {
+	coordinator := NewDeletionCoordinator()
+	deleter := &fakeBatchDeleter{coordinator: coordinator, deletedBy: map[string][]string{}, err: fmt.Errorf("delete: %w", ErrAPICallBudgetExhausted)}
+	limiter := ratelimiter.NewRateLimiter(1000)
+	batch := []*unstructured.Unstructured{newTestConfigMap("default", "a", time.Now()), newTestConfigMap("default", "b", time.Now()), newTestConfigMap("default", "c", time.Now())}
+	if ok, _ := coordinator.BeginDelete(types.UID("a-uid"), newTestPolicy("other")); !ok {
+		t.Fatal("expected the claim by the other policy to be granted")
+	}
+
+	// The resource claimed by another policy is skipped, not deferred
+	deleted, errs := deleteBatchShared(context.Background(), batch, newTestPolicy("budget"), limiter, map[string]string{}, deleter)
+	errs, deferred := splitDeferredShared(errs)
+	if deleted != 0 || len(errs) != 0 || deferred != 2 {
+		t.Errorf("deleted=%d errs=%v deferred=%d, want the 2 unreached resources deferred", deleted, errs, deferred)
+	}
+}
+
+func TestEvaluatePolicy_MaxAPICalls(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reconciler, dynamicClient, policy := setupObservePausedTest(t, false)
+	defer reconciler.cleanupResourceInformer(policy.UID)
+
+	// Both seeded configmaps are expired; the budget covers only one delete call
+	policy.Spec.Paused = false
+	policy.Spec.Behavior.MaxAPICalls = 1
+
+	if err := reconciler.evaluatePolicy(ctx, policy); err != nil {
+		t.Fatalf("evaluatePolicy() returned error: %v", err)
+	}
+	if deleted, _ := policyStatusCount(t, dynamicClient, "resourcesDeleted"); deleted != 1 {
+		t.Errorf("resourcesDeleted = %d, want 1", deleted)
+	}
+	if pending, _ := policyStatusCount(t, dynamicClient, "resourcesPending"); pending != 1 {
+		t.Errorf("resourcesPending = %d, want 1 deferred resource", pending)
+	}
+
+	list, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("Failed to list configmaps: %v", err)
+	}
+	if len(list.Items) != 1 {
+		t.Errorf("%d configmaps remain, want 1 deferred to the next run", len(list.Items))
+	}
+
+	// The next run gets a fresh budget, once the informer has seen the deletion
+	reconciler.resourceInformersMu.RLock()
+	informer := reconciler.resourceInformers[policy.UID]
+	reconciler.resourceInformersMu.RUnlock()
+	deadline := time.Now().Add(5 * time.Second)
+	for len(informer.GetStore().List()) != 1 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err := reconciler.evaluatePolicy(ctx, policy); err != nil {
+		t.Fatalf("evaluatePolicy() returned error: %v", err)
+	}
+	list, err = dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("Failed to list configmaps: %v", err)
+	}
+	if len(list.Items) != 0 {
+		t.Errorf("%d configmaps remain, want the deferred one deleted by the next run", len(list.Items))
+	}
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	gcerrors "github.com/kube-zen/zen-gc/pkg/errors"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+// ReasonAPIGroupNotAllowed is the status condition reason for a policy that targets an
+// API group outside the controller's allowlist.
+const ReasonAPIGroupNotAllowed = "APIGroupNotAllowed"
+
+// allowedAPIGroups returns the API groups policies may target, or nil for every group.
+func (r *GCPolicyReconciler) allowedAPIGroups() []string {
+	if r.config == nil {
+		return nil
+	}
+	return r.config.AllowedAPIGroups
+}
+
+// handleAPIGroupNotAllowed marks a policy targeting a disallowed API group as Error instead
+// of evaluating it.
+func (r *GCPolicyReconciler) handleAPIGroupNotAllowed(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy, err error) (ctrl.Result, error) {
+	r.logger.Warn("Policy targets a disallowed API group, skipping evaluation", sdklog.Operation("reconcile"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Error(err))
+	r.markPolicyError(ctx, policy, gcerrors.Wrap(err, gcerrors.TypeAPIGroupNotAllowed, "API group not allowed"), ReasonAPIGroupNotAllowed)
+	// A spec change triggers a new reconcile; the allowlist only changes on restart
+	return ctrl.Result{RequeueAfter: r.getRequeueIntervalForPolicy(policy)}, nil
+}
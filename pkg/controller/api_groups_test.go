@@ -0,0 +1,108 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic/fake"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/config"
+	"github.com/kube-zen/zen-gc/pkg/validation"
+)
+
+func TestGetOrCreateResourceInformer_AllowedAPIGroups(t *testing.T) {
+	reconciler := setupInformerTestReconciler(t)
+	policy := &v1alpha1.GarbageCollectionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "configmaps", Namespace: "default", UID: types.UID("api-groups-policy")},
+		Spec: v1alpha1.GarbageCollectionPolicySpec{
+			TargetResource: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap", Namespace: "*"},
+		},
+	}
+	t.Cleanup(func() { reconciler.cleanupResourceInformer(policy.UID) })
+
+	reconciler.config.WithAllowedAPIGroups([]string{"batch", "apps"})
+	if _, err := reconciler.getOrCreateResourceInformer(context.Background(), policy); !errors.Is(err, validation.ErrAPIGroupNotAllowed) {
+		t.Fatalf("getOrCreateResourceInformer() error = %v, want %v", err, validation.ErrAPIGroupNotAllowed)
+	}
+	if len(reconciler.resourceInformers) != 0 {
+		t.Errorf("expected no informer for a disallowed API group, got %d", len(reconciler.resourceInformers))
+	}
+
+	reconciler.config.WithAllowedAPIGroups(config.ParseAPIGroups("core,batch"))
+	if _, err := reconciler.getOrCreateResourceInformer(context.Background(), policy); err != nil {
+		t.Fatalf("getOrCreateResourceInformer() returned error for an allowed API group: %v", err)
+	}
+}
+
+func TestHandleAPIGroupNotAllowed_SetsError(t *testing.T) {
+	policy := &v1alpha1.GarbageCollectionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "certificates", Namespace: "default"},
+		Spec: v1alpha1.GarbageCollectionPolicySpec{
+			TargetResource: v1alpha1.TargetResourceSpec{APIVersion: "cert-manager.io/v1", Kind: "Certificate"},
+			TTL:            v1alpha1.TTLSpec{SecondsAfterCreation: int64Ptr(3600)},
+		},
+	}
+	scheme := runtime.NewScheme()
+	dynamicClient := fake.NewSimpleDynamicClient(scheme)
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(policy)
+	if err != nil {
+		t.Fatalf("Failed to convert policy to unstructured: %v", err)
+	}
+	if _, err := dynamicClient.Resource(PolicyGVR).Namespace("default").Create(context.Background(), &unstructured.Unstructured{Object: obj}, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create policy: %v", err)
+	}
+	reconciler := NewGCPolicyReconcilerWithRESTMapper(
+		clientfake.NewClientBuilder().WithScheme(scheme).Build(),
+		scheme,
+		dynamicClient,
+		nil,
+		NewStatusUpdater(dynamicClient),
+		NewEventRecorder(nil),
+		config.NewControllerConfig().WithAllowedAPIGroups([]string{"batch"}),
+	)
+
+	groupErr := validation.CheckAllowedAPIGroups(&policy.Spec, reconciler.allowedAPIGroups())
+	if groupErr == nil {
+		t.Fatal("expected cert-manager.io to be outside the allowed API groups")
+	}
+	if _, err := reconciler.handleAPIGroupNotAllowed(context.Background(), policy, groupErr); err != nil {
+		t.Fatalf("handleAPIGroupNotAllowed() returned error: %v", err)
+	}
+
+	current, err := dynamicClient.Resource(PolicyGVR).Namespace("default").Get(context.Background(), policy.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get policy: %v", err)
+	}
+	phase, _, _ := unstructured.NestedString(current.Object, "status", "phase")
+	conditions, _, _ := unstructured.NestedSlice(current.Object, "status", "conditions")
+	reason := ""
+	if len(conditions) > 0 {
+		reason, _, _ = unstructured.NestedString(conditions[0].(map[string]interface{}), "reason")
+	}
+	if phase != PolicyPhaseError || reason != ReasonAPIGroupNotAllowed {
+		t.Errorf("status phase/reason = %s/%s, want %s/%s", phase, reason, PolicyPhaseError, ReasonAPIGroupNotAllowed)
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// Array length condition operators.
+const (
+	LengthOperatorEquals             = "Equals"
+	LengthOperatorNotEquals          = "NotEquals"
+	LengthOperatorGreaterThan        = "GreaterThan"
+	LengthOperatorGreaterThanOrEqual = "GreaterThanOrEqual"
+	LengthOperatorLessThan           = "LessThan"
+	LengthOperatorLessThanOrEqual    = "LessThanOrEqual"
+)
+
+// meetsArrayLengthConditionsShared checks that all array length conditions match the resource.
+func meetsArrayLengthConditionsShared(resource *unstructured.Unstructured, conds []v1alpha1.ArrayLengthCondition) bool {
+	for i := range conds {
+		if !meetsArrayLengthCondition(resource, &conds[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// meetsArrayLengthCondition compares the length of the condition's array field with its value.
+// A missing field has length 0; a field that is not an array never matches.
+func meetsArrayLengthCondition(resource *unstructured.Unstructured, cond *v1alpha1.ArrayLengthCondition) bool {
+	length, ok := arrayFieldLength(resource, cond.FieldPath)
+	if !ok {
+		return false
+	}
+
+	switch cond.Operator {
+	case LengthOperatorEquals:
+		return length == cond.Value
+	case LengthOperatorNotEquals:
+		return length != cond.Value
+	case LengthOperatorGreaterThan:
+		return length > cond.Value
+	case LengthOperatorGreaterThanOrEqual:
+		return length >= cond.Value
+	case LengthOperatorLessThan:
+		return length < cond.Value
+	case LengthOperatorLessThanOrEqual:
+		return length <= cond.Value
+	default:
+		return false
+	}
+}
+
+// arrayFieldLength returns the number of elements of the array at path, 0 if the field
+// is missing, and false if it is not an array.
+func arrayFieldLength(resource *unstructured.Unstructured, path string) (int64, bool) {
+	value, found, err := nestedFieldNoCopy(resource.Object, path)
+	if err != nil {
+		return 0, false
+	}
+	if !found || value == nil {
+		return 0, true
+	}
+	items, ok := value.([]interface{})
+	if !ok {
+		return 0, false
+	}
+	return int64(len(items)), true
+}
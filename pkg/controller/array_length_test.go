@@ -0,0 +1,92 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func TestMeetsArrayLengthCondition(t *testing.T) {
+	three := []interface{}{"a", "b", "c"}
+	tests := []struct {
+		name     string
+		rules    interface{}
+		operator string
+		value    int64
+		want     bool
+	}{
+		{name: "equals", rules: three, operator: LengthOperatorEquals, value: 3, want: true},
+		{name: "equals mismatch", rules: three, operator: LengthOperatorEquals, value: 2, want: false},
+		{name: "not equals", rules: three, operator: LengthOperatorNotEquals, value: 2, want: true},
+		{name: "greater than", rules: three, operator: LengthOperatorGreaterThan, value: 2, want: true},
+		{name: "greater than at bound", rules: three, operator: LengthOperatorGreaterThan, value: 3, want: false},
+		{name: "greater than or equal at bound", rules: three, operator: LengthOperatorGreaterThanOrEqual, value: 3, want: true},
+		{name: "less than", rules: three, operator: LengthOperatorLessThan, value: 4, want: true},
+		{name: "less than at bound", rules: three, operator: LengthOperatorLessThan, value: 3, want: false},
+		{name: "less than or equal at bound", rules: three, operator: LengthOperatorLessThanOrEqual, value: 3, want: true},
+		{name: "empty array", rules: []interface{}{}, operator: LengthOperatorEquals, value: 0, want: true},
+		{name: "missing array has length 0", rules: nil, operator: LengthOperatorLessThan, value: 1, want: true},
+		{name: "missing array is not longer than 0", rules: nil, operator: LengthOperatorGreaterThan, value: 0, want: false},
+		{name: "non-array never matches", rules: "abc", operator: LengthOperatorEquals, value: 3, want: false},
+		{name: "map never matches", rules: map[string]interface{}{"a": 1}, operator: LengthOperatorGreaterThanOrEqual, value: 0, want: false},
+		{name: "unknown operator", rules: three, operator: "Contains", value: 3, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cond := &v1alpha1.ArrayLengthCondition{FieldPath: "spec.rules", Operator: tt.operator, Value: tt.value}
+			resource := newTestConfigMap("default", "cm", time.Time{})
+			if tt.rules != nil {
+				resource.Object["spec"] = map[string]interface{}{"rules": tt.rules}
+			}
+			if got := meetsArrayLengthCondition(resource, cond); got != tt.want {
+				t.Errorf("meetsArrayLengthCondition() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMeetsConditions_ArrayLengths(t *testing.T) {
+	resource := newTestConfigMap("default", "cm", time.Time{})
+	resource.Object["spec"] = map[string]interface{}{"rules": []interface{}{"a", "b"}}
+	resource.Object["status"] = map[string]interface{}{"conditions": []interface{}{map[string]interface{}{"type": "Ready"}}}
+
+	conditions := &v1alpha1.ConditionsSpec{
+		ArrayLengths: []v1alpha1.ArrayLengthCondition{
+			{FieldPath: "spec.rules", Operator: LengthOperatorGreaterThanOrEqual, Value: 2},
+			{FieldPath: "status.conditions", Operator: LengthOperatorEquals, Value: 1},
+		},
+	}
+	if !meetsConditionsShared(resource, conditions) {
+		t.Error("meetsConditionsShared() = false, want true when every array length matches")
+	}
+
+	// Conditions are ANDed
+	conditions.ArrayLengths[1].Value = 0
+	if meetsConditionsShared(resource, conditions) {
+		t.Error("meetsConditionsShared() = true, want false when one array length does not match")
+	}
+
+	// A non-map along the path never matches
+	conditions.ArrayLengths = []v1alpha1.ArrayLengthCondition{{FieldPath: "spec.rules.items", Operator: LengthOperatorLessThan, Value: 5}}
+	if meetsConditionsShared(resource, conditions) {
+		t.Error("meetsConditionsShared() = true for a path through an array, want false")
+	}
+}
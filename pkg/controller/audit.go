@@ -0,0 +1,132 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/kube-zen/zen-gc/pkg/config"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+// AuditRecord describes one resource deleted by a policy.
+type AuditRecord struct {
+	Time            time.Time `json:"time"`
+	PolicyNamespace string    `json:"policyNamespace"`
+	PolicyName      string    `json:"policyName"`
+	Group           string    `json:"group"`
+	Version         string    `json:"version"`
+	Resource        string    `json:"resource"`
+	Namespace       string    `json:"namespace,omitempty"`
+	Name            string    `json:"name"`
+	UID             string    `json:"uid"`
+	Reason          string    `json:"reason"`
+}
+
+// AuditSink receives a record of every successful deletion. Record is called on the
+// deletion path and must not block.
+type AuditSink interface {
+	Record(record AuditRecord)
+}
+
+// NoopAuditSink discards audit records. It is the default sink.
+type NoopAuditSink struct{}
+
+// Record discards the record.
+func (NoopAuditSink) Record(AuditRecord) {}
+
+// FileAuditSink appends audit records to a file as JSON lines. Records are queued on a
+// buffered channel and written by Start, so deletions never wait for the file; records
+// arriving while the buffer is full are dropped and counted in gc_audit_records_dropped_total.
+type FileAuditSink struct {
+	file    *os.File
+	records chan AuditRecord
+}
+
+// NewFileAuditSink opens path for appending, creating it if needed, with a buffer of
+// bufferSize records (config.DefaultAuditBufferSize if not positive).
+func NewFileAuditSink(path string, bufferSize int) (*FileAuditSink, error) {
+	if bufferSize <= 0 {
+		bufferSize = config.DefaultAuditBufferSize
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	return &FileAuditSink{file: file, records: make(chan AuditRecord, bufferSize)}, nil
+}
+
+// Record queues the record, dropping it if the buffer is full.
+func (s *FileAuditSink) Record(record AuditRecord) {
+	select {
+	case s.records <- record:
+	default:
+		recordAuditRecordDropped()
+	}
+}
+
+// Start writes queued records until ctx is canceled, then writes the records still queued,
+// flushes, and closes the file. It implements manager.Runnable; records arriving after it
+// returns are dropped.
+func (s *FileAuditSink) Start(ctx context.Context) error {
+	defer s.file.Close()
+	writer := bufio.NewWriter(s.file)
+	encoder := json.NewEncoder(writer)
+	for {
+		select {
+		case record := <-s.records:
+			s.write(encoder, record)
+			// Flush once the queue is empty, so a burst of deletions is written together
+			if len(s.records) == 0 {
+				s.flush(writer)
+			}
+		case <-ctx.Done():
+			for {
+				select {
+				case record := <-s.records:
+					s.write(encoder, record)
+				default:
+					return s.flush(writer)
+				}
+			}
+		}
+	}
+}
+
+// write encodes a record as a JSON line.
+func (s *FileAuditSink) write(encoder *json.Encoder, record AuditRecord) {
+	if err := encoder.Encode(record); err != nil {
+		recordAuditRecordDropped()
+		logger := sdklog.NewLogger("zen-gc")
+		logger.Warn("Failed to write audit record", sdklog.Operation("audit"), sdklog.String("resource", fmt.Sprintf("%s/%s", record.Namespace, record.Name)), sdklog.Error(err))
+	}
+}
+
+// flush writes buffered records to the file.
+func (s *FileAuditSink) flush(writer *bufio.Writer) error {
+	if err := writer.Flush(); err != nil {
+		logger := sdklog.NewLogger("zen-gc")
+		logger.Warn("Failed to flush audit log", sdklog.Operation("audit"), sdklog.Error(err))
+		return fmt.Errorf("failed to flush audit log: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-sdk/pkg/gc/ratelimiter"
+)
+
+// recordingAuditSink keeps the audit records it receives.
+type recordingAuditSink struct {
+	mu      sync.Mutex
+	records []AuditRecord
+}
+
+func (s *recordingAuditSink) Record(record AuditRecord) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = append(s.records, record)
+}
+
+func TestDeleteBatchShared_RecordsAudit(t *testing.T) {
+	audit := &recordingAuditSink{}
+	deleter := &fakeBatchDeleter{coordinator: NewDeletionCoordinator(), audit: audit, deletedBy: map[string][]string{}}
+	policy := newTestPolicy("audited")
+	batch := []*unstructured.Unstructured{newTestConfigMap("default", "a", time.Now())}
+	before := time.Now().UTC()
+
+	deleted, errs := deleteBatchShared(context.Background(), batch, policy, ratelimiter.NewRateLimiter(1000), map[string]string{"a-uid": "ttl_expired"}, deleter)
+	if deleted != 1 || len(errs) != 0 {
+		t.Fatalf("deleted=%d errs=%v, want 1 deletion", deleted, errs)
+	}
+	if len(audit.records) != 1 {
+		t.Fatalf("%d audit records, want 1", len(audit.records))
+	}
+	record := audit.records[0]
+	if record.PolicyNamespace != "default" || record.PolicyName != "audited" ||
+		record.Group != "" || record.Version != "v1" || record.Resource != "configmaps" ||
+		record.Namespace != "default" || record.Name != "a" || record.UID != "a-uid" || record.Reason != "ttl_expired" {
+		t.Errorf("audit record = %+v, want default/audited deleting v1 configmaps default/a (a-uid) for ttl_expired", record)
+	}
+	if record.Time.Before(before) || record.Time.Location() != time.UTC {
+		t.Errorf("audit record time = %v, want a UTC time after %v", record.Time, before)
+	}
+
+	// Dry-run deletions are not audited
+	dryRun := newTestPolicy("dry-run")
+	dryRun.Spec.Behavior.DryRun = true
+	if deleted, _ := deleteBatchShared(context.Background(), []*unstructured.Unstructured{newTestConfigMap("default", "c", time.Now())}, dryRun, ratelimiter.NewRateLimiter(1000), nil, deleter); deleted != 1 {
+		t.Fatalf("deleted=%d, want the dry-run deletion counted", deleted)
+	}
+	if len(audit.records) != 1 {
+		t.Errorf("%d audit records after a dry-run deletion, want 1", len(audit.records))
+	}
+
+	// Failed deletions are not audited
+	deleter.fail = true
+	if _, errs := deleteBatchShared(context.Background(), []*unstructured.Unstructured{newTestConfigMap("default", "b", time.Now())}, policy, ratelimiter.NewRateLimiter(1000), nil, deleter); len(errs) != 1 {
+		t.Fatalf("errs=%v, want 1 failed deletion", errs)
+	}
+	if len(audit.records) != 1 {
+		t.Errorf("%d audit records after a failed deletion, want 1", len(audit.records))
+	}
+}
+
+func TestFileAuditSink_FlushesOnShutdown(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	sink, err := NewFileAuditSink(path, 0)
+	if err != nil {
+		t.Fatalf("NewFileAuditSink() returned error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- sink.Start(ctx) }()
+	for _, name := range []string{"a", "b", "c"} {
+		sink.Record(AuditRecord{PolicyNamespace: "default", PolicyName: "audited", Version: "v1", Resource: "configmaps", Namespace: "default", Name: name, UID: name + "-uid"})
+	}
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Start() returned error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Start() did not return after shutdown")
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Failed to open audit log: %v", err)
+	}
+	defer file.Close()
+	var names []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record AuditRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("Audit log line %q is not a JSON record: %v", scanner.Text(), err)
+		}
+		names = append(names, record.Name)
+	}
+	if len(names) != 3 || names[0] != "a" || names[1] != "b" || names[2] != "c" {
+		t.Errorf("audit log names = %v, want [a b c] in deletion order", names)
+	}
+}
+
+func TestFileAuditSink_DropsWhenFull(t *testing.T) {
+	sink, err := NewFileAuditSink(filepath.Join(t.TempDir(), "audit.jsonl"), 1)
+	if err != nil {
+		t.Fatalf("NewFileAuditSink() returned error: %v", err)
+	}
+	defer sink.file.Close()
+	dropped := testutil.ToFloat64(gcAuditRecordsDroppedTotal)
+
+	// Without a writer the second record finds the buffer full; Record must not block
+	sink.Record(AuditRecord{Name: "a"})
+	sink.Record(AuditRecord{Name: "b"})
+
+	if got := testutil.ToFloat64(gcAuditRecordsDroppedTotal) - dropped; got != 1 {
+		t.Errorf("gc_audit_records_dropped_total increased by %v, want 1", got)
+	}
+}
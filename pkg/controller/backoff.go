@@ -4,6 +4,7 @@ package controller
 import (
 	"context"
 
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
@@ -19,5 +20,9 @@ func DeleteResourceWithBackoff(ctx context.Context, reconciler *GCPolicyReconcil
 // deleteResourceWithBackoff is the internal implementation.
 func deleteResourceWithBackoff(ctx context.Context, reconciler *GCPolicyReconciler, resource *unstructured.Unstructured, policy *v1alpha1.GarbageCollectionPolicy, rateLimiter *ratelimiter.RateLimiter) error {
 	// Use the deleter from GCPolicyReconciler
-	return reconciler.deleteResource(ctx, resource, policy, rateLimiter)
+	err := reconciler.deleteResource(ctx, resource, policy, rateLimiter)
+	if k8serrors.IsNotFound(err) {
+		return nil // already deleted
+	}
+	return err
 }
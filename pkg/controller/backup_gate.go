@@ -0,0 +1,163 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	gcerrors "github.com/kube-zen/zen-gc/pkg/errors"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+// Defaults for backup status lookups.
+const (
+	// DefaultBackupStatusAPIVersion is the default API version of the backup status object.
+	DefaultBackupStatusAPIVersion = "v1"
+
+	// DefaultBackupStatusKind is the default kind of the backup status object.
+	DefaultBackupStatusKind = "ConfigMap"
+
+	// DefaultBackupStatusCacheTTL is the default time a backup status lookup is cached.
+	DefaultBackupStatusCacheTTL = 60 * time.Second
+)
+
+var (
+	// ErrNoBackupStatusClient indicates no client is available to read backup status.
+	ErrNoBackupStatusClient = errors.New("no client available to read backup status")
+
+	// ErrBackupStatusFieldNotFound indicates the backup status field is missing or not a string.
+	ErrBackupStatusFieldNotFound = errors.New("backup status field not found")
+)
+
+// backupStatusEntry is a cached last-successful-backup time.
+type backupStatusEntry struct {
+	lastBackup time.Time
+	expiresAt  time.Time
+}
+
+// BackupStatusCache reads the last successful backup time from a ConfigMap or custom
+// resource and caches it briefly, so each evaluation does not hit the API server.
+type BackupStatusCache struct {
+	dynClient dynamic.Interface
+	entries   map[string]backupStatusEntry
+	mu        sync.Mutex
+	now       func() time.Time
+}
+
+// NewBackupStatusCache creates a new BackupStatusCache.
+func NewBackupStatusCache(dynClient dynamic.Interface) *BackupStatusCache {
+	return &BackupStatusCache{
+		dynClient: dynClient,
+		entries:   make(map[string]backupStatusEntry),
+		now:       time.Now,
+	}
+}
+
+// LastBackup returns the last successful backup time recorded in the referenced object.
+// Lookup failures are not cached.
+func (c *BackupStatusCache) LastBackup(ctx context.Context, gate *v1alpha1.BackupGateCondition) (time.Time, error) {
+	if c == nil || c.dynClient == nil {
+		return time.Time{}, ErrNoBackupStatusClient
+	}
+
+	apiVersion := gate.APIVersion
+	if apiVersion == "" {
+		apiVersion = DefaultBackupStatusAPIVersion
+	}
+	kind := gate.Kind
+	if kind == "" {
+		kind = DefaultBackupStatusKind
+	}
+	key := fmt.Sprintf("%s/%s/%s/%s/%s", apiVersion, kind, gate.Namespace, gate.Name, gate.FieldPath)
+
+	c.mu.Lock()
+	if entry, ok := c.entries[key]; ok && c.now().Before(entry.expiresAt) {
+		c.mu.Unlock()
+		return entry.lastBackup, nil
+	}
+	c.mu.Unlock()
+
+	gvr, err := parseGVR(apiVersion, kind)
+	if err != nil {
+		return time.Time{}, err
+	}
+	obj, err := c.dynClient.Resource(gvr).Namespace(gate.Namespace).Get(ctx, gate.Name, metav1.GetOptions{})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get backup status %s %s/%s: %w", kind, gate.Namespace, gate.Name, err)
+	}
+
+	value, found, err := nestedString(obj.Object, gate.FieldPath)
+	if err != nil || !found {
+		return time.Time{}, fmt.Errorf("%w: %s", ErrBackupStatusFieldNotFound, gate.FieldPath)
+	}
+	lastBackup, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid backup timestamp %q (expected RFC3339): %w", value, err)
+	}
+
+	cacheTTL := DefaultBackupStatusCacheTTL
+	if gate.CacheTTLSeconds != nil {
+		cacheTTL = time.Duration(*gate.CacheTTLSeconds) * time.Second
+	}
+	if cacheTTL > 0 {
+		c.mu.Lock()
+		c.entries[key] = backupStatusEntry{lastBackup: lastBackup, expiresAt: c.now().Add(cacheTTL)}
+		c.mu.Unlock()
+	}
+	return lastBackup, nil
+}
+
+// applyBackupGateShared splits resources into those created before the last successful
+// backup (allowed) and the rest (held). If the backup status cannot be read, all
+// resources are held.
+func applyBackupGateShared(
+	ctx context.Context,
+	cache *BackupStatusCache,
+	policy *v1alpha1.GarbageCollectionPolicy,
+	resources []*unstructured.Unstructured,
+) (allowed []*unstructured.Unstructured, held int64) {
+	if policy.Spec.Conditions == nil || policy.Spec.Conditions.BackupGate == nil || len(resources) == 0 {
+		return resources, 0
+	}
+
+	lastBackup, err := cache.LastBackup(ctx, policy.Spec.Conditions.BackupGate)
+	if err != nil {
+		recordError(policy.Namespace, policy.Name, gcerrors.TypeBackupStatusUnavailable)
+		logger := sdklog.NewLogger("zen-gc")
+		logger.Warn("Backup status unavailable, holding deletions", sdklog.Operation("backup_gate"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Error(err))
+		return nil, int64(len(resources))
+	}
+
+	allowed = resources[:0:0]
+	for _, resource := range resources {
+		if resource.GetCreationTimestamp().Time.Before(lastBackup) {
+			allowed = append(allowed, resource)
+		} else {
+			held++
+		}
+	}
+	return allowed, held
+}
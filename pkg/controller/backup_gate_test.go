@@ -0,0 +1,136 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// testBackupGate reads the last successful backup from a ConfigMap written by the backup tool.
+var testBackupGate = v1alpha1.BackupGateCondition{
+	Namespace: "velero",
+	Name:      "backup-status",
+	FieldPath: "data.lastSuccessfulBackup",
+}
+
+func TestApplyBackupGateShared(t *testing.T) {
+	lastBackup := time.Now().Add(-1 * time.Hour).Truncate(time.Second)
+	status := newTestConfigMap("velero", "backup-status", time.Time{})
+	_ = unstructured.SetNestedField(status.Object, lastBackup.UTC().Format(time.RFC3339), "data", "lastSuccessfulBackup")
+	cache := NewBackupStatusCache(fake.NewSimpleDynamicClient(runtime.NewScheme(), status))
+	policy := newTestPolicy("backup-policy")
+	policy.Spec.Conditions = &v1alpha1.ConditionsSpec{BackupGate: &testBackupGate}
+
+	before := newTestConfigMap("default", "before-backup", lastBackup.Add(-time.Hour))
+	after := newTestConfigMap("default", "after-backup", lastBackup.Add(time.Minute))
+	atBackup := newTestConfigMap("default", "at-backup", lastBackup)
+
+	allowed, held := applyBackupGateShared(context.Background(), cache, policy, []*unstructured.Unstructured{before, after, atBackup})
+	if held != 2 {
+		t.Errorf("held = %d, want 2", held)
+	}
+	if len(allowed) != 1 || allowed[0].GetName() != "before-backup" {
+		t.Errorf("expected only before-backup to be allowed, got %v", allowed)
+	}
+}
+
+func TestApplyBackupGateShared_StatusUnavailable(t *testing.T) {
+	resources := []*unstructured.Unstructured{newTestConfigMap("default", "cm-1", time.Now().Add(-48*time.Hour))}
+	policy := newTestPolicy("backup-policy")
+	policy.Spec.Conditions = &v1alpha1.ConditionsSpec{BackupGate: &testBackupGate}
+
+	// Missing status object
+	cache := NewBackupStatusCache(fake.NewSimpleDynamicClient(runtime.NewScheme()))
+	allowed, held := applyBackupGateShared(context.Background(), cache, policy, resources)
+	if len(allowed) != 0 || held != 1 {
+		t.Errorf("missing status: allowed = %d, held = %d, want 0 and 1", len(allowed), held)
+	}
+
+	// No cache configured
+	allowed, held = applyBackupGateShared(context.Background(), nil, policy, resources)
+	if len(allowed) != 0 || held != 1 {
+		t.Errorf("nil cache: allowed = %d, held = %d, want 0 and 1", len(allowed), held)
+	}
+
+	// No gate configured
+	policy.Spec.Conditions.BackupGate = nil
+	allowed, held = applyBackupGateShared(context.Background(), nil, policy, resources)
+	if len(allowed) != 1 || held != 0 {
+		t.Errorf("no gate: allowed = %d, held = %d, want 1 and 0", len(allowed), held)
+	}
+}
+
+func TestBackupStatusCache_LastBackup(t *testing.T) {
+	ctx := context.Background()
+	first := time.Date(2025, 3, 1, 2, 0, 0, 0, time.UTC)
+	status := newTestConfigMap("velero", "backup-status", time.Time{})
+	_ = unstructured.SetNestedField(status.Object, first.Format(time.RFC3339), "data", "lastSuccessfulBackup")
+	dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme(), status)
+	cache := NewBackupStatusCache(dynamicClient)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+	gate := &testBackupGate
+
+	got, err := cache.LastBackup(ctx, gate)
+	if err != nil {
+		t.Fatalf("LastBackup() returned error: %v", err)
+	}
+	if !got.Equal(first) {
+		t.Errorf("LastBackup() = %v, want %v", got, first)
+	}
+
+	// A newer backup is not seen until the cached value expires
+	second := first.Add(24 * time.Hour)
+	_ = unstructured.SetNestedField(status.Object, second.Format(time.RFC3339), "data", "lastSuccessfulBackup")
+	if _, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("velero").Update(ctx, status, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("Failed to update backup status: %v", err)
+	}
+	if got, _ := cache.LastBackup(ctx, gate); !got.Equal(first) {
+		t.Errorf("LastBackup() within cache TTL = %v, want cached %v", got, first)
+	}
+
+	now = now.Add(DefaultBackupStatusCacheTTL + time.Second)
+	if got, _ := cache.LastBackup(ctx, gate); !got.Equal(second) {
+		t.Errorf("LastBackup() after cache TTL = %v, want %v", got, second)
+	}
+}
+
+func TestBackupStatusCache_LastBackup_InvalidField(t *testing.T) {
+	status := newTestConfigMap("velero", "backup-status", time.Time{})
+	_ = unstructured.SetNestedField(status.Object, "yesterday", "data", "lastSuccessfulBackup")
+	cache := NewBackupStatusCache(fake.NewSimpleDynamicClient(runtime.NewScheme(), status))
+	gate := testBackupGate
+
+	if _, err := cache.LastBackup(context.Background(), &gate); err == nil {
+		t.Error("expected error for non-RFC3339 backup timestamp")
+	}
+
+	gate.FieldPath = "data.missing"
+	if _, err := cache.LastBackup(context.Background(), &gate); !errors.Is(err, ErrBackupStatusFieldNotFound) {
+		t.Errorf("expected ErrBackupStatusFieldNotFound, got %v", err)
+	}
+}
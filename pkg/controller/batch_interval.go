@@ -0,0 +1,50 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// batchIntervalShared returns the pause the policy asks for between deletion batches,
+// or 0 when none is configured.
+func batchIntervalShared(policy *v1alpha1.GarbageCollectionPolicy) time.Duration {
+	interval := policy.Spec.Behavior.BatchInterval
+	if interval == nil || interval.Duration <= 0 {
+		return 0
+	}
+	return interval.Duration
+}
+
+// waitBatchIntervalShared pauses for interval before the next deletion batch. It returns
+// false if ctx was canceled while waiting, in which case no further batch should start.
+func waitBatchIntervalShared(ctx context.Context, interval time.Duration) bool {
+	if interval <= 0 {
+		return ctx.Err() == nil
+	}
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
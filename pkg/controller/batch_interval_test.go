@@ -0,0 +1,92 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestWaitBatchIntervalShared_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	start := time.Now()
+	if waitBatchIntervalShared(ctx, time.Hour) {
+		t.Error("waitBatchIntervalShared() = true, want false for a canceled context")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("waitBatchIntervalShared() blocked for %v after cancellation", elapsed)
+	}
+}
+
+func TestEvaluatePolicy_BatchInterval(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reconciler, dynamicClient, policy := setupObservePausedTest(t, false)
+	defer reconciler.cleanupResourceInformer(policy.UID)
+
+	// Two expired configmaps in batches of one: a single pause between them
+	const interval = 200 * time.Millisecond
+	policy.Spec.Paused = false
+	policy.Spec.Behavior.BatchSize = 1
+	policy.Spec.Behavior.BatchInterval = &metav1.Duration{Duration: interval}
+
+	start := time.Now()
+	if err := reconciler.evaluatePolicy(ctx, policy); err != nil {
+		t.Fatalf("evaluatePolicy() returned error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < interval {
+		t.Errorf("evaluatePolicy() took %v, want at least the %v batch interval", elapsed, interval)
+	}
+	if deleted, _ := policyStatusCount(t, dynamicClient, "resourcesDeleted"); deleted != 2 {
+		t.Errorf("resourcesDeleted = %d, want 2", deleted)
+	}
+}
+
+func TestDeleteResourcesInBatchesShared_BatchIntervalCanceled(t *testing.T) {
+	reconciler, dynamicClient, policy := setupObservePausedTest(t, false)
+	defer reconciler.cleanupResourceInformer(policy.UID)
+
+	policy.Spec.Behavior.BatchSize = 1
+	policy.Spec.Behavior.BatchInterval = &metav1.Duration{Duration: time.Hour}
+
+	list, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("Failed to list configmaps: %v", err)
+	}
+	resources := make([]*unstructured.Unstructured, 0, len(list.Items))
+	for i := range list.Items {
+		resources = append(resources, &list.Items[i])
+	}
+
+	// The first batch runs immediately; cancellation must cut the pause before the second
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	deleted, failed, _ := deleteResourcesInBatchesShared(ctx, reconciler, policy, resources, map[string]string{})
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("deleteResourcesInBatchesShared() blocked for %v after cancellation", elapsed)
+	}
+	if deleted != 1 || failed != 0 {
+		t.Errorf("deleted = %d, failed = %d, want 1 and 0", deleted, failed)
+	}
+}
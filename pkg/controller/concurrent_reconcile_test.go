@@ -0,0 +1,244 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/fake"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/config"
+)
+
+func TestMaxConcurrentReconciles(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *config.ControllerConfig
+		expected int
+	}{
+		{name: "nil config", expected: config.DefaultMaxConcurrentEvaluations},
+		{name: "unset", config: &config.ControllerConfig{}, expected: config.DefaultMaxConcurrentEvaluations},
+		{name: "configured", config: config.NewControllerConfig().WithMaxConcurrentEvaluations(12), expected: 12},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reconciler := &GCPolicyReconciler{config: tt.config}
+			if got := reconciler.maxConcurrentReconciles(); got != tt.expected {
+				t.Errorf("maxConcurrentReconciles() = %d, want %d", got, tt.expected)
+			}
+		})
+	}
+}
+
+// Run with -race: concurrent reconciles of different policies share the per-policy maps.
+func TestConcurrentReconciles_PerPolicyState(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reconciler := setupInformerTestReconciler(t)
+
+	const policies = 16
+	var wg sync.WaitGroup
+	errs := make(chan error, policies)
+	for i := range policies {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Policies in the same namespace share an informer
+			policy := &v1alpha1.GarbageCollectionPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("policy-%d", i), Namespace: "default", UID: types.UID(fmt.Sprintf("uid-%d", i))},
+				Spec: v1alpha1.GarbageCollectionPolicySpec{
+					TargetResource: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap", Namespace: []string{"team-a", "team-b"}[i%2]},
+					Behavior:       v1alpha1.BehaviorSpec{MaxDeletionsPerSecond: i + 1},
+				},
+			}
+			nn := types.NamespacedName{Namespace: policy.Namespace, Name: policy.Name}
+			reconciler.trackPolicyUID(nn, policy.UID)
+			reconciler.trackPolicySpec(policy.UID, &policy.Spec)
+
+			for range 3 {
+				if _, err := reconciler.getOrCreateResourceInformer(ctx, policy); err != nil {
+					errs <- err
+					return
+				}
+				if reconciler.getOrCreateRateLimiter(policy) == nil {
+					errs <- fmt.Errorf("no rate limiter for %s", policy.Name)
+					return
+				}
+				reconciler.shouldRecreateInformer(policy)
+				reconciler.evaluationFailures.Record(policy.UID)
+			}
+			reconciler.cleanupPolicyResources(nn)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Errorf("concurrent reconcile failed: %v", err)
+	}
+
+	reconciler.resourceInformersMu.RLock()
+	informers, shared := len(reconciler.resourceInformers), len(reconciler.sharedInformers)
+	reconciler.resourceInformersMu.RUnlock()
+	reconciler.rateLimitersMu.RLock()
+	limiters := len(reconciler.rateLimiters)
+	reconciler.rateLimitersMu.RUnlock()
+	reconciler.policySpecsMu.RLock()
+	specs := len(reconciler.policySpecs)
+	reconciler.policySpecsMu.RUnlock()
+	if informers != 0 || shared != 0 || limiters != 0 || specs != 0 {
+		t.Errorf("after cleanup: %d informers, %d shared informers, %d rate limiters, %d specs remain, want none", informers, shared, limiters, specs)
+	}
+}
+
+// slowListClient holds lists of one resource until released. Blocking in the fake client's
+// reactors would hold its lock and stall every other call too.
+type slowListClient struct {
+	dynamic.Interface
+	slow    schema.GroupVersionResource
+	listing chan struct{}
+	release chan struct{}
+}
+
+func (c *slowListClient) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	resource := c.Interface.Resource(gvr)
+	if gvr != c.slow {
+		return resource
+	}
+	return &slowListResource{NamespaceableResourceInterface: resource, client: c}
+}
+
+type slowListResource struct {
+	dynamic.NamespaceableResourceInterface
+	client *slowListClient
+}
+
+func (r *slowListResource) Namespace(namespace string) dynamic.ResourceInterface {
+	return &slowListNamespacedResource{ResourceInterface: r.NamespaceableResourceInterface.Namespace(namespace), client: r.client}
+}
+
+type slowListNamespacedResource struct {
+	dynamic.ResourceInterface
+	client *slowListClient
+}
+
+func (r *slowListNamespacedResource) List(ctx context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	select {
+	case r.client.listing <- struct{}{}:
+	default:
+	}
+	select {
+	case <-r.client.release:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return r.ResourceInterface.List(ctx, opts)
+}
+
+func TestGetOrCreateResourceInformer_SlowSyncDoesNotBlockOtherPolicies(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme := runtime.NewScheme()
+	dynamicClient := fake.NewSimpleDynamicClientWithCustomListKinds(scheme, map[schema.GroupVersionResource]string{
+		{Version: "v1", Resource: "configmaps"}: "ConfigMapList",
+		{Version: "v1", Resource: "secrets"}:    "SecretList",
+	})
+	// Secrets list only once released, so their informer's cache sync hangs until then
+	slowClient := &slowListClient{
+		Interface: dynamicClient,
+		slow:      schema.GroupVersionResource{Version: "v1", Resource: "secrets"},
+		listing:   make(chan struct{}, 1),
+		release:   make(chan struct{}),
+	}
+	defer close(slowClient.release)
+	reconciler := NewGCPolicyReconcilerWithRESTMapper(
+		clientfake.NewClientBuilder().WithScheme(scheme).Build(),
+		scheme,
+		slowClient,
+		nil,
+		NewStatusUpdater(dynamicClient),
+		NewEventRecorder(nil),
+		config.NewControllerConfig(),
+	)
+
+	policies := map[string]*v1alpha1.GarbageCollectionPolicy{}
+	for name, kind := range map[string]string{"slow": "Secret", "also-slow": "Secret", "fast": "ConfigMap"} {
+		policies[name] = &v1alpha1.GarbageCollectionPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: types.UID(name + "-uid")},
+			Spec: v1alpha1.GarbageCollectionPolicySpec{
+				TargetResource: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: kind, Namespace: "default"},
+			},
+		}
+	}
+
+	slowDone := make(chan error, 2)
+	go func() {
+		_, err := reconciler.getOrCreateResourceInformer(ctx, policies["slow"])
+		slowDone <- err
+	}()
+	<-slowClient.listing
+
+	// A policy watching the same Secrets waits for the informer being started
+	go func() {
+		_, err := reconciler.getOrCreateResourceInformer(ctx, policies["also-slow"])
+		slowDone <- err
+	}()
+
+	// A policy watching another GVR is not held up by the Secrets cache sync
+	fastDone := make(chan error, 1)
+	go func() {
+		_, err := reconciler.getOrCreateResourceInformer(ctx, policies["fast"])
+		fastDone <- err
+	}()
+	select {
+	case err := <-fastDone:
+		if err != nil {
+			t.Fatalf("getOrCreateResourceInformer(fast) returned error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("getOrCreateResourceInformer(fast) blocked on another policy's cache sync")
+	}
+
+	slowClient.release <- struct{}{}
+	for range 2 {
+		if err := <-slowDone; err != nil {
+			t.Fatalf("getOrCreateResourceInformer(slow) returned error: %v", err)
+		}
+	}
+
+	reconciler.resourceInformersMu.RLock()
+	informers, shared := len(reconciler.resourceInformers), len(reconciler.sharedInformers)
+	reconciler.resourceInformersMu.RUnlock()
+	if informers != 3 || shared != 2 {
+		t.Errorf("%d policy informers on %d watches, want 3 on 2", informers, shared)
+	}
+	for _, policy := range policies {
+		reconciler.cleanupResourceInformer(policy.UID)
+	}
+}
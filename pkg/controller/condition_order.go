@@ -0,0 +1,131 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"cmp"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// conditionCost is the relative cost of evaluating a condition against one resource.
+// Conditions are ANDed, so meetsConditionsShared runs cheap checks first and stops at the
+// first that fails, sparing the expensive ones.
+type conditionCost int
+
+const (
+	// conditionCostMetadata reads labels, annotations, or owner references.
+	conditionCostMetadata conditionCost = iota
+	// conditionCostField walks field paths or parses timestamps.
+	conditionCostField
+	// conditionCostCompute hashes or serializes the resource, or evaluates an expression.
+	conditionCostCompute
+	// conditionCostNested evaluates nested condition groups, which may hold any condition.
+	conditionCostNested
+	// conditionCostExternal makes a network round trip.
+	conditionCostExternal
+)
+
+// conditionCheck is one condition of a ConditionsSpec. meets reports true when the
+// condition is unset.
+type conditionCheck struct {
+	cost  conditionCost
+	meets func(resource *unstructured.Unstructured, conditions *v1alpha1.ConditionsSpec) bool
+}
+
+// conditionChecks are the checks of meetsConditionsShared, cheapest first. They are assigned
+// in init since the anyOf check refers back to meetsConditionsShared.
+var conditionChecks []conditionCheck
+
+func init() {
+	conditionChecks = sortConditionChecks([]conditionCheck{
+		{cost: conditionCostField, meets: func(resource *unstructured.Unstructured, conditions *v1alpha1.ConditionsSpec) bool {
+			return meetsPhaseConditionsShared(resource, conditions.Phase)
+		}},
+		{cost: conditionCostField, meets: func(resource *unstructured.Unstructured, conditions *v1alpha1.ConditionsSpec) bool {
+			return meetsNotPhaseConditionsShared(resource, conditions.NotPhase)
+		}},
+		{cost: conditionCostMetadata, meets: func(resource *unstructured.Unstructured, conditions *v1alpha1.ConditionsSpec) bool {
+			return meetsLabelConditionsShared(resource, conditions.HasLabels)
+		}},
+		{cost: conditionCostMetadata, meets: func(resource *unstructured.Unstructured, conditions *v1alpha1.ConditionsSpec) bool {
+			return meetsAnnotationConditionsShared(resource, conditions.HasAnnotations)
+		}},
+		{cost: conditionCostField, meets: func(resource *unstructured.Unstructured, conditions *v1alpha1.ConditionsSpec) bool {
+			return meetsFieldConditionsShared(resource, conditions.And)
+		}},
+		{cost: conditionCostField, meets: func(resource *unstructured.Unstructured, conditions *v1alpha1.ConditionsSpec) bool {
+			return meetsAnyFieldConditionShared(resource, conditions.Or)
+		}},
+		{cost: conditionCostMetadata, meets: func(resource *unstructured.Unstructured, conditions *v1alpha1.ConditionsSpec) bool {
+			return conditions.LabelCount == nil || meetsLabelCountConditionShared(resource, conditions.LabelCount)
+		}},
+		{cost: conditionCostCompute, meets: func(resource *unstructured.Unstructured, conditions *v1alpha1.ConditionsSpec) bool {
+			return conditions.SpecHash == nil || meetsSpecHashConditionShared(resource, conditions.SpecHash)
+		}},
+		{cost: conditionCostField, meets: func(resource *unstructured.Unstructured, conditions *v1alpha1.ConditionsSpec) bool {
+			return meetsDateConditionsShared(resource, conditions.Dates)
+		}},
+		{cost: conditionCostField, meets: func(resource *unstructured.Unstructured, conditions *v1alpha1.ConditionsSpec) bool {
+			return meetsArrayLengthConditionsShared(resource, conditions.ArrayLengths)
+		}},
+		{cost: conditionCostField, meets: func(resource *unstructured.Unstructured, conditions *v1alpha1.ConditionsSpec) bool {
+			return meetsSemverConditionsShared(resource, conditions.Semver)
+		}},
+		{cost: conditionCostField, meets: func(resource *unstructured.Unstructured, conditions *v1alpha1.ConditionsSpec) bool {
+			return conditions.CreatedByDefaultServiceAccount == nil || meetsCreatorConditionShared(resource, conditions.CreatedByDefaultServiceAccount)
+		}},
+		{cost: conditionCostMetadata, meets: func(resource *unstructured.Unstructured, conditions *v1alpha1.ConditionsSpec) bool {
+			return !conditions.OrphansOnly || len(resource.GetOwnerReferences()) == 0
+		}},
+		{cost: conditionCostCompute, meets: func(resource *unstructured.Unstructured, conditions *v1alpha1.ConditionsSpec) bool {
+			return conditions.JMESPath == "" || meetsJMESPathConditionShared(resource, conditions.JMESPath)
+		}},
+		{cost: conditionCostCompute, meets: func(resource *unstructured.Unstructured, conditions *v1alpha1.ConditionsSpec) bool {
+			return conditions.Query == "" || meetsQueryConditionShared(resource, conditions.Query)
+		}},
+		{cost: conditionCostCompute, meets: func(resource *unstructured.Unstructured, conditions *v1alpha1.ConditionsSpec) bool {
+			return conditions.DataDrift == nil || meetsDataDriftConditionShared(resource, conditions.DataDrift)
+		}},
+		{cost: conditionCostField, meets: func(resource *unstructured.Unstructured, conditions *v1alpha1.ConditionsSpec) bool {
+			return conditions.StuckTerminating == nil || meetsStuckTerminatingConditionShared(resource, conditions.StuckTerminating)
+		}},
+		{cost: conditionCostMetadata, meets: func(resource *unstructured.Unstructured, conditions *v1alpha1.ConditionsSpec) bool {
+			return conditions.MissingLabel == nil || meetsMissingLabelConditionShared(resource, conditions.MissingLabel)
+		}},
+		{cost: conditionCostField, meets: func(resource *unstructured.Unstructured, conditions *v1alpha1.ConditionsSpec) bool {
+			return conditions.Unchanged == nil || meetsUnchangedConditionShared(resource, conditions.Unchanged)
+		}},
+		{cost: conditionCostNested, meets: func(resource *unstructured.Unstructured, conditions *v1alpha1.ConditionsSpec) bool {
+			return meetsAnyOfConditionsShared(resource, conditions.AnyOf)
+		}},
+		{cost: conditionCostExternal, meets: func(resource *unstructured.Unstructured, conditions *v1alpha1.ConditionsSpec) bool {
+			return conditions.OPA == nil || meetsOPAConditionShared(resource, conditions.OPA)
+		}},
+	})
+}
+
+// sortConditionChecks sorts checks by cost. Checks of equal cost keep their listed order.
+func sortConditionChecks(checks []conditionCheck) []conditionCheck {
+	slices.SortStableFunc(checks, func(a, b conditionCheck) int {
+		return cmp.Compare(a.cost, b.cost)
+	})
+	return checks
+}
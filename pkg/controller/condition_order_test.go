@@ -0,0 +1,103 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func TestConditionChecks_SortedByCost(t *testing.T) {
+	for i := 1; i < len(conditionChecks); i++ {
+		if conditionChecks[i].cost < conditionChecks[i-1].cost {
+			t.Fatalf("conditionChecks[%d] has cost %d after cost %d", i, conditionChecks[i].cost, conditionChecks[i-1].cost)
+		}
+	}
+	if last := conditionChecks[len(conditionChecks)-1]; last.cost != conditionCostExternal {
+		t.Errorf("Expected the external OPA check last, got cost %d", last.cost)
+	}
+}
+
+func TestMeetsConditions_CheapFailureSkipsExpensiveChecks(t *testing.T) {
+	var queries int32
+	server := newFakeOPAServer(t, http.StatusOK, `{"result": true}`, &queries)
+	defer server.Close()
+	opa := &v1alpha1.OPACondition{URL: server.URL + "/v1/data/gc/allow", CacheTTLSeconds: int64Ptr(0)}
+	allowDefaultOPAURL(t, opa.URL)
+
+	tests := []struct {
+		name            string
+		conditions      *v1alpha1.ConditionsSpec
+		expectedMatch   bool
+		expectedQueries int32
+	}{
+		{
+			name: "failing label condition skips OPA",
+			conditions: &v1alpha1.ConditionsSpec{
+				OPA:       opa,
+				HasLabels: []v1alpha1.LabelCondition{{Key: "gc", Operator: "Exists"}},
+			},
+			expectedMatch:   false,
+			expectedQueries: 0,
+		},
+		{
+			name: "failing field condition skips OPA",
+			conditions: &v1alpha1.ConditionsSpec{
+				OPA: opa,
+				And: []v1alpha1.FieldCondition{{FieldPath: "status.phase", Operator: "Equals", Value: "Failed"}},
+			},
+			expectedMatch:   false,
+			expectedQueries: 0,
+		},
+		{
+			name: "failing nested group skips its OPA",
+			conditions: &v1alpha1.ConditionsSpec{
+				AnyOf: []v1alpha1.ConditionsSpec{{
+					OPA:            opa,
+					HasAnnotations: []v1alpha1.AnnotationCondition{{Key: "gc", Value: "true"}},
+				}},
+			},
+			expectedMatch:   false,
+			expectedQueries: 0,
+		},
+		{
+			name: "passing cheap conditions query OPA",
+			conditions: &v1alpha1.ConditionsSpec{
+				OPA:         opa,
+				OrphansOnly: true,
+			},
+			expectedMatch:   true,
+			expectedQueries: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			atomic.StoreInt32(&queries, 0)
+			if got := meetsConditionsShared(newTestConfigMap("default", "ordered", time.Time{}), tt.conditions); got != tt.expectedMatch {
+				t.Errorf("meetsConditionsShared() = %v, want %v", got, tt.expectedMatch)
+			}
+			if n := atomic.LoadInt32(&queries); n != tt.expectedQueries {
+				t.Errorf("Expected %d OPA queries, got %d", tt.expectedQueries, n)
+			}
+		})
+	}
+}
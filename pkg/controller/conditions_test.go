@@ -3,6 +3,7 @@ package controller
 import (
 	"testing"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
@@ -82,6 +83,72 @@ func TestGCPolicyReconciler_meetsConditions_Phase(t *testing.T) {
 	}
 }
 
+func TestMeetsConditionsShared_NotPhase(t *testing.T) {
+	withPhase := func(phase string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"status": map[string]interface{}{"phase": phase},
+		}}
+	}
+
+	tests := []struct {
+		name          string
+		resource      *unstructured.Unstructured
+		conditions    *v1alpha1.ConditionsSpec
+		expectedMatch bool
+	}{
+		{
+			name:          "excluded phase",
+			resource:      withPhase("Running"),
+			conditions:    &v1alpha1.ConditionsSpec{NotPhase: []string{"Running"}},
+			expectedMatch: false,
+		},
+		{
+			name:          "phase not excluded",
+			resource:      withPhase("Succeeded"),
+			conditions:    &v1alpha1.ConditionsSpec{NotPhase: []string{"Running", "Pending"}},
+			expectedMatch: true,
+		},
+		{
+			name:          "resource without a phase",
+			resource:      &unstructured.Unstructured{Object: map[string]interface{}{}},
+			conditions:    &v1alpha1.ConditionsSpec{NotPhase: []string{"Running"}},
+			expectedMatch: true,
+		},
+		{
+			name:          "empty notPhase excludes nothing",
+			resource:      withPhase("Running"),
+			conditions:    &v1alpha1.ConditionsSpec{NotPhase: []string{}},
+			expectedMatch: true,
+		},
+		{
+			name:          "in phase and not excluded",
+			resource:      withPhase("Succeeded"),
+			conditions:    &v1alpha1.ConditionsSpec{Phase: []string{"Succeeded", "Failed"}, NotPhase: []string{"Failed"}},
+			expectedMatch: true,
+		},
+		{
+			name:          "in both phase and notPhase",
+			resource:      withPhase("Failed"),
+			conditions:    &v1alpha1.ConditionsSpec{Phase: []string{"Succeeded", "Failed"}, NotPhase: []string{"Failed"}},
+			expectedMatch: false,
+		},
+		{
+			name:          "in neither list",
+			resource:      withPhase("Pending"),
+			conditions:    &v1alpha1.ConditionsSpec{Phase: []string{"Succeeded", "Failed"}, NotPhase: []string{"Failed"}},
+			expectedMatch: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := meetsConditionsShared(tt.resource, tt.conditions); got != tt.expectedMatch {
+				t.Errorf("meetsConditionsShared() = %v, want %v", got, tt.expectedMatch)
+			}
+		})
+	}
+}
+
 func TestGCPolicyReconciler_meetsConditions_Labels(t *testing.T) {
 	reconciler := &GCPolicyReconciler{
 		logger: sdklog.NewLogger("zen-gc"),
@@ -304,3 +371,211 @@ func TestGCPolicyReconciler_meetsConditions_FieldConditions(t *testing.T) {
 		})
 	}
 }
+
+func TestGCPolicyReconciler_meetsConditions_LabelCount(t *testing.T) {
+	reconciler := &GCPolicyReconciler{
+		logger: sdklog.NewLogger("zen-gc"),
+	}
+
+	withLabels := func(labels map[string]string) *unstructured.Unstructured {
+		resource := &unstructured.Unstructured{Object: map[string]interface{}{}}
+		resource.SetLabels(labels)
+		return resource
+	}
+	threshold := func(n int32) *int32 { return &n }
+
+	tests := []struct {
+		name          string
+		resource      *unstructured.Unstructured
+		conditions    *v1alpha1.ConditionsSpec
+		expectedMatch bool
+	}{
+		{
+			name: "count above threshold matches",
+			resource: withLabels(map[string]string{
+				"revision.example.com/1": "a",
+				"revision.example.com/2": "b",
+				"revision.example.com/3": "c",
+				"app":                    "web",
+			}),
+			conditions: &v1alpha1.ConditionsSpec{
+				LabelCount: &v1alpha1.LabelCountCondition{Prefix: "revision.example.com/", Threshold: threshold(2)},
+			},
+			expectedMatch: true,
+		},
+		{
+			name: "count equal to threshold does not match",
+			resource: withLabels(map[string]string{
+				"revision.example.com/1": "a",
+				"revision.example.com/2": "b",
+				"app":                    "web",
+			}),
+			conditions: &v1alpha1.ConditionsSpec{
+				LabelCount: &v1alpha1.LabelCountCondition{Prefix: "revision.example.com/", Threshold: threshold(2)},
+			},
+			expectedMatch: false,
+		},
+		{
+			name: "labels without prefix are not counted",
+			resource: withLabels(map[string]string{
+				"app":  "web",
+				"tier": "frontend",
+				"env":  "prod",
+			}),
+			conditions: &v1alpha1.ConditionsSpec{
+				LabelCount: &v1alpha1.LabelCountCondition{Prefix: "revision.example.com/", Threshold: threshold(0)},
+			},
+			expectedMatch: false,
+		},
+		{
+			name:     "no labels does not match",
+			resource: withLabels(nil),
+			conditions: &v1alpha1.ConditionsSpec{
+				LabelCount: &v1alpha1.LabelCountCondition{Prefix: "revision.example.com/", Threshold: threshold(0)},
+			},
+			expectedMatch: false,
+		},
+		{
+			name:     "missing threshold does not match",
+			resource: withLabels(map[string]string{"revision.example.com/1": "a"}),
+			conditions: &v1alpha1.ConditionsSpec{
+				LabelCount: &v1alpha1.LabelCountCondition{Prefix: "revision.example.com/"},
+			},
+			expectedMatch: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := reconciler.meetsConditions(tt.resource, tt.conditions)
+			if result != tt.expectedMatch {
+				t.Errorf("meetsConditions() = %v, want %v", result, tt.expectedMatch)
+			}
+		})
+	}
+}
+
+func TestGCPolicyReconciler_meetsConditions_OrphansOnly(t *testing.T) {
+	reconciler := &GCPolicyReconciler{
+		logger: sdklog.NewLogger("zen-gc"),
+	}
+
+	orphan := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	owned := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	owned.SetOwnerReferences([]metav1.OwnerReference{
+		{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "web-abc123", UID: "1234"},
+	})
+
+	tests := []struct {
+		name          string
+		resource      *unstructured.Unstructured
+		conditions    *v1alpha1.ConditionsSpec
+		expectedMatch bool
+	}{
+		{name: "orphan matches", resource: orphan, conditions: &v1alpha1.ConditionsSpec{OrphansOnly: true}, expectedMatch: true},
+		{name: "owned does not match", resource: owned, conditions: &v1alpha1.ConditionsSpec{OrphansOnly: true}, expectedMatch: false},
+		{name: "owned matches when disabled", resource: owned, conditions: &v1alpha1.ConditionsSpec{}, expectedMatch: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := reconciler.meetsConditions(tt.resource, tt.conditions)
+			if result != tt.expectedMatch {
+				t.Errorf("meetsConditions() = %v, want %v", result, tt.expectedMatch)
+			}
+		})
+	}
+}
+
+func TestGCPolicyReconciler_meetsConditions_OrAndAnyOf(t *testing.T) {
+	reconciler := &GCPolicyReconciler{
+		logger: sdklog.NewLogger("zen-gc"),
+	}
+
+	resource := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"spec": map[string]interface{}{
+				"severity": "LOW",
+			},
+			"status": map[string]interface{}{
+				"phase": "Failed",
+			},
+		},
+	}
+	resource.SetLabels(map[string]string{"team": "batch"})
+
+	tests := []struct {
+		name          string
+		conditions    *v1alpha1.ConditionsSpec
+		expectedMatch bool
+	}{
+		{
+			name: "or matches when one field condition matches",
+			conditions: &v1alpha1.ConditionsSpec{
+				Or: []v1alpha1.FieldCondition{
+					{FieldPath: "spec.severity", Operator: "Equals", Value: "HIGH"},
+					{FieldPath: "spec.severity", Operator: "Equals", Value: "LOW"},
+				},
+			},
+			expectedMatch: true,
+		},
+		{
+			name: "or does not match when no field condition matches",
+			conditions: &v1alpha1.ConditionsSpec{
+				Or: []v1alpha1.FieldCondition{
+					{FieldPath: "spec.severity", Operator: "Equals", Value: "HIGH"},
+					{FieldPath: "spec.missing", Operator: "Exists"},
+				},
+			},
+			expectedMatch: false,
+		},
+		{
+			name: "anyOf matches when one group matches",
+			conditions: &v1alpha1.ConditionsSpec{
+				AnyOf: []v1alpha1.ConditionsSpec{
+					{Phase: []string{"Succeeded"}},
+					{Phase: []string{"Failed"}, HasLabels: []v1alpha1.LabelCondition{{Key: "team", Value: "batch"}}},
+				},
+			},
+			expectedMatch: true,
+		},
+		{
+			name: "anyOf does not match when every group fails",
+			conditions: &v1alpha1.ConditionsSpec{
+				AnyOf: []v1alpha1.ConditionsSpec{
+					{Phase: []string{"Succeeded"}},
+					{Phase: []string{"Failed"}, HasLabels: []v1alpha1.LabelCondition{{Key: "team", Value: "web"}}},
+				},
+			},
+			expectedMatch: false,
+		},
+		{
+			name: "anyOf is ANDed with top-level fields",
+			conditions: &v1alpha1.ConditionsSpec{
+				Phase: []string{"Succeeded"},
+				AnyOf: []v1alpha1.ConditionsSpec{{Phase: []string{"Failed"}}},
+			},
+			expectedMatch: false,
+		},
+		{
+			name: "nested anyOf matches",
+			conditions: &v1alpha1.ConditionsSpec{
+				AnyOf: []v1alpha1.ConditionsSpec{{
+					AnyOf: []v1alpha1.ConditionsSpec{
+						{Or: []v1alpha1.FieldCondition{{FieldPath: "spec.severity", Operator: "In", Values: []string{"LOW", "INFO"}}}},
+					},
+				}},
+			},
+			expectedMatch: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := reconciler.meetsConditions(resource, tt.conditions)
+			if result != tt.expectedMatch {
+				t.Errorf("meetsConditions() = %v, want %v", result, tt.expectedMatch)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// DecisionConfirmations remembers the resources each policy would have deleted in its
+// previous run, so deletions can be limited to decisions that held for two consecutive
+// runs. A nil *DecisionConfirmations confirms nothing.
+type DecisionConfirmations struct {
+	policies map[types.UID]map[string]struct{}
+	mu       sync.Mutex
+}
+
+// NewDecisionConfirmations creates a new DecisionConfirmations.
+func NewDecisionConfirmations() *DecisionConfirmations {
+	return &DecisionConfirmations{
+		policies: make(map[types.UID]map[string]struct{}),
+	}
+}
+
+// Confirm stores the would-delete set of a run and returns the resources that were also
+// in the previous run's set, in their original order.
+func (c *DecisionConfirmations) Confirm(policyUID types.UID, resources []*unstructured.Unstructured) []*unstructured.Unstructured {
+	if c == nil {
+		return nil
+	}
+
+	current := make(map[string]struct{}, len(resources))
+	for _, resource := range resources {
+		current[confirmationKey(resource)] = struct{}{}
+	}
+
+	c.mu.Lock()
+	previous := c.policies[policyUID]
+	c.policies[policyUID] = current
+	c.mu.Unlock()
+
+	confirmed := make([]*unstructured.Unstructured, 0, len(resources))
+	for _, resource := range resources {
+		if _, ok := previous[confirmationKey(resource)]; ok {
+			confirmed = append(confirmed, resource)
+		}
+	}
+	return confirmed
+}
+
+// confirmationKey identifies a resource across runs; a recreated resource gets a new UID
+// and so needs confirming again.
+func confirmationKey(resource *unstructured.Unstructured) string {
+	return fmt.Sprintf("%s/%s/%s", resource.GetNamespace(), resource.GetName(), resource.GetUID())
+}
+
+// Forget drops the would-delete set of a policy.
+func (c *DecisionConfirmations) Forget(policyUID types.UID) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.policies, policyUID)
+}
+
+// applyDeletionConfirmationShared keeps only the resources that were also due for deletion
+// in the policy's previous run and counts the rest as held. Without confirmDeletions all
+// resources are allowed and the policy's previous set is dropped, so enabling it later
+// starts from a fresh run.
+func applyDeletionConfirmationShared(
+	confirmations *DecisionConfirmations,
+	policy *v1alpha1.GarbageCollectionPolicy,
+	resources []*unstructured.Unstructured,
+) (allowed []*unstructured.Unstructured, held int64) {
+	if !policy.Spec.Behavior.ConfirmDeletions {
+		confirmations.Forget(policy.UID)
+		return resources, 0
+	}
+
+	allowed = confirmations.Confirm(policy.UID, resources)
+	return allowed, int64(len(resources) - len(allowed))
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func confirmationNames(resources []*unstructured.Unstructured) []string {
+	names := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		names = append(names, resource.GetName())
+	}
+	return names
+}
+
+func TestApplyDeletionConfirmationShared(t *testing.T) {
+	confirmations := NewDecisionConfirmations()
+	policy := &v1alpha1.GarbageCollectionPolicy{}
+	policy.UID = types.UID("confirm-uid")
+	policy.Spec.Behavior.ConfirmDeletions = true
+
+	stable := newTestConfigMap("default", "stable", time.Now().Add(-2*time.Hour))
+	transient := newTestConfigMap("default", "transient", time.Now().Add(-2*time.Hour))
+	late := newTestConfigMap("default", "late", time.Now().Add(-2*time.Hour))
+
+	runs := []struct {
+		resources []*unstructured.Unstructured
+		allowed   []string
+	}{
+		// The first pass only records decisions
+		{resources: []*unstructured.Unstructured{stable, transient}, allowed: []string{}},
+		// The transient match dropped out; the stable one is confirmed, the late one is new
+		{resources: []*unstructured.Unstructured{stable, late}, allowed: []string{"stable"}},
+		// A match that reappears after a gap needs confirming again
+		{resources: []*unstructured.Unstructured{late, transient}, allowed: []string{"late"}},
+	}
+	for i, run := range runs {
+		allowed, held := applyDeletionConfirmationShared(confirmations, policy, run.resources)
+		got := confirmationNames(allowed)
+		if len(got) != len(run.allowed) || (len(got) > 0 && got[0] != run.allowed[0]) {
+			t.Errorf("run %d: allowed = %v, want %v", i+1, got, run.allowed)
+		}
+		if held != int64(len(run.resources)-len(run.allowed)) {
+			t.Errorf("run %d: held = %d, want %d", i+1, held, len(run.resources)-len(run.allowed))
+		}
+	}
+
+	// A recreated resource with the same name is a new decision
+	recreated := newTestConfigMap("default", "late", time.Now())
+	recreated.SetUID(types.UID("new-uid"))
+	if allowed, _ := applyDeletionConfirmationShared(confirmations, policy, []*unstructured.Unstructured{recreated}); len(allowed) != 0 {
+		t.Errorf("recreated resource was confirmed by its predecessor: %v", confirmationNames(allowed))
+	}
+}
+
+func TestApplyDeletionConfirmationShared_Disabled(t *testing.T) {
+	confirmations := NewDecisionConfirmations()
+	policy := &v1alpha1.GarbageCollectionPolicy{}
+	policy.UID = types.UID("confirm-uid")
+	resources := []*unstructured.Unstructured{newTestConfigMap("default", "cm-1", time.Now())}
+
+	policy.Spec.Behavior.ConfirmDeletions = true
+	applyDeletionConfirmationShared(confirmations, policy, resources)
+
+	// Disabling allows everything and drops the previous set
+	policy.Spec.Behavior.ConfirmDeletions = false
+	if allowed, held := applyDeletionConfirmationShared(confirmations, policy, resources); len(allowed) != 1 || held != 0 {
+		t.Errorf("disabled: allowed = %d, held = %d, want 1 and 0", len(allowed), held)
+	}
+	policy.Spec.Behavior.ConfirmDeletions = true
+	if allowed, _ := applyDeletionConfirmationShared(confirmations, policy, resources); len(allowed) != 0 {
+		t.Errorf("re-enabling confirmed %d resources from before it was disabled, want 0", len(allowed))
+	}
+
+	// A nil store confirms nothing
+	if allowed, held := applyDeletionConfirmationShared(nil, policy, resources); len(allowed) != 0 || held != 1 {
+		t.Errorf("nil store: allowed = %d, held = %d, want 0 and 1", len(allowed), held)
+	}
+}
+
+func TestEvaluatePolicy_ConfirmDeletions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reconciler, dynamicClient, policy := setupObservePausedTest(t, false)
+	defer reconciler.cleanupResourceInformer(policy.UID)
+
+	policy.Spec.Paused = false
+	policy.Spec.Behavior.ConfirmDeletions = true
+
+	// The decision pass holds both expired configmaps
+	if err := reconciler.evaluatePolicy(ctx, policy); err != nil {
+		t.Fatalf("evaluatePolicy() returned error: %v", err)
+	}
+	if pending, _ := policyStatusCount(t, dynamicClient, "resourcesPending"); pending != 2 {
+		t.Errorf("resourcesPending = %d, want 2", pending)
+	}
+	if deleted, _ := policyStatusCount(t, dynamicClient, "resourcesDeleted"); deleted != 0 {
+		t.Errorf("resourcesDeleted = %d, want 0", deleted)
+	}
+
+	// The next run confirms and deletes them
+	if err := reconciler.evaluatePolicy(ctx, policy); err != nil {
+		t.Fatalf("evaluatePolicy() returned error: %v", err)
+	}
+	if deleted, _ := policyStatusCount(t, dynamicClient, "resourcesDeleted"); deleted != 2 {
+		t.Errorf("resourcesDeleted = %d, want 2", deleted)
+	}
+}
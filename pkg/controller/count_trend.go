@@ -0,0 +1,165 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// Count trend values for CountTrendCondition.
+const (
+	TrendIncreasing    = "Increasing"
+	TrendDecreasing    = "Decreasing"
+	TrendStable        = "Stable"
+	TrendNonDecreasing = "NonDecreasing"
+	TrendNonIncreasing = "NonIncreasing"
+)
+
+const (
+	// DefaultCountTrendRuns is the default number of evaluations a trend is observed over.
+	DefaultCountTrendRuns = 3
+
+	// MaxCountTrendRuns is the number of matched counts remembered per policy.
+	MaxCountTrendRuns = 20
+)
+
+// countRing is a fixed-size ring buffer of matched counts.
+type countRing struct {
+	values [MaxCountTrendRuns]int64
+	next   int
+	size   int
+}
+
+// add appends a count, overwriting the oldest one when full.
+func (r *countRing) add(count int64) {
+	r.values[r.next] = count
+	r.next = (r.next + 1) % MaxCountTrendRuns
+	if r.size < MaxCountTrendRuns {
+		r.size++
+	}
+}
+
+// last returns up to n most recent counts, oldest first.
+func (r *countRing) last(n int) []int64 {
+	if n > r.size {
+		n = r.size
+	}
+	out := make([]int64, n)
+	start := (r.next - n + MaxCountTrendRuns) % MaxCountTrendRuns
+	for i := 0; i < n; i++ {
+		out[i] = r.values[(start+i)%MaxCountTrendRuns]
+	}
+	return out
+}
+
+// CountHistory remembers recent matched counts per policy so deletions can be
+// gated on how the count has been trending across evaluations.
+// A nil *CountHistory records nothing and never satisfies a trend.
+type CountHistory struct {
+	policies map[types.UID]*countRing
+	mu       sync.Mutex
+}
+
+// NewCountHistory creates a new CountHistory.
+func NewCountHistory() *CountHistory {
+	return &CountHistory{
+		policies: make(map[types.UID]*countRing),
+	}
+}
+
+// Record stores the matched count of an evaluation and returns up to runs
+// most recent counts, oldest first.
+func (h *CountHistory) Record(policyUID types.UID, count int64, runs int) []int64 {
+	if h == nil {
+		return nil
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ring, ok := h.policies[policyUID]
+	if !ok {
+		ring = &countRing{}
+		h.policies[policyUID] = ring
+	}
+	ring.add(count)
+	return ring.last(runs)
+}
+
+// Forget drops the history of a deleted policy.
+func (h *CountHistory) Forget(policyUID types.UID) {
+	if h == nil {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.policies, policyUID)
+}
+
+// countsFollowTrend reports whether consecutive counts follow the trend.
+func countsFollowTrend(counts []int64, trend string) bool {
+	for i := 1; i < len(counts); i++ {
+		prev, cur := counts[i-1], counts[i]
+		var ok bool
+		switch trend {
+		case TrendIncreasing:
+			ok = cur > prev
+		case TrendDecreasing:
+			ok = cur < prev
+		case TrendStable:
+			ok = cur == prev
+		case TrendNonDecreasing:
+			ok = cur >= prev
+		case TrendNonIncreasing:
+			ok = cur <= prev
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// countTrendAllowsDeletionShared records the policy's matched count and reports whether
+// deletions may proceed. Policies without a count trend condition are always allowed;
+// otherwise deletion waits until enough evaluations have been observed and the counts
+// follow the configured trend.
+func countTrendAllowsDeletionShared(history *CountHistory, policy *v1alpha1.GarbageCollectionPolicy, matchedCount int64) bool {
+	if policy.Spec.Conditions == nil || policy.Spec.Conditions.CountTrend == nil {
+		return true
+	}
+	cond := policy.Spec.Conditions.CountTrend
+
+	runs := DefaultCountTrendRuns
+	if cond.Runs > 0 {
+		runs = int(cond.Runs)
+	}
+	if runs > MaxCountTrendRuns {
+		runs = MaxCountTrendRuns
+	}
+
+	counts := history.Record(policy.UID, matchedCount, runs)
+	if len(counts) < runs {
+		return false
+	}
+	return countsFollowTrend(counts, cond.Trend)
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func TestCountTrendAllowsDeletion_Sequences(t *testing.T) {
+	tests := []struct {
+		name     string
+		trend    string
+		runs     int32
+		counts   []int64
+		expected []bool // gate result after each evaluation
+	}{
+		{
+			name:     "non-decreasing over 3 runs",
+			trend:    TrendNonDecreasing,
+			counts:   []int64{5, 5, 7, 6, 6, 8},
+			expected: []bool{false, false, true, false, false, true},
+		},
+		{
+			name:     "strictly increasing",
+			trend:    TrendIncreasing,
+			runs:     2,
+			counts:   []int64{1, 2, 2, 3},
+			expected: []bool{false, true, false, true},
+		},
+		{
+			name:     "decreasing",
+			trend:    TrendDecreasing,
+			counts:   []int64{9, 7, 4, 4},
+			expected: []bool{false, false, true, false},
+		},
+		{
+			name:     "stable",
+			trend:    TrendStable,
+			counts:   []int64{3, 3, 3, 4, 4, 4},
+			expected: []bool{false, false, true, false, false, true},
+		},
+		{
+			name:     "non-increasing",
+			trend:    TrendNonIncreasing,
+			runs:     4,
+			counts:   []int64{10, 10, 8, 8, 9},
+			expected: []bool{false, false, false, true, false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			history := NewCountHistory()
+			policy := newTestPolicy("trend")
+			policy.Spec.Conditions = &v1alpha1.ConditionsSpec{
+				CountTrend: &v1alpha1.CountTrendCondition{Trend: tt.trend, Runs: tt.runs},
+			}
+			for i, count := range tt.counts {
+				if got := countTrendAllowsDeletionShared(history, policy, count); got != tt.expected[i] {
+					t.Errorf("evaluation %d (count %d): gate = %v, want %v", i, count, got, tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCountTrendAllowsDeletion_NoCondition(t *testing.T) {
+	policy := newTestPolicy("plain")
+	if !countTrendAllowsDeletionShared(nil, policy, 1) {
+		t.Error("expected policies without a count trend to always allow deletion")
+	}
+}
+
+func TestCountTrendAllowsDeletion_NilHistoryHoldsDeletion(t *testing.T) {
+	policy := newTestPolicy("trend")
+	policy.Spec.Conditions = &v1alpha1.ConditionsSpec{
+		CountTrend: &v1alpha1.CountTrendCondition{Trend: TrendStable, Runs: 2},
+	}
+	for i := 0; i < 3; i++ {
+		if countTrendAllowsDeletionShared(nil, policy, 1) {
+			t.Fatal("expected nil history to never satisfy a trend")
+		}
+	}
+}
+
+func TestCountHistory_RingBufferWrapsAndForgets(t *testing.T) {
+	history := NewCountHistory()
+	uid := newTestPolicy("ring").UID
+
+	var last []int64
+	for i := int64(1); i <= MaxCountTrendRuns+5; i++ {
+		last = history.Record(uid, i, 3)
+	}
+	if want := []int64{MaxCountTrendRuns + 3, MaxCountTrendRuns + 4, MaxCountTrendRuns + 5}; !reflect.DeepEqual(last, want) {
+		t.Errorf("Record() = %v, want %v", last, want)
+	}
+	if all := history.Record(uid, 0, MaxCountTrendRuns+10); len(all) != MaxCountTrendRuns {
+		t.Errorf("expected history bounded to %d entries, got %d", MaxCountTrendRuns, len(all))
+	}
+
+	history.Forget(uid)
+	if got := history.Record(uid, 42, 3); !reflect.DeepEqual(got, []int64{42}) {
+		t.Errorf("expected fresh history after Forget, got %v", got)
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// DefaultCreatorAnnotation is the default annotation holding a resource creator's username.
+const DefaultCreatorAnnotation = "gc.kube-zen.io/created-by"
+
+// defaultServiceAccountUsername returns the username of a namespace's default service account.
+func defaultServiceAccountUsername(namespace string) string {
+	return "system:serviceaccount:" + namespace + ":default"
+}
+
+// resourceCreator returns the username that created a resource, if known.
+// The creator annotation takes precedence; managedFields are only consulted when enabled.
+func resourceCreator(resource *unstructured.Unstructured, cond *v1alpha1.CreatorCondition) (string, bool) {
+	annotation := cond.CreatorAnnotation
+	if annotation == "" {
+		annotation = DefaultCreatorAnnotation
+	}
+	if creator := resource.GetAnnotations()[annotation]; creator != "" {
+		return creator, true
+	}
+
+	if !cond.UseManagedFields {
+		return "", false
+	}
+
+	// The earliest managedFields entry belongs to the manager that created the object
+	creator := ""
+	var earliest *metav1.Time
+	for _, entry := range resource.GetManagedFields() {
+		if entry.Time == nil || entry.Manager == "" {
+			continue
+		}
+		if earliest == nil || entry.Time.Before(earliest) {
+			creator = entry.Manager
+			earliest = entry.Time
+		}
+	}
+	return creator, creator != ""
+}
+
+// meetsCreatorConditionShared checks if a resource was created by its namespace's default
+// service account. Cluster-scoped resources and missing creator info never match.
+func meetsCreatorConditionShared(resource *unstructured.Unstructured, cond *v1alpha1.CreatorCondition) bool {
+	namespace := resource.GetNamespace()
+	if namespace == "" {
+		return false
+	}
+	creator, found := resourceCreator(resource, cond)
+	return found && creator == defaultServiceAccountUsername(namespace)
+}
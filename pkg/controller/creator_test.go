@@ -0,0 +1,128 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func TestMeetsCreatorConditionShared(t *testing.T) {
+	now := time.Now()
+	defaultSA := "system:serviceaccount:team-a:default"
+
+	tests := []struct {
+		name          string
+		annotations   map[string]string
+		managedFields []metav1.ManagedFieldsEntry
+		clusterScoped bool
+		cond          v1alpha1.CreatorCondition
+		expected      bool
+	}{
+		{
+			name:        "default annotation with default SA",
+			annotations: map[string]string{DefaultCreatorAnnotation: defaultSA},
+			expected:    true,
+		},
+		{
+			name:        "default SA of another namespace",
+			annotations: map[string]string{DefaultCreatorAnnotation: "system:serviceaccount:team-b:default"},
+			expected:    false,
+		},
+		{
+			name:        "non-default SA",
+			annotations: map[string]string{DefaultCreatorAnnotation: "system:serviceaccount:team-a:builder"},
+			expected:    false,
+		},
+		{
+			name:        "custom annotation",
+			annotations: map[string]string{"example.com/creator": defaultSA},
+			cond:        v1alpha1.CreatorCondition{CreatorAnnotation: "example.com/creator"},
+			expected:    true,
+		},
+		{
+			name:     "missing creator info",
+			expected: false,
+		},
+		{
+			name:          "managedFields ignored unless enabled",
+			managedFields: []metav1.ManagedFieldsEntry{managedFieldsEntry(defaultSA, now)},
+			expected:      false,
+		},
+		{
+			name: "earliest managedFields manager is the creator",
+			managedFields: []metav1.ManagedFieldsEntry{
+				managedFieldsEntry("kube-controller-manager", now),
+				managedFieldsEntry(defaultSA, now.Add(-time.Hour)),
+			},
+			cond:     v1alpha1.CreatorCondition{UseManagedFields: true},
+			expected: true,
+		},
+		{
+			name: "later managedFields manager is not the creator",
+			managedFields: []metav1.ManagedFieldsEntry{
+				managedFieldsEntry("kube-controller-manager", now.Add(-time.Hour)),
+				managedFieldsEntry(defaultSA, now),
+			},
+			cond:     v1alpha1.CreatorCondition{UseManagedFields: true},
+			expected: false,
+		},
+		{
+			name:          "annotation takes precedence over managedFields",
+			annotations:   map[string]string{DefaultCreatorAnnotation: "alice@example.com"},
+			managedFields: []metav1.ManagedFieldsEntry{managedFieldsEntry(defaultSA, now)},
+			cond:          v1alpha1.CreatorCondition{UseManagedFields: true},
+			expected:      false,
+		},
+		{
+			name:          "cluster-scoped resource never matches",
+			annotations:   map[string]string{DefaultCreatorAnnotation: "system:serviceaccount::default"},
+			clusterScoped: true,
+			expected:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := newTestConfigMap("team-a", "cm", time.Time{})
+			if tt.clusterScoped {
+				resource.SetNamespace("")
+			}
+			resource.SetAnnotations(tt.annotations)
+			resource.SetManagedFields(tt.managedFields)
+			if got := meetsCreatorConditionShared(resource, &tt.cond); got != tt.expected {
+				t.Errorf("meetsCreatorConditionShared() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMeetsConditionsShared_CreatedByDefaultServiceAccount(t *testing.T) {
+	conditions := &v1alpha1.ConditionsSpec{CreatedByDefaultServiceAccount: &v1alpha1.CreatorCondition{}}
+	resource := newTestConfigMap("team-a", "cm", time.Time{})
+	if meetsConditionsShared(resource, conditions) {
+		t.Error("expected resource without creator info not to match")
+	}
+	resource.SetAnnotations(map[string]string{DefaultCreatorAnnotation: "system:serviceaccount:team-a:default"})
+	if !meetsConditionsShared(resource, conditions) {
+		t.Error("expected resource created by the default service account to match")
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// ErrNoDataDriftClient indicates no client is available to read a data drift reference.
+var ErrNoDataDriftClient = errors.New("no client available to read data drift reference")
+
+// computeDataHash returns the hex SHA-256 of a resource's data field, encoded as compact
+// JSON with sorted keys and no HTML escaping (missing data hashes as null). This matches
+// `jq -cjS .data | sha256sum` on the object's JSON.
+func computeDataHash(resource *unstructured.Unstructured) (string, error) {
+	data, _, err := unstructured.NestedFieldNoCopy(resource.Object, "data")
+	if err != nil {
+		return "", fmt.Errorf("failed to read data: %w", err)
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(data); err != nil {
+		return "", fmt.Errorf("failed to marshal data: %w", err)
+	}
+	sum := sha256.Sum256(bytes.TrimSuffix(buf.Bytes(), []byte("\n")))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// meetsDataDriftConditionShared checks if a resource's data differs from the expected hash.
+// A reference that was not resolved to a hash fails closed (the resource does not match).
+func meetsDataDriftConditionShared(resource *unstructured.Unstructured, cond *v1alpha1.DataDriftCondition) bool {
+	if cond.ExpectedHash == "" {
+		return false
+	}
+	hash, err := computeDataHash(resource)
+	if err != nil {
+		return false
+	}
+	return hash != normalizeSpecHash(cond.ExpectedHash)
+}
+
+// resolveDataDriftReferenceShared reads the golden object of a policy's data drift reference
+// and replaces the in-memory condition with one expecting its data hash, so condition
+// evaluation needs no API access. The stored policy is not modified.
+func resolveDataDriftReferenceShared(ctx context.Context, dynClient dynamic.Interface, policy *v1alpha1.GarbageCollectionPolicy) error {
+	if policy.Spec.Conditions == nil || policy.Spec.Conditions.DataDrift == nil || policy.Spec.Conditions.DataDrift.Reference == nil {
+		return nil
+	}
+	if dynClient == nil {
+		return ErrNoDataDriftClient
+	}
+
+	ref := policy.Spec.Conditions.DataDrift.Reference
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = policy.Namespace
+	}
+	gvr, err := parseGVR(policy.Spec.TargetResource.APIVersion, policy.Spec.TargetResource.Kind)
+	if err != nil {
+		return err
+	}
+	golden, err := dynClient.Resource(gvr).Namespace(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get data drift reference %s/%s: %w", namespace, ref.Name, err)
+	}
+	hash, err := computeDataHash(golden)
+	if err != nil {
+		return err
+	}
+
+	conditions := *policy.Spec.Conditions
+	conditions.DataDrift = &v1alpha1.DataDriftCondition{ExpectedHash: hash}
+	policy.Spec.Conditions = &conditions
+	return nil
+}
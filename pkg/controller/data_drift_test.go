@@ -0,0 +1,160 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	gcerrors "github.com/kube-zen/zen-gc/pkg/errors"
+)
+
+func TestComputeDataHash(t *testing.T) {
+	cm := newTestConfigMap("default", "cm", time.Time{})
+	_ = unstructured.SetNestedField(cm.Object, map[string]interface{}{"b": "<x>", "a": "1"}, "data")
+	hash, err := computeDataHash(cm)
+	if err != nil {
+		t.Fatalf("computeDataHash() returned error: %v", err)
+	}
+	// sha256 of {"a":"1","b":"<x>"}: sorted keys, compact, no HTML escaping
+	if want := "1899e40672a44c5dd85a31df343ba4d3af3a9d4500242ef81d1ddb43f61edd71"; hash != want {
+		t.Errorf("computeDataHash() = %s, want %s", hash, want)
+	}
+}
+
+func TestMeetsDataDriftConditionShared(t *testing.T) {
+	golden := newTestConfigMap("default", "golden", time.Time{})
+	_ = unstructured.SetNestedField(golden.Object, map[string]interface{}{"config.yaml": "replicas: 3"}, "data")
+	goldenHash, err := computeDataHash(golden)
+	if err != nil {
+		t.Fatalf("computeDataHash() returned error: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		data map[string]interface{}
+		cond *v1alpha1.DataDriftCondition
+		want bool
+	}{
+		{
+			name: "matching data is kept",
+			data: map[string]interface{}{"config.yaml": "replicas: 3"},
+			cond: &v1alpha1.DataDriftCondition{ExpectedHash: goldenHash},
+			want: false,
+		},
+		{
+			name: "drifted data matches",
+			data: map[string]interface{}{"config.yaml": "replicas: 5"},
+			cond: &v1alpha1.DataDriftCondition{ExpectedHash: goldenHash},
+			want: true,
+		},
+		{
+			name: "missing data is drift",
+			cond: &v1alpha1.DataDriftCondition{ExpectedHash: goldenHash},
+			want: true,
+		},
+		{
+			name: "prefixed uppercase hash is normalized",
+			data: map[string]interface{}{"config.yaml": "replicas: 3"},
+			cond: &v1alpha1.DataDriftCondition{ExpectedHash: "sha256:" + goldenHash},
+			want: false,
+		},
+		{
+			name: "unresolved reference fails closed",
+			data: map[string]interface{}{"config.yaml": "replicas: 5"},
+			cond: &v1alpha1.DataDriftCondition{Reference: &v1alpha1.DataDriftReference{Name: "golden"}},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := newTestConfigMap("default", "cm", time.Time{})
+			if tt.data != nil {
+				_ = unstructured.SetNestedField(resource.Object, tt.data, "data")
+			}
+			if got := meetsDataDriftConditionShared(resource, tt.cond); got != tt.want {
+				t.Errorf("meetsDataDriftConditionShared() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluatePolicy_DataDriftReferenceDeletesDriftedCopies(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reconciler, dynamicClient, policy := setupObservePausedTest(t, false)
+	defer reconciler.cleanupResourceInformer(policy.UID)
+
+	for name, config := range map[string]string{"golden": "replicas: 3", "copy": "replicas: 3", "drifted": "replicas: 5"} {
+		cm := newTestConfigMap("default", name, time.Now().Add(-2*time.Hour))
+		_ = unstructured.SetNestedField(cm.Object, map[string]interface{}{"config.yaml": config}, "data")
+		if _, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").Create(ctx, cm, metav1.CreateOptions{}); err != nil {
+			t.Fatalf("Failed to create %s: %v", cm.GetName(), err)
+		}
+	}
+	policy.Spec.Paused = false
+	policy.Spec.Conditions = &v1alpha1.ConditionsSpec{
+		DataDrift: &v1alpha1.DataDriftCondition{Reference: &v1alpha1.DataDriftReference{Name: "golden"}},
+	}
+	stored := policy.Spec.Conditions
+
+	if err := reconciler.evaluatePolicy(ctx, policy); err != nil {
+		t.Fatalf("evaluatePolicy() returned error: %v", err)
+	}
+	if stored.DataDrift.Reference == nil || stored.DataDrift.ExpectedHash != "" {
+		t.Errorf("resolving the reference modified the original conditions: %+v", stored.DataDrift)
+	}
+
+	list, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("Failed to list configmaps: %v", err)
+	}
+	remaining := map[string]bool{}
+	for _, item := range list.Items {
+		remaining[item.GetName()] = true
+	}
+	// cm-1 and cm-2 from the test setup have no data, so they drifted too
+	if len(remaining) != 2 || !remaining["golden"] || !remaining["copy"] {
+		t.Errorf("remaining configmaps = %v, want golden and copy", remaining)
+	}
+}
+
+func TestEvaluatePolicy_DataDriftMissingReferenceDeletesNothing(t *testing.T) {
+	reconciler, dynamicClient, policy := setupObservePausedTest(t, false)
+	defer reconciler.cleanupResourceInformer(policy.UID)
+	policy.Spec.Paused = false
+	policy.Spec.Conditions = &v1alpha1.ConditionsSpec{
+		DataDrift: &v1alpha1.DataDriftCondition{Reference: &v1alpha1.DataDriftReference{Name: "missing"}},
+	}
+
+	err := reconciler.evaluatePolicy(context.Background(), policy)
+	assertErrorCode(t, err, gcerrors.TypeDataDriftReferenceFailed)
+
+	list, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("Failed to list configmaps: %v", err)
+	}
+	if len(list.Items) != 2 {
+		t.Errorf("%d configmaps remain, want both", len(list.Items))
+	}
+}
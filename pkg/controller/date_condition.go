@@ -0,0 +1,114 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// Date condition operators and references.
+const (
+	// DateOperatorOlderThan matches dates before the reference minus the age.
+	DateOperatorOlderThan = "OlderThan"
+
+	// DateOperatorNewerThan matches dates after the reference minus the age.
+	DateOperatorNewerThan = "NewerThan"
+
+	// DateReferenceNow compares against the current time.
+	DateReferenceNow = "Now"
+
+	// DateReferenceToday compares against the start of the current day.
+	DateReferenceToday = "Today"
+)
+
+// dateLocations caches loaded time zones by name.
+var dateLocations sync.Map
+
+// loadDateLocation returns the time zone for a date condition (UTC if unset).
+func loadDateLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	if loc, ok := dateLocations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+	dateLocations.Store(name, loc)
+	return loc, nil
+}
+
+// meetsDateConditionsShared checks that all date conditions match the resource.
+func meetsDateConditionsShared(resource *unstructured.Unstructured, conds []v1alpha1.DateCondition) bool {
+	if len(conds) == 0 {
+		return true
+	}
+	now := time.Now()
+	for i := range conds {
+		if !meetsDateConditionAt(resource, &conds[i], now) {
+			return false
+		}
+	}
+	return true
+}
+
+// meetsDateConditionAt evaluates a date condition at the given time.
+// Missing, non-string, or unparsable fields do not match.
+func meetsDateConditionAt(resource *unstructured.Unstructured, cond *v1alpha1.DateCondition, now time.Time) bool {
+	value, found, err := nestedFieldNoCopy(resource.Object, cond.FieldPath)
+	if err != nil || !found {
+		return false
+	}
+	str, ok := value.(string)
+	if !ok {
+		return false
+	}
+
+	loc, err := loadDateLocation(cond.TimeZone)
+	if err != nil {
+		return false
+	}
+	date, err := time.ParseInLocation(cond.Layout, str, loc)
+	if err != nil {
+		return false
+	}
+
+	reference := now.In(loc)
+	if cond.Reference == DateReferenceToday {
+		year, month, day := reference.Date()
+		reference = time.Date(year, month, day, 0, 0, 0, 0, loc)
+	}
+	if cond.AgeSeconds != nil {
+		reference = reference.Add(-time.Duration(*cond.AgeSeconds) * time.Second)
+	}
+
+	switch cond.Operator {
+	case DateOperatorOlderThan:
+		return date.Before(reference)
+	case DateOperatorNewerThan:
+		return date.After(reference)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func TestMeetsDateConditionAt(t *testing.T) {
+	// 2025-03-15 10:30 UTC
+	now := time.Date(2025, 3, 15, 10, 30, 0, 0, time.UTC)
+	day := int64(24 * 60 * 60)
+
+	tests := []struct {
+		name     string
+		value    interface{}
+		cond     v1alpha1.DateCondition
+		expected bool
+	}{
+		{
+			name:     "date-only layout before today",
+			value:    "2025-03-14",
+			cond:     v1alpha1.DateCondition{Layout: "2006-01-02", Operator: DateOperatorOlderThan, Reference: DateReferenceToday},
+			expected: true,
+		},
+		{
+			name:     "date-only layout for today is not before today",
+			value:    "2025-03-15",
+			cond:     v1alpha1.DateCondition{Layout: "2006-01-02", Operator: DateOperatorOlderThan, Reference: DateReferenceToday},
+			expected: false,
+		},
+		{
+			name:     "day-first layout with time older than now",
+			value:    "15/03/2025 09:00",
+			cond:     v1alpha1.DateCondition{Layout: "02/01/2006 15:04", Operator: DateOperatorOlderThan},
+			expected: true,
+		},
+		{
+			name:     "compact layout older than seven days",
+			value:    "20250307",
+			cond:     v1alpha1.DateCondition{Layout: "20060102", Operator: DateOperatorOlderThan, Reference: DateReferenceToday, AgeSeconds: int64Ptr(7 * day)},
+			expected: true,
+		},
+		{
+			name:     "compact layout within seven days",
+			value:    "20250309",
+			cond:     v1alpha1.DateCondition{Layout: "20060102", Operator: DateOperatorOlderThan, Reference: DateReferenceToday, AgeSeconds: int64Ptr(7 * day)},
+			expected: false,
+		},
+		{
+			name:     "month name layout newer than now",
+			value:    "Apr 2, 2025",
+			cond:     v1alpha1.DateCondition{Layout: "Jan 2, 2006", Operator: DateOperatorNewerThan},
+			expected: true,
+		},
+		{
+			name:  "time zone shifts the start of today",
+			value: "2025-03-15 05:00",
+			// 10:30 UTC is 19:30 in Tokyo; 05:00 Tokyo is after Tokyo midnight
+			cond:     v1alpha1.DateCondition{Layout: "2006-01-02 15:04", Operator: DateOperatorNewerThan, Reference: DateReferenceToday, TimeZone: "Asia/Tokyo"},
+			expected: true,
+		},
+		{
+			name:     "unparsable value does not match",
+			value:    "yesterday",
+			cond:     v1alpha1.DateCondition{Layout: "2006-01-02", Operator: DateOperatorOlderThan},
+			expected: false,
+		},
+		{
+			name:     "non-string value does not match",
+			value:    int64(20250101),
+			cond:     v1alpha1.DateCondition{Layout: "20060102", Operator: DateOperatorOlderThan},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cond.FieldPath = "spec.reportDate"
+			resource := newTestConfigMap("default", "report", time.Time{})
+			_ = unstructured.SetNestedField(resource.Object, tt.value, "spec", "reportDate")
+			if got := meetsDateConditionAt(resource, &tt.cond, now); got != tt.expected {
+				t.Errorf("meetsDateConditionAt() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMeetsConditionsShared_Dates(t *testing.T) {
+	conditions := &v1alpha1.ConditionsSpec{
+		Dates: []v1alpha1.DateCondition{
+			{FieldPath: "spec.reportDate", Layout: "2006-01-02", Operator: DateOperatorOlderThan, Reference: DateReferenceToday},
+		},
+	}
+	resource := newTestConfigMap("default", "report", time.Time{})
+	if meetsConditionsShared(resource, conditions) {
+		t.Error("expected resource without the date field not to match")
+	}
+	_ = unstructured.SetNestedField(resource.Object, "2000-01-01", "spec", "reportDate")
+	if !meetsConditionsShared(resource, conditions) {
+		t.Error("expected old report to match")
+	}
+	_ = unstructured.SetNestedField(resource.Object, "2999-01-01", "spec", "reportDate")
+	if meetsConditionsShared(resource, conditions) {
+		t.Error("expected future report not to match")
+	}
+}
@@ -0,0 +1,352 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-sdk/pkg/gc/ratelimiter"
+)
+
+// DebugBundlePath is the path policy debug bundles are served on.
+const DebugBundlePath = "/debug/policy-bundle"
+
+// DefaultDebugBundleDecisionLimit is the maximum number of decisions listed in a bundle.
+const DefaultDebugBundleDecisionLimit = 5000
+
+// debugBundleTimeout bounds the one-off evaluation behind a bundle.
+const debugBundleTimeout = 60 * time.Second
+
+// debugBundleListPageSize is the number of resources a bundle lists per API call.
+const debugBundleListPageSize = 500
+
+// Actions recorded for a resource in a debug bundle.
+const (
+	DebugActionDelete = "delete"
+	DebugActionKeep   = "keep"
+)
+
+// DebugDecision is the decision a one-off evaluation made for a matched resource.
+type DebugDecision struct {
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	UID       string `json:"uid"`
+	Action    string `json:"action"`
+	Reason    string `json:"reason"`
+	HeldBy    string `json:"heldBy,omitempty"`
+}
+
+// DebugBundleTimings records how long each phase of a one-off evaluation took.
+type DebugBundleTimings struct {
+	FetchPolicyMilliseconds int64 `json:"fetchPolicyMilliseconds"`
+	ListMilliseconds        int64 `json:"listMilliseconds"`
+	EvaluateMilliseconds    int64 `json:"evaluateMilliseconds"`
+	TotalMilliseconds       int64 `json:"totalMilliseconds"`
+}
+
+// DebugBundle packages a one-off evaluation of a policy for support cases: the policy's
+// spec (selectors included), every matched resource's decision, and timings. Decisions is
+// capped at DefaultDebugBundleDecisionLimit; Matched counts them all. Gates that depend on
+// earlier runs are not evaluated and are listed in NotEvaluated.
+type DebugBundle struct {
+	Policy       string                               `json:"policy"`
+	GeneratedAt  time.Time                            `json:"generatedAt"`
+	Spec         v1alpha1.GarbageCollectionPolicySpec `json:"spec"`
+	Candidates   int                                  `json:"candidates"`
+	Matched      int                                  `json:"matched"`
+	WouldDelete  int                                  `json:"wouldDelete"`
+	Kept         int                                  `json:"kept"`
+	NotEvaluated []string                             `json:"notEvaluated,omitempty"`
+	Timings      DebugBundleTimings                   `json:"timings"`
+	Decisions    []DebugDecision                      `json:"decisions"`
+}
+
+// GenerateDebugBundle runs a one-off evaluation of a policy through the PolicyEvaluationService
+// the controller evaluates policies with, and records every decision. Nothing is deleted or
+// written: deletions are recorded instead, and the policy's status, annotations, events,
+// metrics, and the state kept between runs (confirmations, count history, namespace
+// rotation) are left untouched.
+func (r *GCPolicyReconciler) GenerateDebugBundle(ctx context.Context, namespace, name string) (*DebugBundle, error) {
+	start := time.Now()
+	obj, err := r.dynamicClient.Resource(PolicyGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	policy := &v1alpha1.GarbageCollectionPolicy{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, policy); err != nil {
+		return nil, fmt.Errorf("failed to convert policy: %w", err)
+	}
+	resolveTargetNamespaceShared(r.restMapper, policy, r.defaultTargetNamespaceMode())
+	if err := resolveDataDriftReferenceShared(ctx, r.dynamicClient, policy); err != nil {
+		return nil, err
+	}
+	if err := resolveBadHashesConfigMapsShared(ctx, r.dynamicClient, policy); err != nil {
+		return nil, err
+	}
+	fetched := time.Now()
+
+	// Settings that depend on earlier runs, bound a run's deletions, or only have effects
+	// outside the evaluation are not applied; they are listed in NotEvaluated instead
+	traced := policy.DeepCopy()
+	behavior := &traced.Spec.Behavior
+	behavior.ConfirmDeletions = false
+	behavior.NamespacesPerRun = 0
+	behavior.MaxDeletionsPerRun = 0
+	behavior.MaxAPICalls = 0
+	behavior.BatchInterval = nil
+	behavior.ResultWebhook = nil
+	behavior.AnnotateDecisions = false
+	behavior.ReportProtected = false
+	if traced.Spec.Conditions != nil {
+		traced.Spec.Conditions.CountTrend = nil
+	}
+
+	lister := &debugBundleLister{client: r.dynamicClient}
+	deleter := &debugBundleDeleter{}
+	var kept *decisionLog
+	adapter := NewGCPolicyReconcilerAdapter(r)
+	service := NewPolicyEvaluationService(
+		lister,
+		adapter.GetSelectorMatcher(),
+		adapter.GetConditionMatcher(),
+		nil, // TTLCalculator (using shared function for now)
+		adapter.GetRateLimiterProvider(),
+		deleter,
+		nil, // No status updates
+		nil, // No events
+		r.logger,
+	)
+	service.namespaceSampler = nil
+	service.stalePolicies = nil
+	service.protectAnnotation = r.protectAnnotation()
+	service.evaluationWorkers = r.evaluationWorkers()
+	service.backupStatus = r.backupStatus
+	service.metricThreshold = r.metricThreshold
+	service.decisionHook = func(decisions *decisionLog) { kept = decisions }
+	service.skipMetrics = true
+	if err := service.EvaluatePolicy(ctx, traced); err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	evaluated := time.Now()
+	if kept == nil {
+		kept = &decisionLog{}
+	}
+
+	bundle := &DebugBundle{
+		Policy:       fmt.Sprintf("%s/%s", policy.Namespace, policy.Name),
+		GeneratedAt:  start.UTC(),
+		Spec:         policy.Spec,
+		Candidates:   lister.listed,
+		Matched:      len(deleter.decisions) + len(kept.resources),
+		WouldDelete:  len(deleter.decisions),
+		Kept:         len(kept.resources),
+		NotEvaluated: debugBundleNotEvaluated(policy),
+		Decisions:    make([]DebugDecision, 0, len(deleter.decisions)+len(kept.resources)),
+	}
+	bundle.Decisions = append(bundle.Decisions, deleter.decisions...)
+	for i, resource := range kept.resources {
+		bundle.Decisions = append(bundle.Decisions, newDebugDecision(resource, DebugActionKeep, kept.reasons[i], kept.heldBy[i]))
+	}
+	sort.SliceStable(bundle.Decisions, func(i, j int) bool {
+		a, b := bundle.Decisions[i], bundle.Decisions[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	if len(bundle.Decisions) > DefaultDebugBundleDecisionLimit {
+		bundle.Decisions = bundle.Decisions[:DefaultDebugBundleDecisionLimit]
+	}
+
+	bundle.Timings = DebugBundleTimings{
+		FetchPolicyMilliseconds: fetched.Sub(start).Milliseconds(),
+		ListMilliseconds:        lister.duration.Milliseconds(),
+		EvaluateMilliseconds:    (evaluated.Sub(fetched) - lister.duration).Milliseconds(),
+		TotalMilliseconds:       time.Since(start).Milliseconds(),
+	}
+	return bundle, nil
+}
+
+// debugBundleLister lists a policy's candidates from the API server in pages of
+// debugBundleListPageSize, with the policy's label selector pushed down, and records how
+// many it listed and how long it took.
+type debugBundleLister struct {
+	client   dynamic.Interface
+	listed   int
+	duration time.Duration
+}
+
+// ListResources lists all resources of the given GVR in the namespace, or in all
+// namespaces if it is empty or "*".
+func (l *debugBundleLister) ListResources(ctx context.Context, gvr schema.GroupVersionResource, namespace string) ([]*unstructured.Unstructured, error) {
+	return l.list(ctx, gvr, namespace, "")
+}
+
+// ListPolicyResources lists the resources the policy's label selector can match.
+func (l *debugBundleLister) ListPolicyResources(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy) ([]*unstructured.Unstructured, error) {
+	gvr, err := parseGVR(policy.Spec.TargetResource.APIVersion, policy.Spec.TargetResource.Kind)
+	if err != nil {
+		return nil, err
+	}
+	return l.list(ctx, gvr, policy.Spec.TargetResource.Namespace, listLabelSelector(&policy.Spec.TargetResource))
+}
+
+func (l *debugBundleLister) list(ctx context.Context, gvr schema.GroupVersionResource, namespace, labelSelector string) ([]*unstructured.Unstructured, error) {
+	start := time.Now()
+	var resourceInterface dynamic.ResourceInterface
+	if namespace == "" || namespace == "*" {
+		resourceInterface = l.client.Resource(gvr)
+	} else {
+		resourceInterface = l.client.Resource(gvr).Namespace(namespace)
+	}
+
+	opts := metav1.ListOptions{LabelSelector: labelSelector, Limit: debugBundleListPageSize}
+	var resources []*unstructured.Unstructured
+	for {
+		list, err := resourceInterface.List(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s: %w", gvr.Resource, err)
+		}
+		for i := range list.Items {
+			resources = append(resources, &list.Items[i])
+		}
+		if opts.Continue = list.GetContinue(); opts.Continue == "" {
+			break
+		}
+	}
+	l.listed = len(resources)
+	l.duration = time.Since(start)
+	return resources, nil
+}
+
+// debugBundleDeleter records the resources it is asked to delete as delete decisions
+// instead of deleting them, reporting each as deleted so the evaluation proceeds as it would.
+type debugBundleDeleter struct {
+	mu        sync.Mutex
+	decisions []DebugDecision
+}
+
+// DeleteBatch records the batch and reports every resource as deleted.
+func (d *debugBundleDeleter) DeleteBatch(ctx context.Context, batch []*unstructured.Unstructured, policy *v1alpha1.GarbageCollectionPolicy, rateLimiter *ratelimiter.RateLimiter, reasons map[string]string) (int64, []error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, resource := range batch {
+		d.decisions = append(d.decisions, newDebugDecision(resource, DebugActionDelete, reasons[string(resource.GetUID())], ""))
+	}
+	return int64(len(batch)), nil
+}
+
+// debugBundleNotEvaluated lists the policy's settings a one-off evaluation does not honor:
+// pausing, and settings that depend on earlier runs or only bound a run's deletions.
+func debugBundleNotEvaluated(policy *v1alpha1.GarbageCollectionPolicy) []string {
+	var notEvaluated []string
+	if policy.Spec.Paused {
+		notEvaluated = append(notEvaluated, "paused")
+	}
+	if policy.Spec.Behavior.ConfirmDeletions {
+		notEvaluated = append(notEvaluated, "confirmDeletions")
+	}
+	if policy.Spec.Conditions != nil && policy.Spec.Conditions.CountTrend != nil {
+		notEvaluated = append(notEvaluated, "countTrend")
+	}
+	if policy.Spec.Behavior.NamespacesPerRun > 0 {
+		notEvaluated = append(notEvaluated, "namespacesPerRun")
+	}
+	if policy.Spec.Behavior.MaxDeletionsPerRun > 0 {
+		notEvaluated = append(notEvaluated, "maxDeletionsPerRun")
+	}
+	if policy.Spec.Behavior.MaxAPICalls > 0 {
+		notEvaluated = append(notEvaluated, "maxApiCalls")
+	}
+	return notEvaluated
+}
+
+func newDebugDecision(resource *unstructured.Unstructured, action, reason, heldBy string) DebugDecision {
+	return DebugDecision{
+		Namespace: resource.GetNamespace(),
+		Name:      resource.GetName(),
+		UID:       string(resource.GetUID()),
+		Action:    action,
+		Reason:    reason,
+		HeldBy:    heldBy,
+	}
+}
+
+// DebugBundleHandler serves debug bundles as downloadable JSON. The policy query parameter
+// ("namespace/name") is required. One bundle is generated at a time, so repeated requests
+// cannot pile up list calls against the API server.
+type DebugBundleHandler struct {
+	reconciler *GCPolicyReconciler
+	busy       sync.Mutex
+}
+
+// GetDebugBundleHandler returns the handler serving debug bundles of the reconciler's policies.
+func (r *GCPolicyReconciler) GetDebugBundleHandler() *DebugBundleHandler {
+	return &DebugBundleHandler{reconciler: r}
+}
+
+// ServeHTTP generates a debug bundle for the requested policy.
+func (h *DebugBundleHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	namespace, name, ok := strings.Cut(req.URL.Query().Get("policy"), "/")
+	if !ok || namespace == "" || name == "" {
+		http.Error(w, "policy query parameter must be namespace/name", http.StatusBadRequest)
+		return
+	}
+	if !h.busy.TryLock() {
+		http.Error(w, "a debug bundle is already being generated", http.StatusTooManyRequests)
+		return
+	}
+	defer h.busy.Unlock()
+
+	ctx, cancel := context.WithTimeout(req.Context(), debugBundleTimeout)
+	defer cancel()
+	bundle, err := h.reconciler.GenerateDebugBundle(ctx, namespace, name)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if k8serrors.IsNotFound(err) {
+			status = http.StatusNotFound
+		}
+		http.Error(w, fmt.Sprintf("failed to generate debug bundle for policy %s/%s: %v", namespace, name, err), status)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("gc-debug-%s-%s.json", namespace, name)))
+	_ = json.NewEncoder(w).Encode(bundle)
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func TestDebugBundleHandler_ServesReadOnlyBundle(t *testing.T) {
+	reconciler, dynamicClient, _ := setupObservePausedTest(t, false)
+
+	recorder := httptest.NewRecorder()
+	reconciler.GetDebugBundleHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, DebugBundlePath+"?policy=default/paused-policy", nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", recorder.Code, http.StatusOK, recorder.Body.String())
+	}
+	if got := recorder.Header().Get("Content-Disposition"); !strings.Contains(got, "gc-debug-default-paused-policy.json") {
+		t.Errorf("Content-Disposition = %q, want an attachment named after the policy", got)
+	}
+
+	var bundle DebugBundle
+	if err := json.Unmarshal(recorder.Body.Bytes(), &bundle); err != nil {
+		t.Fatalf("failed to parse bundle: %v", err)
+	}
+	if bundle.Policy != "default/paused-policy" || bundle.Spec.TargetResource.Kind != "ConfigMap" {
+		t.Errorf("bundle = %+v, want default/paused-policy with its spec", bundle)
+	}
+	if bundle.Matched != 2 || bundle.WouldDelete != 2 || len(bundle.Decisions) != 2 {
+		t.Fatalf("bundle matched %d, would delete %d, with %d decisions; want 2, 2, 2", bundle.Matched, bundle.WouldDelete, len(bundle.Decisions))
+	}
+	for i, name := range []string{"cm-1", "cm-2"} {
+		if got := bundle.Decisions[i]; got.Name != name || got.Action != DebugActionDelete || got.Reason != ReasonTTLExpired {
+			t.Errorf("decision %d = %+v, want %s deleted for %s", i, got, name, ReasonTTLExpired)
+		}
+	}
+	if len(bundle.NotEvaluated) != 1 || bundle.NotEvaluated[0] != "paused" {
+		t.Errorf("notEvaluated = %v, want [paused]", bundle.NotEvaluated)
+	}
+
+	// Generating the bundle deleted and patched nothing
+	for _, name := range []string{"cm-1", "cm-2"} {
+		if _, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").Get(context.Background(), name, metav1.GetOptions{}); err != nil {
+			t.Errorf("configmap %s: %v", name, err)
+		}
+	}
+	if deletes := countActions(dynamicClient, "delete", ""); deletes != 0 {
+		t.Errorf("bundle deleted %d resources, want 0", deletes)
+	}
+	if patches := countActions(dynamicClient, "patch", ""); patches != 0 {
+		t.Errorf("bundle patched %d resources, want 0", patches)
+	}
+}
+
+func TestDebugBundleHandler_RejectsBadRequests(t *testing.T) {
+	reconciler, _, _ := setupObservePausedTest(t, false)
+	handler := reconciler.GetDebugBundleHandler()
+
+	tests := []struct {
+		name   string
+		method string
+		query  string
+		want   int
+	}{
+		{name: "missing policy", method: http.MethodGet, query: "", want: http.StatusBadRequest},
+		{name: "malformed policy", method: http.MethodGet, query: "?policy=paused-policy", want: http.StatusBadRequest},
+		{name: "unknown policy", method: http.MethodGet, query: "?policy=default/missing", want: http.StatusNotFound},
+		{name: "post", method: http.MethodPost, query: "?policy=default/paused-policy", want: http.StatusMethodNotAllowed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(tt.method, DebugBundlePath+tt.query, nil))
+			if recorder.Code != tt.want {
+				t.Errorf("status = %d, want %d: %s", recorder.Code, tt.want, recorder.Body.String())
+			}
+		})
+	}
+}
+
+func TestDebugBundleLister_ListsSelectedResourcesInPages(t *testing.T) {
+	dynamicClient := fake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{observeTestConfigMapGVR: "ConfigMapList"},
+	)
+	pages := []*unstructured.UnstructuredList{
+		{Items: []unstructured.Unstructured{*newTestConfigMap("default", "cm-1", time.Now())}},
+		{Items: []unstructured.Unstructured{*newTestConfigMap("default", "cm-2", time.Now())}},
+	}
+	pages[0].SetContinue("page-2")
+	for _, page := range pages {
+		page.Items[0].SetLabels(map[string]string{"app": "web"})
+	}
+	var selectors []string
+	dynamicClient.PrependReactor("list", "configmaps", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if len(selectors) == len(pages) {
+			return true, nil, errors.New("listed past the last page")
+		}
+		selectors = append(selectors, action.(k8stesting.ListActionImpl).GetListRestrictions().Labels.String())
+		return true, pages[len(selectors)-1], nil
+	})
+
+	policy := &v1alpha1.GarbageCollectionPolicy{
+		Spec: v1alpha1.GarbageCollectionPolicySpec{
+			TargetResource: v1alpha1.TargetResourceSpec{
+				APIVersion:    "v1",
+				Kind:          "ConfigMap",
+				Namespace:     "default",
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			},
+		},
+	}
+	lister := &debugBundleLister{client: dynamicClient}
+	resources, err := lister.ListPolicyResources(context.Background(), policy)
+	if err != nil {
+		t.Fatalf("ListPolicyResources() returned error: %v", err)
+	}
+	if len(resources) != 2 || lister.listed != 2 {
+		t.Errorf("listed %d resources (recorded %d), want 2 across both pages", len(resources), lister.listed)
+	}
+	if len(selectors) != 2 || selectors[0] != "app=web" || selectors[1] != "app=web" {
+		t.Errorf("list label selectors = %q, want app=web on both pages", selectors)
+	}
+}
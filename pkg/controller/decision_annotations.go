@@ -0,0 +1,190 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+// Limits on decision annotation writes.
+const (
+	// DefaultDecisionAnnotationsPerRun is the maximum number of resources annotated per evaluation.
+	DefaultDecisionAnnotationsPerRun = 50
+
+	// DefaultDecisionAnnotationRefresh is how long an unchanged decision is left as is
+	// before its timestamp is refreshed.
+	DefaultDecisionAnnotationRefresh = time.Hour
+)
+
+// decisionLog collects the matched resources a policy did not delete and why.
+// heldBy names the gate that held a ReasonDeletionHeld resource.
+// A nil decisionLog records nothing.
+type decisionLog struct {
+	resources []*unstructured.Unstructured
+	reasons   []string
+	heldBy    []string
+}
+
+// newDecisionLog returns a decisionLog if the policy annotates decisions or reports
+// protected resources, nil otherwise.
+func newDecisionLog(policy *v1alpha1.GarbageCollectionPolicy) *decisionLog {
+	if !policy.Spec.Behavior.AnnotateDecisions && !policy.Spec.Behavior.ReportProtected {
+		return nil
+	}
+	return &decisionLog{}
+}
+
+// record adds a skipped resource and the reason it was skipped.
+func (d *decisionLog) record(resource *unstructured.Unstructured, reason string) {
+	if d == nil {
+		return
+	}
+	d.resources = append(d.resources, resource)
+	d.reasons = append(d.reasons, reason)
+	d.heldBy = append(d.heldBy, "")
+}
+
+// skipReasonShared returns the reason recorded for a resource that was not deleted:
+// the evaluation reason, or ReasonNotAllowed if the resource was deletable for a
+// reason outside the policy's allowedReasons.
+func skipReasonShared(shouldDelete bool, reason string) string {
+	if shouldDelete {
+		return ReasonNotAllowed
+	}
+	return reason
+}
+
+// recordHeld records resources dropped from the deletion list by a count trend, backup gate,
+// or minRemaining, as named by gate.
+func (d *decisionLog) recordHeld(evaluated, allowed []*unstructured.Unstructured, gate string) {
+	if d == nil || len(evaluated) == len(allowed) {
+		return
+	}
+	kept := make(map[*unstructured.Unstructured]struct{}, len(allowed))
+	for _, resource := range allowed {
+		kept[resource] = struct{}{}
+	}
+	for _, resource := range evaluated {
+		if _, ok := kept[resource]; !ok {
+			d.record(resource, ReasonDeletionHeld)
+			d.heldBy[len(d.heldBy)-1] = gate
+		}
+	}
+}
+
+// DecisionAnnotator stamps skipped resources with the controller's latest decision.
+type DecisionAnnotator struct {
+	dynClient dynamic.Interface
+	perRun    int
+	refresh   time.Duration
+	now       func() time.Time
+}
+
+// NewDecisionAnnotator creates a new DecisionAnnotator.
+func NewDecisionAnnotator(dynClient dynamic.Interface) *DecisionAnnotator {
+	return &DecisionAnnotator{
+		dynClient: dynClient,
+		perRun:    DefaultDecisionAnnotationsPerRun,
+		refresh:   DefaultDecisionAnnotationRefresh,
+		now:       time.Now,
+	}
+}
+
+// Annotate writes the last-evaluated annotations for the logged decisions and returns
+// the number of resources patched. Resources whose reason is unchanged and whose
+// timestamp is younger than the refresh interval are skipped, and at most perRun
+// resources are patched, so steady-state evaluations do not churn resources.
+// Nothing is written in dry-run. Patch failures are logged and do not fail the evaluation.
+func (a *DecisionAnnotator) Annotate(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy, decisions *decisionLog) int {
+	if a == nil || a.dynClient == nil || decisions == nil || len(decisions.resources) == 0 || !policy.Spec.Behavior.AnnotateDecisions {
+		return 0
+	}
+
+	logger := sdklog.NewLogger("zen-gc")
+	policyKey := fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)
+	if dryRunShared(policy) {
+		logger.Debug("[DRY RUN] Skipping decision annotations", sdklog.Operation("annotate_decisions"), sdklog.String("policy", policyKey), sdklog.Int("resources", len(decisions.resources)))
+		return 0
+	}
+
+	gvr, err := parseGVR(policy.Spec.TargetResource.APIVersion, policy.Spec.TargetResource.Kind)
+	if err != nil {
+		return 0
+	}
+
+	now := a.now()
+	patched := 0
+	for i, resource := range decisions.resources {
+		if patched >= a.perRun {
+			logger.Debug("Decision annotation limit reached", sdklog.Operation("annotate_decisions"), sdklog.String("policy", policyKey), sdklog.Int("limit", a.perRun))
+			break
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		reason := decisions.reasons[i]
+		if !a.needsUpdate(resource, reason, now) {
+			continue
+		}
+
+		patch, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": map[string]string{
+					v1alpha1.LastEvaluatedAnnotation:       now.UTC().Format(time.RFC3339),
+					v1alpha1.LastEvaluatedReasonAnnotation: reason,
+				},
+			},
+		})
+		if err != nil {
+			continue
+		}
+		_, err = a.dynClient.Resource(gvr).Namespace(resource.GetNamespace()).Patch(ctx, resource.GetName(), types.MergePatchType, patch, metav1.PatchOptions{FieldManager: FieldManager})
+		if err != nil {
+			if !k8serrors.IsNotFound(err) {
+				logger.Debug("Failed to annotate decision", sdklog.Operation("annotate_decisions"), sdklog.String("policy", policyKey), sdklog.String("resource", fmt.Sprintf("%s/%s", resource.GetNamespace(), resource.GetName())), sdklog.Error(err))
+			}
+			continue
+		}
+		patched++
+	}
+	return patched
+}
+
+// needsUpdate reports whether the resource's annotations differ from the decision or are stale.
+func (a *DecisionAnnotator) needsUpdate(resource *unstructured.Unstructured, reason string, now time.Time) bool {
+	annotations := resource.GetAnnotations()
+	if annotations[v1alpha1.LastEvaluatedReasonAnnotation] != reason {
+		return true
+	}
+	last, err := time.Parse(time.RFC3339, annotations[v1alpha1.LastEvaluatedAnnotation])
+	if err != nil {
+		return true
+	}
+	return now.Sub(last) >= a.refresh
+}
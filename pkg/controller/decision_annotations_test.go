@@ -0,0 +1,194 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+var decisionTestConfigMapGVR = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+func newDecisionTestAnnotator(objs ...runtime.Object) (*DecisionAnnotator, *fake.FakeDynamicClient) {
+	dynamicClient := fake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{decisionTestConfigMapGVR: "ConfigMapList"},
+		objs...,
+	)
+	return NewDecisionAnnotator(dynamicClient), dynamicClient
+}
+
+func decisionTestAnnotations(t *testing.T, dynamicClient *fake.FakeDynamicClient, name string) map[string]string {
+	t.Helper()
+	obj, err := dynamicClient.Resource(decisionTestConfigMapGVR).Namespace("default").Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get configmap %s: %v", name, err)
+	}
+	return obj.GetAnnotations()
+}
+
+func TestEvaluateResources_AnnotatesSkipReasons(t *testing.T) {
+	service := NewPolicyEvaluationService(nil, NewDefaultSelectorMatcher(), NewDefaultConditionMatcher(), nil, nil, nil, nil, nil, nil)
+	fresh := newTestConfigMap("default", "fresh", time.Now().Add(-10*time.Minute))
+	failing := newTestConfigMap("default", "failing", time.Now().Add(-2*time.Hour))
+	expired := newTestConfigMap("default", "expired", time.Now().Add(-2*time.Hour))
+	fresh.SetLabels(map[string]string{"gc-eligible": "true"})
+	expired.SetLabels(map[string]string{"gc-eligible": "true"})
+	annotator, dynamicClient := newDecisionTestAnnotator(fresh, failing, expired)
+
+	policy := newTestPolicy("decisions")
+	policy.Spec.Behavior.AnnotateDecisions = true
+	policy.Spec.Conditions = &v1alpha1.ConditionsSpec{
+		HasLabels: []v1alpha1.LabelCondition{{Key: "gc-eligible", Operator: "Exists"}},
+	}
+
+	var oldest oldestPending
+	decisions := newDecisionLog(policy)
+	toDelete := make([]*unstructured.Unstructured, 0)
+	service.evaluateResources(context.Background(), []*unstructured.Unstructured{fresh, failing, expired}, policy, &toDelete, make(map[string]string), "v1", "ConfigMap", &oldest, decisions)
+
+	if patched := annotator.Annotate(context.Background(), policy, decisions); patched != 2 {
+		t.Fatalf("Annotate() patched %d resources, want 2", patched)
+	}
+	if got := decisionTestAnnotations(t, dynamicClient, "fresh")[v1alpha1.LastEvaluatedReasonAnnotation]; got != ReasonNotExpired {
+		t.Errorf("fresh reason = %q, want %q", got, ReasonNotExpired)
+	}
+	if got := decisionTestAnnotations(t, dynamicClient, "failing")[v1alpha1.LastEvaluatedReasonAnnotation]; got != ReasonConditionNotMet {
+		t.Errorf("failing reason = %q, want %q", got, ReasonConditionNotMet)
+	}
+	if _, err := time.Parse(time.RFC3339, decisionTestAnnotations(t, dynamicClient, "fresh")[v1alpha1.LastEvaluatedAnnotation]); err != nil {
+		t.Errorf("last-evaluated is not an RFC3339 timestamp: %v", err)
+	}
+	if _, found := decisionTestAnnotations(t, dynamicClient, "expired")[v1alpha1.LastEvaluatedReasonAnnotation]; found {
+		t.Error("resource selected for deletion should not be annotated")
+	}
+}
+
+func TestEvaluateResources_AnnotatesReasonNotAllowed(t *testing.T) {
+	service := NewPolicyEvaluationService(nil, NewDefaultSelectorMatcher(), NewDefaultConditionMatcher(), nil, nil, nil, nil, nil, nil)
+	expired := newTestConfigMap("default", "expired", time.Now().Add(-2*time.Hour))
+	annotator, dynamicClient := newDecisionTestAnnotator(expired)
+	policy := newTestPolicy("decisions")
+	policy.Spec.Behavior.AnnotateDecisions = true
+	policy.Spec.Behavior.AllowedReasons = []string{"size_exceeded"}
+
+	var oldest oldestPending
+	decisions := newDecisionLog(policy)
+	toDelete := make([]*unstructured.Unstructured, 0)
+	service.evaluateResources(context.Background(), []*unstructured.Unstructured{expired}, policy, &toDelete, make(map[string]string), "v1", "ConfigMap", &oldest, decisions)
+	annotator.Annotate(context.Background(), policy, decisions)
+
+	if got := decisionTestAnnotations(t, dynamicClient, "expired")[v1alpha1.LastEvaluatedReasonAnnotation]; got != ReasonNotAllowed {
+		t.Errorf("reason = %q, want %q", got, ReasonNotAllowed)
+	}
+}
+
+func TestDecisionLog_DisabledRecordsNothing(t *testing.T) {
+	policy := newTestPolicy("decisions")
+	decisions := newDecisionLog(policy)
+	decisions.record(newTestConfigMap("default", "fresh", time.Now()), ReasonNotExpired)
+	if decisions != nil {
+		t.Error("newDecisionLog() should be nil when annotateDecisions is off")
+	}
+}
+
+func TestDecisionLog_RecordHeld(t *testing.T) {
+	policy := newTestPolicy("decisions")
+	policy.Spec.Behavior.AnnotateDecisions = true
+	allowed := newTestConfigMap("default", "allowed", time.Now())
+	held := newTestConfigMap("default", "held", time.Now())
+	decisions := newDecisionLog(policy)
+	decisions.recordHeld([]*unstructured.Unstructured{allowed, held}, []*unstructured.Unstructured{allowed}, HeldByMinRemaining)
+	if len(decisions.resources) != 1 || decisions.resources[0] != held || decisions.reasons[0] != ReasonDeletionHeld {
+		t.Errorf("recordHeld() logged %d resources with reasons %v, want only held/%s", len(decisions.resources), decisions.reasons, ReasonDeletionHeld)
+	}
+	if decisions.heldBy[0] != HeldByMinRemaining {
+		t.Errorf("recordHeld() heldBy = %q, want %q", decisions.heldBy[0], HeldByMinRemaining)
+	}
+}
+
+func TestDecisionAnnotator_DryRunWritesNothing(t *testing.T) {
+	fresh := newTestConfigMap("default", "fresh", time.Now())
+	annotator, dynamicClient := newDecisionTestAnnotator(fresh)
+	policy := newTestPolicy("decisions")
+	policy.Spec.Behavior.AnnotateDecisions = true
+	policy.Spec.Behavior.DryRun = true
+
+	decisions := newDecisionLog(policy)
+	decisions.record(fresh, ReasonNotExpired)
+	if patched := annotator.Annotate(context.Background(), policy, decisions); patched != 0 {
+		t.Errorf("Annotate() patched %d resources in dry run, want 0", patched)
+	}
+	if patches := countActions(dynamicClient, "patch", ""); patches != 0 {
+		t.Errorf("dry run issued %d patches, want 0", patches)
+	}
+}
+
+func TestDecisionAnnotator_BoundsWrites(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	unchanged := newTestConfigMap("default", "unchanged", now.Add(-time.Hour))
+	unchanged.SetAnnotations(map[string]string{
+		v1alpha1.LastEvaluatedAnnotation:       now.Add(-10 * time.Minute).Format(time.RFC3339),
+		v1alpha1.LastEvaluatedReasonAnnotation: ReasonNotExpired,
+	})
+	stale := newTestConfigMap("default", "stale", now.Add(-3*time.Hour))
+	stale.SetAnnotations(map[string]string{
+		v1alpha1.LastEvaluatedAnnotation:       now.Add(-2 * time.Hour).Format(time.RFC3339),
+		v1alpha1.LastEvaluatedReasonAnnotation: ReasonNotExpired,
+	})
+	changed := newTestConfigMap("default", "changed", now.Add(-time.Hour))
+	changed.SetAnnotations(map[string]string{
+		v1alpha1.LastEvaluatedAnnotation:       now.Add(-10 * time.Minute).Format(time.RFC3339),
+		v1alpha1.LastEvaluatedReasonAnnotation: ReasonNotExpired,
+	})
+	annotator, dynamicClient := newDecisionTestAnnotator(unchanged, stale, changed)
+	annotator.now = func() time.Time { return now }
+
+	policy := newTestPolicy("decisions")
+	policy.Spec.Behavior.AnnotateDecisions = true
+	decisions := newDecisionLog(policy)
+	decisions.record(unchanged, ReasonNotExpired)
+	decisions.record(stale, ReasonNotExpired)
+	decisions.record(changed, ReasonConditionNotMet)
+
+	// Unchanged decisions younger than the refresh interval are not rewritten
+	if patched := annotator.Annotate(context.Background(), policy, decisions); patched != 2 {
+		t.Errorf("Annotate() patched %d resources, want 2", patched)
+	}
+	if got := decisionTestAnnotations(t, dynamicClient, "changed")[v1alpha1.LastEvaluatedReasonAnnotation]; got != ReasonConditionNotMet {
+		t.Errorf("changed reason = %q, want %q", got, ReasonConditionNotMet)
+	}
+
+	// The per-run limit caps writes
+	annotator.now = func() time.Time { return now.Add(24 * time.Hour) }
+	annotator.perRun = 1
+	if patched := annotator.Annotate(context.Background(), policy, decisions); patched != 1 {
+		t.Errorf("Annotate() with perRun=1 patched %d resources, want 1", patched)
+	}
+	if patches := countActions(dynamicClient, "patch", ""); patches != 3 {
+		t.Errorf("issued %d patches in total, want 3", patches)
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// Dedup scopes for DedupSpec.
+const (
+	// DedupScopeNamespace keeps the newest resource per namespace and key.
+	DedupScopeNamespace = "Namespace"
+
+	// DedupScopeCluster keeps the newest resource per key across all namespaces.
+	DedupScopeCluster = "Cluster"
+)
+
+// dedupKey computes a resource's dedup key. Returns false if any key label or field is missing.
+func dedupKey(resource *unstructured.Unstructured, spec *v1alpha1.DedupSpec) (string, bool) {
+	return groupKey(resource, spec.KeyLabels, spec.KeyFields, spec.Scope)
+}
+
+// groupKey computes a resource's group key from label values and field values, prefixed
+// with its namespace unless scope is Cluster. Returns false if any key label or field is missing.
+func groupKey(resource *unstructured.Unstructured, keyLabels, keyFields []string, scope string) (string, bool) {
+	parts := make([]interface{}, 0, len(keyLabels)+len(keyFields)+1)
+	if scope != DedupScopeCluster {
+		parts = append(parts, resource.GetNamespace())
+	}
+
+	resourceLabels := resource.GetLabels()
+	for _, key := range keyLabels {
+		value, ok := resourceLabels[key]
+		if !ok {
+			return "", false
+		}
+		parts = append(parts, value)
+	}
+	for _, field := range keyFields {
+		value, found, err := nestedFieldNoCopy(resource.Object, field)
+		if err != nil || !found {
+			return "", false
+		}
+		parts = append(parts, value)
+	}
+
+	// JSON keeps values of different types and values containing separators distinct
+	key, err := json.Marshal(parts)
+	if err != nil {
+		return "", false
+	}
+	return string(key), true
+}
+
+// newerForDedup reports whether a is newer than b. Ties are broken by namespace/name so the
+// survivor is stable across evaluations.
+func newerForDedup(a, b *unstructured.Unstructured) bool {
+	aTime, bTime := a.GetCreationTimestamp().Time, b.GetCreationTimestamp().Time
+	if !aTime.Equal(bTime) {
+		return aTime.After(bTime)
+	}
+	return strings.Compare(a.GetNamespace()+"/"+a.GetName(), b.GetNamespace()+"/"+b.GetName()) > 0
+}
+
+// dedupDuplicatesShared returns the matched resources that are duplicates: they share a key
+// with a newer resource. Only duplicates may be deleted by a dedup policy.
+func dedupDuplicatesShared(resources []*unstructured.Unstructured, spec *v1alpha1.DedupSpec) map[*unstructured.Unstructured]struct{} {
+	newest := make(map[string]*unstructured.Unstructured)
+	keyed := make(map[*unstructured.Unstructured]string, len(resources))
+	for _, resource := range resources {
+		key, ok := dedupKey(resource, spec)
+		if !ok {
+			continue
+		}
+		keyed[resource] = key
+		if current, exists := newest[key]; !exists || newerForDedup(resource, current) {
+			newest[key] = resource
+		}
+	}
+
+	duplicates := make(map[*unstructured.Unstructured]struct{}, len(keyed)-len(newest))
+	for resource, key := range keyed {
+		if newest[key] != resource {
+			duplicates[resource] = struct{}{}
+		}
+	}
+	return duplicates
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func dedupNames(duplicates map[*unstructured.Unstructured]struct{}) []string {
+	names := make([]string, 0, len(duplicates))
+	for resource := range duplicates {
+		names = append(names, resource.GetNamespace()+"/"+resource.GetName())
+	}
+	sort.Strings(names)
+	return names
+}
+
+// newDedupTestResources returns labeled configmaps across three namespaces, all with the
+// same data.version, plus one unlabeled configmap.
+func newDedupTestResources() []*unstructured.Unstructured {
+	resources := make([]*unstructured.Unstructured, 0, 6)
+	for _, cm := range []struct {
+		namespace, name, app string
+		age                  time.Duration
+	}{
+		{"team-a", "web-1", "web", 3 * time.Hour},
+		{"team-b", "web-2", "web", 2 * time.Hour},
+		{"team-b", "web-3", "web", 1 * time.Hour},
+		{"team-a", "api-1", "api", 5 * time.Hour},
+		{"team-c", "api-2", "api", 4 * time.Hour},
+	} {
+		resource := newTestConfigMap(cm.namespace, cm.name, time.Now().Add(-cm.age))
+		resource.SetLabels(map[string]string{"app": cm.app})
+		_ = unstructured.SetNestedField(resource.Object, "v1", "data", "version")
+		resources = append(resources, resource)
+	}
+	return append(resources, newTestConfigMap("team-a", "unlabeled", time.Time{}))
+}
+
+func TestDedupDuplicatesShared_ClusterScope(t *testing.T) {
+	spec := &v1alpha1.DedupSpec{KeyLabels: []string{"app"}, KeyFields: []string{"data.version"}, Scope: DedupScopeCluster}
+
+	got := dedupNames(dedupDuplicatesShared(newDedupTestResources(), spec))
+	want := []string{"team-a/api-1", "team-a/web-1", "team-b/web-2"}
+	if len(got) != len(want) {
+		t.Fatalf("duplicates = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("duplicates = %v, want %v", got, want)
+			break
+		}
+	}
+}
+
+func TestDedupDuplicatesShared_NamespaceScope(t *testing.T) {
+	spec := &v1alpha1.DedupSpec{KeyLabels: []string{"app"}}
+
+	// Only team-b has two resources with the same key
+	got := dedupNames(dedupDuplicatesShared(newDedupTestResources(), spec))
+	if len(got) != 1 || got[0] != "team-b/web-2" {
+		t.Errorf("duplicates = %v, want [team-b/web-2]", got)
+	}
+}
+
+func TestDedupDuplicatesShared_MissingKeyAndTies(t *testing.T) {
+	spec := &v1alpha1.DedupSpec{KeyFields: []string{"data.owner"}, Scope: DedupScopeCluster}
+	if got := dedupDuplicatesShared(newDedupTestResources(), spec); len(got) != 0 {
+		t.Errorf("resources without key fields should never be duplicates, got %v", dedupNames(got))
+	}
+
+	created := time.Now().Add(-time.Hour)
+	a := newTestConfigMap("ns-a", "same", created)
+	b := newTestConfigMap("ns-b", "same", created)
+	a.SetLabels(map[string]string{"app": "web"})
+	b.SetLabels(map[string]string{"app": "web"})
+	spec = &v1alpha1.DedupSpec{KeyLabels: []string{"app"}, Scope: DedupScopeCluster}
+	for _, order := range [][]*unstructured.Unstructured{{a, b}, {b, a}} {
+		if got := dedupNames(dedupDuplicatesShared(order, spec)); len(got) != 1 || got[0] != "ns-a/same" {
+			t.Errorf("tie should keep the same survivor regardless of order, duplicates = %v", got)
+		}
+	}
+}
+
+func TestEvaluateResources_DedupOnlyDeletesDuplicates(t *testing.T) {
+	service := NewPolicyEvaluationService(nil, NewDefaultSelectorMatcher(), NewDefaultConditionMatcher(), nil, nil, nil, nil, nil, nil)
+	policy := &v1alpha1.GarbageCollectionPolicy{
+		Spec: v1alpha1.GarbageCollectionPolicySpec{
+			TargetResource: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap"},
+			TTL:            v1alpha1.TTLSpec{SecondsAfterCreation: int64Ptr(60)},
+			Dedup:          &v1alpha1.DedupSpec{KeyLabels: []string{"app"}, Scope: DedupScopeCluster},
+		},
+	}
+
+	var oldest oldestPending
+	toDelete := make([]*unstructured.Unstructured, 0)
+	matched, pending := service.evaluateResources(context.Background(), newDedupTestResources(), policy, &toDelete, make(map[string]string), "v1", "ConfigMap", &oldest, nil)
+	if matched != 6 {
+		t.Errorf("matched = %d, want 6", matched)
+	}
+	if pending != 0 {
+		t.Errorf("pending = %d, want 0", pending)
+	}
+	names := make([]string, 0, len(toDelete))
+	for _, resource := range toDelete {
+		names = append(names, resource.GetNamespace()+"/"+resource.GetName())
+	}
+	sort.Strings(names)
+	if len(names) != 3 || names[0] != "team-a/api-1" || names[1] != "team-a/web-1" || names[2] != "team-b/web-2" {
+		t.Errorf("deleted = %v, want [team-a/api-1 team-a/web-1 team-b/web-2]", names)
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"math/rand/v2"
+	"time"
+
+	"github.com/kube-zen/zen-gc/pkg/config"
+)
+
+// deleteBackoff spaces the retries of a deletion failing with a transient API error.
+type deleteBackoff struct {
+	initial    time.Duration
+	max        time.Duration
+	multiplier float64
+	maxRetries int
+}
+
+// deleteBackoffFromConfig reads the deletion retry backoff from the controller config,
+// falling back to the defaults for unset or invalid values.
+func deleteBackoffFromConfig(cfg *config.ControllerConfig) deleteBackoff {
+	b := deleteBackoff{
+		initial:    config.DefaultDeleteBackoffInitialInterval,
+		max:        config.DefaultDeleteBackoffMaxInterval,
+		multiplier: config.DefaultDeleteBackoffMultiplier,
+		maxRetries: config.DefaultDeleteMaxRetries,
+	}
+	if cfg == nil {
+		return b
+	}
+	if cfg.DeleteBackoffInitialInterval > 0 {
+		b.initial = cfg.DeleteBackoffInitialInterval
+	}
+	if cfg.DeleteBackoffMaxInterval > 0 {
+		b.max = cfg.DeleteBackoffMaxInterval
+	}
+	if cfg.DeleteBackoffMultiplier >= 1 {
+		b.multiplier = cfg.DeleteBackoffMultiplier
+	}
+	if cfg.DeleteMaxRetries >= 0 {
+		b.maxRetries = cfg.DeleteMaxRetries
+	}
+	return b
+}
+
+// interval returns the wait before the given retry, counted from 0, without jitter:
+// the initial interval grown by the multiplier per retry, capped at the max interval.
+func (b deleteBackoff) interval(retry int) time.Duration {
+	d := float64(b.initial)
+	for i := 0; i < retry && d < float64(b.max); i++ {
+		d *= b.multiplier
+	}
+	return min(time.Duration(d), b.max)
+}
+
+// jitteredBackoff picks a wait uniformly from [d/2, d], so workers failing together
+// against an overloaded API server do not retry in lockstep.
+func jitteredBackoff(d time.Duration) time.Duration {
+	half := d / 2
+	if half <= 0 {
+		return d
+	}
+	return d - half + rand.N(half+1) //nolint:gosec // jitter needs no cryptographic randomness
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/config"
+	"github.com/kube-zen/zen-sdk/pkg/gc/ratelimiter"
+)
+
+// countingDeleter fails every deletion with err and counts the attempts.
+type countingDeleter struct {
+	err      error
+	attempts int
+}
+
+func (d *countingDeleter) DeleteResourceWithContext(_ context.Context, _ *unstructured.Unstructured, _ *v1alpha1.GarbageCollectionPolicy, _ *ratelimiter.RateLimiter) error {
+	d.attempts++
+	return d.err
+}
+
+func TestDeleteResourceWithBackoffShared_StopsAtMaxRetries(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		maxRetries int
+		expected   int
+	}{
+		{name: "transient error retried", err: k8serrors.NewTooManyRequests("slow down", 1), maxRetries: 3, expected: 4},
+		{name: "retries disabled", err: k8serrors.NewServiceUnavailable("unavailable"), maxRetries: 0, expected: 1},
+		{name: "non-retryable error", err: k8serrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "pod", errors.New("denied")), maxRetries: 3, expected: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deleter := &countingDeleter{err: tt.err}
+			retry := deleteBackoff{initial: time.Millisecond, max: 2 * time.Millisecond, multiplier: 2, maxRetries: tt.maxRetries}
+
+			err := deleteResourceWithBackoffShared(context.Background(), &unstructured.Unstructured{}, &v1alpha1.GarbageCollectionPolicy{}, nil, deleter, nil, retry)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("Expected the deletion error to be returned, got %v", err)
+			}
+			if deleter.attempts != tt.expected {
+				t.Errorf("Expected %d attempts, got %d", tt.expected, deleter.attempts)
+			}
+		})
+	}
+}
+
+func TestDeleteBackoff_Interval(t *testing.T) {
+	b := deleteBackoff{initial: 100 * time.Millisecond, max: time.Second, multiplier: 3}
+	expected := []time.Duration{100 * time.Millisecond, 300 * time.Millisecond, 900 * time.Millisecond, time.Second, time.Second}
+	for retry, want := range expected {
+		if got := b.interval(retry); got != want {
+			t.Errorf("interval(%d) = %v, want %v", retry, got, want)
+		}
+	}
+}
+
+func TestJitteredBackoff_WithinBounds(t *testing.T) {
+	d := 800 * time.Millisecond
+	for i := 0; i < 1000; i++ {
+		if got := jitteredBackoff(d); got < d/2 || got > d {
+			t.Fatalf("jitteredBackoff(%v) = %v, want within [%v, %v]", d, got, d/2, d)
+		}
+	}
+	if got := jitteredBackoff(0); got != 0 {
+		t.Errorf("jitteredBackoff(0) = %v, want 0", got)
+	}
+}
+
+func TestDeleteBackoffFromConfig(t *testing.T) {
+	cfg := config.NewControllerConfig().WithDeleteBackoff(time.Second, time.Minute, 1.5, 0)
+	b := deleteBackoffFromConfig(cfg)
+	if b.initial != time.Second || b.max != time.Minute || b.multiplier != 1.5 || b.maxRetries != 0 {
+		t.Errorf("Expected the configured backoff, got %+v", b)
+	}
+
+	b = deleteBackoffFromConfig(&config.ControllerConfig{DeleteMaxRetries: -1})
+	if b.initial != config.DefaultDeleteBackoffInitialInterval || b.multiplier != config.DefaultDeleteBackoffMultiplier || b.maxRetries != config.DefaultDeleteMaxRetries {
+		t.Errorf("Expected defaults for unset values, got %+v", b)
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// DefaultClaimTTL is how long a deletion claim is remembered after it was last touched.
+const DefaultClaimTTL = 10 * time.Minute
+
+// claimState is the lifecycle state of a deletion claim.
+type claimState int
+
+const (
+	// claimPending means a policy claimed the resource but has not deleted it (e.g. the last attempt failed).
+	claimPending claimState = iota
+
+	// claimDeleting means a deletion is in flight and the claim cannot be preempted.
+	claimDeleting
+
+	// claimDeleted means the resource was deleted by the claiming policy.
+	claimDeleted
+)
+
+// deletionClaim records which policy claimed a resource for deletion.
+type deletionClaim struct {
+	policy    types.NamespacedName
+	priority  int32
+	state     claimState
+	updatedAt time.Time
+}
+
+// DeletionCoordinator ensures a resource matched by several policies is deleted only once
+// and attributed to a single claiming policy.
+//
+// The first policy to start deleting a resource claims it. A pending claim (one whose
+// deletion has not succeeded yet) may be taken over by a policy with a strictly higher
+// spec.priority; in-flight and completed deletions are never preempted.
+// A nil *DeletionCoordinator performs no coordination.
+type DeletionCoordinator struct {
+	claims    map[types.UID]*deletionClaim
+	ttl       time.Duration
+	lastPrune time.Time
+	mu        sync.Mutex
+	now       func() time.Time
+}
+
+// NewDeletionCoordinator creates a new DeletionCoordinator.
+func NewDeletionCoordinator() *DeletionCoordinator {
+	return &DeletionCoordinator{
+		claims: make(map[types.UID]*deletionClaim),
+		ttl:    DefaultClaimTTL,
+		now:    time.Now,
+	}
+}
+
+// BeginDelete claims a resource for deletion by policy.
+// Returns true if the caller may delete the resource. Otherwise, it returns false
+// together with the policy that holds the claim.
+func (c *DeletionCoordinator) BeginDelete(uid types.UID, policy *v1alpha1.GarbageCollectionPolicy) (bool, types.NamespacedName) {
+	claimant := types.NamespacedName{Namespace: policy.Namespace, Name: policy.Name}
+	if c == nil || uid == "" {
+		return true, claimant
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	c.pruneLocked(now)
+
+	existing, ok := c.claims[uid]
+	if ok && now.Sub(existing.updatedAt) > c.ttl && existing.state != claimDeleting {
+		ok = false
+	}
+	if ok && existing.policy != claimant {
+		if existing.state != claimPending || existing.priority >= policy.Spec.Priority {
+			return false, existing.policy
+		}
+	}
+
+	c.claims[uid] = &deletionClaim{
+		policy:    claimant,
+		priority:  policy.Spec.Priority,
+		state:     claimDeleting,
+		updatedAt: now,
+	}
+	return true, claimant
+}
+
+// FinishDelete records the outcome of a deletion started with BeginDelete.
+// Successful deletions stay claimed so other policies skip the resource;
+// failed deletions leave a pending claim that higher-priority policies may take over.
+func (c *DeletionCoordinator) FinishDelete(uid types.UID, deleted bool) {
+	if c == nil || uid == "" {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	claim, ok := c.claims[uid]
+	if !ok {
+		return
+	}
+	claim.updatedAt = c.now()
+	if deleted {
+		claim.state = claimDeleted
+	} else {
+		claim.state = claimPending
+	}
+}
+
+// ClaimedBy returns the policy currently holding the claim on a resource, if any.
+func (c *DeletionCoordinator) ClaimedBy(uid types.UID) (types.NamespacedName, bool) {
+	if c == nil {
+		return types.NamespacedName{}, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	claim, ok := c.claims[uid]
+	if !ok || c.now().Sub(claim.updatedAt) > c.ttl {
+		return types.NamespacedName{}, false
+	}
+	return claim.policy, true
+}
+
+// pruneLocked removes expired claims, at most once per tenth of the claim TTL.
+// Caller must hold c.mu.
+func (c *DeletionCoordinator) pruneLocked(now time.Time) {
+	if now.Sub(c.lastPrune) < c.ttl/10 {
+		return
+	}
+	c.lastPrune = now
+	for uid, claim := range c.claims {
+		if claim.state != claimDeleting && now.Sub(claim.updatedAt) > c.ttl {
+			delete(c.claims, uid)
+		}
+	}
+}
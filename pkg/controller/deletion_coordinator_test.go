@@ -0,0 +1,228 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-sdk/pkg/gc/ratelimiter"
+)
+
+var errFakeDeleteFailed = errors.New("fake delete failed")
+
+// fakeBatchDeleter records deletions per policy and can be told to fail.
+type fakeBatchDeleter struct {
+	coordinator *DeletionCoordinator
+	breaker     *ErrorRateBreaker
+	sentinel    *DeletionSentinel
+	audit       AuditSink
+	snapshotDir string
+	deletedBy   map[string][]string // resource name -> policy names
+	fail        bool
+	err         error // returned instead of errFakeDeleteFailed when set
+}
+
+func (f *fakeBatchDeleter) DeleteResourceWithBackoff(ctx context.Context, resource *unstructured.Unstructured, policy *v1alpha1.GarbageCollectionPolicy, rateLimiter *ratelimiter.RateLimiter) error {
+	if f.err != nil {
+		return f.err
+	}
+	if f.fail {
+		return errFakeDeleteFailed
+	}
+	if dryRunShared(policy) {
+		return nil
+	}
+	f.deletedBy[resource.GetName()] = append(f.deletedBy[resource.GetName()], policy.Name)
+	return nil
+}
+
+func (f *fakeBatchDeleter) GetEventRecorder() *EventRecorder { return nil }
+
+func (f *fakeBatchDeleter) GetDeletionCoordinator() *DeletionCoordinator { return f.coordinator }
+
+func (f *fakeBatchDeleter) GetErrorRateBreaker() *ErrorRateBreaker { return f.breaker }
+
+func (f *fakeBatchDeleter) GetDeletionSentinel() *DeletionSentinel { return f.sentinel }
+
+func (f *fakeBatchDeleter) GetAuditSink() AuditSink { return f.audit }
+
+func (f *fakeBatchDeleter) GetSnapshotDir() string { return f.snapshotDir }
+
+func TestDeletionCoordinator_OverlappingPoliciesDeleteOnce(t *testing.T) {
+	coordinator := NewDeletionCoordinator()
+	deleter := &fakeBatchDeleter{coordinator: coordinator, deletedBy: map[string][]string{}}
+	limiter := ratelimiter.NewRateLimiter(1000)
+	batch := []*unstructured.Unstructured{newTestConfigMap("default", "a", time.Now()), newTestConfigMap("default", "b", time.Now())}
+
+	first := newTestPolicy("first")
+	second := newTestPolicy("second")
+	second.Spec.Priority = 10
+
+	deleted, errs := deleteBatchShared(context.Background(), batch, first, limiter, map[string]string{}, deleter)
+	if deleted != 2 || len(errs) != 0 {
+		t.Fatalf("first policy: deleted=%d errs=%v, want 2 deletions", deleted, errs)
+	}
+
+	// A completed deletion is never taken over, even by a higher-priority policy
+	deleted, errs = deleteBatchShared(context.Background(), batch, second, limiter, map[string]string{}, deleter)
+	if deleted != 0 || len(errs) != 0 {
+		t.Fatalf("second policy: deleted=%d errs=%v, want 0 deletions", deleted, errs)
+	}
+
+	for _, name := range []string{"a", "b"} {
+		if got := deleter.deletedBy[name]; len(got) != 1 || got[0] != "first" {
+			t.Errorf("resource %s deleted by %v, want [first]", name, got)
+		}
+		owner, ok := coordinator.ClaimedBy(types.UID(name + "-uid"))
+		if !ok || owner.Name != "first" {
+			t.Errorf("resource %s attributed to %v, want default/first", name, owner)
+		}
+	}
+}
+
+func TestDeletionCoordinator_PriorityTakesOverPendingClaim(t *testing.T) {
+	coordinator := NewDeletionCoordinator()
+	low := newTestPolicy("low")
+	low.Spec.Priority = 1
+	high := newTestPolicy("high")
+	high.Spec.Priority = 5
+	peer := newTestPolicy("peer")
+	peer.Spec.Priority = 1
+	uid := types.UID("res-uid")
+
+	if ok, _ := coordinator.BeginDelete(uid, low); !ok {
+		t.Fatal("expected first claim to be granted")
+	}
+
+	// In-flight deletions cannot be preempted
+	if ok, owner := coordinator.BeginDelete(uid, high); ok || owner.Name != "low" {
+		t.Fatalf("expected in-flight claim to be held by low, got ok=%v owner=%v", ok, owner)
+	}
+
+	// Failed deletion leaves a pending claim
+	coordinator.FinishDelete(uid, false)
+
+	// Equal priority cannot take over
+	if ok, _ := coordinator.BeginDelete(uid, peer); ok {
+		t.Fatal("expected equal-priority policy to be denied")
+	}
+
+	// Higher priority takes over the pending claim
+	if ok, _ := coordinator.BeginDelete(uid, high); !ok {
+		t.Fatal("expected higher-priority policy to take over pending claim")
+	}
+	coordinator.FinishDelete(uid, true)
+
+	if owner, _ := coordinator.ClaimedBy(uid); owner.Name != "high" {
+		t.Errorf("expected deletion attributed to high, got %v", owner)
+	}
+
+	// The original claimant may retry its own claim only while it still holds it
+	if ok, _ := coordinator.BeginDelete(uid, low); ok {
+		t.Error("expected low to be denied after high deleted the resource")
+	}
+}
+
+func TestDeletionCoordinator_DryRunPolicyDoesNotClaim(t *testing.T) {
+	coordinator := NewDeletionCoordinator()
+	deleter := &fakeBatchDeleter{coordinator: coordinator, deletedBy: map[string][]string{}}
+	limiter := ratelimiter.NewRateLimiter(1000)
+	batch := []*unstructured.Unstructured{newTestConfigMap("default", "a", time.Now())}
+
+	dryRun := newTestPolicy("dry-run")
+	dryRun.Spec.Priority = 10
+	dryRun.Spec.Behavior.DryRun = true
+	armed := newTestPolicy("armed")
+
+	// Every run of the dry-run policy would otherwise refresh a completed claim
+	for run := 0; run < 2; run++ {
+		if _, errs := deleteBatchShared(context.Background(), batch, dryRun, limiter, map[string]string{}, deleter); len(errs) != 0 {
+			t.Fatalf("dry-run policy: errs=%v", errs)
+		}
+		if owner, ok := coordinator.ClaimedBy("a-uid"); ok {
+			t.Fatalf("dry-run policy run %d claimed the resource for %v", run, owner)
+		}
+	}
+
+	if deleted, errs := deleteBatchShared(context.Background(), batch, armed, limiter, map[string]string{}, deleter); deleted != 1 || len(errs) != 0 {
+		t.Fatalf("armed policy: deleted=%d errs=%v, want 1 deletion", deleted, errs)
+	}
+	if got := deleter.deletedBy["a"]; len(got) != 1 || got[0] != "armed" {
+		t.Errorf("resource deleted by %v, want [armed]", got)
+	}
+	if owner, ok := coordinator.ClaimedBy("a-uid"); !ok || owner.Name != "armed" {
+		t.Errorf("resource attributed to %v, want default/armed", owner)
+	}
+}
+
+func TestDeletionCoordinator_ClaimsExpire(t *testing.T) {
+	coordinator := NewDeletionCoordinator()
+	now := time.Now()
+	coordinator.now = func() time.Time { return now }
+	uid := types.UID("res-uid")
+
+	coordinator.BeginDelete(uid, newTestPolicy("a"))
+	coordinator.FinishDelete(uid, true)
+
+	now = now.Add(DefaultClaimTTL + time.Second)
+	if _, ok := coordinator.ClaimedBy(uid); ok {
+		t.Error("expected claim to expire")
+	}
+	if ok, _ := coordinator.BeginDelete(uid, newTestPolicy("b")); !ok {
+		t.Error("expected expired claim to be reclaimable")
+	}
+}
+
+func TestDeletionCoordinator_NilIsNoop(t *testing.T) {
+	var coordinator *DeletionCoordinator
+	deleter := &fakeBatchDeleter{deletedBy: map[string][]string{}}
+	limiter := ratelimiter.NewRateLimiter(1000)
+	batch := []*unstructured.Unstructured{newTestConfigMap("default", "a", time.Now())}
+
+	for _, name := range []string{"one", "two"} {
+		if deleted, _ := deleteBatchShared(context.Background(), batch, newTestPolicy(name), limiter, map[string]string{}, deleter); deleted != 1 {
+			t.Errorf("policy %s: expected deletion without coordinator", name)
+		}
+	}
+	if _, ok := coordinator.ClaimedBy("a-uid"); ok {
+		t.Error("nil coordinator should not report claims")
+	}
+}
+
+func TestDeletionCoordinator_FailedDeletionReleasesForRetry(t *testing.T) {
+	coordinator := NewDeletionCoordinator()
+	deleter := &fakeBatchDeleter{coordinator: coordinator, deletedBy: map[string][]string{}, fail: true}
+	limiter := ratelimiter.NewRateLimiter(1000)
+	batch := []*unstructured.Unstructured{newTestConfigMap("default", "a", time.Now())}
+	policy := newTestPolicy("owner")
+
+	if _, errs := deleteBatchShared(context.Background(), batch, policy, limiter, map[string]string{}, deleter); len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %v", errs)
+	}
+
+	deleter.fail = false
+	if deleted, _ := deleteBatchShared(context.Background(), batch, policy, limiter, map[string]string{}, deleter); deleted != 1 {
+		t.Error("expected the claiming policy to retry its pending claim")
+	}
+}
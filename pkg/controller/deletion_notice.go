@@ -0,0 +1,179 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+// deletionNoticeShared returns the policy's deletionNoticeSeconds window, or 0 if the
+// policy deletes without notice.
+func deletionNoticeShared(policy *v1alpha1.GarbageCollectionPolicy) time.Duration {
+	seconds := policy.Spec.Behavior.DeletionNoticeSeconds
+	if seconds == nil || *seconds <= 0 {
+		return 0
+	}
+	return time.Duration(*seconds) * time.Second
+}
+
+// deleteAfterShared returns the time stamped in the resource's delete-after annotation by
+// the policy, and false if it has none, it was stamped by another policy, it cannot be
+// parsed, or it is stale: elapsed more than a notice window before now.
+func deleteAfterShared(resource *unstructured.Unstructured, policy *v1alpha1.GarbageCollectionPolicy, now time.Time) (time.Time, bool) {
+	annotations := resource.GetAnnotations()
+	value, ok := annotations[v1alpha1.DeleteAfterAnnotation]
+	if !ok || annotations[v1alpha1.DeleteAfterPolicyAnnotation] != noticePolicyKey(policy) {
+		return time.Time{}, false
+	}
+	deleteAfter, err := time.Parse(time.RFC3339, value)
+	if err != nil || now.After(deleteAfter.Add(deletionNoticeShared(policy))) {
+		return time.Time{}, false
+	}
+	return deleteAfter, true
+}
+
+// noticePolicyKey returns the delete-after-policy annotation value of the policy.
+func noticePolicyKey(policy *v1alpha1.GarbageCollectionPolicy) string {
+	return fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)
+}
+
+// deletionNoticePendingShared reports whether a due resource of a policy with a notice
+// window was noticed and its window has not elapsed yet. Resources that were not noticed
+// are not pending here; applyDeletionNoticeShared notices and holds them.
+func deletionNoticePendingShared(resource *unstructured.Unstructured, policy *v1alpha1.GarbageCollectionPolicy, now time.Time) bool {
+	if deletionNoticeShared(policy) == 0 {
+		return false
+	}
+	deleteAfter, ok := deleteAfterShared(resource, policy, now)
+	return ok && now.Before(deleteAfter)
+}
+
+// DeletionNotifier warns before deleting: it stamps due resources with the time after
+// which they may be deleted and records a PendingDeletion event on them.
+type DeletionNotifier struct {
+	dynClient dynamic.Interface
+	now       func() time.Time
+}
+
+// NewDeletionNotifier creates a new DeletionNotifier.
+func NewDeletionNotifier(dynClient dynamic.Interface) *DeletionNotifier {
+	return &DeletionNotifier{
+		dynClient: dynClient,
+		now:       time.Now,
+	}
+}
+
+// Notice stamps each resource with a delete-after annotation notice from now and records
+// a PendingDeletion event on it, returning the number of resources noticed. Resources
+// that cannot be annotated are retried on the next evaluation.
+func (n *DeletionNotifier) Notice(
+	ctx context.Context,
+	policy *v1alpha1.GarbageCollectionPolicy,
+	eventRecorder *EventRecorder,
+	resources []*unstructured.Unstructured,
+	notice time.Duration,
+) int {
+	if n == nil || n.dynClient == nil || len(resources) == 0 {
+		return 0
+	}
+
+	logger := sdklog.NewLogger("zen-gc")
+	policyKey := fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)
+	gvr, err := parseGVR(policy.Spec.TargetResource.APIVersion, policy.Spec.TargetResource.Kind)
+	if err != nil {
+		return 0
+	}
+
+	deleteAfter := n.now().Add(notice).UTC().Format(time.RFC3339)
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				v1alpha1.DeleteAfterAnnotation:       deleteAfter,
+				v1alpha1.DeleteAfterPolicyAnnotation: noticePolicyKey(policy),
+			},
+		},
+	})
+	if err != nil {
+		return 0
+	}
+
+	noticed := 0
+	for _, resource := range resources {
+		if ctx.Err() != nil {
+			break
+		}
+		_, err := n.dynClient.Resource(gvr).Namespace(resource.GetNamespace()).Patch(ctx, resource.GetName(), types.MergePatchType, patch, metav1.PatchOptions{FieldManager: FieldManager})
+		if err != nil {
+			logger.Debug("Failed to notice resource for deletion", sdklog.Operation("deletion_notice"), sdklog.String("policy", policyKey), sdklog.String("resource", fmt.Sprintf("%s/%s", resource.GetNamespace(), resource.GetName())), sdklog.Error(err))
+			continue
+		}
+		if eventRecorder != nil {
+			eventRecorder.RecordPendingDeletion(policy, resource, deleteAfter)
+		}
+		noticed++
+	}
+	return noticed
+}
+
+// applyDeletionNoticeShared holds due resources until their deletion notice has been
+// given. Resources without a current delete-after annotation from the policy are noticed
+// and held, so they are deleted by a later evaluation once the window has passed;
+// resources whose window has elapsed are allowed. Without deletionNoticeSeconds, or in dry-run where nothing is
+// written, all resources are allowed.
+func applyDeletionNoticeShared(
+	ctx context.Context,
+	notifier *DeletionNotifier,
+	eventRecorder *EventRecorder,
+	policy *v1alpha1.GarbageCollectionPolicy,
+	resources []*unstructured.Unstructured,
+	decisions *decisionLog,
+) (allowed []*unstructured.Unstructured, held int64) {
+	notice := deletionNoticeShared(policy)
+	if notice == 0 || dryRunShared(policy) {
+		return resources, 0
+	}
+
+	now := time.Now()
+	allowed = make([]*unstructured.Unstructured, 0, len(resources))
+	var unnoticed []*unstructured.Unstructured
+	for _, resource := range resources {
+		deleteAfter, ok := deleteAfterShared(resource, policy, now)
+		switch {
+		case ok && !now.Before(deleteAfter):
+			allowed = append(allowed, resource)
+			continue
+		case !ok:
+			unnoticed = append(unnoticed, resource)
+		}
+		decisions.record(resource, ReasonDeletionNoticePending)
+	}
+	if len(unnoticed) > 0 {
+		notifier.Notice(ctx, policy, eventRecorder, unnoticed, notice)
+	}
+	return allowed, int64(len(resources) - len(allowed))
+}
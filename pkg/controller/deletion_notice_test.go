@@ -0,0 +1,227 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+// noticeAnnotations returns the annotations of the policy's deletion notice.
+func noticeAnnotations(policy *v1alpha1.GarbageCollectionPolicy, deleteAfter string) map[string]string {
+	return map[string]string{
+		v1alpha1.DeleteAfterAnnotation:       deleteAfter,
+		v1alpha1.DeleteAfterPolicyAnnotation: noticePolicyKey(policy),
+	}
+}
+
+func TestDeletionNoticePendingShared(t *testing.T) {
+	now := time.Now()
+	future := now.Add(time.Hour).UTC().Format(time.RFC3339)
+	past := now.Add(-time.Hour).UTC().Format(time.RFC3339)
+
+	tests := []struct {
+		name        string
+		deleteAfter string
+		notice      *int64
+		want        bool
+	}{
+		{name: "window not elapsed", deleteAfter: future, notice: int64Ptr(3600), want: true},
+		{name: "window elapsed", deleteAfter: past, notice: int64Ptr(3600), want: false},
+		{name: "not noticed", notice: int64Ptr(3600), want: false},
+		{name: "unparseable annotation", deleteAfter: "tomorrow", notice: int64Ptr(3600), want: false},
+		{name: "policy without notice", deleteAfter: future, notice: nil, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := newTestConfigMap("default", "cm", now.Add(-2*time.Hour))
+			policy := newTestPolicy("notice-policy")
+			policy.Spec.Behavior.DeletionNoticeSeconds = tt.notice
+			if tt.deleteAfter != "" {
+				resource.SetAnnotations(noticeAnnotations(policy, tt.deleteAfter))
+			}
+			if got := deletionNoticePendingShared(resource, policy, now); got != tt.want {
+				t.Errorf("deletionNoticePendingShared() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGCPolicyReconciler_shouldDelete_DeletionNotice(t *testing.T) {
+	reconciler := &GCPolicyReconciler{
+		logger: sdklog.NewLogger("zen-gc"),
+	}
+	policy := newTestPolicy("notice-policy")
+	policy.Spec.Behavior.DeletionNoticeSeconds = int64Ptr(3600)
+	resource := newTestConfigMap("default", "cm", time.Now().Add(-2*time.Hour))
+
+	resource.SetAnnotations(noticeAnnotations(policy, time.Now().Add(time.Hour).UTC().Format(time.RFC3339)))
+	if shouldDelete, reason := reconciler.shouldDelete(resource, policy); shouldDelete || reason != ReasonDeletionNoticePending {
+		t.Errorf("shouldDelete() = %v, %q, want false, %q", shouldDelete, reason, ReasonDeletionNoticePending)
+	}
+	resource.SetAnnotations(noticeAnnotations(policy, time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)))
+	if shouldDelete, reason := reconciler.shouldDelete(resource, policy); !shouldDelete || reason != ReasonTTLExpired {
+		t.Errorf("shouldDelete() after the notice window = %v, %q, want true, %q", shouldDelete, reason, ReasonTTLExpired)
+	}
+	// Not yet noticed: due, and left to the notice gate
+	resource.SetAnnotations(nil)
+	if shouldDelete, _ := reconciler.shouldDelete(resource, policy); !shouldDelete {
+		t.Error("shouldDelete() for a resource not yet noticed = false, want true")
+	}
+}
+
+func TestApplyDeletionNoticeShared(t *testing.T) {
+	policy := newTestPolicy("notice-policy")
+	policy.Spec.Behavior.DeletionNoticeSeconds = int64Ptr(600)
+	fresh := newTestConfigMap("default", "fresh", time.Now().Add(-2*time.Hour))
+	elapsed := newTestConfigMap("default", "elapsed", time.Now().Add(-2*time.Hour))
+	elapsed.SetAnnotations(noticeAnnotations(policy, time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)))
+	waiting := newTestConfigMap("default", "waiting", time.Now().Add(-2*time.Hour))
+	waiting.SetAnnotations(noticeAnnotations(policy, time.Now().Add(time.Hour).UTC().Format(time.RFC3339)))
+	dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme(), fresh, elapsed, waiting)
+	notifier := NewDeletionNotifier(dynamicClient)
+	decisions := &decisionLog{}
+
+	allowed, held := applyDeletionNoticeShared(context.Background(), notifier, nil, policy, []*unstructured.Unstructured{fresh, elapsed, waiting}, decisions)
+	if len(allowed) != 1 || allowed[0] != elapsed {
+		t.Errorf("allowed = %v, want only the resource whose notice elapsed", allowed)
+	}
+	if held != 2 {
+		t.Errorf("held = %d, want 2", held)
+	}
+	for _, reason := range decisions.reasons {
+		if reason != ReasonDeletionNoticePending {
+			t.Errorf("decision reason = %q, want %q", reason, ReasonDeletionNoticePending)
+		}
+	}
+
+	// Only the resource without a notice is stamped
+	if patches := countActions(dynamicClient, "patch", ""); patches != 1 {
+		t.Errorf("patches = %d, want 1", patches)
+	}
+	got, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").Get(context.Background(), "fresh", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get configmap: %v", err)
+	}
+	deleteAfter, ok := deleteAfterShared(got, policy, time.Now())
+	if !ok {
+		t.Fatalf("fresh configmap has no parseable %s annotation", v1alpha1.DeleteAfterAnnotation)
+	}
+	if window := time.Until(deleteAfter); window < 9*time.Minute || window > 10*time.Minute {
+		t.Errorf("delete-after is %v away, want about the 10m notice", window)
+	}
+}
+
+func TestApplyDeletionNoticeShared_StaleOrForeignNotice(t *testing.T) {
+	policy := newTestPolicy("notice-policy")
+	policy.Spec.Behavior.DeletionNoticeSeconds = int64Ptr(600)
+	stale := newTestConfigMap("default", "stale", time.Now().Add(-48*time.Hour))
+	stale.SetAnnotations(noticeAnnotations(policy, time.Now().Add(-24*time.Hour).UTC().Format(time.RFC3339)))
+	foreign := newTestConfigMap("default", "foreign", time.Now().Add(-2*time.Hour))
+	foreign.SetAnnotations(noticeAnnotations(newTestPolicy("other-policy"), time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)))
+	unscoped := newTestConfigMap("default", "unscoped", time.Now().Add(-2*time.Hour))
+	unscoped.SetAnnotations(map[string]string{v1alpha1.DeleteAfterAnnotation: time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)})
+	dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme(), stale, foreign, unscoped)
+	notifier := NewDeletionNotifier(dynamicClient)
+
+	// None of them was freshly noticed by this policy, so all are noticed again and held
+	allowed, held := applyDeletionNoticeShared(context.Background(), notifier, nil, policy, []*unstructured.Unstructured{stale, foreign, unscoped}, nil)
+	if len(allowed) != 0 || held != 3 {
+		t.Errorf("applyDeletionNoticeShared() = %d allowed, %d held, want 0 and 3", len(allowed), held)
+	}
+	if patches := countActions(dynamicClient, "patch", ""); patches != 3 {
+		t.Errorf("patches = %d, want 3", patches)
+	}
+	for _, name := range []string{"stale", "foreign", "unscoped"} {
+		got, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").Get(context.Background(), name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("Failed to get configmap: %v", err)
+		}
+		if !deletionNoticePendingShared(got, policy, time.Now()) {
+			t.Errorf("configmap %s has no pending notice from %s", name, noticePolicyKey(policy))
+		}
+	}
+}
+
+func TestApplyDeletionNoticeShared_Disabled(t *testing.T) {
+	fresh := newTestConfigMap("default", "fresh", time.Now().Add(-2*time.Hour))
+	dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme(), fresh)
+	notifier := NewDeletionNotifier(dynamicClient)
+	resources := []*unstructured.Unstructured{fresh}
+
+	dryRun := newTestPolicy("notice-policy")
+	dryRun.Spec.Behavior.DeletionNoticeSeconds = int64Ptr(600)
+	dryRun.Spec.Behavior.DryRun = true
+	for name, policy := range map[string]*v1alpha1.GarbageCollectionPolicy{
+		"no notice": newTestPolicy("notice-policy"),
+		"dry run":   dryRun,
+	} {
+		t.Run(name, func(t *testing.T) {
+			allowed, held := applyDeletionNoticeShared(context.Background(), notifier, nil, policy, resources, nil)
+			if len(allowed) != 1 || held != 0 {
+				t.Errorf("applyDeletionNoticeShared() = %d allowed, %d held, want 1 and 0", len(allowed), held)
+			}
+		})
+	}
+	if patches := countActions(dynamicClient, "patch", ""); patches != 0 {
+		t.Errorf("patches = %d, want none", patches)
+	}
+}
+
+func TestEvaluatePolicy_DeletionNotice(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reconciler, dynamicClient, policy := setupObservePausedTest(t, false)
+	defer reconciler.cleanupResourceInformer(policy.UID)
+
+	// Both seeded configmaps are expired, but must be noticed before they are deleted
+	policy.Spec.Paused = false
+	policy.Spec.Behavior.DeletionNoticeSeconds = int64Ptr(3600)
+
+	if err := reconciler.evaluatePolicy(ctx, policy); err != nil {
+		t.Fatalf("evaluatePolicy() returned error: %v", err)
+	}
+	if deleted, _ := policyStatusCount(t, dynamicClient, "resourcesDeleted"); deleted != 0 {
+		t.Errorf("resourcesDeleted = %d, want 0 during the notice window", deleted)
+	}
+	if pending, _ := policyStatusCount(t, dynamicClient, "resourcesPending"); pending != 2 {
+		t.Errorf("resourcesPending = %d, want 2 noticed resources", pending)
+	}
+
+	list, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("Failed to list configmaps: %v", err)
+	}
+	if len(list.Items) != 2 {
+		t.Fatalf("%d configmaps remain, want 2", len(list.Items))
+	}
+	for i := range list.Items {
+		if _, ok := deleteAfterShared(&list.Items[i], policy, time.Now()); !ok {
+			t.Errorf("configmap %s was not stamped with %s", list.Items[i].GetName(), v1alpha1.DeleteAfterAnnotation)
+		}
+	}
+}
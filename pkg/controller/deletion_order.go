@@ -0,0 +1,148 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+// Deletion orders.
+const (
+	// DeletionOrderOldestFirst deletes the oldest resources first (the default).
+	DeletionOrderOldestFirst = "OldestFirst"
+
+	// DeletionOrderNewestFirst deletes the newest resources first.
+	DeletionOrderNewestFirst = "NewestFirst"
+
+	// DeletionOrderReverseDependency deletes resources before the owners they reference,
+	// otherwise oldest first.
+	DeletionOrderReverseDependency = "ReverseDependency"
+)
+
+// maxOwnerGraphSize bounds the ownerReference graph built per run. Larger deletion lists
+// are deleted oldest first.
+const maxOwnerGraphSize = 10000
+
+// orderDeletionsShared orders a policy's deletions according to Behavior.DeletionOrder.
+// Ordering happens before batching, so a run capped by maxDeletionsPerRun or rate limits
+// defers the resources at the end of the order to the next run.
+func orderDeletionsShared(policy *v1alpha1.GarbageCollectionPolicy, resourcesToDelete []*unstructured.Unstructured) []*unstructured.Unstructured {
+	if len(resourcesToDelete) < 2 {
+		return resourcesToDelete
+	}
+	order := policy.Spec.Behavior.DeletionOrder
+	ordered := creationTimestampOrder(resourcesToDelete, order == DeletionOrderNewestFirst)
+	if order != DeletionOrderReverseDependency {
+		return ordered
+	}
+	if len(ordered) > maxOwnerGraphSize {
+		logger := sdklog.NewLogger("zen-gc")
+		logger.Debug("Deletion list exceeds owner graph bound, deleting oldest first", sdklog.Operation("order_deletions"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Int("resources", len(ordered)))
+		return ordered
+	}
+	return reverseDependencyOrder(ordered)
+}
+
+// creationTimestampOrder returns resources sorted by creation timestamp, oldest first unless
+// newestFirst. The sort is stable: resources created in the same second keep their order.
+func creationTimestampOrder(resources []*unstructured.Unstructured, newestFirst bool) []*unstructured.Unstructured {
+	type entry struct {
+		resource *unstructured.Unstructured
+		created  time.Time
+	}
+	entries := make([]entry, len(resources))
+	for i, resource := range resources {
+		entries[i] = entry{resource: resource, created: resource.GetCreationTimestamp().Time}
+	}
+	slices.SortStableFunc(entries, func(a, b entry) int {
+		if newestFirst {
+			return b.created.Compare(a.created)
+		}
+		return a.created.Compare(b.created)
+	})
+
+	ordered := make([]*unstructured.Unstructured, len(entries))
+	for i := range entries {
+		ordered[i] = entries[i].resource
+	}
+	return ordered
+}
+
+// reverseDependencyOrder returns resources ordered so that each resource comes before every
+// owner it references through ownerReferences, leaves first. Only owners within resources
+// are considered. Order is otherwise preserved, and resources in an ownerReference cycle
+// are appended in their original order.
+func reverseDependencyOrder(resources []*unstructured.Unstructured) []*unstructured.Unstructured {
+	index := make(map[types.UID]int, len(resources))
+	for i, resource := range resources {
+		if uid := resource.GetUID(); uid != "" {
+			index[uid] = i
+		}
+	}
+
+	// owners[i] lists the in-set owners of resources[i]; children[j] counts its in-set dependents
+	owners := make([][]int, len(resources))
+	children := make([]int, len(resources))
+	for i, resource := range resources {
+		seen := make(map[int]bool)
+		for _, ref := range resource.GetOwnerReferences() {
+			owner, ok := index[ref.UID]
+			if !ok || owner == i || seen[owner] {
+				continue
+			}
+			seen[owner] = true
+			owners[i] = append(owners[i], owner)
+			children[owner]++
+		}
+	}
+
+	ordered := make([]*unstructured.Unstructured, 0, len(resources))
+	queue := make([]int, 0, len(resources))
+	for i := range resources {
+		if children[i] == 0 {
+			queue = append(queue, i)
+		}
+	}
+	placed := make([]bool, len(resources))
+	for len(queue) > 0 {
+		i := queue[0]
+		queue = queue[1:]
+		placed[i] = true
+		ordered = append(ordered, resources[i])
+		for _, owner := range owners[i] {
+			children[owner]--
+			if children[owner] == 0 {
+				queue = append(queue, owner)
+			}
+		}
+	}
+
+	for i, resource := range resources {
+		if !placed[i] {
+			ordered = append(ordered, resource)
+		}
+	}
+	return ordered
+}
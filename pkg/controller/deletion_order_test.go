@@ -0,0 +1,241 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// newOwnerGraph returns expired configmaps forming a root owning two children, one of which
+// owns a leaf, plus an unrelated resource, in an order that puts owners first.
+func newOwnerGraph() []*unstructured.Unstructured {
+	byName := make(map[string]*unstructured.Unstructured, 5)
+	resources := make([]*unstructured.Unstructured, 0, 5)
+	for _, cm := range []struct{ name, owner string }{
+		{"root", ""},
+		{"child-a", "root"},
+		{"leaf", "child-a"},
+		{"unrelated", ""},
+		{"child-b", "root"},
+	} {
+		resource := newTestConfigMap("default", cm.name, time.Now().Add(-2*time.Hour))
+		if owner := byName[cm.owner]; owner != nil {
+			resource.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "v1", Kind: "ConfigMap", Name: owner.GetName(), UID: owner.GetUID()}})
+		}
+		byName[cm.name] = resource
+		resources = append(resources, resource)
+	}
+	return resources
+}
+
+// assertLeavesFirst fails if any resource in names is preceded by one of its owners.
+func assertLeavesFirst(t *testing.T, resources []*unstructured.Unstructured, names []string) {
+	t.Helper()
+	position := make(map[string]int, len(names))
+	for i, name := range names {
+		position[name] = i
+	}
+	for _, resource := range resources {
+		for _, ref := range resource.GetOwnerReferences() {
+			if position[resource.GetName()] > position[ref.Name] {
+				t.Errorf("%s deleted after its owner %s (order %v)", resource.GetName(), ref.Name, names)
+			}
+		}
+	}
+}
+
+func TestReverseDependencyOrder(t *testing.T) {
+	resources := newOwnerGraph()
+	ordered := reverseDependencyOrder(resources)
+
+	names := make([]string, 0, len(ordered))
+	for _, resource := range ordered {
+		names = append(names, resource.GetName())
+	}
+	want := []string{"leaf", "unrelated", "child-b", "child-a", "root"}
+	if len(names) != len(want) {
+		t.Fatalf("order = %v, want %v", names, want)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Fatalf("order = %v, want %v", names, want)
+		}
+	}
+	assertLeavesFirst(t, resources, names)
+}
+
+func TestReverseDependencyOrder_CycleKeepsEveryResource(t *testing.T) {
+	a := newTestConfigMap("default", "a", time.Now().Add(-2*time.Hour))
+	b := newTestConfigMap("default", "b", time.Now().Add(-2*time.Hour))
+	a.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "v1", Kind: "ConfigMap", Name: "b", UID: b.GetUID()}})
+	b.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "v1", Kind: "ConfigMap", Name: "a", UID: a.GetUID()}})
+	free := newTestConfigMap("default", "free", time.Now().Add(-2*time.Hour))
+
+	ordered := reverseDependencyOrder([]*unstructured.Unstructured{a, b, free})
+	if len(ordered) != 3 || ordered[0] != free || ordered[1] != a || ordered[2] != b {
+		t.Errorf("cycle should be appended in original order after free resources, got %d resources", len(ordered))
+	}
+}
+
+// newAgedConfigMaps returns expired configmaps created the given number of minutes ago.
+func newAgedConfigMaps(ages map[string]int) []*unstructured.Unstructured {
+	now := time.Now().Truncate(time.Second)
+	resources := make([]*unstructured.Unstructured, 0, len(ages))
+	for _, name := range slices.Sorted(maps.Keys(ages)) {
+		resources = append(resources, newTestConfigMap("default", name, now.Add(-time.Duration(ages[name])*time.Minute)))
+	}
+	return resources
+}
+
+func orderedNames(resources []*unstructured.Unstructured) []string {
+	names := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		names = append(names, resource.GetName())
+	}
+	return names
+}
+
+func TestOrderDeletionsShared_CreationTimestamp(t *testing.T) {
+	// Listed by name: a (30m), b (90m), c (60m), d (90m), e (10m)
+	resources := newAgedConfigMaps(map[string]int{"a": 30, "b": 90, "c": 60, "d": 90, "e": 10})
+
+	tests := []struct {
+		order string
+		want  []string
+	}{
+		{order: "", want: []string{"b", "d", "c", "a", "e"}},
+		{order: DeletionOrderOldestFirst, want: []string{"b", "d", "c", "a", "e"}},
+		{order: DeletionOrderNewestFirst, want: []string{"e", "a", "c", "b", "d"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.order, func(t *testing.T) {
+			policy := &v1alpha1.GarbageCollectionPolicy{}
+			policy.Spec.Behavior.DeletionOrder = tt.order
+			if got := orderedNames(orderDeletionsShared(policy, resources)); !slices.Equal(got, tt.want) {
+				t.Errorf("order = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if got := orderedNames(resources); !slices.Equal(got, []string{"a", "b", "c", "d", "e"}) {
+		t.Errorf("input reordered to %v, want it left as is", got)
+	}
+}
+
+func TestOrderDeletionsShared_StableForEqualTimestamps(t *testing.T) {
+	resources := newOwnerGraph()
+	created := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	for _, resource := range resources {
+		resource.SetCreationTimestamp(created)
+	}
+	for _, order := range []string{DeletionOrderOldestFirst, DeletionOrderNewestFirst} {
+		policy := &v1alpha1.GarbageCollectionPolicy{}
+		policy.Spec.Behavior.DeletionOrder = order
+		ordered := orderDeletionsShared(policy, resources)
+		for i := range resources {
+			if ordered[i] != resources[i] {
+				t.Fatalf("%s reordered resources created at the same time: %v", order, orderedNames(ordered))
+			}
+		}
+	}
+}
+
+func TestEvaluatePolicy_MaxDeletionsPerRunFollowsDeletionOrder(t *testing.T) {
+	tests := []struct {
+		order     string
+		remaining []string
+	}{
+		{order: "", remaining: []string{"cm-1", "cm-2"}},
+		{order: DeletionOrderNewestFirst, remaining: []string{"older", "oldest"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.order, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			reconciler, dynamicClient, policy := setupObservePausedTest(t, false)
+			defer reconciler.cleanupResourceInformer(policy.UID)
+
+			// cm-1 and cm-2 from the test setup were created 2h ago
+			for _, resource := range newAgedConfigMaps(map[string]int{"old": 180, "older": 240, "oldest": 300}) {
+				if _, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").Create(ctx, resource, metav1.CreateOptions{}); err != nil {
+					t.Fatalf("Failed to create %s: %v", resource.GetName(), err)
+				}
+			}
+			policy.Spec.Paused = false
+			policy.Spec.Behavior.MaxDeletionsPerRun = 3
+			policy.Spec.Behavior.DeletionOrder = tt.order
+
+			if err := reconciler.evaluatePolicy(ctx, policy); err != nil {
+				t.Fatalf("evaluatePolicy() returned error: %v", err)
+			}
+
+			list, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").List(ctx, metav1.ListOptions{})
+			if err != nil {
+				t.Fatalf("Failed to list configmaps: %v", err)
+			}
+			remaining := make([]string, 0, len(list.Items))
+			for i := range list.Items {
+				remaining = append(remaining, list.Items[i].GetName())
+			}
+			slices.Sort(remaining)
+			if !slices.Equal(remaining, tt.remaining) {
+				t.Errorf("remaining after a capped run = %v, want %v", remaining, tt.remaining)
+			}
+		})
+	}
+}
+
+func TestEvaluatePolicy_ReverseDependencyDeletesLeavesFirst(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reconciler, dynamicClient, policy := setupObservePausedTest(t, false)
+	defer reconciler.cleanupResourceInformer(policy.UID)
+
+	graph := newOwnerGraph()
+	for _, resource := range graph {
+		if _, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").Create(ctx, resource, metav1.CreateOptions{}); err != nil {
+			t.Fatalf("Failed to create %s: %v", resource.GetName(), err)
+		}
+	}
+	policy.Spec.Paused = false
+	policy.Spec.Behavior.DeletionOrder = DeletionOrderReverseDependency
+
+	if err := reconciler.evaluatePolicy(ctx, policy); err != nil {
+		t.Fatalf("evaluatePolicy() returned error: %v", err)
+	}
+
+	var deleted []string
+	for _, action := range dynamicClient.Actions() {
+		if del, ok := action.(k8stesting.DeleteAction); ok && del.GetResource() == observeTestConfigMapGVR {
+			deleted = append(deleted, del.GetName())
+		}
+	}
+	// cm-1 and cm-2 from the test setup are deleted too
+	if len(deleted) != len(graph)+2 {
+		t.Fatalf("deleted %v, want all %d expired configmaps", deleted, len(graph)+2)
+	}
+	assertLeavesFirst(t, graph, deleted)
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kube-zen/zen-gc/pkg/config"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+// SentinelEnabledKey is the sentinel ConfigMap data key that must be "true" for deletions
+// to proceed.
+const SentinelEnabledKey = "enabled"
+
+// sentinelMaxBodyBytes bounds the sentinel URL response body that is read.
+const sentinelMaxBodyBytes = 1024
+
+var (
+	// ErrSentinelDisabled indicates the sentinel was reached but is not marked enabled.
+	ErrSentinelDisabled = errors.New("deletion sentinel is not enabled")
+
+	// ErrSentinelUnexpectedStatus indicates the sentinel URL returned a non-2xx HTTP status.
+	ErrSentinelUnexpectedStatus = errors.New("unexpected deletion sentinel response status")
+)
+
+var configMapGVR = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+// DeletionSentinel is a dead man's switch for deletions: it periodically checks a sentinel
+// that must be reachable and marked enabled, and suspends deletions across all policies
+// while it is not, so a controller cut off from the control plane or disabled by an
+// operator during an incident stops deleting.
+//
+// The sentinel is a ConfigMap whose data key SentinelEnabledKey is "true", a URL answering
+// 2xx with a body of "true" or "enabled", or both, in which case both must pass. Deletions
+// are suspended from startup until the first check passes.
+// A nil *DeletionSentinel never suspends deletions.
+type DeletionSentinel struct {
+	checks   []func(ctx context.Context) error
+	interval time.Duration
+	enabled  bool
+	checked  bool
+	mu       sync.Mutex
+}
+
+// NewDeletionSentinel creates a DeletionSentinel for the sentinel ConfigMap and URL in cfg,
+// or returns nil if neither is configured.
+func NewDeletionSentinel(cfg *config.ControllerConfig, dynamicClient dynamic.Interface, httpClient *http.Client) *DeletionSentinel {
+	if cfg == nil || (cfg.SentinelConfigMap == "" && cfg.SentinelURL == "") {
+		return nil
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: config.DefaultSentinelTimeout}
+	}
+	sentinel := &DeletionSentinel{interval: cfg.SentinelInterval}
+	if sentinel.interval <= 0 {
+		sentinel.interval = config.DefaultSentinelInterval
+	}
+	if cfg.SentinelConfigMap != "" {
+		namespace, name, _ := config.ParseObjectRef(cfg.SentinelConfigMap)
+		sentinel.checks = append(sentinel.checks, func(ctx context.Context) error {
+			return checkSentinelConfigMap(ctx, dynamicClient, namespace, name)
+		})
+	}
+	if cfg.SentinelURL != "" {
+		url := cfg.SentinelURL
+		sentinel.checks = append(sentinel.checks, func(ctx context.Context) error {
+			return checkSentinelURL(ctx, httpClient, url)
+		})
+	}
+	return sentinel
+}
+
+// Allow reports whether deletions may proceed.
+func (s *DeletionSentinel) Allow() bool {
+	if s == nil {
+		return true
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.enabled
+}
+
+// Start checks the sentinel every interval until ctx is canceled. It implements
+// manager.Runnable.
+func (s *DeletionSentinel) Start(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		s.Check(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection reports false so followers have a current sentinel state by the time
+// they take over.
+func (s *DeletionSentinel) NeedLeaderElection() bool {
+	return false
+}
+
+// Check checks the sentinel once and suspends or resumes deletions accordingly.
+func (s *DeletionSentinel) Check(ctx context.Context) {
+	checkCtx, cancel := context.WithTimeout(ctx, config.DefaultSentinelTimeout)
+	defer cancel()
+	var err error
+	for _, check := range s.checks {
+		if err = check(checkCtx); err != nil {
+			break
+		}
+	}
+	if ctx.Err() != nil {
+		// Shutting down; not a verdict on the sentinel
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.setEnabledLocked(err == nil, err)
+}
+
+// setEnabledLocked suspends or resumes deletions, logging and recording transitions.
+func (s *DeletionSentinel) setEnabledLocked(enabled bool, err error) {
+	if s.checked && s.enabled == enabled {
+		return
+	}
+	s.checked = true
+	s.enabled = enabled
+	recordDeletionsSuspended(!enabled)
+	logger := sdklog.NewLogger("zen-gc")
+	if enabled {
+		logger.Info("Deletion sentinel enabled, deletions allowed", sdklog.Operation("deletion_sentinel"))
+	} else {
+		logger.Warn("Deletion sentinel unreachable or disabled, suspending all deletions", sdklog.Operation("deletion_sentinel"), sdklog.Error(err))
+	}
+}
+
+// checkSentinelConfigMap returns nil if the sentinel ConfigMap exists and is marked enabled.
+func checkSentinelConfigMap(ctx context.Context, dynamicClient dynamic.Interface, namespace, name string) error {
+	configMap, err := dynamicClient.Resource(configMapGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get sentinel ConfigMap %s/%s: %w", namespace, name, err)
+	}
+	value, _, _ := unstructured.NestedString(configMap.Object, "data", SentinelEnabledKey)
+	if enabled, err := strconv.ParseBool(strings.TrimSpace(value)); err != nil || !enabled {
+		return fmt.Errorf("%w: ConfigMap %s/%s has %s=%q", ErrSentinelDisabled, namespace, name, SentinelEnabledKey, value)
+	}
+	return nil
+}
+
+// checkSentinelURL returns nil if the sentinel URL answers 2xx with a body of "true" or
+// "enabled".
+func checkSentinelURL(ctx context.Context, httpClient *http.Client, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("failed to create sentinel request: %w", err)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach sentinel URL: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%w: %d", ErrSentinelUnexpectedStatus, resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, sentinelMaxBodyBytes))
+	if err != nil {
+		return fmt.Errorf("failed to read sentinel response: %w", err)
+	}
+	switch strings.ToLower(strings.TrimSpace(string(body))) {
+	case "true", "enabled":
+		return nil
+	default:
+		return fmt.Errorf("%w: URL answered %q", ErrSentinelDisabled, strings.TrimSpace(string(body)))
+	}
+}
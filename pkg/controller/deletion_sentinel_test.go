@@ -0,0 +1,253 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/kube-zen/zen-gc/pkg/config"
+	"github.com/kube-zen/zen-sdk/pkg/gc/ratelimiter"
+)
+
+func TestNewDeletionSentinel_DisabledWithoutSentinel(t *testing.T) {
+	if sentinel := NewDeletionSentinel(config.NewControllerConfig(), nil, nil); sentinel != nil {
+		t.Fatal("expected no sentinel when none is configured")
+	}
+	var sentinel *DeletionSentinel
+	if !sentinel.Allow() {
+		t.Error("a nil sentinel should never suspend deletions")
+	}
+}
+
+func TestDeletionSentinel_ConfigMap(t *testing.T) {
+	ctx := context.Background()
+	sentinelConfigMap := newTestConfigMap("gc-system", "gc-sentinel", time.Time{})
+	_ = unstructured.SetNestedField(sentinelConfigMap.Object, "true", "data", SentinelEnabledKey)
+	dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme(), sentinelConfigMap)
+	configMaps := dynamicClient.Resource(configMapGVR).Namespace("gc-system")
+	sentinel := NewDeletionSentinel(config.NewControllerConfig().WithSentinel("gc-system/gc-sentinel", "", 0), dynamicClient, nil)
+
+	if sentinel.Allow() {
+		t.Fatal("deletions should be suspended until the sentinel is first checked")
+	}
+	sentinel.Check(ctx)
+	if !sentinel.Allow() {
+		t.Fatal("deletions should be allowed while the sentinel ConfigMap is enabled")
+	}
+
+	// Disabled by an operator
+	_ = unstructured.SetNestedField(sentinelConfigMap.Object, "false", "data", SentinelEnabledKey)
+	if _, err := configMaps.Update(ctx, sentinelConfigMap, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("Failed to update sentinel ConfigMap: %v", err)
+	}
+	sentinel.Check(ctx)
+	if sentinel.Allow() {
+		t.Error("deletions should be suspended while the sentinel ConfigMap is disabled")
+	}
+
+	// Re-enabled
+	_ = unstructured.SetNestedField(sentinelConfigMap.Object, "true", "data", SentinelEnabledKey)
+	if _, err := configMaps.Update(ctx, sentinelConfigMap, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("Failed to update sentinel ConfigMap: %v", err)
+	}
+	sentinel.Check(ctx)
+	if !sentinel.Allow() {
+		t.Error("deletions should resume once the sentinel ConfigMap is enabled again")
+	}
+
+	// Unreachable
+	if err := configMaps.Delete(ctx, "gc-sentinel", metav1.DeleteOptions{}); err != nil {
+		t.Fatalf("Failed to delete sentinel ConfigMap: %v", err)
+	}
+	sentinel.Check(ctx)
+	if sentinel.Allow() {
+		t.Error("deletions should be suspended while the sentinel ConfigMap cannot be read")
+	}
+}
+
+func TestDeletionSentinel_URL(t *testing.T) {
+	ctx := context.Background()
+	var body atomic.Value
+	body.Store("enabled")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if answer := body.Load().(string); answer != "" {
+			_, _ = w.Write([]byte(answer + "\n"))
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	sentinel := NewDeletionSentinel(config.NewControllerConfig().WithSentinel("", server.URL, 0), nil, server.Client())
+
+	tests := []struct {
+		name    string
+		answer  string
+		allowed bool
+	}{
+		{name: "enabled", answer: "enabled", allowed: true},
+		{name: "disabled", answer: "disabled", allowed: false},
+		{name: "true", answer: "TRUE", allowed: true},
+		{name: "error status", answer: "", allowed: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body.Store(tt.answer)
+			sentinel.Check(ctx)
+			if sentinel.Allow() != tt.allowed {
+				t.Errorf("Allow() = %v, want %v", sentinel.Allow(), tt.allowed)
+			}
+		})
+	}
+
+	// Unreachable
+	body.Store("enabled")
+	sentinel.Check(ctx)
+	server.Close()
+	sentinel.Check(ctx)
+	if sentinel.Allow() {
+		t.Error("deletions should be suspended while the sentinel URL is unreachable")
+	}
+}
+
+func TestDeletionSentinel_BothMustPass(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("enabled"))
+	}))
+	defer server.Close()
+	sentinelConfigMap := newTestConfigMap("gc-system", "gc-sentinel", time.Time{})
+	_ = unstructured.SetNestedField(sentinelConfigMap.Object, "false", "data", SentinelEnabledKey)
+	dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme(), sentinelConfigMap)
+	sentinel := NewDeletionSentinel(config.NewControllerConfig().WithSentinel("gc-system/gc-sentinel", server.URL, 0), dynamicClient, server.Client())
+
+	sentinel.Check(ctx)
+	if sentinel.Allow() {
+		t.Error("deletions should be suspended while either sentinel is disabled")
+	}
+}
+
+func TestDeleteBatchShared_SentinelSuspendsDeletions(t *testing.T) {
+	ctx := context.Background()
+	sentinelConfigMap := newTestConfigMap("gc-system", "gc-sentinel", time.Time{})
+	_ = unstructured.SetNestedField(sentinelConfigMap.Object, "false", "data", SentinelEnabledKey)
+	dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme(), sentinelConfigMap)
+	sentinel := NewDeletionSentinel(config.NewControllerConfig().WithSentinel("gc-system/gc-sentinel", "", 0), dynamicClient, nil)
+	sentinel.Check(ctx)
+	deleter := &fakeBatchDeleter{deletedBy: map[string][]string{}, sentinel: sentinel}
+	limiter := ratelimiter.NewRateLimiter(1000)
+	policy := newTestPolicy("sentinel")
+	batch := []*unstructured.Unstructured{newTestConfigMap("default", "a", time.Now()), newTestConfigMap("default", "b", time.Now())}
+
+	deleted, errs := deleteBatchShared(ctx, batch, policy, limiter, map[string]string{}, deleter)
+	errs, deferred := splitDeferredShared(errs)
+	if deleted != 0 || len(errs) != 0 || deferred != 2 || len(deleter.deletedBy) != 0 {
+		t.Fatalf("deleted=%d errs=%v deferred=%d deletedBy=%v, want the batch deferred", deleted, errs, deferred, deleter.deletedBy)
+	}
+
+	_ = unstructured.SetNestedField(sentinelConfigMap.Object, "true", "data", SentinelEnabledKey)
+	if _, err := dynamicClient.Resource(configMapGVR).Namespace("gc-system").Update(ctx, sentinelConfigMap, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("Failed to update sentinel ConfigMap: %v", err)
+	}
+	sentinel.Check(ctx)
+	deleted, errs = deleteBatchShared(ctx, batch, policy, limiter, map[string]string{}, deleter)
+	if deleted != 2 || len(errs) != 0 {
+		t.Errorf("deleted=%d errs=%v, want both resources deleted once the sentinel is enabled", deleted, errs)
+	}
+}
+
+func TestDeleteResourcesInBatchesShared_SentinelStopsBatches(t *testing.T) {
+	reconciler, dynamicClient, policy := setupObservePausedTest(t, false)
+	defer reconciler.cleanupResourceInformer(policy.UID)
+
+	list, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("Failed to list configmaps: %v", err)
+	}
+	resources := make([]*unstructured.Unstructured, 0, len(list.Items))
+	for i := range list.Items {
+		resources = append(resources, &list.Items[i])
+	}
+
+	sentinelConfigMap := newTestConfigMap("gc-system", "gc-sentinel", time.Time{})
+	_ = unstructured.SetNestedField(sentinelConfigMap.Object, "false", "data", SentinelEnabledKey)
+	if _, err := dynamicClient.Resource(configMapGVR).Namespace("gc-system").Create(context.Background(), sentinelConfigMap, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create sentinel ConfigMap: %v", err)
+	}
+	reconciler.deletionSentinel = NewDeletionSentinel(config.NewControllerConfig().WithSentinel("gc-system/gc-sentinel", "", 0), dynamicClient, nil)
+	reconciler.deletionSentinel.Check(context.Background())
+
+	policy.Spec.Behavior.BatchSize = 1
+	policy.Spec.Behavior.BatchInterval = &metav1.Duration{Duration: time.Hour}
+
+	// Batches that would all be deferred are not paced through the hour-long interval
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	deleted, failed, deferred := deleteResourcesInBatchesShared(ctx, reconciler, policy, resources, map[string]string{})
+	if ctx.Err() != nil {
+		t.Fatal("deleteResourcesInBatchesShared() kept waiting between deferred batches")
+	}
+	if deleted != 0 || failed != 0 || deferred != 2 {
+		t.Errorf("deleted = %d, failed = %d, deferred = %d, want 0, 0 and 2", deleted, failed, deferred)
+	}
+}
+
+func TestPolicyEvaluationService_SentinelStopsBatches(t *testing.T) {
+	reconciler, dynamicClient, policy := setupObservePausedTest(t, false)
+	defer reconciler.cleanupResourceInformer(policy.UID)
+
+	list, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("Failed to list configmaps: %v", err)
+	}
+	resources := make([]*unstructured.Unstructured, 0, len(list.Items))
+	for i := range list.Items {
+		resources = append(resources, &list.Items[i])
+	}
+
+	sentinelConfigMap := newTestConfigMap("gc-system", "gc-sentinel", time.Time{})
+	_ = unstructured.SetNestedField(sentinelConfigMap.Object, "false", "data", SentinelEnabledKey)
+	if _, err := dynamicClient.Resource(configMapGVR).Namespace("gc-system").Create(context.Background(), sentinelConfigMap, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create sentinel ConfigMap: %v", err)
+	}
+	reconciler.deletionSentinel = NewDeletionSentinel(config.NewControllerConfig().WithSentinel("gc-system/gc-sentinel", "", 0), dynamicClient, nil)
+	reconciler.deletionSentinel.Check(context.Background())
+	adapter := NewGCPolicyReconcilerAdapter(reconciler)
+	service := NewPolicyEvaluationService(nil, nil, nil, nil, adapter.GetRateLimiterProvider(), adapter.GetBatchDeleter(), nil, nil, nil)
+
+	policy.Spec.Behavior.BatchSize = 1
+	policy.Spec.Behavior.BatchInterval = &metav1.Duration{Duration: time.Hour}
+
+	// The suspension is not a failed deletion and later batches are not paced through
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	deleted, failed, deferred := service.deleteResourcesInBatches(ctx, policy, resources, map[string]string{})
+	if ctx.Err() != nil {
+		t.Fatal("deleteResourcesInBatches() kept waiting between deferred batches")
+	}
+	if deleted != 0 || failed != 0 || deferred != 2 {
+		t.Errorf("deleted = %d, failed = %d, deferred = %d, want 0, 0 and 2", deleted, failed, deferred)
+	}
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+// resolveDependentSpec returns a copy of a dependent spec with parent placeholders substituted
+// in its namespace and selector values. An empty namespace resolves to the parent's namespace.
+func resolveDependentSpec(dependent *v1alpha1.TargetResourceSpec, parent *unstructured.Unstructured) *v1alpha1.TargetResourceSpec {
+	replacer := strings.NewReplacer(v1alpha1.ParentNamePlaceholder, parent.GetName(), v1alpha1.ParentNamespacePlaceholder, parent.GetNamespace())
+	resolved := dependent.DeepCopy()
+
+	if resolved.Namespace == "" {
+		resolved.Namespace = parent.GetNamespace()
+	}
+	resolved.Namespace = replacer.Replace(resolved.Namespace)
+
+	resolveSelector := func(selector *metav1.LabelSelector) {
+		for key, value := range selector.MatchLabels {
+			selector.MatchLabels[key] = replacer.Replace(value)
+		}
+		for i := range selector.MatchExpressions {
+			for j, value := range selector.MatchExpressions[i].Values {
+				selector.MatchExpressions[i].Values[j] = replacer.Replace(value)
+			}
+		}
+	}
+	if resolved.LabelSelector != nil {
+		resolveSelector(resolved.LabelSelector)
+	}
+	for i := range resolved.LabelSelectors {
+		resolveSelector(&resolved.LabelSelectors[i])
+	}
+	if resolved.FieldSelector != nil {
+		for field, value := range resolved.FieldSelector.MatchFields {
+			resolved.FieldSelector.MatchFields[field] = replacer.Replace(value)
+		}
+	}
+	return resolved
+}
+
+// deleteDependentsOf deletes the policy's alsoDelete dependents of a parent, if it has any.
+// Policies with preconditions skip them: the parent's delete can still be rejected as
+// modified after its dependents are gone.
+func (r *GCPolicyReconciler) deleteDependentsOf(ctx context.Context, parent *unstructured.Unstructured, policy *v1alpha1.GarbageCollectionPolicy) error {
+	if len(policy.Spec.Behavior.AlsoDelete) == 0 || policy.Spec.Behavior.Preconditions {
+		return nil
+	}
+	return r.deleteDependents(ctx, parent, policy)
+}
+
+// deleteDependents deletes the policy's alsoDelete dependents of a parent before the parent
+// itself, so a failure leaves the parent in place to retry. Already deleted dependents are
+// ignored. Dependents go through the same claim, audit, and API call budget as the parent.
+func (r *GCPolicyReconciler) deleteDependents(ctx context.Context, parent *unstructured.Unstructured, policy *v1alpha1.GarbageCollectionPolicy) error {
+	for i := range policy.Spec.Behavior.AlsoDelete {
+		dependentSpec := resolveDependentSpec(&policy.Spec.Behavior.AlsoDelete[i], parent)
+		deleteOptions := buildDeleteOptions(policy, dependentSpec.Kind)
+		dependents, err := r.listDependents(ctx, dependentSpec)
+		if err != nil {
+			return fmt.Errorf("failed to list %s dependents of %s/%s: %w", dependentSpec.Kind, parent.GetNamespace(), parent.GetName(), err)
+		}
+
+		for _, dependent := range dependents {
+			if parent.GetUID() != "" && dependent.GetUID() == parent.GetUID() {
+				continue
+			}
+			if dryRunShared(policy) {
+				r.logger.Info("[DRY RUN] Would delete dependent resource", sdklog.Operation("delete_dependents"), sdklog.String("resource", fmt.Sprintf("%s/%s", dependent.GetNamespace(), dependent.GetName())), sdklog.String("kind", dependentSpec.Kind), sdklog.String("parent", fmt.Sprintf("%s/%s", parent.GetNamespace(), parent.GetName())))
+				continue
+			}
+			if err := r.deleteDependent(ctx, dependent, parent, policy, deleteOptions); err != nil {
+				return fmt.Errorf("failed to delete %s dependent %s/%s: %w", dependentSpec.Kind, dependent.GetNamespace(), dependent.GetName(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// deleteDependent deletes one dependent of a parent. A dependent claimed by another policy
+// is left to it; its deletion is recorded in the audit sink like the parent's.
+func (r *GCPolicyReconciler) deleteDependent(ctx context.Context, dependent, parent *unstructured.Unstructured, policy *v1alpha1.GarbageCollectionPolicy, deleteOptions *metav1.DeleteOptions) error {
+	resourceKey := fmt.Sprintf("%s/%s", dependent.GetNamespace(), dependent.GetName())
+	if ok, owner := r.deletionCoordinator.BeginDelete(dependent.GetUID(), policy); !ok {
+		r.logger.Debug("Dependent resource claimed by another policy, skipping", sdklog.Operation("delete_dependents"), sdklog.String("resource", resourceKey), sdklog.String("claimed_by", owner.String()))
+		return nil
+	}
+
+	// The dynamic client charges the delete to the evaluation's API call budget
+	gvr := r.resolveGVRForDeletion(dependent)
+	err := r.performResourceDeletion(ctx, dependent, gvr, deleteOptions)
+	if k8serrors.IsNotFound(err) {
+		r.deletionCoordinator.FinishDelete(dependent.GetUID(), true)
+		return nil
+	}
+	r.deletionCoordinator.FinishDelete(dependent.GetUID(), err == nil)
+	if err != nil {
+		return err
+	}
+
+	if r.auditSink != nil {
+		r.auditSink.Record(AuditRecord{
+			Time:            time.Now().UTC(),
+			PolicyNamespace: policy.Namespace,
+			PolicyName:      policy.Name,
+			Group:           gvr.Group,
+			Version:         gvr.Version,
+			Resource:        gvr.Resource,
+			Namespace:       dependent.GetNamespace(),
+			Name:            dependent.GetName(),
+			UID:             string(dependent.GetUID()),
+			Reason:          ReasonDependentOfDeleted,
+		})
+	}
+	r.logger.Info("Deleted dependent resource", sdklog.Operation("delete_dependents"), sdklog.String("resource", resourceKey), sdklog.String("kind", dependent.GetKind()), sdklog.String("parent", fmt.Sprintf("%s/%s", parent.GetNamespace(), parent.GetName())), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)))
+	return nil
+}
+
+// listDependents lists resources matching a resolved dependent spec. Like the policy's own
+// targets, resources in the controller's protected namespaces or carrying the protect
+// annotation are never dependents.
+func (r *GCPolicyReconciler) listDependents(ctx context.Context, dependentSpec *v1alpha1.TargetResourceSpec) ([]*unstructured.Unstructured, error) {
+	stub := &unstructured.Unstructured{}
+	stub.SetAPIVersion(dependentSpec.APIVersion)
+	stub.SetKind(dependentSpec.Kind)
+	gvr := r.resolveGVRForDeletion(stub)
+
+	list, err := r.dynamicClient.Resource(gvr).
+		Namespace(normalizeNamespace(dependentSpec.Namespace)).
+		List(ctx, metav1.ListOptions{LabelSelector: listLabelSelector(dependentSpec)})
+	if err != nil {
+		return nil, err
+	}
+
+	dependents := make([]*unstructured.Unstructured, 0, len(list.Items))
+	for i := range list.Items {
+		dependent := &list.Items[i]
+		if dependent.GetAPIVersion() == "" {
+			dependent.SetAPIVersion(dependentSpec.APIVersion)
+			dependent.SetKind(dependentSpec.Kind)
+		}
+		if r.matchesSelectors(dependent, dependentSpec) && !protectedByAnnotationShared(dependent, r.protectAnnotation()) {
+			dependents = append(dependents, dependent)
+		}
+	}
+	return dependents, nil
+}
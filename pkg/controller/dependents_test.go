@@ -0,0 +1,307 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/fake"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/config"
+	"github.com/kube-zen/zen-sdk/pkg/gc/ratelimiter"
+)
+
+var (
+	dependentsTestConfigMapGVR = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	dependentsTestSecretGVR    = schema.GroupVersionResource{Version: "v1", Resource: "secrets"}
+)
+
+func setupDependentsTest(t *testing.T) (*GCPolicyReconciler, *fake.FakeDynamicClient, *v1alpha1.GarbageCollectionPolicy, *unstructured.Unstructured) {
+	t.Helper()
+	policy := newTestPolicy("parents")
+	policy.Namespace = "team-a"
+	policy.Spec.TargetResource.Namespace = "team-a"
+	policy.Spec.Behavior.AlsoDelete = []v1alpha1.TargetResourceSpec{
+		{
+			APIVersion:    "v1",
+			Kind:          "Secret",
+			Namespace:     "*",
+			LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"example.com/parent": v1alpha1.ParentNamePlaceholder}},
+		},
+		{
+			APIVersion:    "v1",
+			Kind:          "ConfigMap",
+			LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"example.com/parent": v1alpha1.ParentNamePlaceholder}},
+		},
+	}
+
+	parent := newTestConfigMap("team-a", "app-1", time.Time{})
+	objects := []runtime.Object{parent}
+	for _, dependent := range []struct{ kind, namespace, name, parent string }{
+		{kind: "ConfigMap", namespace: "team-a", name: "app-1-settings", parent: "app-1"},
+		{kind: "ConfigMap", namespace: "team-b", name: "app-1-remote", parent: "app-1"},
+		{kind: "Secret", namespace: "team-b", name: "app-1-creds", parent: "app-1"},
+		{kind: "Secret", namespace: "team-a", name: "app-2-creds", parent: "app-2"},
+	} {
+		resource := newTestConfigMap(dependent.namespace, dependent.name, time.Time{})
+		resource.SetKind(dependent.kind)
+		resource.SetLabels(map[string]string{"example.com/parent": dependent.parent})
+		objects = append(objects, resource)
+	}
+
+	scheme := runtime.NewScheme()
+	dynamicClient := fake.NewSimpleDynamicClientWithCustomListKinds(
+		scheme,
+		map[schema.GroupVersionResource]string{
+			dependentsTestConfigMapGVR: "ConfigMapList",
+			dependentsTestSecretGVR:    "SecretList",
+		},
+		objects...,
+	)
+	reconciler := NewGCPolicyReconcilerWithRESTMapper(
+		clientfake.NewClientBuilder().WithScheme(scheme).Build(),
+		scheme,
+		dynamicClient,
+		nil,
+		NewStatusUpdater(dynamicClient),
+		NewEventRecorder(nil),
+		config.NewControllerConfig(),
+	)
+	return reconciler, dynamicClient, policy, parent
+}
+
+func remainingDependentsTestNames(t *testing.T, dynamicClient *fake.FakeDynamicClient) []string {
+	t.Helper()
+	names := make([]string, 0)
+	for _, gvr := range []schema.GroupVersionResource{dependentsTestConfigMapGVR, dependentsTestSecretGVR} {
+		list, err := dynamicClient.Resource(gvr).List(context.Background(), metav1.ListOptions{})
+		if err != nil {
+			t.Fatalf("Failed to list %s: %v", gvr.Resource, err)
+		}
+		for _, item := range list.Items {
+			names = append(names, item.GetNamespace()+"/"+item.GetName())
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestResolveDependentSpec(t *testing.T) {
+	parent := newTestConfigMap("team-a", "app-1", time.Time{})
+	dependent := &v1alpha1.TargetResourceSpec{
+		APIVersion: "v1",
+		Kind:       "Secret",
+		LabelSelector: &metav1.LabelSelector{
+			MatchLabels:      map[string]string{"example.com/parent": v1alpha1.ParentNamePlaceholder},
+			MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "example.com/ns", Operator: metav1.LabelSelectorOpIn, Values: []string{v1alpha1.ParentNamespacePlaceholder}}},
+		},
+		FieldSelector: &v1alpha1.FieldSelectorSpec{MatchFields: map[string]string{"metadata.name": v1alpha1.ParentNamePlaceholder + "-creds"}},
+	}
+
+	resolved := resolveDependentSpec(dependent, parent)
+	if resolved.Namespace != "team-a" {
+		t.Errorf("namespace = %q, want parent namespace team-a", resolved.Namespace)
+	}
+	if got := resolved.LabelSelector.MatchLabels["example.com/parent"]; got != "app-1" {
+		t.Errorf("matchLabels value = %q, want app-1", got)
+	}
+	if got := resolved.LabelSelector.MatchExpressions[0].Values[0]; got != "team-a" {
+		t.Errorf("matchExpressions value = %q, want team-a", got)
+	}
+	if got := resolved.FieldSelector.MatchFields["metadata.name"]; got != "app-1-creds" {
+		t.Errorf("matchFields value = %q, want app-1-creds", got)
+	}
+	if dependent.LabelSelector.MatchLabels["example.com/parent"] != v1alpha1.ParentNamePlaceholder {
+		t.Error("resolveDependentSpec() modified the policy spec")
+	}
+}
+
+func TestDeleteResource_AlsoDeletesDependents(t *testing.T) {
+	reconciler, dynamicClient, policy, parent := setupDependentsTest(t)
+
+	if err := reconciler.deleteResource(context.Background(), parent, policy, ratelimiter.NewRateLimiter(100)); err != nil {
+		t.Fatalf("deleteResource() returned error: %v", err)
+	}
+
+	// Secrets are matched in all namespaces, ConfigMaps only in the parent's namespace
+	got := remainingDependentsTestNames(t, dynamicClient)
+	want := []string{"team-a/app-2-creds", "team-b/app-1-remote"}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("remaining resources = %v, want %v", got, want)
+	}
+}
+
+func TestDeleteResource_AlsoDeleteDryRunKeepsDependents(t *testing.T) {
+	reconciler, dynamicClient, policy, parent := setupDependentsTest(t)
+	policy.Spec.Behavior.DryRun = true
+
+	if err := reconciler.deleteResource(context.Background(), parent, policy, ratelimiter.NewRateLimiter(100)); err != nil {
+		t.Fatalf("deleteResource() returned error: %v", err)
+	}
+	if got := remainingDependentsTestNames(t, dynamicClient); len(got) != 5 {
+		t.Errorf("remaining resources = %v, want all 5 in dry run", got)
+	}
+}
+
+// propagationRecorder wraps a dynamic client to record the propagation policy of each delete,
+// which the fake client does not keep.
+type propagationRecorder struct {
+	dynamic.Interface
+	deletes map[string]metav1.DeletionPropagation
+}
+
+func (p *propagationRecorder) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	return &propagationRecorderResource{NamespaceableResourceInterface: p.Interface.Resource(gvr), recorder: p, resource: gvr.Resource}
+}
+
+type propagationRecorderResource struct {
+	dynamic.NamespaceableResourceInterface
+	recorder *propagationRecorder
+	resource string
+}
+
+func (r *propagationRecorderResource) Namespace(namespace string) dynamic.ResourceInterface {
+	return &propagationRecorderNamespacedResource{ResourceInterface: r.NamespaceableResourceInterface.Namespace(namespace), parent: r}
+}
+
+type propagationRecorderNamespacedResource struct {
+	dynamic.ResourceInterface
+	parent *propagationRecorderResource
+}
+
+func (r *propagationRecorderNamespacedResource) Delete(ctx context.Context, name string, opts metav1.DeleteOptions, subresources ...string) error {
+	if opts.PropagationPolicy != nil {
+		r.parent.recorder.deletes[r.parent.resource+"/"+name] = *opts.PropagationPolicy
+	}
+	return r.ResourceInterface.Delete(ctx, name, opts, subresources...)
+}
+
+func TestDeleteResource_PropagationByKind(t *testing.T) {
+	reconciler, dynamicClient, policy, parent := setupDependentsTest(t)
+	recorder := &propagationRecorder{Interface: dynamicClient, deletes: make(map[string]metav1.DeletionPropagation)}
+	reconciler.dynamicClient = recorder
+
+	policy.Spec.Behavior.PropagationPolicy = PropagationPolicyForeground
+	policy.Spec.Behavior.PropagationByKind = map[string]string{"Secret": PropagationPolicyOrphan}
+
+	if err := reconciler.deleteResource(context.Background(), parent, policy, ratelimiter.NewRateLimiter(100)); err != nil {
+		t.Fatalf("deleteResource() returned error: %v", err)
+	}
+
+	want := map[string]metav1.DeletionPropagation{
+		"secrets/app-1-creds":       metav1.DeletePropagationOrphan,
+		"configmaps/app-1-settings": metav1.DeletePropagationForeground,
+		"configmaps/app-1":          metav1.DeletePropagationForeground,
+	}
+	if len(recorder.deletes) != len(want) {
+		t.Fatalf("delete propagation = %v, want %v", recorder.deletes, want)
+	}
+	for key, propagation := range want {
+		if recorder.deletes[key] != propagation {
+			t.Errorf("propagation for %s = %q, want %q", key, recorder.deletes[key], propagation)
+		}
+	}
+}
+
+func TestDeleteResource_AlsoDeleteSkipsProtectedDependents(t *testing.T) {
+	reconciler, dynamicClient, policy, parent := setupDependentsTest(t)
+	reconciler.config.ProtectedNamespaces = []string{"team-b"}
+	settings, err := dynamicClient.Resource(dependentsTestConfigMapGVR).Namespace("team-a").Get(context.Background(), "app-1-settings", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get configmap: %v", err)
+	}
+	settings.SetAnnotations(map[string]string{config.DefaultProtectAnnotation: "true"})
+	if _, err := dynamicClient.Resource(dependentsTestConfigMapGVR).Namespace("team-a").Update(context.Background(), settings, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("Failed to update configmap: %v", err)
+	}
+
+	if err := reconciler.deleteResource(context.Background(), parent, policy, ratelimiter.NewRateLimiter(100)); err != nil {
+		t.Fatalf("deleteResource() returned error: %v", err)
+	}
+
+	// Dependents in protected namespaces or carrying the protect annotation are kept
+	got := remainingDependentsTestNames(t, dynamicClient)
+	want := []string{"team-a/app-1-settings", "team-a/app-2-creds", "team-b/app-1-creds", "team-b/app-1-remote"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("remaining resources = %v, want %v", got, want)
+	}
+}
+
+func TestDeleteResource_AlsoDeleteRecordsAudit(t *testing.T) {
+	reconciler, _, policy, parent := setupDependentsTest(t)
+	audit := &recordingAuditSink{}
+	reconciler.SetAuditSink(audit)
+
+	if err := reconciler.deleteResource(context.Background(), parent, policy, ratelimiter.NewRateLimiter(100)); err != nil {
+		t.Fatalf("deleteResource() returned error: %v", err)
+	}
+
+	names := make([]string, 0, len(audit.records))
+	for _, record := range audit.records {
+		if record.Reason != ReasonDependentOfDeleted || record.PolicyName != policy.Name {
+			t.Errorf("audit record = %+v, want reason %q from policy %s", record, ReasonDependentOfDeleted, policy.Name)
+		}
+		names = append(names, record.Namespace+"/"+record.Name)
+	}
+	sort.Strings(names)
+	if want := []string{"team-a/app-1-settings", "team-b/app-1-creds"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("audited dependents = %v, want %v", names, want)
+	}
+}
+
+func TestDeleteResource_AlsoDeleteWaitsForParent(t *testing.T) {
+	t.Run("finalizer mode awaiting other finalizers", func(t *testing.T) {
+		reconciler, dynamicClient, policy, parent := setupDependentsTest(t)
+		policy.Spec.Behavior.Finalizer = testPolicyFinalizer
+		parent.SetFinalizers([]string{"example.com/other"})
+		if _, err := dynamicClient.Resource(dependentsTestConfigMapGVR).Namespace("team-a").Update(context.Background(), parent, metav1.UpdateOptions{}); err != nil {
+			t.Fatalf("Failed to update parent: %v", err)
+		}
+
+		if err := reconciler.deleteResource(context.Background(), parent, policy, ratelimiter.NewRateLimiter(100)); !errors.Is(err, ErrAwaitingFinalizers) {
+			t.Fatalf("deleteResource() error = %v, want ErrAwaitingFinalizers", err)
+		}
+		if got := remainingDependentsTestNames(t, dynamicClient); len(got) != 5 {
+			t.Errorf("remaining resources = %v, want all 5 while the parent awaits finalizers", got)
+		}
+	})
+
+	t.Run("preconditions", func(t *testing.T) {
+		reconciler, dynamicClient, policy, parent := setupDependentsTest(t)
+		policy.Spec.Behavior.Preconditions = true
+
+		if err := reconciler.deleteResource(context.Background(), parent, policy, ratelimiter.NewRateLimiter(100)); err != nil {
+			t.Fatalf("deleteResource() returned error: %v", err)
+		}
+		want := []string{"team-a/app-1-settings", "team-a/app-2-creds", "team-b/app-1-creds", "team-b/app-1-remote"}
+		if got := remainingDependentsTestNames(t, dynamicClient); !reflect.DeepEqual(got, want) {
+			t.Errorf("remaining resources = %v, want only the parent deleted", got)
+		}
+	})
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// MaxDryRunSampleNames caps the resource names recorded in a dry-run impact.
+const MaxDryRunSampleNames = 10
+
+// dryRunImpactShared summarizes the resources a dry-run policy would delete, or returns
+// nil for armed policies. The hash covers the policy generation and the sorted resource
+// identities, so it changes whenever the spec or the would-be-deleted set changes.
+func dryRunImpactShared(policy *v1alpha1.GarbageCollectionPolicy, resources []*unstructured.Unstructured) *v1alpha1.DryRunImpact {
+	if !policy.Spec.Behavior.DryRun {
+		return nil
+	}
+
+	keys := make([]string, 0, len(resources))
+	names := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		keys = append(keys, fmt.Sprintf("%s/%s/%s", resource.GetNamespace(), resource.GetName(), resource.GetUID()))
+		if resource.GetNamespace() == "" {
+			names = append(names, resource.GetName())
+		} else {
+			names = append(names, resource.GetNamespace()+"/"+resource.GetName())
+		}
+	}
+	sort.Strings(keys)
+	sort.Strings(names)
+	if len(names) > MaxDryRunSampleNames {
+		names = names[:MaxDryRunSampleNames]
+	}
+
+	h := sha256.New()
+	fmt.Fprintf(h, "%d\n", policy.Generation)
+	for _, key := range keys {
+		h.Write([]byte(key))
+		h.Write([]byte{'\n'})
+	}
+
+	now := metav1.Now()
+	return &v1alpha1.DryRunImpact{
+		WouldDelete:        int64(len(resources)),
+		SampleNames:        names,
+		Hash:               "sha256:" + hex.EncodeToString(h.Sum(nil)),
+		ObservedGeneration: policy.Generation,
+		ObservedAt:         &now,
+	}
+}
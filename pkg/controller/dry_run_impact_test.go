@@ -0,0 +1,136 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func TestDryRunImpactShared(t *testing.T) {
+	a := newTestConfigMap("default", "a", time.Time{})
+	b := newTestConfigMap("default", "b", time.Time{})
+	policy := newTestPolicy("dry-run-policy")
+	policy.Generation = 2
+	policy.Spec.Behavior.DryRun = true
+	armed := policy.DeepCopy()
+	armed.Spec.Behavior.DryRun = false
+
+	if impact := dryRunImpactShared(armed, []*unstructured.Unstructured{a}); impact != nil {
+		t.Errorf("dryRunImpactShared() for armed policy = %+v, want nil", impact)
+	}
+
+	impact := dryRunImpactShared(policy, []*unstructured.Unstructured{a, b})
+	if impact.WouldDelete != 2 || impact.ObservedGeneration != 2 || impact.ObservedAt == nil {
+		t.Errorf("dryRunImpactShared() = %+v, want wouldDelete 2 at generation 2", impact)
+	}
+	if reordered := dryRunImpactShared(policy, []*unstructured.Unstructured{b, a}); reordered.Hash != impact.Hash {
+		t.Errorf("hash depends on resource order: %s != %s", reordered.Hash, impact.Hash)
+	}
+	if fewer := dryRunImpactShared(policy, []*unstructured.Unstructured{a}); fewer.Hash == impact.Hash {
+		t.Error("expected hash to change when the would-be-deleted set changes")
+	}
+	policy.Generation = 3
+	if changed := dryRunImpactShared(policy, []*unstructured.Unstructured{a, b}); changed.Hash == impact.Hash {
+		t.Error("expected hash to change with the policy generation")
+	}
+}
+
+func TestStatusUpdater_UpdateStatus_DryRunImpact(t *testing.T) {
+	dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme())
+	updater := NewStatusUpdater(dynamicClient)
+	policy := newTestPolicy("dry-run-policy")
+	policy.Generation = 2
+	policy.Spec.Behavior.DryRun = true
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(policy)
+	if err != nil {
+		t.Fatalf("Failed to convert policy to unstructured: %v", err)
+	}
+	if _, err := dynamicClient.Resource(PolicyGVR).Namespace("default").Create(context.Background(), &unstructured.Unstructured{Object: obj}, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create policy: %v", err)
+	}
+
+	getHash := func() (string, bool) {
+		t.Helper()
+		current, err := dynamicClient.Resource(PolicyGVR).Namespace("default").Get(context.Background(), policy.Name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("Failed to get policy: %v", err)
+		}
+		hash, found, _ := unstructured.NestedString(current.Object, "status", "dryRunImpact", "hash")
+		return hash, found
+	}
+
+	impact := dryRunImpactShared(policy, []*unstructured.Unstructured{newTestConfigMap("default", "a", time.Time{})})
+	if err := updater.UpdateStatus(context.Background(), policy, 1, 0, 0, 0, 0, impact); err != nil {
+		t.Fatalf("UpdateStatus() returned error: %v", err)
+	}
+	if hash, found := getHash(); !found || hash != impact.Hash {
+		t.Errorf("status.dryRunImpact.hash = %q (found %v), want %q", hash, found, impact.Hash)
+	}
+	current, err := dynamicClient.Resource(PolicyGVR).Namespace("default").Get(context.Background(), policy.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get policy: %v", err)
+	}
+	if names, _, _ := unstructured.NestedStringSlice(current.Object, "status", "dryRunImpact", "sampleNames"); !reflect.DeepEqual(names, []string{"default/a"}) {
+		t.Errorf("status.dryRunImpact.sampleNames = %q, want [default/a]", names)
+	}
+
+	// Once armed, the impact is cleared
+	armed := policy.DeepCopy()
+	armed.Spec.Behavior.DryRun = false
+	if err := updater.UpdateStatus(context.Background(), armed, 1, 1, 0, 0, 0, nil); err != nil {
+		t.Fatalf("UpdateStatus() returned error: %v", err)
+	}
+	if hash, found := getHash(); found {
+		t.Errorf("status.dryRunImpact.hash = %q, want it cleared for an armed policy", hash)
+	}
+}
+
+func TestDryRunImpactShared_SampleNames(t *testing.T) {
+	policy := newTestPolicy("dry-run-policy")
+	policy.Generation = 2
+	policy.Spec.Behavior.DryRun = true
+
+	resources := make([]*unstructured.Unstructured, 0, MaxDryRunSampleNames+5)
+	for i := MaxDryRunSampleNames + 4; i >= 0; i-- {
+		resources = append(resources, newTestConfigMap("default", fmt.Sprintf("cm-%02d", i), time.Time{}))
+	}
+	impact := dryRunImpactShared(policy, resources)
+	if impact.WouldDelete != int64(len(resources)) {
+		t.Errorf("WouldDelete = %d, want %d", impact.WouldDelete, len(resources))
+	}
+	if len(impact.SampleNames) != MaxDryRunSampleNames {
+		t.Fatalf("len(SampleNames) = %d, want %d", len(impact.SampleNames), MaxDryRunSampleNames)
+	}
+	if impact.SampleNames[0] != "default/cm-00" || impact.SampleNames[MaxDryRunSampleNames-1] != "default/cm-09" {
+		t.Errorf("SampleNames = %q, want the first %d names in sorted order", impact.SampleNames, MaxDryRunSampleNames)
+	}
+
+	clusterScoped := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	clusterScoped.SetName("ns-a")
+	if impact := dryRunImpactShared(policy, []*unstructured.Unstructured{clusterScoped}); !reflect.DeepEqual(impact.SampleNames, []string{"ns-a"}) {
+		t.Errorf("SampleNames = %q, want [ns-a] for a cluster-scoped resource", impact.SampleNames)
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	gcerrors "github.com/kube-zen/zen-gc/pkg/errors"
+	"github.com/kube-zen/zen-sdk/pkg/gc/ratelimiter"
+)
+
+var errListFailed = errors.New("list failed")
+
+// errorCodeTestLister fails every list with errListFailed.
+type errorCodeTestLister struct{}
+
+func (errorCodeTestLister) ListResources(context.Context, schema.GroupVersionResource, string) ([]*unstructured.Unstructured, error) {
+	return nil, errListFailed
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	if err == nil {
+		t.Fatalf("expected an error with code %q, got nil", want)
+	}
+	if got := gcerrors.Code(err); got != want {
+		t.Errorf("error code = %q, want %q (error: %v)", got, want, err)
+	}
+}
+
+func TestErrorCodes_EvaluationService(t *testing.T) {
+	newPolicy := func(apiVersion string) *v1alpha1.GarbageCollectionPolicy {
+		return &v1alpha1.GarbageCollectionPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "codes", Namespace: "default"},
+			Spec: v1alpha1.GarbageCollectionPolicySpec{
+				TargetResource: v1alpha1.TargetResourceSpec{APIVersion: apiVersion, Kind: "ConfigMap"},
+				TTL:            v1alpha1.TTLSpec{SecondsAfterCreation: int64Ptr(3600)},
+			},
+		}
+	}
+
+	t.Run("invalid GVR", func(t *testing.T) {
+		service := NewPolicyEvaluationService(errorCodeTestLister{}, NewDefaultSelectorMatcher(), NewDefaultConditionMatcher(), nil, nil, nil, nil, nil, nil)
+		assertErrorCode(t, service.EvaluatePolicy(context.Background(), newPolicy("a/b/c")), gcerrors.TypeInvalidGVR)
+	})
+
+	t.Run("list failure", func(t *testing.T) {
+		service := NewPolicyEvaluationService(errorCodeTestLister{}, NewDefaultSelectorMatcher(), NewDefaultConditionMatcher(), nil, nil, nil, nil, nil, nil)
+		err := service.EvaluatePolicy(context.Background(), newPolicy("v1"))
+		assertErrorCode(t, err, gcerrors.TypeListResourcesFailed)
+		if !errors.Is(err, errListFailed) {
+			t.Errorf("error %v does not wrap the list error", err)
+		}
+	})
+
+	t.Run("status update failure", func(t *testing.T) {
+		// The policy does not exist in the dynamic client, so the status read fails
+		dynamicClient := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+			PolicyGVR: "GarbageCollectionPolicyList",
+		})
+		service := NewPolicyEvaluationService(nil, NewDefaultSelectorMatcher(), NewDefaultConditionMatcher(), nil, nil, nil, NewStatusUpdater(dynamicClient), nil, nil)
+		err := service.updatePolicyStatus(context.Background(), newPolicy("v1"), 0, 0, 0, 0, 0, nil)
+		assertErrorCode(t, err, gcerrors.TypeStatusUpdateFailed)
+	})
+}
+
+func TestErrorCodes_StatusUpdater(t *testing.T) {
+	dynamicClient := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		PolicyGVR: "GarbageCollectionPolicyList",
+	})
+	policy := &v1alpha1.GarbageCollectionPolicy{ObjectMeta: metav1.ObjectMeta{Name: "missing", Namespace: "default"}}
+
+	err := NewStatusUpdater(dynamicClient).UpdateStatus(context.Background(), policy, 0, 0, 0, 0, 0, nil)
+	assertErrorCode(t, err, gcerrors.TypeStatusGetFailed)
+}
+
+func TestErrorCodes_DeletionFailed(t *testing.T) {
+	reconciler, dynamicClient, policy := setupObservePausedTest(t, false)
+	dynamicClient.PrependReactor("delete", "configmaps", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, k8serrors.NewForbidden(schema.GroupResource{Resource: "configmaps"}, "cm-1", errors.New("denied"))
+	})
+
+	_, errs := reconciler.deleteBatch(context.Background(), []*unstructured.Unstructured{newTestConfigMap("default", "cm-1", time.Now().Add(-2*time.Hour))}, policy, ratelimiter.NewRateLimiter(100), nil)
+	if len(errs) != 1 {
+		t.Fatalf("deleteBatch() returned %d errors, want 1", len(errs))
+	}
+	assertErrorCode(t, errs[0], gcerrors.TypeDeletionFailed)
+}
+
+func TestErrorCodes_TargetScopeMismatchStatusMessage(t *testing.T) {
+	reconciler, dynamicClient, policy := setupObservePausedTest(t, false)
+
+	if _, err := reconciler.handleTargetScopeMismatch(context.Background(), policy, ErrNamespaceForClusterScopedKind); err != nil {
+		t.Fatalf("handleTargetScopeMismatch() returned error: %v", err)
+	}
+	current, err := dynamicClient.Resource(observeTestPolicyGVR).Namespace("default").Get(context.Background(), policy.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get policy: %v", err)
+	}
+	conditions, _, _ := unstructured.NestedSlice(current.Object, "status", "conditions")
+	if len(conditions) == 0 {
+		t.Fatal("expected an Error condition")
+	}
+	message, _, _ := unstructured.NestedString(conditions[0].(map[string]interface{}), "message")
+	if !strings.HasPrefix(message, "["+gcerrors.TypeTargetScopeMismatch+"] ") {
+		t.Errorf("condition message = %q, want it prefixed with the error code", message)
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+// errorRateBuckets is the number of buckets the error rate window is divided into.
+const errorRateBuckets = 30
+
+// errorRateBucket counts deletion outcomes in one slice of the window.
+type errorRateBucket struct {
+	start    int64
+	attempts int
+	failures int
+}
+
+// ErrorRateBreaker pauses deletions across all policies while too many of them fail with
+// API server errors, so the controller backs off a struggling API server.
+//
+// Outcomes are counted over a sliding window. Once the window holds at least minAttempts
+// deletions and the share failing with API server errors (timeouts, throttling,
+// unavailability, internal errors) exceeds the threshold, the breaker opens: every
+// deletion is deferred to a later run. No new outcomes are recorded while it is open, so
+// it closes again once enough failures have aged out of the window.
+// A nil *ErrorRateBreaker never pauses deletions.
+type ErrorRateBreaker struct {
+	thresholdPercent int
+	minAttempts      int
+	bucketWidth      time.Duration
+	buckets          [errorRateBuckets]errorRateBucket
+	open             bool
+	mu               sync.Mutex
+	now              func() time.Time
+}
+
+// NewErrorRateBreaker creates an ErrorRateBreaker, or returns nil if thresholdPercent is
+// not positive or window is not positive.
+func NewErrorRateBreaker(thresholdPercent int, window time.Duration, minAttempts int) *ErrorRateBreaker {
+	if thresholdPercent <= 0 || window <= 0 {
+		return nil
+	}
+	bucketWidth := window / errorRateBuckets
+	if bucketWidth <= 0 {
+		bucketWidth = 1
+	}
+	return &ErrorRateBreaker{
+		thresholdPercent: thresholdPercent,
+		minAttempts:      minAttempts,
+		bucketWidth:      bucketWidth,
+		now:              time.Now,
+	}
+}
+
+// Allow reports whether deletions may proceed. An open breaker closes once the error
+// rate over the window has dropped back to the threshold or below.
+func (b *ErrorRateBreaker) Allow() bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.open && !b.exceededLocked(b.now()) {
+		b.setOpenLocked(false)
+	}
+	return !b.open
+}
+
+// Record counts the outcome of a deletion attempt. Only API server errors count as
+// failures; other errors, such as NotFound or Forbidden, count as attempts.
+func (b *ErrorRateBreaker) Record(err error) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := b.now()
+	bucket := b.bucketLocked(now)
+	bucket.attempts++
+	if isAPIServerError(err) {
+		bucket.failures++
+	}
+	if !b.open && b.exceededLocked(now) {
+		b.setOpenLocked(true)
+	}
+}
+
+// bucketLocked returns the bucket for now, resetting it if it holds an older slice.
+func (b *ErrorRateBreaker) bucketLocked(now time.Time) *errorRateBucket {
+	start := now.UnixNano() / int64(b.bucketWidth)
+	bucket := &b.buckets[start%errorRateBuckets]
+	if bucket.start != start {
+		*bucket = errorRateBucket{start: start}
+	}
+	return bucket
+}
+
+// exceededLocked reports whether the error rate over the window is above the threshold.
+func (b *ErrorRateBreaker) exceededLocked(now time.Time) bool {
+	current := now.UnixNano() / int64(b.bucketWidth)
+	attempts, failures := 0, 0
+	for i := range b.buckets {
+		if current-b.buckets[i].start < errorRateBuckets {
+			attempts += b.buckets[i].attempts
+			failures += b.buckets[i].failures
+		}
+	}
+	if attempts == 0 || attempts < b.minAttempts {
+		return false
+	}
+	return failures*100 > b.thresholdPercent*attempts
+}
+
+// setOpenLocked opens or closes the breaker, logging and recording the transition.
+func (b *ErrorRateBreaker) setOpenLocked(open bool) {
+	b.open = open
+	recordDeletionCooldown(open)
+	logger := sdklog.NewLogger("zen-gc")
+	if open {
+		logger.Warn("Deletion error rate exceeded threshold, deferring all deletions", sdklog.Operation("error_rate_breaker"), sdklog.Int("threshold_percent", b.thresholdPercent))
+	} else {
+		logger.Info("Deletion error rate recovered, resuming deletions", sdklog.Operation("error_rate_breaker"), sdklog.Int("threshold_percent", b.thresholdPercent))
+	}
+}
+
+// isAPIServerError reports whether err indicates the API server is struggling.
+func isAPIServerError(err error) bool {
+	if err == nil {
+		return false
+	}
+	return k8serrors.IsTimeout(err) || k8serrors.IsServerTimeout(err) ||
+		k8serrors.IsTooManyRequests(err) || k8serrors.IsServiceUnavailable(err) ||
+		k8serrors.IsInternalError(err) || k8serrors.IsUnexpectedServerError(err)
+}
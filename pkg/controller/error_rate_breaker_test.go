@@ -0,0 +1,229 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kube-zen/zen-sdk/pkg/gc/ratelimiter"
+)
+
+var errBreakerTestUnavailable = k8serrors.NewServiceUnavailable("etcd overloaded")
+
+// newTestErrorRateBreaker returns a breaker on a fake clock and a function advancing it.
+func newTestErrorRateBreaker(thresholdPercent int, window time.Duration, minAttempts int) (*ErrorRateBreaker, func(time.Duration)) {
+	breaker := NewErrorRateBreaker(thresholdPercent, window, minAttempts)
+	now := time.Unix(1700000000, 0)
+	breaker.now = func() time.Time { return now }
+	return breaker, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestErrorRateBreaker_OpensAboveThresholdAndRecovers(t *testing.T) {
+	breaker, advance := newTestErrorRateBreaker(50, time.Minute, 4)
+
+	for range 4 {
+		breaker.Record(nil)
+		breaker.Record(errBreakerTestUnavailable)
+	}
+	if !breaker.Allow() {
+		t.Fatal("Allow() = false at exactly the threshold, want true")
+	}
+	if got := testutil.ToFloat64(gcDeletionCooldownActive); got != 0 {
+		t.Errorf("gc_deletion_cooldown_active = %v below the threshold, want 0", got)
+	}
+
+	cooldowns := testutil.ToFloat64(gcDeletionCooldownsTotal)
+	breaker.Record(errBreakerTestUnavailable)
+	if breaker.Allow() {
+		t.Fatal("Allow() = true above the threshold, want false")
+	}
+	if got := testutil.ToFloat64(gcDeletionCooldownActive); got != 1 {
+		t.Errorf("gc_deletion_cooldown_active = %v while cooling down, want 1", got)
+	}
+	if got := testutil.ToFloat64(gcDeletionCooldownsTotal); got != cooldowns+1 {
+		t.Errorf("gc_deletion_cooldowns_total = %v, want %v", got, cooldowns+1)
+	}
+
+	// Still within the window: the failures have not aged out
+	advance(30 * time.Second)
+	if breaker.Allow() {
+		t.Error("Allow() = true before the failures left the window, want false")
+	}
+
+	advance(time.Minute)
+	if !breaker.Allow() {
+		t.Fatal("Allow() = false after the failures left the window, want true")
+	}
+	if got := testutil.ToFloat64(gcDeletionCooldownActive); got != 0 {
+		t.Errorf("gc_deletion_cooldown_active = %v after recovery, want 0", got)
+	}
+}
+
+func TestErrorRateBreaker_MinAttempts(t *testing.T) {
+	breaker, _ := newTestErrorRateBreaker(10, time.Minute, 5)
+	for range 4 {
+		breaker.Record(errBreakerTestUnavailable)
+	}
+	if !breaker.Allow() {
+		t.Error("Allow() = false with fewer attempts than minAttempts, want true")
+	}
+	breaker.Record(errBreakerTestUnavailable)
+	if breaker.Allow() {
+		t.Error("Allow() = true once minAttempts failed, want false")
+	}
+}
+
+func TestErrorRateBreaker_OnlyAPIServerErrorsCount(t *testing.T) {
+	breaker, _ := newTestErrorRateBreaker(10, time.Minute, 1)
+	gr := schema.GroupResource{Resource: "configmaps"}
+	for _, err := range []error{
+		k8serrors.NewNotFound(gr, "gone"),
+		k8serrors.NewForbidden(gr, "denied", errors.New("rbac")),
+		errors.New("webhook denied"),
+	} {
+		breaker.Record(err)
+	}
+	if !breaker.Allow() {
+		t.Error("Allow() = false after client-side errors, want true")
+	}
+
+	for _, err := range []error{
+		k8serrors.NewTooManyRequests("slow down", 1),
+		k8serrors.NewInternalError(errors.New("boom")),
+		k8serrors.NewTimeoutError("timeout", 1),
+	} {
+		if !isAPIServerError(err) {
+			t.Errorf("isAPIServerError(%v) = false, want true", err)
+		}
+	}
+}
+
+func TestErrorRateBreaker_Disabled(t *testing.T) {
+	if breaker := NewErrorRateBreaker(0, time.Minute, 1); breaker != nil {
+		t.Fatal("NewErrorRateBreaker() with a zero threshold should be nil")
+	}
+	var breaker *ErrorRateBreaker
+	breaker.Record(errBreakerTestUnavailable)
+	if !breaker.Allow() {
+		t.Error("nil breaker should always allow deletions")
+	}
+}
+
+func TestDeleteBatch_ErrorRateBreakerDefersDeletions(t *testing.T) {
+	breaker, advance := newTestErrorRateBreaker(50, time.Minute, 2)
+	deleter := &fakeBatchDeleter{breaker: breaker, deletedBy: map[string][]string{}, err: errBreakerTestUnavailable}
+	limiter := ratelimiter.NewRateLimiter(1000)
+	policy := newTestPolicy("policy")
+	batch := []*unstructured.Unstructured{
+		newTestConfigMap("default", "a", time.Now()), newTestConfigMap("default", "b", time.Now()), newTestConfigMap("default", "c", time.Now()), newTestConfigMap("default", "d", time.Now()),
+	}
+
+	// Two failures open the breaker and the rest of the batch is deferred, not failed
+	deleted, errs := deleteBatchShared(context.Background(), batch, policy, limiter, map[string]string{}, deleter)
+	errs, deferred := splitDeferredShared(errs)
+	if deleted != 0 || len(errs) != 2 || deferred != 2 {
+		t.Fatalf("deleted=%d errs=%d deferred=%d, want 0 deletions and 2 failures before deferring 2", deleted, len(errs), deferred)
+	}
+
+	// Other policies are deferred too while cooling down
+	deleter.err = nil
+	deleted, errs = deleteBatchShared(context.Background(), batch, newTestPolicy("other"), limiter, map[string]string{}, deleter)
+	errs, deferred = splitDeferredShared(errs)
+	if deleted != 0 || len(errs) != 0 || deferred != 4 {
+		t.Fatalf("deleted=%d errs=%d deferred=%d while cooling down, want all 4 deferred", deleted, len(errs), deferred)
+	}
+
+	// Deletions resume once the failures leave the window
+	advance(2 * time.Minute)
+	deleted, errs = deleteBatchShared(context.Background(), batch, policy, limiter, map[string]string{}, deleter)
+	if deleted != 4 || len(errs) != 0 {
+		t.Errorf("deleted=%d errs=%v after recovery, want 4 deletions", deleted, errs)
+	}
+}
+
+func TestDeleteResourcesInBatchesShared_ErrorRateBreakerStopsBatches(t *testing.T) {
+	reconciler, dynamicClient, policy := setupObservePausedTest(t, false)
+	defer reconciler.cleanupResourceInformer(policy.UID)
+
+	breaker, _ := newTestErrorRateBreaker(50, time.Minute, 1)
+	breaker.Record(errBreakerTestUnavailable)
+	reconciler.errorRateBreaker = breaker
+
+	policy.Spec.Behavior.BatchSize = 1
+	policy.Spec.Behavior.BatchInterval = &metav1.Duration{Duration: time.Hour}
+	list, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("Failed to list configmaps: %v", err)
+	}
+	resources := make([]*unstructured.Unstructured, 0, len(list.Items))
+	for i := range list.Items {
+		resources = append(resources, &list.Items[i])
+	}
+
+	// Batches that would all be deferred are not paced through the hour-long interval
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	deleted, failed, deferred := deleteResourcesInBatchesShared(ctx, reconciler, policy, resources, map[string]string{})
+	if ctx.Err() != nil {
+		t.Fatal("deleteResourcesInBatchesShared() kept waiting between deferred batches")
+	}
+	if deleted != 0 || failed != 0 || deferred != 2 {
+		t.Errorf("deleted = %d, failed = %d, deferred = %d, want 0, 0 and 2", deleted, failed, deferred)
+	}
+}
+
+func TestPolicyEvaluationService_ErrorRateBreakerStopsBatches(t *testing.T) {
+	reconciler, dynamicClient, policy := setupObservePausedTest(t, false)
+	defer reconciler.cleanupResourceInformer(policy.UID)
+
+	breaker, _ := newTestErrorRateBreaker(50, time.Minute, 1)
+	breaker.Record(errBreakerTestUnavailable)
+	reconciler.errorRateBreaker = breaker
+	adapter := NewGCPolicyReconcilerAdapter(reconciler)
+	service := NewPolicyEvaluationService(nil, nil, nil, nil, adapter.GetRateLimiterProvider(), adapter.GetBatchDeleter(), nil, nil, nil)
+
+	policy.Spec.Behavior.BatchSize = 1
+	policy.Spec.Behavior.BatchInterval = &metav1.Duration{Duration: time.Hour}
+	list, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("Failed to list configmaps: %v", err)
+	}
+	resources := make([]*unstructured.Unstructured, 0, len(list.Items))
+	for i := range list.Items {
+		resources = append(resources, &list.Items[i])
+	}
+
+	// The breaker's deferral is not a failed deletion and later batches are not paced through
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	deleted, failed, deferred := service.deleteResourcesInBatches(ctx, policy, resources, map[string]string{})
+	if ctx.Err() != nil {
+		t.Fatal("deleteResourcesInBatches() kept waiting between deferred batches")
+	}
+	if deleted != 0 || failed != 0 || deferred != 2 {
+		t.Errorf("deleted = %d, failed = %d, deferred = %d, want 0, 0 and 2", deleted, failed, deferred)
+	}
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kube-zen/zen-gc/pkg/config"
+)
+
+// evaluationResourcesPerWorker is the fewest resources given a worker of their own; smaller
+// policies are evaluated with fewer workers, or inline, to avoid goroutine overhead.
+const evaluationResourcesPerWorker = 100
+
+// resourceVerdict is the outcome of evaluating one resource against a policy.
+type resourceVerdict struct {
+	// evaluated is unset for resources not reached before the context was canceled
+	evaluated bool
+	matched   bool
+	deletable bool
+	// pending resources count toward the pending count and oldest pending age
+	pending bool
+	// reason is the deletion reason of a deletable resource, or the decision reason otherwise
+	reason string
+}
+
+// indexedVerdict carries a verdict from a worker to the collector.
+type indexedVerdict struct {
+	index   int
+	verdict resourceVerdict
+}
+
+// evaluateConcurrentlyShared evaluates n resources with up to workers goroutines and
+// returns their verdicts in resource order, so callers aggregate them exactly as a serial
+// loop would. evaluate must only read shared state. Once ctx is canceled no further
+// resources are handed out; resources not reached are left unevaluated, and since
+// resources are handed out in order the evaluated verdicts form a prefix.
+func evaluateConcurrentlyShared(ctx context.Context, n, workers int, evaluate func(i int) resourceVerdict) []resourceVerdict {
+	verdicts := make([]resourceVerdict, n)
+	if maxWorkers := (n + evaluationResourcesPerWorker - 1) / evaluationResourcesPerWorker; workers > maxWorkers {
+		workers = maxWorkers
+	}
+
+	if workers <= 1 {
+		const contextCheckInterval = 100
+		for i := 0; i < n; i++ {
+			if i%contextCheckInterval == 0 && ctx.Err() != nil {
+				break
+			}
+			verdicts[i] = evaluate(i)
+			verdicts[i].evaluated = true
+		}
+		return verdicts
+	}
+
+	indexes := make(chan int)
+	results := make(chan indexedVerdict, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				verdict := evaluate(i)
+				verdict.evaluated = true
+				results <- indexedVerdict{index: i, verdict: verdict}
+			}
+		}()
+	}
+	go func() {
+		defer close(indexes)
+		for i := 0; i < n; i++ {
+			select {
+			case <-ctx.Done():
+				return
+			case indexes <- i:
+			}
+		}
+	}()
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	for result := range results {
+		verdicts[result.index] = result.verdict
+	}
+	return verdicts
+}
+
+// evaluationWorkers returns the number of workers that evaluate a policy's resources, the
+// default if the reconciler has no config.
+func (r *GCPolicyReconciler) evaluationWorkers() int {
+	if r.config == nil {
+		return config.DefaultEvaluationWorkers
+	}
+	return r.config.EvaluationWorkers
+}
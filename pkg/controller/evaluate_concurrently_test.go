@@ -0,0 +1,131 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func TestEvaluateConcurrentlyShared_KeepsResourceOrder(t *testing.T) {
+	const n = 1000
+	for _, workers := range []int{1, 8} {
+		t.Run(fmt.Sprintf("%d workers", workers), func(t *testing.T) {
+			var calls int32
+			verdicts := evaluateConcurrentlyShared(context.Background(), n, workers, func(i int) resourceVerdict {
+				atomic.AddInt32(&calls, 1)
+				return resourceVerdict{matched: i%3 == 0, reason: strconv.Itoa(i)}
+			})
+
+			if calls != n {
+				t.Errorf("Expected %d evaluations, got %d", n, calls)
+			}
+			for i, verdict := range verdicts {
+				if !verdict.evaluated || verdict.matched != (i%3 == 0) || verdict.reason != strconv.Itoa(i) {
+					t.Fatalf("verdicts[%d] = %+v, want the verdict of resource %d", i, verdict, i)
+				}
+			}
+		})
+	}
+}
+
+func TestEvaluateConcurrentlyShared_StopsOnCancel(t *testing.T) {
+	const n = 1000
+	for _, workers := range []int{1, 8} {
+		t.Run(fmt.Sprintf("%d workers", workers), func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			verdicts := evaluateConcurrentlyShared(ctx, n, workers, func(i int) resourceVerdict {
+				if i == 150 {
+					cancel()
+				}
+				return resourceVerdict{matched: true}
+			})
+
+			evaluated := 0
+			for evaluated < n && verdicts[evaluated].evaluated {
+				evaluated++
+			}
+			for i := evaluated; i < n; i++ {
+				if verdicts[i].evaluated {
+					t.Fatalf("verdicts[%d] evaluated after unevaluated verdicts[%d]; evaluated verdicts must form a prefix", i, evaluated)
+				}
+			}
+			if evaluated <= 150 || evaluated == n {
+				t.Errorf("Expected evaluation to stop after resource 150 and before %d, stopped after %d", n, evaluated)
+			}
+		})
+	}
+}
+
+func TestEvaluateResources_ConcurrentMatchesSerial(t *testing.T) {
+	policy := newTestPolicy("decisions")
+	policy.Spec.Behavior.AnnotateDecisions = true
+	policy.Spec.Conditions = &v1alpha1.ConditionsSpec{
+		HasLabels: []v1alpha1.LabelCondition{{Key: "gc-eligible", Operator: "Exists"}},
+	}
+	resources := make([]*unstructured.Unstructured, 0, 500)
+	for i := 0; i < 500; i++ {
+		age := 10 * time.Minute
+		if i%2 == 0 {
+			age = 2 * time.Hour
+		}
+		resource := newTestConfigMap("default", fmt.Sprintf("cm-%d", i), time.Now().Add(-age))
+		resource.SetUID(types.UID(fmt.Sprintf("uid-%d", i)))
+		if i%5 != 0 {
+			resource.SetLabels(map[string]string{"gc-eligible": "true"})
+		}
+		resources = append(resources, resource)
+	}
+
+	evaluate := func(workers int) ([]string, int64, int64) {
+		service := NewPolicyEvaluationService(nil, NewDefaultSelectorMatcher(), NewDefaultConditionMatcher(), nil, nil, nil, nil, nil, nil)
+		service.evaluationWorkers = workers
+		var oldest oldestPending
+		toDelete := make([]*unstructured.Unstructured, 0)
+		matched, pending := service.evaluateResources(context.Background(), resources, policy, &toDelete, make(map[string]string), "v1", "ConfigMap", &oldest, nil)
+		names := make([]string, len(toDelete))
+		for i, resource := range toDelete {
+			names[i] = resource.GetName()
+		}
+		return names, matched, pending
+	}
+
+	serialNames, serialMatched, serialPending := evaluate(1)
+	concurrentNames, concurrentMatched, concurrentPending := evaluate(8)
+
+	if serialMatched != 500 || concurrentMatched != serialMatched || concurrentPending != serialPending {
+		t.Errorf("matched/pending = %d/%d concurrently, %d/%d serially", concurrentMatched, concurrentPending, serialMatched, serialPending)
+	}
+	// Every tenth resource is old but unlabeled, so 200 of the 250 old resources are deleted
+	if len(serialNames) != 200 {
+		t.Fatalf("Expected 200 deletions, got %d", len(serialNames))
+	}
+	if fmt.Sprint(concurrentNames) != fmt.Sprint(serialNames) {
+		t.Error("Expected concurrent evaluation to produce the serial deletion list in the same order")
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/config"
 	gcerrors "github.com/kube-zen/zen-gc/pkg/errors"
 	"github.com/kube-zen/zen-gc/pkg/validation"
 	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
@@ -41,7 +42,24 @@ type PolicyEvaluationService struct {
 	batchDeleter        BatchDeleterCore
 	statusUpdater       *StatusUpdater
 	eventRecorder       *EventRecorder
+	confirmations       *DecisionConfirmations
+	countHistory        *CountHistory
+	namespaceSampler    *NamespaceSampler
+	backupStatus        *BackupStatusCache
+	metricThreshold     *MetricThresholdCache
+	decisionAnnotator   *DecisionAnnotator
+	deletionNotifier    *DeletionNotifier
+	protectedReports    *ProtectedReports
+	stalePolicies       *StalePolicies
+	staleAfterEmptyRuns int
+	protectAnnotation   string
+	evaluationWorkers   int
 	logger              *sdklog.Logger
+
+	// decisionHook, if set, receives the decision of every resource an evaluation kept
+	decisionHook func(decisions *decisionLog)
+	// skipMetrics leaves the policy's metrics untouched, for one-off evaluations
+	skipMetrics bool
 }
 
 // NewPolicyEvaluationService creates a new PolicyEvaluationService with injected dependencies.
@@ -68,6 +86,14 @@ func NewPolicyEvaluationService(
 		batchDeleter:        batchDeleter,
 		statusUpdater:       statusUpdater,
 		eventRecorder:       eventRecorder,
+		confirmations:       NewDecisionConfirmations(),
+		countHistory:        NewCountHistory(),
+		namespaceSampler:    NewNamespaceSampler(),
+		metricThreshold:     NewMetricThresholdCache(NewPrometheusMetricProvider(nil)),
+		stalePolicies:       NewStalePolicies(),
+		staleAfterEmptyRuns: config.DefaultStaleAfterEmptyRuns,
+		protectAnnotation:   config.DefaultProtectAnnotation,
+		evaluationWorkers:   config.DefaultEvaluationWorkers,
 		logger:              logger,
 	}
 }
@@ -77,39 +103,20 @@ func NewPolicyEvaluationService(
 func (s *PolicyEvaluationService) EvaluatePolicy(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy) error {
 	startTime := time.Now()
 	defer func() {
+		if s.skipMetrics {
+			return
+		}
 		duration := time.Since(startTime).Seconds()
 		recordEvaluationDuration(policy.Namespace, policy.Name, duration)
 	}()
 
 	s.logger.Debug("Evaluating policy", sdklog.Operation("evaluate_policy"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)))
 
-	// Parse GVR from policy
-	gvr, err := parseGVR(policy.Spec.TargetResource.APIVersion, policy.Spec.TargetResource.Kind)
-	if err != nil {
-		gcErr := gcerrors.Wrap(err, "invalid_gvr", "failed to parse GVR")
-		gcErr = gcErr.WithContext("policy_namespace", policy.Namespace)
-		gcErr = gcErr.WithContext("policy_name", policy.Name)
-		recordError(policy.Namespace, policy.Name, "invalid_gvr")
-		s.logger.Error(gcErr, "Invalid GVR in policy", sdklog.Operation("evaluate_policy"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.ErrorCode("INVALID_GVR"))
-		return gcErr
-	}
-
-	// Get namespace (use "*" for all namespaces if empty)
-	namespace := policy.Spec.TargetResource.Namespace
-	if namespace == "" {
-		namespace = "*"
-	}
-
-	// List resources using ResourceLister interface
-	resources, err := s.resourceLister.ListResources(ctx, gvr, namespace)
+	resources, err := s.listPolicyResources(ctx, policy)
 	if err != nil {
-		gcErr := gcerrors.Wrap(err, "list_resources_failed", "failed to list resources")
-		gcErr = gcErr.WithContext("policy_namespace", policy.Namespace)
-		gcErr = gcErr.WithContext("policy_name", policy.Name)
-		recordError(policy.Namespace, policy.Name, "list_resources_failed")
-		s.logger.Error(gcErr, "Error listing resources", sdklog.Operation("evaluate_policy"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.ErrorCode("LIST_RESOURCES_FAILED"))
-		return gcErr
+		return err
 	}
+	resources = sampleNamespacesShared(s.namespaceSampler, policy, resources)
 
 	var matchedCount, deletedCount, pendingCount int64
 
@@ -125,20 +132,102 @@ func (s *PolicyEvaluationService) EvaluatePolicy(ctx context.Context, policy *v1
 	resourcesToDeleteReasons := make(map[string]string, estimatedDeletions)
 
 	// Evaluate each resource
-	matchedCount, pendingCount = s.evaluateResources(ctx, resources, policy, &resourcesToDelete, resourcesToDeleteReasons, resourceAPIVersion, resourceKind)
+	var oldest oldestPending
+	decisions := newDecisionLog(policy)
+	if decisions == nil && s.decisionHook != nil {
+		decisions = &decisionLog{}
+	}
+	matchedCount, pendingCount = s.evaluateResources(ctx, resources, policy, &resourcesToDelete, resourcesToDeleteReasons, resourceAPIVersion, resourceKind, &oldest, decisions)
+	evaluated := resourcesToDelete
+
+	// Surface policies that keep matching nothing
+	recordMatchedCountShared(s.stalePolicies, s.eventRecorder, policy, matchedCount, s.staleAfterEmptyRuns)
+
+	// Hold deletions that were not also decided in the previous run
+	var unconfirmed int64
+	beforeGate := resourcesToDelete
+	resourcesToDelete, unconfirmed = applyDeletionConfirmationShared(s.confirmations, policy, resourcesToDelete)
+	pendingCount += unconfirmed
+	decisions.recordHeld(beforeGate, resourcesToDelete, HeldByConfirmation)
+
+	// Hold deletions until the matched count follows the configured trend
+	if !countTrendAllowsDeletionShared(s.countHistory, policy, matchedCount) && len(resourcesToDelete) > 0 {
+		s.logger.Debug("Count trend not met, holding deletions", sdklog.Operation("evaluate_policy"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Int("held", len(resourcesToDelete)))
+		pendingCount += int64(len(resourcesToDelete))
+		decisions.recordHeld(resourcesToDelete, nil, HeldByCountTrend)
+		resourcesToDelete = resourcesToDelete[:0]
+	}
+
+	// Hold deletions until the external metric crosses its threshold
+	if len(resourcesToDelete) > 0 && !metricThresholdAllowsDeletionShared(ctx, s.metricThreshold, policy) {
+		s.logger.Debug("Metric threshold not crossed, holding deletions", sdklog.Operation("evaluate_policy"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Int("held", len(resourcesToDelete)))
+		pendingCount += int64(len(resourcesToDelete))
+		decisions.recordHeld(resourcesToDelete, nil, HeldByMetricThreshold)
+		resourcesToDelete = resourcesToDelete[:0]
+	}
+
+	// Hold deletions of resources not yet covered by a successful backup
+	var backupHeld int64
+	beforeGate = resourcesToDelete
+	resourcesToDelete, backupHeld = applyBackupGateShared(ctx, s.backupStatus, policy, resourcesToDelete)
+	pendingCount += backupHeld
+	decisions.recordHeld(beforeGate, resourcesToDelete, HeldByBackupGate)
+
+	// Hold deletions that would leave a group below its minimum
+	if policy.Spec.Behavior.MinRemaining != nil {
+		matched := make([]*unstructured.Unstructured, 0, matchedCount)
+		for _, resource := range resources {
+			if s.selectorMatcher.MatchesSelectors(resource, &policy.Spec.TargetResource) {
+				matched = append(matched, resource)
+			}
+		}
+		var minHeld int64
+		beforeGate = resourcesToDelete
+		resourcesToDelete, minHeld = applyMinRemainingShared(policy, matched, resourcesToDelete)
+		pendingCount += minHeld
+		decisions.recordHeld(beforeGate, resourcesToDelete, HeldByMinRemaining)
+	}
+
+	// Warn before deleting: resources due for the first time are noticed and held
+	var noticeHeld int64
+	resourcesToDelete, noticeHeld = applyDeletionNoticeShared(ctx, s.deletionNotifier, s.eventRecorder, policy, resourcesToDelete, decisions)
+	pendingCount += noticeHeld
+	oldest.observeHeld(evaluated, resourcesToDelete)
+	resourcesToDelete = orderDeletionsShared(policy, resourcesToDelete)
+
+	// Record what a dry-run policy would delete so arming it can be acknowledged
+	dryRunImpact := dryRunImpactShared(policy, resourcesToDelete)
 
 	// Delete resources in batches using BatchDeleterCore interface
+	var failedCount, deferredCount int64
 	if len(resourcesToDelete) > 0 {
-		deletedCount = s.deleteResourcesInBatches(ctx, policy, resourcesToDelete, resourcesToDeleteReasons)
+		deletedCount, failedCount, deferredCount = s.deleteResourcesInBatches(ctx, policy, resourcesToDelete, resourcesToDeleteReasons)
+		pendingCount += deferredCount
+	}
+
+	// Report the run to the result webhook (non-blocking)
+	notifyResultWebhookShared(policy, matchedCount, deletedCount, pendingCount, failedCount)
+	addToSweepSummary(ctx, matchedCount, deletedCount, failedCount)
+
+	// Stamp skipped resources with the latest decision
+	s.decisionAnnotator.Annotate(ctx, policy, decisions)
+	s.protectedReports.Record(policy, decisions, time.Now())
+	if s.decisionHook != nil {
+		s.decisionHook(decisions)
 	}
 
 	// Record pending resources metric
-	if pendingCount > 0 {
-		recordResourcesPending(policy.Namespace, policy.Name, resourceAPIVersion, resourceKind, pendingCount)
+	oldestPendingAge := oldest.ageSeconds(time.Now())
+	if !s.skipMetrics {
+		if pendingCount > 0 {
+			recordResourcesPending(policy.Namespace, policy.Name, resourceAPIVersion, resourceKind, pendingCount)
+		}
+		recordOldestPendingAge(policy.Namespace, policy.Name, resourceAPIVersion, resourceKind, oldestPendingAge)
+		recordPolicyCounts(policy.Namespace, policy.Name, matchedCount, pendingCount, deletedCount)
 	}
 
 	// Update policy status
-	if err := s.updatePolicyStatus(ctx, policy, matchedCount, deletedCount, pendingCount); err != nil {
+	if err := s.updatePolicyStatus(ctx, policy, matchedCount, deletedCount, pendingCount, oldestPendingAge, deferredCount, dryRunImpact); err != nil {
 		return err
 	}
 
@@ -150,6 +239,75 @@ func (s *PolicyEvaluationService) EvaluatePolicy(ctx context.Context, policy *v1
 	return nil
 }
 
+// ObservePolicy lists and counts a policy's resources without deleting anything.
+// Used for paused policies with observeWhenPaused so their status and metrics stay current.
+func (s *PolicyEvaluationService) ObservePolicy(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy) error {
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime).Seconds()
+		recordEvaluationDuration(policy.Namespace, policy.Name, duration)
+	}()
+
+	s.logger.Debug("Observing paused policy", sdklog.Operation("observe_policy"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)))
+
+	resources, err := s.listPolicyResources(ctx, policy)
+	if err != nil {
+		return err
+	}
+
+	// Resources that would be deleted are reported as pending
+	var oldest oldestPending
+	resourcesToDelete := make([]*unstructured.Unstructured, 0)
+	matchedCount, pendingCount := s.evaluateResources(ctx, resources, policy, &resourcesToDelete, make(map[string]string), policy.Spec.TargetResource.APIVersion, policy.Spec.TargetResource.Kind, &oldest, nil)
+	pendingCount += int64(len(resourcesToDelete))
+	oldest.observeAll(resourcesToDelete)
+
+	recordResourcesPending(policy.Namespace, policy.Name, policy.Spec.TargetResource.APIVersion, policy.Spec.TargetResource.Kind, pendingCount)
+	oldestPendingAge := oldest.ageSeconds(time.Now())
+	recordOldestPendingAge(policy.Namespace, policy.Name, policy.Spec.TargetResource.APIVersion, policy.Spec.TargetResource.Kind, oldestPendingAge)
+	recordPolicyCounts(policy.Namespace, policy.Name, matchedCount, pendingCount, 0)
+
+	return s.updatePolicyStatus(ctx, policy, matchedCount, 0, pendingCount, oldestPendingAge, 0, dryRunImpactShared(policy, resourcesToDelete))
+}
+
+// listPolicyResources lists the resources targeted by a policy.
+func (s *PolicyEvaluationService) listPolicyResources(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy) ([]*unstructured.Unstructured, error) {
+	// Parse GVR from policy
+	gvr, err := parseGVR(policy.Spec.TargetResource.APIVersion, policy.Spec.TargetResource.Kind)
+	if err != nil {
+		gcErr := gcerrors.Wrap(err, gcerrors.TypeInvalidGVR, "failed to parse GVR")
+		gcErr = gcErr.WithContext("policy_namespace", policy.Namespace)
+		gcErr = gcErr.WithContext("policy_name", policy.Name)
+		recordError(policy.Namespace, policy.Name, gcerrors.TypeInvalidGVR)
+		s.logger.Error(gcErr, "Invalid GVR in policy", sdklog.Operation("evaluate_policy"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.ErrorCode("INVALID_GVR"))
+		return nil, gcErr
+	}
+
+	// Get namespace (use "*" for all namespaces if empty)
+	namespace := policy.Spec.TargetResource.Namespace
+	if namespace == "" {
+		namespace = "*"
+	}
+
+	// List resources using ResourceLister interface, narrowed by informer indexes when available
+	var resources []*unstructured.Unstructured
+	if indexed, ok := s.resourceLister.(PolicyResourceLister); ok {
+		resources, err = indexed.ListPolicyResources(ctx, policy)
+	} else {
+		resources, err = s.resourceLister.ListResources(ctx, gvr, namespace)
+	}
+	if err != nil {
+		gcErr := gcerrors.Wrap(err, gcerrors.TypeListResourcesFailed, "failed to list resources")
+		gcErr = gcErr.WithContext("policy_namespace", policy.Namespace)
+		gcErr = gcErr.WithContext("policy_name", policy.Name)
+		recordError(policy.Namespace, policy.Name, gcerrors.TypeListResourcesFailed)
+		s.logger.Error(gcErr, "Error listing resources", sdklog.Operation("evaluate_policy"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.ErrorCode("LIST_RESOURCES_FAILED"))
+		return nil, gcErr
+	}
+
+	return resources, nil
+}
+
 // evaluateResources evaluates all resources and builds the deletion list.
 func (s *PolicyEvaluationService) evaluateResources(
 	ctx context.Context,
@@ -158,6 +316,8 @@ func (s *PolicyEvaluationService) evaluateResources(
 	resourcesToDelete *[]*unstructured.Unstructured,
 	resourcesToDeleteReasons map[string]string,
 	resourceAPIVersion, resourceKind string,
+	oldest *oldestPending,
+	decisions *decisionLog,
 ) (matchedCount, pendingCount int64) {
 	// Check context cancellation at start to avoid unnecessary work
 	select {
@@ -167,78 +327,136 @@ func (s *PolicyEvaluationService) evaluateResources(
 	default:
 	}
 
-	const contextCheckInterval = 100
-	for i, resource := range resources {
-		// Check context cancellation periodically
-		if i%contextCheckInterval == 0 {
-			select {
-			case <-ctx.Done():
-				s.logger.Debug("Stopping policy evaluation: context canceled", sdklog.Operation("evaluate_policy"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)))
-				return matchedCount, pendingCount
-			default:
+	// Dedup and retention size their groups from every matched resource
+	var matched []*unstructured.Unstructured
+	if policy.Spec.Dedup != nil || policy.Spec.Retention != nil {
+		matched = make([]*unstructured.Unstructured, 0, len(resources))
+		for _, resource := range resources {
+			if s.selectorMatcher.MatchesSelectors(resource, &policy.Spec.TargetResource) {
+				matched = append(matched, resource)
 			}
 		}
+	}
 
-		// Check if resource matches selectors using SelectorMatcher interface
-		if !s.selectorMatcher.MatchesSelectors(resource, &policy.Spec.TargetResource) {
+	// Only duplicates are deletable under dedup; the newest resource per key is kept
+	var duplicates map[*unstructured.Unstructured]struct{}
+	if policy.Spec.Dedup != nil {
+		duplicates = dedupDuplicatesShared(matched, policy.Spec.Dedup)
+	}
+
+	// Retention keeps the newest keepLast resources per group
+	var retained map[*unstructured.Unstructured]struct{}
+	if policy.Spec.Retention != nil {
+		retained = retainedShared(matched, policy.Spec.Retention)
+	}
+
+	// Matching is read-only, so resources are evaluated concurrently and aggregated in order
+	verdicts := evaluateConcurrentlyShared(ctx, len(resources), s.evaluationWorkers, func(i int) resourceVerdict {
+		return s.evaluateResource(resources[i], policy, duplicates, retained)
+	})
+	for i, verdict := range verdicts {
+		if !verdict.evaluated {
+			s.logger.Debug("Stopping policy evaluation: context canceled", sdklog.Operation("evaluate_policy"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)))
+			return matchedCount, pendingCount
+		}
+		if !verdict.matched {
 			continue
 		}
 
+		resource := resources[i]
 		matchedCount++
-		recordResourceMatched(policy.Namespace, policy.Name, resourceAPIVersion, resourceKind)
+		if !s.skipMetrics {
+			recordResourceMatched(policy.Namespace, policy.Name, resourceAPIVersion, resourceKind)
+		}
 
-		// Check conditions using ConditionMatcher interface
-		if policy.Spec.Conditions != nil {
-			if !s.conditionMatcher.MeetsConditions(resource, policy.Spec.Conditions) {
+		if !verdict.deletable {
+			if verdict.pending {
 				pendingCount++
-				continue
+				oldest.observe(resource)
 			}
-		}
-
-		// Check TTL using shared function (TTLCalculator interface is for future use)
-		shouldDelete, reason := s.shouldDelete(resource, policy)
-		if !shouldDelete {
-			pendingCount++
+			decisions.record(resource, verdict.reason)
 			continue
 		}
 
 		// Add to deletion list
 		*resourcesToDelete = append(*resourcesToDelete, resource)
-		resourcesToDeleteReasons[string(resource.GetUID())] = reason
+		resourcesToDeleteReasons[string(resource.GetUID())] = verdict.reason
 	}
 	return matchedCount, pendingCount
 }
 
-// deleteResourcesInBatches deletes resources in batches.
+// evaluateResource decides whether a single resource is matched and deletable. It only
+// reads shared state, so it may run on several resources concurrently.
+func (s *PolicyEvaluationService) evaluateResource(
+	resource *unstructured.Unstructured,
+	policy *v1alpha1.GarbageCollectionPolicy,
+	duplicates, retained map[*unstructured.Unstructured]struct{},
+) resourceVerdict {
+	// Check if resource matches selectors using SelectorMatcher interface
+	if !s.selectorMatcher.MatchesSelectors(resource, &policy.Spec.TargetResource) {
+		return resourceVerdict{}
+	}
+
+	if duplicates != nil {
+		if _, ok := duplicates[resource]; !ok {
+			return resourceVerdict{matched: true, reason: ReasonDedupKept}
+		}
+	}
+	if _, ok := retained[resource]; ok {
+		return resourceVerdict{matched: true, reason: ReasonRetentionKept}
+	}
+
+	// TTL and conditions read missing fields as the policy's missingFields says
+	evaluated := missingFieldsViewShared(resource, policy)
+
+	// Check conditions using ConditionMatcher interface
+	if policy.Spec.Conditions != nil {
+		if !s.conditionMatcher.MeetsConditions(evaluated, policy.Spec.Conditions) {
+			return resourceVerdict{matched: true, pending: true, reason: ReasonConditionNotMet}
+		}
+	}
+
+	// Check TTL using shared function (TTLCalculator interface is for future use)
+	shouldDelete, reason := s.shouldDelete(evaluated, policy)
+	// Reasons outside the allowlist are deferred rather than acted on
+	if !shouldDelete || !reasonAllowedShared(&policy.Spec.Behavior, reason) {
+		return resourceVerdict{matched: true, pending: true, reason: skipReasonShared(shouldDelete, reason)}
+	}
+	return resourceVerdict{matched: true, deletable: true, reason: reason}
+}
+
+// deleteResourcesInBatches deletes resources in batches and returns the deleted and failed counts,
+// and the count of resources deferred to the next run by the policy's maxDeletionsPerRun cap,
+// its maxApiCalls budget, or paused deletions.
 func (s *PolicyEvaluationService) deleteResourcesInBatches(
 	ctx context.Context,
 	policy *v1alpha1.GarbageCollectionPolicy,
 	resourcesToDelete []*unstructured.Unstructured,
 	resourcesToDeleteReasons map[string]string,
-) int64 {
+) (deletedCount, failedCount, deferredCount int64) {
 	// Check context cancellation at start
 	select {
 	case <-ctx.Done():
 		s.logger.Debug("Stopping batch deletion: context canceled", sdklog.Operation("delete_batch"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)))
-		return 0
+		return 0, 0, 0
 	default:
 	}
 
 	rateLimiter := s.rateLimiterProvider.GetOrCreateRateLimiter(policy)
 	if rateLimiter == nil {
 		s.logger.Error(nil, "Rate limiter is nil, cannot proceed with deletions", sdklog.Operation("delete_batch"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.ErrorCode("RATE_LIMITER_NIL"))
-		return 0
+		return 0, 0, 0
 	}
 	batchSize := s.getBatchSize(policy)
-	deletedCount := int64(0)
+	batchInterval := batchIntervalShared(policy)
 
 	// Process deletions in batches
-	for i := 0; i < len(resourcesToDelete); i += batchSize {
+	for i := 0; i < len(resourcesToDelete); {
 		// Check context cancellation between batches
 		select {
 		case <-ctx.Done():
 			s.logger.Debug("Stopping batch deletion: context canceled", sdklog.Operation("delete_batch"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)))
-			return deletedCount
+			return deletedCount, failedCount, deferredCount
 		default:
 		}
 
@@ -246,15 +464,31 @@ func (s *PolicyEvaluationService) deleteResourcesInBatches(
 		if end > len(resourcesToDelete) {
 			end = len(resourcesToDelete)
 		}
+
+		// Stop at the per-run cap; the remaining candidates are retried next run
+		end = maxDeletionsBatchEndShared(policy, i, end, deletedCount)
+		if end == i {
+			deferredCount = int64(len(resourcesToDelete) - i)
+			recordMaxDeletionsPerRunReachedShared(s.eventRecorder, policy, deferredCount)
+			return deletedCount, failedCount, deferredCount
+		}
+
+		// Pace bursts: pause between batches, not before the first one
+		if i > 0 && !waitBatchIntervalShared(ctx, batchInterval) {
+			return deletedCount, failedCount, deferredCount
+		}
 		batch := resourcesToDelete[i:end]
+		i = end
 
 		// Delete batch using BatchDeleterCore interface
 		batchDeleted, batchErrors := s.batchDeleter.DeleteBatch(ctx, batch, policy, rateLimiter, resourcesToDeleteReasons)
+		batchErrors, batchDeferred := splitDeferredShared(batchErrors)
 		deletedCount += batchDeleted
+		failedCount += int64(len(batchErrors))
 
 		// Track deletion failures
 		if len(batchErrors) > 0 {
-			recordError(policy.Namespace, policy.Name, "deletion_failed")
+			recordError(policy.Namespace, policy.Name, gcerrors.TypeDeletionFailed)
 		}
 
 		// Log errors
@@ -264,15 +498,32 @@ func (s *PolicyEvaluationService) deleteResourcesInBatches(
 			}
 			s.logger.Error(err, "Error deleting batch for policy", sdklog.Operation("delete_batch"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.ErrorCode("DELETE_BATCH_FAILED"))
 		}
+
+		// Stop once the run's API call budget is spent; candidates not reached are retried next run
+		if apiCallBudgetExhausted(ctx) {
+			deferredCount = batchDeferred + int64(len(resourcesToDelete)-end)
+			if deferredCount > 0 {
+				recordAPICallBudgetExhaustedShared(s.eventRecorder, policy, deferredCount)
+			}
+			return deletedCount, failedCount, deferredCount
+		}
+
+		// Stop while deletions are paused rather than pacing batches that would all be deferred
+		if batchDeferred > 0 {
+			deferredCount = batchDeferred + int64(len(resourcesToDelete)-end)
+			s.logger.Debug("Deletions paused, deferring remaining batches", sdklog.Operation("delete_batch"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Int64("deferred", deferredCount))
+			return deletedCount, failedCount, deferredCount
+		}
 	}
-	return deletedCount
+	return deletedCount, failedCount, deferredCount
 }
 
 // updatePolicyStatus updates the policy status.
 func (s *PolicyEvaluationService) updatePolicyStatus(
 	ctx context.Context,
 	policy *v1alpha1.GarbageCollectionPolicy,
-	matchedCount, deletedCount, pendingCount int64,
+	matchedCount, deletedCount, pendingCount, oldestPendingAgeSeconds, deferredCount int64,
+	dryRunImpact *v1alpha1.DryRunImpact,
 ) error {
 	if s.statusUpdater == nil {
 		return nil
@@ -281,15 +532,15 @@ func (s *PolicyEvaluationService) updatePolicyStatus(
 	statusCtx, statusCancel := context.WithTimeout(ctx, 10*time.Second)
 	defer statusCancel()
 
-	if err := s.statusUpdater.UpdateStatus(statusCtx, policy, matchedCount, deletedCount, pendingCount); err != nil {
+	if err := s.statusUpdater.UpdateStatus(statusCtx, policy, matchedCount, deletedCount, pendingCount, oldestPendingAgeSeconds, deferredCount, dryRunImpact); err != nil {
 		if statusCtx.Err() != nil {
 			s.logger.Debug("Status update canceled or timed out", sdklog.Operation("update_status"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Error(statusCtx.Err()))
 			return nil
 		}
-		gcErr := gcerrors.Wrap(err, "status_update_failed", "failed to update policy status")
+		gcErr := gcerrors.Wrap(err, gcerrors.TypeStatusUpdateFailed, "failed to update policy status")
 		gcErr = gcErr.WithContext("policy_namespace", policy.Namespace)
 		gcErr = gcErr.WithContext("policy_name", policy.Name)
-		recordError(policy.Namespace, policy.Name, "status_update_failed")
+		recordError(policy.Namespace, policy.Name, gcerrors.TypeStatusUpdateFailed)
 		if s.eventRecorder != nil {
 			s.eventRecorder.RecordStatusUpdateFailed(policy, gcErr)
 		}
@@ -301,6 +552,11 @@ func (s *PolicyEvaluationService) updatePolicyStatus(
 
 // shouldDelete determines if a resource should be deleted based on TTL.
 func (s *PolicyEvaluationService) shouldDelete(resource *unstructured.Unstructured, policy *v1alpha1.GarbageCollectionPolicy) (shouldDelete bool, reason string) {
+	// Resource owners can protect individual objects from every policy
+	if protectedByAnnotationShared(resource, s.protectAnnotation) {
+		return false, ReasonProtectedByAnnotation
+	}
+
 	// Calculate expiration time using shared function
 	expirationTime, err := calculateExpirationTimeShared(resource, &policy.Spec.TTL)
 	if err != nil {
@@ -313,7 +569,12 @@ func (s *PolicyEvaluationService) shouldDelete(resource *unstructured.Unstructur
 	}
 
 	// Check if expired
-	if time.Now().After(expirationTime) {
+	now := time.Now()
+	if now.After(expirationTime) {
+		// A noticed resource is only deleted once its notice window has passed
+		if deletionNoticePendingShared(resource, policy, now) {
+			return false, ReasonDeletionNoticePending
+		}
 		return true, ReasonTTLExpired
 	}
 
@@ -39,6 +39,8 @@ type PolicyEvaluator interface {
 	getBatchSize(policy *v1alpha1.GarbageCollectionPolicy) int
 	deleteBatch(ctx context.Context, batch []*unstructured.Unstructured, policy *v1alpha1.GarbageCollectionPolicy, rateLimiter *ratelimiter.RateLimiter, reasons map[string]string) (int64, []error)
 	getStatusUpdater() *StatusUpdater
+	getNamespaceSampler() *NamespaceSampler
+	evaluationWorkers() int
 	GetEventRecorder() *EventRecorder
 }
 
@@ -49,8 +51,9 @@ func evaluatePolicyResourcesShared(
 	policy *v1alpha1.GarbageCollectionPolicy,
 	informer cache.SharedInformer,
 ) *PolicyEvaluationResult {
-	// Get all resources from cache
-	resources := informer.GetStore().List()
+	// Get candidate resources from cache, narrowed by informer indexes
+	resources := policyCandidatesShared(informer.GetStore(), policy)
+	resources = sampleNamespaceObjectsShared(evaluator.getNamespaceSampler(), policy, resources)
 
 	result := &PolicyEvaluationResult{
 		MatchedCount:             int64(0),
@@ -58,76 +61,113 @@ func evaluatePolicyResourcesShared(
 		PendingCount:             int64(0),
 		ResourcesToDelete:        make([]*unstructured.Unstructured, 0, len(resources)/10),
 		ResourcesToDeleteReasons: make(map[string]string, len(resources)/10),
+		Decisions:                newDecisionLog(policy),
 	}
 
 	resourceAPIVersion := policy.Spec.TargetResource.APIVersion
 	resourceKind := policy.Spec.TargetResource.Kind
 
-	logger := sdklog.NewLogger("zen-gc")
-	const contextCheckInterval = 100 // Check context every 100 iterations
-	for i, obj := range resources {
-		// Check context cancellation periodically to reduce overhead
-		if i%contextCheckInterval == 0 {
-			select {
-			case <-ctx.Done():
-				logger.Debug("Stopping policy evaluation: context canceled", sdklog.Operation("evaluate_policy"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)))
-				return result
-			default:
+	// Dedup, retention, and minRemaining need every matched resource up front to size their groups
+	if policy.Spec.Dedup != nil || policy.Spec.Retention != nil || policy.Spec.Behavior.MinRemaining != nil {
+		for _, obj := range resources {
+			if resource, ok := obj.(*unstructured.Unstructured); ok && evaluator.matchesSelectors(resource, &policy.Spec.TargetResource) {
+				result.Matched = append(result.Matched, resource)
 			}
 		}
+	}
 
-		resource, ok := obj.(*unstructured.Unstructured)
-		if !ok {
-			continue
+	// Only duplicates are deletable under dedup; the newest resource per key is kept
+	var duplicates map[*unstructured.Unstructured]struct{}
+	if policy.Spec.Dedup != nil {
+		duplicates = dedupDuplicatesShared(result.Matched, policy.Spec.Dedup)
+	}
+
+	// Retention keeps the newest keepLast resources per group
+	var retained map[*unstructured.Unstructured]struct{}
+	if policy.Spec.Retention != nil {
+		retained = retainedShared(result.Matched, policy.Spec.Retention)
+	}
+
+	// Matching is read-only, so resources are evaluated concurrently and aggregated in order
+	verdicts := evaluateConcurrentlyShared(ctx, len(resources), evaluator.evaluationWorkers(), func(i int) resourceVerdict {
+		resource, ok := resources[i].(*unstructured.Unstructured)
+		if !ok || !evaluator.matchesSelectors(resource, &policy.Spec.TargetResource) {
+			return resourceVerdict{}
 		}
+		if duplicates != nil {
+			if _, ok := duplicates[resource]; !ok {
+				return resourceVerdict{matched: true, reason: ReasonDedupKept}
+			}
+		}
+		if _, ok := retained[resource]; ok {
+			return resourceVerdict{matched: true, reason: ReasonRetentionKept}
+		}
+		// Check if resource should be deleted
+		shouldDelete, reason := evaluator.shouldDelete(resource, policy)
+		// Reasons outside the allowlist are deferred rather than acted on
+		if !shouldDelete || !reasonAllowedShared(&policy.Spec.Behavior, reason) {
+			return resourceVerdict{matched: true, pending: true, reason: skipReasonShared(shouldDelete, reason)}
+		}
+		return resourceVerdict{matched: true, deletable: true, reason: reason}
+	})
 
-		// Check if resource matches selectors
-		if !evaluator.matchesSelectors(resource, &policy.Spec.TargetResource) {
+	logger := sdklog.NewLogger("zen-gc")
+	for i, verdict := range verdicts {
+		if !verdict.evaluated {
+			logger.Debug("Stopping policy evaluation: context canceled", sdklog.Operation("evaluate_policy"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)))
+			return result
+		}
+		if !verdict.matched {
 			continue
 		}
 
+		resource := resources[i].(*unstructured.Unstructured)
 		result.MatchedCount++
 		recordResourceMatched(policy.Namespace, policy.Name, resourceAPIVersion, resourceKind)
 
-		// Check if resource should be deleted
-		shouldDelete, reason := evaluator.shouldDelete(resource, policy)
-		if !shouldDelete {
-			result.PendingCount++
+		if !verdict.deletable {
+			if verdict.pending {
+				result.PendingCount++
+				result.OldestPending.observe(resource)
+			}
+			result.Decisions.record(resource, verdict.reason)
 			continue
 		}
 
 		// Add to deletion list
 		result.ResourcesToDelete = append(result.ResourcesToDelete, resource)
-		result.ResourcesToDeleteReasons[string(resource.GetUID())] = reason
+		result.ResourcesToDeleteReasons[string(resource.GetUID())] = verdict.reason
 	}
 
 	return result
 }
 
-// deleteResourcesInBatchesShared deletes resources in batches.
+// deleteResourcesInBatchesShared deletes resources in batches and returns the deleted and failed counts,
+// and the count of resources deferred to the next run by the policy's maxDeletionsPerRun cap,
+// its maxApiCalls budget, or paused deletions.
 func deleteResourcesInBatchesShared(
 	ctx context.Context,
 	evaluator PolicyEvaluator,
 	policy *v1alpha1.GarbageCollectionPolicy,
 	resourcesToDelete []*unstructured.Unstructured,
 	resourcesToDeleteReasons map[string]string,
-) int64 {
+) (deletedCount, failedCount, deferredCount int64) {
 	if len(resourcesToDelete) == 0 {
-		return 0
+		return 0, 0, 0
 	}
 
 	rateLimiter := evaluator.getOrCreateRateLimiter(policy)
 	batchSize := evaluator.getBatchSize(policy)
-	deletedCount := int64(0)
+	batchInterval := batchIntervalShared(policy)
 
 	logger := sdklog.NewLogger("zen-gc")
 	// Process deletions in batches
-	for i := 0; i < len(resourcesToDelete); i += batchSize {
+	for i := 0; i < len(resourcesToDelete); {
 		// Check context cancellation between batches
 		select {
 		case <-ctx.Done():
 			logger.Debug("Stopping batch deletion: context canceled", sdklog.Operation("delete_batch"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)))
-			return deletedCount
+			return deletedCount, failedCount, deferredCount
 		default:
 		}
 
@@ -135,17 +175,33 @@ func deleteResourcesInBatchesShared(
 		if end > len(resourcesToDelete) {
 			end = len(resourcesToDelete)
 		}
+
+		// Stop at the per-run cap; the remaining candidates are retried next run
+		end = maxDeletionsBatchEndShared(policy, i, end, deletedCount)
+		if end == i {
+			deferredCount = int64(len(resourcesToDelete) - i)
+			recordMaxDeletionsPerRunReachedShared(evaluator.GetEventRecorder(), policy, deferredCount)
+			return deletedCount, failedCount, deferredCount
+		}
+
+		// Pace bursts: pause between batches, not before the first one
+		if i > 0 && !waitBatchIntervalShared(ctx, batchInterval) {
+			return deletedCount, failedCount, deferredCount
+		}
 		batch := resourcesToDelete[i:end]
+		i = end
 
 		// Delete batch
 		// Track deletion attempts (total resources in batch)
 		deletionAttempts := int64(len(batch))
 		batchDeleted, batchErrors := evaluator.deleteBatch(ctx, batch, policy, rateLimiter, resourcesToDeleteReasons)
+		batchErrors, batchDeferred := splitDeferredShared(batchErrors)
 		deletedCount += batchDeleted
+		failedCount += int64(len(batchErrors))
 
 		// Track deletion failures
 		if len(batchErrors) > 0 {
-			recordError(policy.Namespace, policy.Name, "deletion_failed")
+			recordError(policy.Namespace, policy.Name, gcerrors.TypeDeletionFailed)
 		}
 
 		// Log errors
@@ -159,9 +215,25 @@ func deleteResourcesInBatchesShared(
 
 		// Log deletion attempt metrics
 		logger.Debug("Policy deletion batch completed", sdklog.Operation("delete_batch"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Int64("attempted", deletionAttempts), sdklog.Int64("succeeded", batchDeleted), sdklog.Int64("failed", int64(len(batchErrors))))
+
+		// Stop once the run's API call budget is spent; candidates not reached are retried next run
+		if apiCallBudgetExhausted(ctx) {
+			deferredCount = batchDeferred + int64(len(resourcesToDelete)-end)
+			if deferredCount > 0 {
+				recordAPICallBudgetExhaustedShared(eventRecorder, policy, deferredCount)
+			}
+			return deletedCount, failedCount, deferredCount
+		}
+
+		// Stop while deletions are paused rather than pacing batches that would all be deferred
+		if batchDeferred > 0 {
+			deferredCount = batchDeferred + int64(len(resourcesToDelete)-end)
+			logger.Debug("Deletions paused, deferring remaining batches", sdklog.Operation("delete_batch"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Int64("deferred", deferredCount))
+			return deletedCount, failedCount, deferredCount
+		}
 	}
 
-	return deletedCount
+	return deletedCount, failedCount, deferredCount
 }
 
 // updatePolicyStatusShared updates the policy status.
@@ -169,7 +241,8 @@ func updatePolicyStatusShared(
 	ctx context.Context,
 	evaluator PolicyEvaluator,
 	policy *v1alpha1.GarbageCollectionPolicy,
-	matchedCount, deletedCount, pendingCount int64,
+	matchedCount, deletedCount, pendingCount, oldestPendingAgeSeconds, deferredCount int64,
+	dryRunImpact *v1alpha1.DryRunImpact,
 ) error {
 	statusUpdater := evaluator.getStatusUpdater()
 	if statusUpdater == nil {
@@ -181,16 +254,16 @@ func updatePolicyStatusShared(
 	defer statusCancel()
 
 	logger := sdklog.NewLogger("zen-gc")
-	if err := statusUpdater.UpdateStatus(statusCtx, policy, matchedCount, deletedCount, pendingCount); err != nil {
+	if err := statusUpdater.UpdateStatus(statusCtx, policy, matchedCount, deletedCount, pendingCount, oldestPendingAgeSeconds, deferredCount, dryRunImpact); err != nil {
 		// Check if error is due to context cancellation/timeout
 		if statusCtx.Err() != nil {
 			logger.Debug("Status update canceled or timed out", sdklog.Operation("update_status"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Error(statusCtx.Err()))
 			return nil // Don't treat cancellation as error
 		}
-		gcErr := gcerrors.Wrap(err, "status_update_failed", "failed to update policy status")
+		gcErr := gcerrors.Wrap(err, gcerrors.TypeStatusUpdateFailed, "failed to update policy status")
 		gcErr = gcErr.WithContext("policy_namespace", policy.Namespace)
 		gcErr = gcErr.WithContext("policy_name", policy.Name)
-		recordError(policy.Namespace, policy.Name, "status_update_failed")
+		recordError(policy.Namespace, policy.Name, gcerrors.TypeStatusUpdateFailed)
 		eventRecorder := evaluator.GetEventRecorder()
 		if eventRecorder != nil {
 			eventRecorder.RecordStatusUpdateFailed(policy, gcErr)
@@ -25,4 +25,9 @@ type PolicyEvaluationResult struct {
 	PendingCount             int64
 	ResourcesToDelete        []*unstructured.Unstructured
 	ResourcesToDeleteReasons map[string]string
+	OldestPending            oldestPending
+	Decisions                *decisionLog
+
+	// Matched holds every matched resource, collected only for dedup and minRemaining policies
+	Matched []*unstructured.Unstructured
 }
@@ -0,0 +1,94 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	gcerrors "github.com/kube-zen/zen-gc/pkg/errors"
+)
+
+// ReasonEvaluationFailed is the Degraded reason of a policy whose evaluation failed with an
+// error of no more specific type.
+const ReasonEvaluationFailed = "EvaluationFailed"
+
+// EvaluationFailures counts consecutive failed evaluations per policy, so a policy is only
+// marked Degraded once its failures persist. A nil *EvaluationFailures counts nothing.
+type EvaluationFailures struct {
+	policies map[types.UID]int
+	mu       sync.Mutex
+}
+
+// NewEvaluationFailures creates a new EvaluationFailures.
+func NewEvaluationFailures() *EvaluationFailures {
+	return &EvaluationFailures{
+		policies: make(map[types.UID]int),
+	}
+}
+
+// Record counts a failed evaluation and returns the number of consecutive failures.
+func (f *EvaluationFailures) Record(policyUID types.UID) int {
+	if f == nil {
+		return 1
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.policies[policyUID]++
+	return f.policies[policyUID]
+}
+
+// Forget resets the count after a successful evaluation or the policy's deletion.
+func (f *EvaluationFailures) Forget(policyUID types.UID) {
+	if f == nil {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.policies, policyUID)
+}
+
+// reasonAcronyms are error type words written in capitals in condition reasons.
+var reasonAcronyms = map[string]string{"api": "API", "gvr": "GVR", "opa": "OPA", "url": "URL"}
+
+// evaluationErrorReasonShared converts an error type such as "list_resources_failed" into
+// the Degraded reason "ListResourcesFailed". Untyped errors get ReasonEvaluationFailed.
+func evaluationErrorReasonShared(errorType string) string {
+	if errorType == "" || errorType == gcerrors.TypeUnknown {
+		return ReasonEvaluationFailed
+	}
+	var reason strings.Builder
+	for _, word := range strings.Split(errorType, "_") {
+		if word == "" {
+			continue
+		}
+		if acronym, ok := reasonAcronyms[word]; ok {
+			reason.WriteString(acronym)
+			continue
+		}
+		reason.WriteString(strings.ToUpper(word[:1]))
+		reason.WriteString(word[1:])
+	}
+	if reason.Len() == 0 {
+		return ReasonEvaluationFailed
+	}
+	return reason.String()
+}
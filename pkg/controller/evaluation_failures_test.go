@@ -0,0 +1,117 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kube-zen/zen-gc/pkg/config"
+	gcerrors "github.com/kube-zen/zen-gc/pkg/errors"
+)
+
+func TestEvaluationErrorReasonShared(t *testing.T) {
+	tests := []struct {
+		errorType string
+		expected  string
+	}{
+		{errorType: gcerrors.TypeListResourcesFailed, expected: "ListResourcesFailed"},
+		{errorType: gcerrors.TypeEvaluationFailed, expected: ReasonEvaluationFailed},
+		{errorType: gcerrors.TypeInvalidGVR, expected: "InvalidGVR"},
+		{errorType: gcerrors.TypeUnknown, expected: ReasonEvaluationFailed},
+		{errorType: gcerrors.TypeAPIGroupNotAllowed, expected: "APIGroupNotAllowed"},
+		{errorType: gcerrors.TypeOPAURLNotAllowed, expected: ReasonOPAURLNotAllowed},
+		{errorType: gcerrors.TypeResultWebhookURLNotAllowed, expected: ReasonResultWebhookURLNotAllowed},
+		{errorType: "", expected: ReasonEvaluationFailed},
+		{errorType: "_", expected: ReasonEvaluationFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.errorType, func(t *testing.T) {
+			if got := evaluationErrorReasonShared(tt.errorType); got != tt.expected {
+				t.Errorf("evaluationErrorReasonShared(%q) = %q, want %q", tt.errorType, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestHandleEvaluationError_DegradesAfterConfiguredFailures(t *testing.T) {
+	ctx := context.Background()
+	updater, dynamicClient, policy := setupConditionsTest(t)
+	scheme := runtime.NewScheme()
+	reconciler := NewGCPolicyReconcilerWithRESTMapper(
+		clientfake.NewClientBuilder().WithScheme(scheme).Build(),
+		scheme,
+		dynamicClient,
+		nil,
+		updater,
+		NewEventRecorder(nil),
+		config.NewControllerConfig().WithDegradedAfterFailures(2),
+	)
+	evalErr := gcerrors.Wrap(errors.New("connection refused"), gcerrors.TypeListResourcesFailed, "failed to list resources")
+
+	// A single failure may be transient
+	if _, err := reconciler.handleEvaluationError(ctx, evalErr, policy); err != nil {
+		t.Fatalf("handleEvaluationError() returned error: %v", err)
+	}
+	if _, conditions := getPolicyConditions(t, dynamicClient); meta.FindStatusCondition(conditions, ConditionTypeDegraded) != nil {
+		t.Errorf("after one failure: conditions=%+v, want no Degraded condition yet", conditions)
+	}
+
+	// The second failure in a row marks the policy Degraded
+	if _, err := reconciler.handleEvaluationError(ctx, evalErr, policy); err != nil {
+		t.Fatalf("handleEvaluationError() returned error: %v", err)
+	}
+	phase, conditions := getPolicyConditions(t, dynamicClient)
+	degraded := meta.FindStatusCondition(conditions, ConditionTypeDegraded)
+	if phase != PolicyPhaseError || degraded == nil || degraded.Status != metav1.ConditionTrue || degraded.Reason != "ListResourcesFailed" {
+		t.Errorf("after two failures: phase=%s Degraded=%+v, want Error with Degraded=True (ListResourcesFailed)", phase, degraded)
+	}
+	if !meta.IsStatusConditionFalse(conditions, ConditionTypeReady) {
+		t.Errorf("after two failures: conditions=%+v, want Ready=False", conditions)
+	}
+
+	// Further identical failures do not rewrite the status
+	writes := countActions(dynamicClient, "update", "status")
+	if _, err := reconciler.handleEvaluationError(ctx, evalErr, policy); err != nil {
+		t.Fatalf("handleEvaluationError() returned error: %v", err)
+	}
+	if n := countActions(dynamicClient, "update", "status"); n != writes {
+		t.Errorf("%d status writes for an unchanged Degraded condition, want none", n-writes)
+	}
+
+	// A successful evaluation recovers the policy and resets the count
+	reconciler.evaluationFailures.Forget(policy.UID)
+	if err := updater.UpdateStatus(ctx, policy, 1, 0, 0, 0, 0, nil); err != nil {
+		t.Fatalf("UpdateStatus() returned error: %v", err)
+	}
+	phase, conditions = getPolicyConditions(t, dynamicClient)
+	if phase != PolicyPhaseActive || !meta.IsStatusConditionTrue(conditions, ConditionTypeReady) || !meta.IsStatusConditionFalse(conditions, ConditionTypeDegraded) {
+		t.Errorf("after recovery: phase=%s conditions=%+v, want Active and Ready", phase, conditions)
+	}
+	if _, err := reconciler.handleEvaluationError(ctx, evalErr, policy); err != nil {
+		t.Fatalf("handleEvaluationError() returned error: %v", err)
+	}
+	if _, conditions := getPolicyConditions(t, dynamicClient); !meta.IsStatusConditionFalse(conditions, ConditionTypeDegraded) {
+		t.Errorf("after one failure following recovery: conditions=%+v, want Degraded=False", conditions)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	gcerrors "github.com/kube-zen/zen-gc/pkg/errors"
 	sdkevents "github.com/kube-zen/zen-sdk/pkg/events"
 )
 
@@ -43,6 +44,8 @@ func (er *EventRecorder) RecordPolicyEvaluated(
 }
 
 // RecordResourceDeleted records that a resource was deleted.
+// The message names the policy that claimed the resource, so deletions stay
+// attributable when several policies match the same resource.
 // Events for CRDs may not be supported by all Kubernetes clusters.
 // This function logs errors but does not fail if event recording fails.
 func (er *EventRecorder) RecordResourceDeleted(
@@ -58,8 +61,8 @@ func (er *EventRecorder) RecordResourceDeleted(
 		policy,
 		corev1.EventTypeNormal,
 		"ResourceDeleted",
-		"Deleted resource %s (reason: %s)",
-		sdkevents.GetResourceName(resource), reason,
+		"Deleted resource %s (reason: %s, policy: %s/%s)",
+		sdkevents.GetResourceName(resource), reason, policy.Namespace, policy.Name,
 	)
 }
 
@@ -78,8 +81,8 @@ func (er *EventRecorder) RecordEvaluationFailed(
 		policy,
 		corev1.EventTypeWarning,
 		"EvaluationFailed",
-		"Failed to evaluate policy: %v",
-		err,
+		"Failed to evaluate policy: %s",
+		gcerrors.Format(err),
 	)
 }
 
@@ -98,8 +101,89 @@ func (er *EventRecorder) RecordStatusUpdateFailed(
 		policy,
 		corev1.EventTypeWarning,
 		"StatusUpdateFailed",
-		"Failed to update policy status: %v",
-		err,
+		"Failed to update policy status: %s",
+		gcerrors.Format(err),
+	)
+}
+
+// RecordMaxDeletionsPerRunReached records that a run stopped deleting at the policy's
+// maxDeletionsPerRun cap, deferring the remaining candidates to the next run.
+// Events for CRDs may not be supported by all Kubernetes clusters.
+// This function logs errors but does not fail if event recording fails.
+func (er *EventRecorder) RecordMaxDeletionsPerRunReached(
+	policy *v1alpha1.GarbageCollectionPolicy,
+	deferred int64,
+) {
+	if er == nil || er.Recorder == nil {
+		return
+	}
+	// Event recording for CRDs may fail - log but don't fail
+	er.Eventf(
+		policy,
+		corev1.EventTypeWarning,
+		"MaxDeletionsPerRunReached",
+		"Stopped after %d deletions (maxDeletionsPerRun), deferred=%d",
+		policy.Spec.Behavior.MaxDeletionsPerRun, deferred,
+	)
+}
+
+// RecordAPICallBudgetExhausted records that a run stopped at the policy's maxApiCalls
+// budget, deferring the remaining candidates to the next run.
+// This function logs errors but does not fail if event recording fails.
+func (er *EventRecorder) RecordAPICallBudgetExhausted(
+	policy *v1alpha1.GarbageCollectionPolicy,
+	deferred int64,
+) {
+	if er == nil || er.Recorder == nil {
+		return
+	}
+	// Event recording for CRDs may fail - log but don't fail
+	er.Eventf(
+		policy,
+		corev1.EventTypeWarning,
+		"APICallBudgetExhausted",
+		"Stopped after %d API calls (maxApiCalls), deferred=%d",
+		policy.Spec.Behavior.MaxAPICalls, deferred,
+	)
+}
+
+// RecordPolicyMatchedNothing records that a policy matched no resources in its last
+// emptyRuns evaluations, which usually means a typo'd target or selector.
+// This function logs errors but does not fail if event recording fails.
+func (er *EventRecorder) RecordPolicyMatchedNothing(
+	policy *v1alpha1.GarbageCollectionPolicy,
+	emptyRuns int,
+) {
+	if er == nil || er.Recorder == nil {
+		return
+	}
+	// Event recording for CRDs may fail - log but don't fail
+	er.Eventf(
+		policy,
+		corev1.EventTypeWarning,
+		"PolicyMatchedNothing",
+		"Matched no resources in %d consecutive evaluations; check targetResource and its selectors",
+		emptyRuns,
+	)
+}
+
+// RecordPendingDeletion records on a resource that it will be deleted by the policy
+// once deleteAfter (RFC 3339) has passed, giving its owner a window to protect it.
+// This function logs errors but does not fail if event recording fails.
+func (er *EventRecorder) RecordPendingDeletion(
+	policy *v1alpha1.GarbageCollectionPolicy,
+	resource runtime.Object,
+	deleteAfter string,
+) {
+	if er == nil || er.Recorder == nil {
+		return
+	}
+	er.Eventf(
+		resource,
+		corev1.EventTypeWarning,
+		"PendingDeletion",
+		"Resource will be deleted after %s by policy %s/%s",
+		deleteAfter, policy.Namespace, policy.Name,
 	)
 }
 
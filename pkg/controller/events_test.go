@@ -229,3 +229,18 @@ func TestEventSinkWrapper_Patch(t *testing.T) {
 	// Should not panic
 	recorder.Eventf(policy, corev1.EventTypeNormal, "TestReason", "Test message with format: %s", "value")
 }
+
+func TestEventRecorder_RecordPolicyMatchedNothing(t *testing.T) {
+	recorder := NewEventRecorder(nil)
+	policy := &v1alpha1.GarbageCollectionPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-policy",
+			Namespace: "default",
+		},
+	}
+	// Should not panic
+	recorder.RecordPolicyMatchedNothing(policy, 10)
+
+	var nilRecorder *EventRecorder
+	nilRecorder.RecordPolicyMatchedNothing(policy, 10)
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"path"
+)
+
+// namespaceExcludedShared reports whether a namespace matches any of the patterns, each a
+// namespace name or a glob such as "kube-*". Cluster-scoped resources have no namespace
+// and are never excluded.
+func namespaceExcludedShared(namespace string, patterns []string) bool {
+	if namespace == "" {
+		return false
+	}
+	for _, pattern := range patterns {
+		if matched, err := path.Match(pattern, namespace); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// protectedNamespaces returns the namespaces excluded from every policy, none if the
+// reconciler has no config.
+func (r *GCPolicyReconciler) protectedNamespaces() []string {
+	if r.config == nil {
+		return nil
+	}
+	return r.config.ProtectedNamespaces
+}
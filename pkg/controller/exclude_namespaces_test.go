@@ -0,0 +1,111 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/config"
+)
+
+func TestMatchesSelectorsShared_ExcludeNamespaces(t *testing.T) {
+	tests := []struct {
+		name          string
+		namespace     string
+		target        *v1alpha1.TargetResourceSpec
+		expectedMatch bool
+	}{
+		{
+			name:          "empty list places no restriction",
+			namespace:     "kube-system",
+			target:        &v1alpha1.TargetResourceSpec{},
+			expectedMatch: true,
+		},
+		{
+			name:          "listed namespace is excluded",
+			namespace:     "kube-system",
+			target:        &v1alpha1.TargetResourceSpec{ExcludeNamespaces: []string{"default", "kube-system"}},
+			expectedMatch: false,
+		},
+		{
+			name:          "unlisted namespace matches",
+			namespace:     "team-a",
+			target:        &v1alpha1.TargetResourceSpec{ExcludeNamespaces: []string{"kube-system"}},
+			expectedMatch: true,
+		},
+		{
+			name:          "glob excludes matching namespaces",
+			namespace:     "kube-node-lease",
+			target:        &v1alpha1.TargetResourceSpec{ExcludeNamespaces: []string{"kube-*"}},
+			expectedMatch: false,
+		},
+		{
+			name:          "glob does not exclude other namespaces",
+			namespace:     "my-kube-apps",
+			target:        &v1alpha1.TargetResourceSpec{ExcludeNamespaces: []string{"kube-*"}},
+			expectedMatch: true,
+		},
+		{
+			name:          "exclusion applies to an explicitly targeted namespace",
+			namespace:     "kube-public",
+			target:        &v1alpha1.TargetResourceSpec{Namespace: "kube-public", ExcludeNamespaces: []string{"kube-*"}},
+			expectedMatch: false,
+		},
+		{
+			name:          "cluster-scoped resources are never excluded",
+			namespace:     "",
+			target:        &v1alpha1.TargetResourceSpec{ExcludeNamespaces: []string{"*"}},
+			expectedMatch: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchesSelectorsShared(newTestConfigMap(tt.namespace, "cm", time.Time{}), tt.target); got != tt.expectedMatch {
+				t.Errorf("matchesSelectorsShared() = %v, want %v", got, tt.expectedMatch)
+			}
+		})
+	}
+}
+
+func TestGCPolicyReconciler_matchesSelectors_ProtectedNamespaces(t *testing.T) {
+	target := &v1alpha1.TargetResourceSpec{Namespace: "*"}
+
+	unconfigured := &GCPolicyReconciler{}
+	if !unconfigured.matchesSelectors(newTestConfigMap("kube-system", "cm", time.Time{}), target) {
+		t.Error("Expected no namespace to be protected without a config")
+	}
+
+	reconciler := &GCPolicyReconciler{
+		config: config.NewControllerConfig().WithProtectedNamespaces([]string{"kube-*", "cert-manager"}),
+	}
+	tests := []struct {
+		namespace     string
+		expectedMatch bool
+	}{
+		{namespace: "kube-system", expectedMatch: false},
+		{namespace: "cert-manager", expectedMatch: false},
+		{namespace: "default", expectedMatch: true},
+	}
+	for _, tt := range tests {
+		if got := reconciler.matchesSelectors(newTestConfigMap(tt.namespace, "cm", time.Time{}), target); got != tt.expectedMatch {
+			t.Errorf("matchesSelectors() in %s = %v, want %v", tt.namespace, got, tt.expectedMatch)
+		}
+	}
+}
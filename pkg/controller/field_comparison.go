@@ -0,0 +1,121 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"cmp"
+	"math"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// isFieldComparisonOperator reports whether a field condition operator orders its operands.
+func isFieldComparisonOperator(operator string) bool {
+	switch operator {
+	case OperatorGreaterThan, OperatorGreaterThanOrEqual, OperatorLessThan, OperatorLessThanOrEqual:
+		return true
+	}
+	return false
+}
+
+// meetsFieldComparisonShared compares the field at the condition's fieldPath with the condition's value.
+// Both operands are compared as numbers when they parse as such, so integer and float
+// fields work as well as numeric strings; otherwise both must be RFC3339 timestamps.
+// A missing field, or operands that are neither, never match.
+func meetsFieldComparisonShared(resource *unstructured.Unstructured, cond v1alpha1.FieldCondition) bool {
+	value, found, err := nestedFieldNoCopy(resource.Object, cond.FieldPath)
+	if err != nil || !found {
+		return false
+	}
+
+	if fieldNumber, ok := fieldComparisonNumber(value); ok {
+		if condNumber, err := strconv.ParseFloat(cond.Value, 64); err == nil && !math.IsNaN(condNumber) {
+			return matchesComparisonOperator(cmp.Compare(fieldNumber, condNumber), cond.Operator)
+		}
+	}
+
+	fieldString, ok := value.(string)
+	if !ok {
+		return false
+	}
+	fieldTime, err := time.Parse(time.RFC3339, fieldString)
+	if err != nil {
+		return false
+	}
+	condTime, err := time.Parse(time.RFC3339, cond.Value)
+	if err != nil {
+		return false
+	}
+	return matchesComparisonOperator(fieldTime.Compare(condTime), cond.Operator)
+}
+
+// fieldComparisonNumber returns a field value as a number: JSON numbers as they are and
+// strings when they parse as one.
+func fieldComparisonNumber(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case float64:
+		return v, !math.IsNaN(v)
+	case float32:
+		return float64(v), !math.IsNaN(float64(v))
+	case string:
+		number, err := strconv.ParseFloat(v, 64)
+		return number, err == nil && !math.IsNaN(number)
+	default:
+		return 0, false
+	}
+}
+
+// matchesComparisonOperator applies a comparison operator to the result of comparing the
+// field with the condition's value.
+func matchesComparisonOperator(result int, operator string) bool {
+	switch operator {
+	case OperatorGreaterThan:
+		return result > 0
+	case OperatorGreaterThanOrEqual:
+		return result >= 0
+	case OperatorLessThan:
+		return result < 0
+	case OperatorLessThanOrEqual:
+		return result <= 0
+	default:
+		return false
+	}
+}
+
+// meetsLabelComparisonShared compares a label value with the condition's value as numbers,
+// e.g. a "revision" label LessThan "37". Label values that are not numbers never match.
+func meetsLabelComparisonShared(value string, cond v1alpha1.LabelCondition) bool {
+	labelNumber, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(labelNumber) {
+		return false
+	}
+	condNumber, err := strconv.ParseFloat(cond.Value, 64)
+	if err != nil || math.IsNaN(condNumber) {
+		return false
+	}
+	return matchesComparisonOperator(cmp.Compare(labelNumber, condNumber), cond.Operator)
+}
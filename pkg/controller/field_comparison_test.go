@@ -0,0 +1,103 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func TestMeetsFieldConditionsShared_Comparison(t *testing.T) {
+	resource := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"spec": map[string]interface{}{
+				"replicas": int64(0),
+				"ratio":    0.75,
+				"priority": "10",
+				"name":     "web",
+			},
+			"status": map[string]interface{}{
+				"completionTime": "2026-01-15T10:00:00Z",
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		condition v1alpha1.FieldCondition
+		want      bool
+	}{
+		{name: "int64 field equal bound", condition: v1alpha1.FieldCondition{FieldPath: "spec.replicas", Operator: OperatorLessThanOrEqual, Value: "0"}, want: true},
+		{name: "int64 field not greater", condition: v1alpha1.FieldCondition{FieldPath: "spec.replicas", Operator: OperatorGreaterThan, Value: "0"}, want: false},
+		{name: "float64 field", condition: v1alpha1.FieldCondition{FieldPath: "spec.ratio", Operator: OperatorGreaterThan, Value: "0.5"}, want: true},
+		{name: "numeric string field", condition: v1alpha1.FieldCondition{FieldPath: "spec.priority", Operator: OperatorGreaterThanOrEqual, Value: "9.5"}, want: true},
+		{name: "numeric not lexical order", condition: v1alpha1.FieldCondition{FieldPath: "spec.priority", Operator: OperatorLessThan, Value: "9"}, want: false},
+		{name: "timestamp before", condition: v1alpha1.FieldCondition{FieldPath: "status.completionTime", Operator: OperatorLessThan, Value: "2026-02-01T00:00:00Z"}, want: true},
+		{name: "timestamp with offset", condition: v1alpha1.FieldCondition{FieldPath: "status.completionTime", Operator: OperatorGreaterThanOrEqual, Value: "2026-01-15T11:00:00+01:00"}, want: true},
+		{name: "timestamp after", condition: v1alpha1.FieldCondition{FieldPath: "status.completionTime", Operator: OperatorGreaterThan, Value: "2026-02-01T00:00:00Z"}, want: false},
+		{name: "non-numeric non-timestamp field", condition: v1alpha1.FieldCondition{FieldPath: "spec.name", Operator: OperatorGreaterThan, Value: "1"}, want: false},
+		{name: "number against timestamp", condition: v1alpha1.FieldCondition{FieldPath: "spec.replicas", Operator: OperatorLessThan, Value: "2026-02-01T00:00:00Z"}, want: false},
+		{name: "missing field", condition: v1alpha1.FieldCondition{FieldPath: "spec.missing", Operator: OperatorLessThan, Value: "1"}, want: false},
+		{name: "object field", condition: v1alpha1.FieldCondition{FieldPath: "spec", Operator: OperatorLessThan, Value: "1"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := meetsFieldConditionsShared(resource, []v1alpha1.FieldCondition{tt.condition}); got != tt.want {
+				t.Errorf("meetsFieldConditionsShared() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMeetsLabelConditionsShared_Comparison(t *testing.T) {
+	resource := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	resource.SetLabels(map[string]string{
+		"revision": "42",
+		"weight":   "0.5",
+		"channel":  "stable",
+		"build":    "",
+	})
+
+	tests := []struct {
+		name      string
+		condition v1alpha1.LabelCondition
+		want      bool
+	}{
+		{name: "less than", condition: v1alpha1.LabelCondition{Key: "revision", Operator: OperatorLessThan, Value: "50"}, want: true},
+		{name: "not less than", condition: v1alpha1.LabelCondition{Key: "revision", Operator: OperatorLessThan, Value: "37"}, want: false},
+		{name: "numeric not lexical order", condition: v1alpha1.LabelCondition{Key: "revision", Operator: OperatorGreaterThan, Value: "9"}, want: true},
+		{name: "equal bound inclusive", condition: v1alpha1.LabelCondition{Key: "revision", Operator: OperatorGreaterThanOrEqual, Value: "42"}, want: true},
+		{name: "equal bound exclusive", condition: v1alpha1.LabelCondition{Key: "revision", Operator: OperatorGreaterThan, Value: "42"}, want: false},
+		{name: "decimal label", condition: v1alpha1.LabelCondition{Key: "weight", Operator: OperatorLessThanOrEqual, Value: "1"}, want: true},
+		{name: "non-numeric label", condition: v1alpha1.LabelCondition{Key: "channel", Operator: OperatorLessThan, Value: "100"}, want: false},
+		{name: "empty label", condition: v1alpha1.LabelCondition{Key: "build", Operator: OperatorLessThan, Value: "100"}, want: false},
+		{name: "missing label", condition: v1alpha1.LabelCondition{Key: "missing", Operator: OperatorLessThan, Value: "100"}, want: false},
+		{name: "non-numeric value", condition: v1alpha1.LabelCondition{Key: "revision", Operator: OperatorLessThan, Value: "latest"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := meetsLabelConditionsShared(resource, []v1alpha1.LabelCondition{tt.condition}); got != tt.want {
+				t.Errorf("meetsLabelConditionsShared() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
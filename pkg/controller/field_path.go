@@ -1,13 +1,188 @@
 package controller
 
-import "strings"
+import (
+	"fmt"
+	"strings"
 
-// parseFieldPath parses a dot-separated field path into a slice for nested field access.
-// Example: "spec.severity" -> ["spec", "severity"].
-// Example: "status.lastProcessedAt" -> ["status", "lastProcessedAt"].
-func parseFieldPath(path string) []string {
-	if path == "" {
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/validation"
+	sdkttl "github.com/kube-zen/zen-sdk/pkg/gc/ttl"
+)
+
+// parseFieldPath parses a field path into its segments for nested field access, or returns
+// nil if the path is empty or malformed.
+// Example: "spec.severity" -> [spec severity].
+// Example: "status.conditions[0].status" -> [status conditions 0 status].
+// Example: "metadata.annotations['gc.kube-zen.io/ttl']" -> [metadata annotations gc.kube-zen.io/ttl].
+func parseFieldPath(path string) []validation.FieldPathSegment {
+	segments, err := validation.ParseFieldPath(path)
+	if err != nil {
 		return nil
 	}
-	return strings.Split(path, ".")
+	return segments
+}
+
+// fieldPathKeys returns the keys of a path that has no array indices, for use with the
+// unstructured helpers, or false if the path is empty, malformed, or indexes an array.
+func fieldPathKeys(path string) ([]string, bool) {
+	segments := parseFieldPath(path)
+	if segments == nil {
+		return nil, false
+	}
+	keys := make([]string, len(segments))
+	for i, segment := range segments {
+		if segment.IsIndex || segment.IsFilter {
+			return nil, false
+		}
+		keys[i] = segment.Key
+	}
+	return keys, true
+}
+
+// nestedFieldNoCopy returns the value at a field path without copying it, walking maps by
+// key and arrays by index or key=value filter. Like unstructured.NestedFieldNoCopy, a
+// missing key, an index out of range, or a filter matching no element is reported as not
+// found, while a path through a value of the wrong type or a malformed path is an error.
+func nestedFieldNoCopy(obj map[string]interface{}, path string) (interface{}, bool, error) {
+	segments, err := validation.ParseFieldPath(path)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var value interface{} = obj
+	for _, segment := range segments {
+		if segment.IsIndex {
+			items, ok := value.([]interface{})
+			if !ok {
+				return nil, false, fmt.Errorf("%s: [%d] indexes %T, not an array", path, segment.Index, value)
+			}
+			if segment.Index >= len(items) {
+				return nil, false, nil
+			}
+			value = items[segment.Index]
+			continue
+		}
+		if segment.IsFilter {
+			items, ok := value.([]interface{})
+			if !ok {
+				return nil, false, fmt.Errorf("%s: [%s=%s] filters %T, not an array", path, segment.Key, segment.Value, value)
+			}
+			if value, ok = filterArrayElement(items, segment.Key, segment.Value); !ok {
+				return nil, false, nil
+			}
+			continue
+		}
+		fields, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false, fmt.Errorf("%s: %q accesses %T, not an object", path, segment.Key, value)
+		}
+		if value, ok = fields[segment.Key]; !ok {
+			return nil, false, nil
+		}
+	}
+	return value, true, nil
+}
+
+// filterArrayElement returns the first object in items whose field key holds value. Scalar
+// fields compare by their string form, so "[port=80]" matches a numeric port.
+func filterArrayElement(items []interface{}, key, value string) (interface{}, bool) {
+	for _, item := range items {
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		switch field := fields[key].(type) {
+		case nil, map[string]interface{}, []interface{}:
+			continue
+		default:
+			if fmt.Sprint(field) == value {
+				return item, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// nestedString returns the string at a field path, like unstructured.NestedString but
+// through nestedFieldNoCopy.
+func nestedString(obj map[string]interface{}, path string) (string, bool, error) {
+	value, found, err := nestedFieldNoCopy(obj, path)
+	if !found || err != nil {
+		return "", found, err
+	}
+	s, ok := value.(string)
+	if !ok {
+		return "", false, fmt.Errorf("%s accessor error: %v is of the type %T, expected string", path, value, value)
+	}
+	return s, true, nil
+}
+
+// isPlainFieldPath reports whether a path is a plain dotted path whose keys contain no dots,
+// which the zen-sdk TTL evaluator can split on dots itself.
+func isPlainFieldPath(path string) bool {
+	keys, ok := fieldPathKeys(path)
+	if !ok {
+		return false
+	}
+	for _, key := range keys {
+		if strings.Contains(key, ".") {
+			return false
+		}
+	}
+	return true
+}
+
+// Top-level keys that TTL field values the zen-sdk evaluator cannot reach are resolved under.
+const (
+	sdkTTLFieldPathKey  = "__gcTTLFieldPath"
+	sdkTTLRelativeToKey = "__gcTTLRelativeTo"
+)
+
+// resolveSDKTTLFields prepares a resource and TTL spec for the zen-sdk evaluator, which
+// splits field paths on dots. A fieldPath or relativeTo it cannot split, one with array
+// indices or dotted keys, is resolved here: its value is placed under a top-level key of a
+// shallow copy of the resource and the spec is pointed at that key. Plain paths are left
+// to the evaluator unchanged.
+func resolveSDKTTLFields(resource *unstructured.Unstructured, spec *sdkttl.Spec) (*unstructured.Unstructured, *sdkttl.Spec) {
+	resolveFieldPath := spec.FieldPath != "" && !isPlainFieldPath(spec.FieldPath)
+	resolveRelativeTo := spec.RelativeTo != "" && !isPlainFieldPath(spec.RelativeTo)
+	if !resolveFieldPath && !resolveRelativeTo {
+		return resource, spec
+	}
+
+	object := make(map[string]interface{}, len(resource.Object)+2)
+	for key, value := range resource.Object {
+		object[key] = value
+	}
+	resolved := *spec
+	if resolveFieldPath {
+		resolved.FieldPath = sdkTTLFieldPathKey
+		if value, found, err := nestedFieldNoCopy(resource.Object, spec.FieldPath); found && err == nil {
+			object[sdkTTLFieldPathKey] = value
+		}
+	}
+	if resolveRelativeTo {
+		resolved.RelativeTo = sdkTTLRelativeToKey
+		if value, found, err := nestedFieldNoCopy(resource.Object, spec.RelativeTo); found && err == nil {
+			object[sdkTTLRelativeToKey] = value
+		}
+	}
+	return &unstructured.Unstructured{Object: object}, &resolved
+}
+
+// resolveSDKTTLLabel prepares a resource and TTL spec for the zen-sdk evaluator like
+// resolveSDKTTLFields, pointing the spec's fieldPath at the value of the label labelKey.
+// A resource without the label gets the spec's default, as a missing field does.
+func resolveSDKTTLLabel(resource *unstructured.Unstructured, spec *sdkttl.Spec, labelKey string) (*unstructured.Unstructured, *sdkttl.Spec) {
+	object := make(map[string]interface{}, len(resource.Object)+1)
+	for key, value := range resource.Object {
+		object[key] = value
+	}
+	if value, ok := resource.GetLabels()[labelKey]; ok {
+		object[sdkTTLFieldPathKey] = value
+	}
+	resolved := *spec
+	resolved.FieldPath = sdkTTLFieldPathKey
+	return &unstructured.Unstructured{Object: object}, &resolved
 }
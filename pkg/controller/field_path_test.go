@@ -1,7 +1,15 @@
 package controller
 
 import (
+	"reflect"
 	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/validation"
 )
 
 func TestParseFieldPath(t *testing.T) {
@@ -45,10 +53,160 @@ func TestParseFieldPath(t *testing.T) {
 				return
 			}
 			for i := range result {
-				if result[i] != tt.expected[i] {
-					t.Errorf("parseFieldPath(%q)[%d] = %q, want %q", tt.input, i, result[i], tt.expected[i])
+				if result[i] != (validation.FieldPathSegment{Key: tt.expected[i]}) {
+					t.Errorf("parseFieldPath(%q)[%d] = %+v, want key %q", tt.input, i, result[i], tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func fieldPathTestResource() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]interface{}{
+			"name":              "test",
+			"creationTimestamp": "2025-01-01T00:00:00Z",
+			"annotations": map[string]interface{}{
+				"gc.kube-zen.io/ttl":          "short",
+				"gc.kube-zen.io/processed-at": "2025-01-01T06:00:00Z",
+			},
+			"labels": map[string]interface{}{
+				"app.kubernetes.io/name": "web",
+			},
+		},
+		"spec": map[string]interface{}{
+			"severity": "LOW",
+			"containers": []interface{}{
+				map[string]interface{}{"name": "app", "image": "nginx:1.27"},
+			},
+		},
+		"status": map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "False"},
+			},
+		},
+	}}
+}
+
+func TestNestedFieldNoCopy(t *testing.T) {
+	resource := fieldPathTestResource()
+
+	tests := []struct {
+		name      string
+		path      string
+		expected  interface{}
+		found     bool
+		expectErr bool
+	}{
+		{name: "plain dotted path", path: "spec.severity", expected: "LOW", found: true},
+		{name: "array index", path: "status.conditions[0].status", expected: "False", found: true},
+		{name: "array element field", path: "spec.containers[0].image", expected: "nginx:1.27", found: true},
+		{name: "quoted annotation key", path: "metadata.annotations['gc.kube-zen.io/ttl']", expected: "short", found: true},
+		{name: "escaped dots", path: `metadata.labels.app\.kubernetes\.io/name`, expected: "web", found: true},
+		{name: "unescaped dotted key is a nested path", path: "metadata.labels.app.kubernetes.io/name", found: false},
+		{name: "array filter", path: "status.conditions[type=Ready].status", expected: "False", found: true},
+		{name: "array filter matching nothing", path: "status.conditions[type=Synced].status", found: false},
+		{name: "index out of range", path: "status.conditions[1].status", found: false},
+		{name: "missing key", path: "spec.missing", found: false},
+		{name: "index into an object", path: "spec[0]", expectErr: true},
+		{name: "filter on an object", path: "spec[severity=LOW]", expectErr: true},
+		{name: "key into an array", path: "spec.containers.name", expectErr: true},
+		{name: "malformed path", path: "spec..severity", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, found, err := nestedFieldNoCopy(resource.Object, tt.path)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("nestedFieldNoCopy(%q) returned no error", tt.path)
 				}
+				return
+			}
+			if err != nil || found != tt.found || !reflect.DeepEqual(value, tt.expected) {
+				t.Errorf("nestedFieldNoCopy(%q) = %v, %v, %v, want %v, %v", tt.path, value, found, err, tt.expected, tt.found)
 			}
 		})
 	}
 }
+
+func TestMeetsFieldConditions_BracketedPaths(t *testing.T) {
+	resource := fieldPathTestResource()
+
+	conds := []v1alpha1.FieldCondition{
+		{FieldPath: "status.conditions[0].status", Operator: "Equals", Value: "False"},
+		{FieldPath: "metadata.annotations['gc.kube-zen.io/ttl']", Operator: "In", Values: []string{"short", "medium"}},
+	}
+	if !meetsFieldConditionsShared(resource, conds) {
+		t.Error("Expected field conditions on an array index and a quoted annotation key to match")
+	}
+
+	conds[0].Value = "True"
+	if meetsFieldConditionsShared(resource, conds) {
+		t.Error("Expected a mismatched array element to fail the field conditions")
+	}
+}
+
+func TestCalculateExpirationTime_BracketedTTLPaths(t *testing.T) {
+	resource := fieldPathTestResource()
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	resource.SetCreationTimestamp(metav1.NewTime(created))
+
+	t.Run("fieldPath with quoted key", func(t *testing.T) {
+		ttl := &v1alpha1.TTLSpec{
+			FieldPath: "metadata.annotations['gc.kube-zen.io/ttl']",
+			Mappings:  map[string]int64{"short": 3600},
+		}
+		got, err := calculateExpirationTimeShared(resource, ttl)
+		if err != nil || !got.Equal(created.Add(time.Hour)) {
+			t.Errorf("calculateExpirationTimeShared() = %v, %v, want %v", got, err, created.Add(time.Hour))
+		}
+	})
+
+	t.Run("relativeTo with quoted key", func(t *testing.T) {
+		processedAt := time.Now().UTC().Truncate(time.Second)
+		resource := fieldPathTestResource()
+		_ = unstructured.SetNestedField(resource.Object, processedAt.Format(time.RFC3339), "metadata", "annotations", "gc.kube-zen.io/processed-at")
+		secondsAfter := int64(3600)
+		ttl := &v1alpha1.TTLSpec{
+			RelativeTo:   "metadata.annotations['gc.kube-zen.io/processed-at']",
+			SecondsAfter: &secondsAfter,
+		}
+		got, err := calculateExpirationTimeShared(resource, ttl)
+		if err != nil || !got.Equal(processedAt.Add(time.Hour)) {
+			t.Errorf("calculateExpirationTimeShared() = %v, %v, want %v", got, err, processedAt.Add(time.Hour))
+		}
+	})
+
+	t.Run("relativeTo a condition's lastTransitionTime", func(t *testing.T) {
+		readyAt := time.Now().UTC().Truncate(time.Second)
+		resource := fieldPathTestResource()
+		_ = unstructured.SetNestedSlice(resource.Object, []interface{}{
+			map[string]interface{}{"type": "Initialized", "status": "True", "lastTransitionTime": readyAt.Add(-time.Hour).Format(time.RFC3339)},
+			map[string]interface{}{"type": "Ready", "status": "True", "lastTransitionTime": readyAt.Format(time.RFC3339)},
+		}, "status", "conditions")
+		secondsAfter := int64(3600)
+		ttl := &v1alpha1.TTLSpec{
+			RelativeTo:   "status.conditions[type=Ready].lastTransitionTime",
+			SecondsAfter: &secondsAfter,
+		}
+		got, err := calculateExpirationTimeShared(resource, ttl)
+		if err != nil || !got.Equal(readyAt.Add(time.Hour)) {
+			t.Errorf("calculateExpirationTimeShared() = %v, %v, want %v", got, err, readyAt.Add(time.Hour))
+		}
+
+		ttl.RelativeTo = "status.conditions[type=Synced].lastTransitionTime"
+		if _, err := calculateExpirationTimeShared(resource, ttl); err == nil {
+			t.Error("Expected an error for a relativeTo condition type the resource does not have")
+		}
+	})
+
+	t.Run("missing bracketed fieldPath", func(t *testing.T) {
+		ttl := &v1alpha1.TTLSpec{FieldPath: "spec.containers[3].ttlSeconds"}
+		if _, err := calculateExpirationTimeShared(resource, ttl); err == nil {
+			t.Error("Expected an error for a fieldPath that is not found")
+		}
+	})
+}
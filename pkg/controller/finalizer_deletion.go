@@ -0,0 +1,112 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+// isAwaitingFinalizers reports whether a deletion was deferred by the policy's finalizer mode.
+func isAwaitingFinalizers(err error) bool {
+	return errors.Is(err, ErrAwaitingFinalizers)
+}
+
+// prepareFinalizerDeletion readies a resource for deletion in finalizer mode: it adds the
+// policy's finalizer if missing and reports whether the resource may be deleted now, i.e.
+// the policy's finalizer is the only one left, returning the resource version to delete.
+// Otherwise it returns ErrAwaitingFinalizers and the resource is retried on the next
+// evaluation. A resource already being deleted is left to whoever removes the finalizer,
+// typically an external cleanup system.
+func (r *GCPolicyReconciler) prepareFinalizerDeletion(ctx context.Context, resource *unstructured.Unstructured, gvr schema.GroupVersionResource, policy *v1alpha1.GarbageCollectionPolicy) (string, error) {
+	finalizer := policy.Spec.Behavior.Finalizer
+	resourceKey := fmt.Sprintf("%s/%s", resource.GetNamespace(), resource.GetName())
+	if resource.GetDeletionTimestamp() != nil {
+		return "", fmt.Errorf("%w: %s is already being deleted", ErrAwaitingFinalizers, resourceKey)
+	}
+
+	finalizers := resource.GetFinalizers()
+	resourceVersion := resource.GetResourceVersion()
+	if !hasFinalizer(finalizers, finalizer) {
+		patched, err := r.addFinalizer(ctx, resource, gvr, finalizer)
+		if err != nil {
+			return "", err
+		}
+		// Our own patch is the only change a resourceVersion precondition may ignore
+		finalizers, resourceVersion = patched.GetFinalizers(), patched.GetResourceVersion()
+		r.logger.Info("Added finalizer before deletion", sdklog.Operation("delete_resource"), sdklog.String("resource", resourceKey), sdklog.String("finalizer", finalizer), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)))
+	}
+
+	if len(finalizers) > 1 {
+		return "", fmt.Errorf("%w: %s has finalizers %v", ErrAwaitingFinalizers, resourceKey, finalizers)
+	}
+	return resourceVersion, nil
+}
+
+// addFinalizer appends a finalizer to a resource and returns the patched resource. The patch
+// tests the current finalizers first, so a concurrent change fails the patch instead of
+// being overwritten; the resource is retried on the next evaluation.
+func (r *GCPolicyReconciler) addFinalizer(ctx context.Context, resource *unstructured.Unstructured, gvr schema.GroupVersionResource, finalizer string) (*unstructured.Unstructured, error) {
+	current := resource.GetFinalizers()
+	added := append(append(make([]string, 0, len(current)+1), current...), finalizer)
+
+	ops := []map[string]interface{}{
+		{"op": "add", "path": "/metadata/finalizers", "value": added},
+	}
+	if len(current) > 0 {
+		ops = []map[string]interface{}{
+			{"op": "test", "path": "/metadata/finalizers", "value": current},
+			{"op": "replace", "path": "/metadata/finalizers", "value": added},
+		}
+	}
+	patch, err := json.Marshal(ops)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build finalizer patch: %w", err)
+	}
+
+	namespace := resource.GetNamespace()
+	var patched *unstructured.Unstructured
+	if namespace == "" {
+		patched, err = r.dynamicClient.Resource(gvr).Patch(ctx, resource.GetName(), types.JSONPatchType, patch, metav1.PatchOptions{FieldManager: FieldManager})
+	} else {
+		patched, err = r.dynamicClient.Resource(gvr).Namespace(namespace).Patch(ctx, resource.GetName(), types.JSONPatchType, patch, metav1.PatchOptions{FieldManager: FieldManager})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to add finalizer %s to %s/%s: %w", finalizer, namespace, resource.GetName(), err)
+	}
+	return patched, nil
+}
+
+// hasFinalizer reports whether finalizers contains finalizer.
+func hasFinalizer(finalizers []string, finalizer string) bool {
+	for _, f := range finalizers {
+		if f == finalizer {
+			return true
+		}
+	}
+	return false
+}
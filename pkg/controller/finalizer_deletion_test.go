@@ -0,0 +1,158 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-sdk/pkg/gc/ratelimiter"
+)
+
+const testPolicyFinalizer = "cleanup.example.com/archive"
+
+func TestDeleteResource_FinalizerAddedThenDeleted(t *testing.T) {
+	reconciler, dynamicClient, _ := setupObservePausedTest(t, false)
+	ctx := context.Background()
+	policy := newTestPolicy("finalizer-policy")
+	policy.Spec.Behavior.Finalizer = testPolicyFinalizer
+	cm := newTestConfigMap("default", "plain", time.Now().Add(-2*time.Hour))
+	if _, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").Create(ctx, cm, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create ConfigMap: %v", err)
+	}
+	dynamicClient.ClearActions()
+
+	// The policy's finalizer is the only one once added, so the delete follows at once
+	if err := reconciler.deleteResource(ctx, cm, policy, ratelimiter.NewRateLimiter(100)); err != nil {
+		t.Fatalf("deleteResource() returned error: %v", err)
+	}
+	actions := dynamicClient.Actions()
+	if len(actions) != 2 || actions[0].GetVerb() != "patch" || actions[1].GetVerb() != "delete" {
+		t.Errorf("actions = %v, want a finalizer patch followed by a delete", actions)
+	}
+}
+
+func TestDeleteResource_FinalizerWaitsForOtherFinalizers(t *testing.T) {
+	reconciler, dynamicClient, _ := setupObservePausedTest(t, false)
+	ctx := context.Background()
+	policy := newTestPolicy("finalizer-policy")
+	policy.Spec.Behavior.Finalizer = testPolicyFinalizer
+	cm := newTestConfigMap("default", "guarded", time.Now().Add(-2*time.Hour))
+	cm.SetFinalizers([]string{"kubernetes.io/pvc-protection"})
+	if _, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").Create(ctx, cm, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create ConfigMap: %v", err)
+	}
+	dynamicClient.ClearActions()
+
+	err := reconciler.deleteResource(ctx, cm, policy, ratelimiter.NewRateLimiter(100))
+	if !errors.Is(err, ErrAwaitingFinalizers) {
+		t.Fatalf("deleteResource() error = %v, want ErrAwaitingFinalizers", err)
+	}
+	got, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").Get(ctx, "guarded", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get ConfigMap: %v", err)
+	}
+	if want := []string{"kubernetes.io/pvc-protection", testPolicyFinalizer}; !reflect.DeepEqual(got.GetFinalizers(), want) {
+		t.Errorf("finalizers = %v, want %v", got.GetFinalizers(), want)
+	}
+	if deletes := countActions(dynamicClient, "delete", ""); deletes != 0 {
+		t.Errorf("deletes = %d, want none while other finalizers remain", deletes)
+	}
+
+	// Later evaluations leave it alone until the other finalizer clears
+	dynamicClient.ClearActions()
+	if err := reconciler.deleteResource(ctx, got, policy, ratelimiter.NewRateLimiter(100)); !errors.Is(err, ErrAwaitingFinalizers) {
+		t.Fatalf("deleteResource() error = %v, want ErrAwaitingFinalizers", err)
+	}
+	if actions := dynamicClient.Actions(); len(actions) != 0 {
+		t.Errorf("actions = %v, want none for a resource already carrying the finalizer", actions)
+	}
+
+	got.SetFinalizers([]string{testPolicyFinalizer})
+	if err := reconciler.deleteResource(ctx, got, policy, ratelimiter.NewRateLimiter(100)); err != nil {
+		t.Fatalf("deleteResource() once only the policy finalizer remains returned error: %v", err)
+	}
+	if deletes := countActions(dynamicClient, "delete", ""); deletes != 1 {
+		t.Errorf("deletes = %d, want 1", deletes)
+	}
+}
+
+func TestDeleteResource_FinalizerLeavesTerminatingResource(t *testing.T) {
+	reconciler, dynamicClient, _ := setupObservePausedTest(t, false)
+	policy := newTestPolicy("finalizer-policy")
+	policy.Spec.Behavior.Finalizer = testPolicyFinalizer
+	deletionTimestamp := metav1.NewTime(time.Now().Add(-time.Minute))
+	terminating := newTestConfigMap("default", "terminating", time.Now().Add(-2*time.Hour))
+	terminating.SetDeletionTimestamp(&deletionTimestamp)
+	terminating.SetFinalizers([]string{testPolicyFinalizer})
+	dynamicClient.ClearActions()
+
+	err := reconciler.deleteResource(context.Background(), terminating, policy, ratelimiter.NewRateLimiter(100))
+	if !errors.Is(err, ErrAwaitingFinalizers) {
+		t.Fatalf("deleteResource() error = %v, want ErrAwaitingFinalizers", err)
+	}
+	if actions := dynamicClient.Actions(); len(actions) != 0 {
+		t.Errorf("actions = %v, want none for a resource already being deleted", actions)
+	}
+}
+
+func TestDeleteResource_FinalizerPatchFailsOnConcurrentChange(t *testing.T) {
+	reconciler, dynamicClient, _ := setupObservePausedTest(t, false)
+	ctx := context.Background()
+	policy := newTestPolicy("finalizer-policy")
+	policy.Spec.Behavior.Finalizer = testPolicyFinalizer
+	cm := newTestConfigMap("default", "changed", time.Now().Add(-2*time.Hour))
+	cm.SetFinalizers([]string{"example.com/added"})
+	if _, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").Create(ctx, cm, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create ConfigMap: %v", err)
+	}
+
+	// The evaluated copy predates a finalizer added since
+	stale := cm.DeepCopy()
+	stale.SetFinalizers([]string{"example.com/other"})
+	err := reconciler.deleteResource(ctx, stale, policy, ratelimiter.NewRateLimiter(100))
+	if err == nil || errors.Is(err, ErrAwaitingFinalizers) || k8serrors.IsNotFound(err) {
+		t.Fatalf("deleteResource() error = %v, want a failed finalizer patch", err)
+	}
+	if deletes := countActions(dynamicClient, "delete", ""); deletes != 0 {
+		t.Errorf("deletes = %d, want none", deletes)
+	}
+}
+
+func TestDeleteBatch_AwaitingFinalizersNotCounted(t *testing.T) {
+	reconciler, dynamicClient, _ := setupObservePausedTest(t, false)
+	ctx := context.Background()
+	policy := newTestPolicy("finalizer-policy")
+	policy.Spec.Behavior.Finalizer = testPolicyFinalizer
+	cm := newTestConfigMap("default", "guarded", time.Now().Add(-2*time.Hour))
+	cm.SetFinalizers([]string{"kubernetes.io/pvc-protection"})
+	if _, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").Create(ctx, cm, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create ConfigMap: %v", err)
+	}
+
+	deleted, errs := reconciler.deleteBatch(ctx, []*unstructured.Unstructured{cm}, policy, ratelimiter.NewRateLimiter(100), map[string]string{})
+	if deleted != 0 || len(errs) != 0 {
+		t.Errorf("deleteBatch() = %d deleted, %v errors, want neither", deleted, errs)
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// newTestPolicy returns a policy in the default namespace targeting ConfigMaps with a one-hour
+// TTL. Tests set the fields they exercise on the result.
+func newTestPolicy(name string) *v1alpha1.GarbageCollectionPolicy {
+	return &v1alpha1.GarbageCollectionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: types.UID(name + "-uid")},
+		Spec: v1alpha1.GarbageCollectionPolicySpec{
+			TargetResource: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap"},
+			TTL:            v1alpha1.TTLSpec{SecondsAfterCreation: int64Ptr(3600)},
+		},
+	}
+}
+
+// newTestConfigMap returns a ConfigMap created at created, or without a creation timestamp
+// if created is zero.
+func newTestConfigMap(namespace, name string, created time.Time) *unstructured.Unstructured {
+	cm := &unstructured.Unstructured{}
+	cm.SetAPIVersion("v1")
+	cm.SetKind("ConfigMap")
+	cm.SetNamespace(namespace)
+	cm.SetName(name)
+	cm.SetUID(types.UID(name + "-uid"))
+	if !created.IsZero() {
+		cm.SetCreationTimestamp(metav1.NewTime(created))
+	}
+	return cm
+}
+
+// managedFieldsEntry returns an Update entry by manager at the given time.
+func managedFieldsEntry(manager string, at time.Time) metav1.ManagedFieldsEntry {
+	t := metav1.NewTime(at)
+	return metav1.ManagedFieldsEntry{Manager: manager, Operation: metav1.ManagedFieldsOperationUpdate, Time: &t}
+}
+
+// resourceNames returns the sorted names of the resources.
+func resourceNames(resources []*unstructured.Unstructured) []string {
+	names := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		names = append(names, resource.GetName())
+	}
+	sort.Strings(names)
+	return names
+}
+
+// countActions returns how many calls with the verb on the subresource, "" for the object
+// itself, the fake dynamic client received.
+func countActions(dynamicClient *fake.FakeDynamicClient, verb, subresource string) int {
+	n := 0
+	for _, action := range dynamicClient.Actions() {
+		if action.GetVerb() == verb && action.GetSubresource() == subresource {
+			n++
+		}
+	}
+	return n
+}
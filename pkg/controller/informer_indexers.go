@@ -0,0 +1,95 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// PhaseIndex is the name of the informer index on status.phase.
+const PhaseIndex = "phase"
+
+// phaseIndexFunc indexes resources by status.phase. Resources without a phase are not indexed.
+func phaseIndexFunc(obj interface{}) ([]string, error) {
+	resource, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, nil
+	}
+	phase, found, _ := unstructured.NestedString(resource.Object, "status", "phase")
+	if !found || phase == "" {
+		return nil, nil
+	}
+	return []string{phase}, nil
+}
+
+// indexersForPolicy returns the informer indexers a policy needs beyond the namespace
+// index dynamic informers already have: the phase index when the policy has phase conditions.
+func indexersForPolicy(policy *v1alpha1.GarbageCollectionPolicy) cache.Indexers {
+	indexers := cache.Indexers{}
+	if needsPhaseIndex(&policy.Spec) {
+		indexers[PhaseIndex] = phaseIndexFunc
+	}
+	return indexers
+}
+
+// needsPhaseIndex reports whether a policy spec has phase conditions to index.
+func needsPhaseIndex(spec *v1alpha1.GarbageCollectionPolicySpec) bool {
+	return spec.Conditions != nil && len(spec.Conditions.Phase) > 0
+}
+
+// policyCandidatesShared returns the store's objects that can match the policy, using
+// informer indexes to skip resources the policy's phase conditions or namespace rule out.
+// Every returned object is still evaluated in full. Dedup, retention, and minRemaining policies need
+// every matched resource to size their groups, so they always get the whole store.
+// Falls back to listing the whole store if the index is missing, e.g. when the policy
+// gained phase conditions after its informer was created.
+func policyCandidatesShared(store cache.Store, policy *v1alpha1.GarbageCollectionPolicy) []interface{} {
+	indexer, ok := store.(cache.Indexer)
+	if !ok || policy.Spec.Dedup != nil || policy.Spec.Retention != nil || policy.Spec.Behavior.MinRemaining != nil {
+		return store.List()
+	}
+
+	if needsPhaseIndex(&policy.Spec) {
+		candidates := make([]interface{}, 0)
+		seen := make(map[string]struct{}, len(policy.Spec.Conditions.Phase))
+		for _, phase := range policy.Spec.Conditions.Phase {
+			if _, dup := seen[phase]; dup {
+				continue
+			}
+			seen[phase] = struct{}{}
+			objs, err := indexer.ByIndex(PhaseIndex, phase)
+			if err != nil {
+				return store.List()
+			}
+			candidates = append(candidates, objs...)
+		}
+		return candidates
+	}
+
+	namespace := policy.Spec.TargetResource.Namespace
+	if namespace != "" && namespace != "*" {
+		objs, err := indexer.ByIndex(cache.NamespaceIndex, namespace)
+		if err != nil {
+			return store.List()
+		}
+		return objs
+	}
+	return store.List()
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func newIndexerTestStore(t *testing.T, indexers cache.Indexers) cache.Indexer {
+	t.Helper()
+	store := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	if err := store.AddIndexers(indexers); err != nil {
+		t.Fatalf("Failed to add indexers: %v", err)
+	}
+	for _, pod := range []struct{ namespace, name, phase string }{
+		{namespace: "team-a", name: "a-failed", phase: "Failed"},
+		{namespace: "team-a", name: "a-running", phase: "Running"},
+		{namespace: "team-a", name: "a-succeeded", phase: "Succeeded"},
+		{namespace: "team-b", name: "b-failed", phase: "Failed"},
+		{namespace: "team-b", name: "b-pending"},
+	} {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata":   map[string]interface{}{"namespace": pod.namespace, "name": pod.name},
+		}}
+		if pod.phase != "" {
+			_ = unstructured.SetNestedField(obj.Object, pod.phase, "status", "phase")
+		}
+		if err := store.Add(obj); err != nil {
+			t.Fatalf("Failed to add pod: %v", err)
+		}
+	}
+	return store
+}
+
+func candidateNames(objs []interface{}) []string {
+	names := make([]string, 0, len(objs))
+	for _, obj := range objs {
+		if u, ok := obj.(*unstructured.Unstructured); ok {
+			names = append(names, u.GetName())
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestPolicyCandidatesShared(t *testing.T) {
+	tests := []struct {
+		name      string
+		namespace string
+		phases    []string
+		indexers  cache.Indexers
+		expected  []string
+	}{
+		{
+			name:     "phase index",
+			phases:   []string{"Failed", "Succeeded"},
+			expected: []string{"a-failed", "a-succeeded", "b-failed"},
+		},
+		{
+			name:     "repeated phase listed once",
+			phases:   []string{"Failed", "Failed"},
+			expected: []string{"a-failed", "b-failed"},
+		},
+		{
+			name:      "namespace index",
+			namespace: "team-b",
+			expected:  []string{"b-failed", "b-pending"},
+		},
+		{
+			name:      "all namespaces without phase lists everything",
+			namespace: "*",
+			expected:  []string{"a-failed", "a-running", "a-succeeded", "b-failed", "b-pending"},
+		},
+		{
+			name:     "missing phase index falls back to the whole store",
+			phases:   []string{"Failed"},
+			indexers: cache.Indexers{},
+			expected: []string{"a-failed", "a-running", "a-succeeded", "b-failed", "b-pending"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := newTestPolicy("indexed")
+			policy.Spec.TargetResource = v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "Pod", Namespace: tt.namespace}
+			if len(tt.phases) > 0 {
+				policy.Spec.Conditions = &v1alpha1.ConditionsSpec{Phase: tt.phases}
+			}
+			indexers := tt.indexers
+			if indexers == nil {
+				indexers = indexersForPolicy(policy)
+			}
+			got := candidateNames(policyCandidatesShared(newIndexerTestStore(t, indexers), policy))
+			if len(got) != len(tt.expected) {
+				t.Fatalf("policyCandidatesShared() = %v, want %v", got, tt.expected)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Fatalf("policyCandidatesShared() = %v, want %v", got, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestPolicyCandidatesShared_DedupListsEverything(t *testing.T) {
+	policy := newTestPolicy("indexed")
+	policy.Spec.TargetResource = v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "Pod"}
+	policy.Spec.Conditions = &v1alpha1.ConditionsSpec{Phase: []string{"Failed"}}
+	policy.Spec.Dedup = &v1alpha1.DedupSpec{KeyLabels: []string{"app"}}
+	if got := policyCandidatesShared(newIndexerTestStore(t, indexersForPolicy(policy)), policy); len(got) != 5 {
+		t.Errorf("policyCandidatesShared() with dedup returned %d objects, want 5", len(got))
+	}
+}
+
+func TestInformerStoreResourceLister_ListPolicyResources(t *testing.T) {
+	policy := newTestPolicy("indexed")
+	policy.Spec.TargetResource = v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "Pod", Namespace: "team-a"}
+	policy.Spec.Conditions = &v1alpha1.ConditionsSpec{Phase: []string{"Failed"}}
+	lister := NewInformerStoreResourceLister(newIndexerTestStore(t, indexersForPolicy(policy)))
+	indexed, ok := lister.(PolicyResourceLister)
+	if !ok {
+		t.Fatal("InformerStoreResourceLister should implement PolicyResourceLister")
+	}
+	resources, err := indexed.ListPolicyResources(context.Background(), policy)
+	if err != nil {
+		t.Fatalf("ListPolicyResources() returned error: %v", err)
+	}
+	if len(resources) != 1 || resources[0].GetName() != "a-failed" {
+		t.Errorf("ListPolicyResources() returned %d resources, want only a-failed", len(resources))
+	}
+}
+
+func TestGetOrCreateResourceInformer_AddsPolicyIndexers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	reconciler := setupInformerTestReconciler(t)
+	policy := newTestPolicy("indexed")
+	policy.Spec.Conditions = &v1alpha1.ConditionsSpec{Phase: []string{"Failed"}}
+	informer, err := reconciler.getOrCreateResourceInformer(ctx, policy)
+	if err != nil {
+		t.Fatalf("getOrCreateResourceInformer() returned error: %v", err)
+	}
+	defer reconciler.cleanupResourceInformer(policy.UID)
+
+	indexer, ok := informer.GetStore().(cache.Indexer)
+	if !ok {
+		t.Fatal("informer store should be an indexer")
+	}
+	for _, name := range []string{cache.NamespaceIndex, PhaseIndex} {
+		if _, ok := indexer.GetIndexers()[name]; !ok {
+			t.Errorf("informer is missing the %q index", name)
+		}
+	}
+	objs, err := indexer.ByIndex(cache.NamespaceIndex, "team-b")
+	if err != nil || len(objs) != 1 {
+		t.Errorf("ByIndex(namespace, team-b) = %d objects (err %v), want 1", len(objs), err)
+	}
+}
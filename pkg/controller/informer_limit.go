@@ -0,0 +1,116 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+// ReasonInformerLimitReached is the status reason of a policy waiting for informer capacity.
+const ReasonInformerLimitReached = "informer_limit_reached"
+
+// informerLimitRequeueInterval is how often a policy waiting for informer capacity retries.
+const informerLimitRequeueInterval = 30 * time.Second
+
+// informerWaitlistTTL drops waiting policies that stopped retrying, e.g. because they were
+// paused, so they do not hold back the policies queued after them.
+const informerWaitlistTTL = 2 * informerLimitRequeueInterval
+
+// ErrInformerLimitReached indicates the controller runs its maximum number of informers.
+var ErrInformerLimitReached = errors.New("informer limit reached")
+
+// informerWaiter is a policy waiting for informer capacity.
+type informerWaiter struct {
+	uid      types.UID
+	lastSeen time.Time
+}
+
+// reserveInformerLocked admits a policy to create an informer under the controller's
+// informer cap. Policies over the cap wait in arrival order, and freed capacity goes to
+// the longest-waiting policies first. Caller must hold resourceInformersMu for writing.
+func (r *GCPolicyReconciler) reserveInformerLocked(policyUID types.UID) error {
+	if r.config == nil || r.config.MaxInformers <= 0 {
+		return nil
+	}
+
+	// Drop waiters that stopped retrying
+	now := time.Now()
+	waitlist := r.informerWaitlist[:0]
+	position := -1
+	for _, waiter := range r.informerWaitlist {
+		if waiter.uid == policyUID {
+			waiter.lastSeen = now
+			position = len(waitlist)
+		} else if now.Sub(waiter.lastSeen) > informerWaitlistTTL {
+			continue
+		}
+		waitlist = append(waitlist, waiter)
+	}
+	r.informerWaitlist = waitlist
+
+	free := r.config.MaxInformers - len(r.sharedInformers) - len(r.startingInformers)
+	switch {
+	case position == -1 && free > len(r.informerWaitlist):
+		return nil
+	case position == -1:
+		r.informerWaitlist = append(r.informerWaitlist, informerWaiter{uid: policyUID, lastSeen: now})
+		return fmt.Errorf("%w (%d)", ErrInformerLimitReached, r.config.MaxInformers)
+	case position < free:
+		r.informerWaitlist = append(r.informerWaitlist[:position], r.informerWaitlist[position+1:]...)
+		return nil
+	default:
+		return fmt.Errorf("%w (%d)", ErrInformerLimitReached, r.config.MaxInformers)
+	}
+}
+
+// forgetInformerWaiterLocked removes a policy from the informer waitlist.
+// Caller must hold resourceInformersMu for writing.
+func (r *GCPolicyReconciler) forgetInformerWaiterLocked(policyUID types.UID) {
+	for i, waiter := range r.informerWaitlist {
+		if waiter.uid == policyUID {
+			r.informerWaitlist = append(r.informerWaitlist[:i], r.informerWaitlist[i+1:]...)
+			return
+		}
+	}
+}
+
+// handleInformerLimitReached marks a policy waiting for informer capacity as Pending and
+// retries it until capacity frees up.
+func (r *GCPolicyReconciler) handleInformerLimitReached(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy, err error) (ctrl.Result, error) {
+	r.logger.Info("Informer limit reached, policy queued", sdklog.Operation("reconcile"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Error(err))
+	if r.statusUpdater != nil {
+		message := fmt.Sprintf("Waiting for informer capacity: %v", err)
+		if statusErr := r.statusUpdater.SetPending(ctx, policy, ReasonInformerLimitReached, message); statusErr != nil {
+			r.logger.Warn("Failed to set policy Pending status", sdklog.Operation("update_status"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Error(statusErr))
+		}
+	}
+	return ctrl.Result{RequeueAfter: informerLimitRequeueInterval}, nil
+}
+
+// isInformerLimitReached reports whether err means the policy waits for informer capacity.
+func isInformerLimitReached(err error) bool {
+	return errors.Is(err, ErrInformerLimitReached)
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestGetOrCreateResourceInformer_CapDefersAndLaterAdmits(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reconciler := setupInformerTestReconciler(t)
+	reconciler.config.WithMaxInformers(1)
+	first := newTestPolicy("first")
+	first.Spec.TargetResource.Namespace = "team-a"
+	second := newTestPolicy("second")
+	second.Spec.TargetResource.Namespace = "team-b"
+	defer reconciler.cleanupResourceInformer(first.UID)
+	defer reconciler.cleanupResourceInformer(second.UID)
+
+	if _, err := reconciler.getOrCreateResourceInformer(ctx, first); err != nil {
+		t.Fatalf("first policy should be admitted: %v", err)
+	}
+	// An existing informer is returned even at the cap
+	if _, err := reconciler.getOrCreateResourceInformer(ctx, first); err != nil {
+		t.Fatalf("existing informer should be reused at the cap: %v", err)
+	}
+
+	if _, err := reconciler.getOrCreateResourceInformer(ctx, second); !isInformerLimitReached(err) {
+		t.Fatalf("second policy error = %v, want informer limit reached", err)
+	}
+	reconciler.resourceInformersMu.RLock()
+	count := len(reconciler.sharedInformers)
+	reconciler.resourceInformersMu.RUnlock()
+	if count != 1 {
+		t.Fatalf("%d informers running, want 1", count)
+	}
+
+	// Releasing the first informer admits the queued policy
+	reconciler.cleanupResourceInformer(first.UID)
+	informer, err := reconciler.getOrCreateResourceInformer(ctx, second)
+	if err != nil {
+		t.Fatalf("queued policy should be admitted once capacity frees up: %v", err)
+	}
+	if names := storeNames(informer); !names["cm-b"] {
+		t.Errorf("admitted informer store = %v, want cm-b", names)
+	}
+	if len(reconciler.informerWaitlist) != 0 {
+		t.Errorf("waitlist = %v, want empty after admission", reconciler.informerWaitlist)
+	}
+}
+
+func TestReserveInformerLocked_FreedCapacityGoesToLongestWaiting(t *testing.T) {
+	reconciler := setupInformerTestReconciler(t)
+	reconciler.config.WithMaxInformers(1)
+	reconciler.sharedInformers[informerKey{namespace: "running"}] = &sharedInformer{}
+
+	if err := reconciler.reserveInformerLocked("b"); !isInformerLimitReached(err) {
+		t.Fatalf("b should wait, got %v", err)
+	}
+	if err := reconciler.reserveInformerLocked("c"); !isInformerLimitReached(err) {
+		t.Fatalf("c should wait, got %v", err)
+	}
+
+	delete(reconciler.sharedInformers, informerKey{namespace: "running"})
+	if err := reconciler.reserveInformerLocked("c"); !isInformerLimitReached(err) {
+		t.Errorf("c should keep waiting behind b, got %v", err)
+	}
+	if err := reconciler.reserveInformerLocked("b"); err != nil {
+		t.Errorf("b should be admitted first, got %v", err)
+	}
+}
+
+func TestReserveInformerLocked_DropsWaitersThatStoppedRetrying(t *testing.T) {
+	reconciler := setupInformerTestReconciler(t)
+	reconciler.config.WithMaxInformers(1)
+	reconciler.informerWaitlist = []informerWaiter{{uid: "paused", lastSeen: time.Now().Add(-2 * informerWaitlistTTL)}}
+
+	if err := reconciler.reserveInformerLocked("active"); err != nil {
+		t.Errorf("a stale waiter should not hold back new policies, got %v", err)
+	}
+}
+
+func TestReserveInformerLocked_NoCap(t *testing.T) {
+	reconciler := setupInformerTestReconciler(t)
+	for i := range 5 {
+		reconciler.sharedInformers[informerKey{namespace: string(rune('a' + i))}] = &sharedInformer{}
+	}
+	if err := reconciler.reserveInformerLocked("next"); err != nil {
+		t.Errorf("no cap configured, got %v", err)
+	}
+}
+
+func TestEvaluatePolicy_InformerLimitSetsPending(t *testing.T) {
+	reconciler, dynamicClient, policy := setupObservePausedTest(t, false)
+	reconciler.config.WithMaxInformers(1)
+	reconciler.sharedInformers[informerKey{namespace: "other-policy"}] = &sharedInformer{}
+	policy.Spec.Paused = false
+
+	err := reconciler.evaluatePolicy(context.Background(), policy)
+	if !isInformerLimitReached(err) {
+		t.Fatalf("evaluatePolicy() error = %v, want informer limit reached", err)
+	}
+	result, err := reconciler.handleInformerLimitReached(context.Background(), policy, err)
+	if err != nil || result.RequeueAfter != informerLimitRequeueInterval {
+		t.Fatalf("handleInformerLimitReached() = %v, %v", result, err)
+	}
+
+	current, err := dynamicClient.Resource(observeTestPolicyGVR).Namespace("default").Get(context.Background(), policy.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get policy: %v", err)
+	}
+	phase, _, _ := unstructured.NestedString(current.Object, "status", "phase")
+	conditions, _, _ := unstructured.NestedSlice(current.Object, "status", "conditions")
+	if phase != PolicyPhasePending || len(conditions) == 0 {
+		t.Fatalf("status phase = %q with %d conditions, want %s", phase, len(conditions), PolicyPhasePending)
+	}
+	if reason, _, _ := unstructured.NestedString(conditions[0].(map[string]interface{}), "reason"); reason != ReasonInformerLimitReached {
+		t.Errorf("condition reason = %q, want %q", reason, ReasonInformerLimitReached)
+	}
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/tools/cache"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/config"
+)
+
+func setupInformerTestReconciler(t *testing.T) *GCPolicyReconciler {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	dynamicClient := fake.NewSimpleDynamicClientWithCustomListKinds(
+		scheme,
+		map[schema.GroupVersionResource]string{{Version: "v1", Resource: "configmaps"}: "ConfigMapList"},
+		newTestConfigMap("team-a", "cm-a", time.Time{}),
+		newTestConfigMap("team-b", "cm-b", time.Time{}),
+	)
+	return NewGCPolicyReconcilerWithRESTMapper(
+		clientfake.NewClientBuilder().WithScheme(scheme).Build(),
+		scheme,
+		dynamicClient,
+		nil,
+		NewStatusUpdater(dynamicClient),
+		NewEventRecorder(nil),
+		config.NewControllerConfig(),
+	)
+}
+
+func storeNames(informer cache.SharedInformer) map[string]bool {
+	names := map[string]bool{}
+	for _, obj := range informer.GetStore().List() {
+		if u, ok := obj.(*unstructured.Unstructured); ok {
+			names[u.GetName()] = true
+		}
+	}
+	return names
+}
+
+func TestHandleInformerRecreation_MakeBeforeBreak(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	reconciler := setupInformerTestReconciler(t)
+	policy := &v1alpha1.GarbageCollectionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default", UID: types.UID("policy-uid")},
+		Spec: v1alpha1.GarbageCollectionPolicySpec{
+			TargetResource: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap", Namespace: "team-a"},
+		},
+	}
+
+	oldInformer, err := reconciler.getOrCreateResourceInformer(ctx, policy)
+	if err != nil {
+		t.Fatalf("getOrCreateResourceInformer() returned error: %v", err)
+	}
+	if names := storeNames(oldInformer); !names["cm-a"] || names["cm-b"] {
+		t.Fatalf("initial informer store = %v, want only cm-a", names)
+	}
+	reconciler.trackPolicySpec(policy.UID, &policy.Spec)
+
+	// Poll concurrently: a synced informer must be available throughout the swap
+	var gaps int32
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			reconciler.resourceInformersMu.RLock()
+			informer, ok := reconciler.resourceInformers[policy.UID]
+			reconciler.resourceInformersMu.RUnlock()
+			if !ok || !informer.HasSynced() {
+				atomic.AddInt32(&gaps, 1)
+			}
+		}
+	}()
+
+	// Selector change: move the policy to another namespace
+	policy.Spec.TargetResource.Namespace = "team-b"
+	reconciler.handleInformerRecreation(ctx, policy)
+	close(done)
+	<-stopped
+
+	if n := atomic.LoadInt32(&gaps); n != 0 {
+		t.Errorf("observed %d polls without a synced informer during recreation", n)
+	}
+
+	reconciler.resourceInformersMu.RLock()
+	newInformer, ok := reconciler.resourceInformers[policy.UID]
+	reconciler.resourceInformersMu.RUnlock()
+	if !ok || newInformer == oldInformer {
+		t.Fatal("expected informer to be replaced")
+	}
+	if names := storeNames(newInformer); !names["cm-b"] || names["cm-a"] {
+		t.Errorf("new informer store = %v, want only cm-b", names)
+	}
+
+	if stoppable, ok := oldInformer.(interface{ IsStopped() bool }); ok {
+		deadline := time.Now().Add(5 * time.Second)
+		for !stoppable.IsStopped() && time.Now().Before(deadline) {
+			time.Sleep(10 * time.Millisecond)
+		}
+		if !stoppable.IsStopped() {
+			t.Error("expected old informer to be stopped after the swap")
+		}
+	}
+}
+
+func TestHandleInformerRecreation_StopsPriorWatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	reconciler := setupInformerTestReconciler(t)
+	policy := &v1alpha1.GarbageCollectionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default", UID: types.UID("policy-uid")},
+		Spec: v1alpha1.GarbageCollectionPolicySpec{
+			TargetResource: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap", Namespace: "team-a"},
+		},
+	}
+	defer reconciler.cleanupResourceInformer(policy.UID)
+
+	service, err := reconciler.getOrCreateEvaluationService(ctx, policy)
+	if err != nil {
+		t.Fatalf("getOrCreateEvaluationService() returned error: %v", err)
+	}
+	oldKey, err := informerKeyForPolicy(policy)
+	if err != nil {
+		t.Fatalf("informerKeyForPolicy() returned error: %v", err)
+	}
+	reconciler.resourceInformersMu.RLock()
+	oldInformer := reconciler.resourceInformers[policy.UID]
+	reconciler.resourceInformersMu.RUnlock()
+	reconciler.trackPolicySpec(policy.UID, &policy.Spec)
+
+	policy.Spec.TargetResource.Namespace = "team-b"
+	reconciler.handleInformerRecreation(ctx, policy)
+
+	reconciler.resourceInformersMu.RLock()
+	_, oldWatchKept := reconciler.sharedInformers[oldKey]
+	reconciler.resourceInformersMu.RUnlock()
+	if oldWatchKept {
+		t.Errorf("watch %s is still registered after the policy moved off it", oldKey)
+	}
+	waitInformerStopped(t, oldInformer, true)
+
+	// The cached evaluation service follows the new informer rather than the stopped one
+	lister, ok := service.resourceLister.(PolicyResourceLister)
+	if !ok {
+		t.Fatal("evaluation service lister should implement PolicyResourceLister")
+	}
+	resources, err := lister.ListPolicyResources(ctx, policy)
+	if err != nil {
+		t.Fatalf("ListPolicyResources() returned error: %v", err)
+	}
+	if len(resources) != 1 || resources[0].GetName() != "cm-b" {
+		t.Errorf("listed %d resources after recreation, want only cm-b", len(resources))
+	}
+}
+
+func TestHandleInformerRecreation_NoInformerYet(t *testing.T) {
+	reconciler := setupInformerTestReconciler(t)
+	policy := &v1alpha1.GarbageCollectionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default", UID: types.UID("policy-uid")},
+		Spec: v1alpha1.GarbageCollectionPolicySpec{
+			TargetResource: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap", Namespace: "team-a"},
+		},
+	}
+	reconciler.trackPolicySpec(policy.UID, &policy.Spec)
+
+	policy.Spec.TargetResource.Namespace = "team-b"
+	reconciler.handleInformerRecreation(context.Background(), policy)
+
+	reconciler.resourceInformersMu.RLock()
+	_, ok := reconciler.resourceInformers[policy.UID]
+	reconciler.resourceInformersMu.RUnlock()
+	if ok {
+		t.Error("expected no informer to be created eagerly when none existed")
+	}
+}
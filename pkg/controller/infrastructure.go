@@ -88,10 +88,11 @@ func NewDefaultResourceLister(client dynamic.Interface) ResourceLister {
 	return &DefaultResourceLister{client: client}
 }
 
-// ListResources lists all resources of the given GVR in the namespace.
+// ListResources lists all resources of the given GVR in the namespace, or in all
+// namespaces if it is "*".
 func (l *DefaultResourceLister) ListResources(ctx context.Context, gvr schema.GroupVersionResource, namespace string) ([]*unstructured.Unstructured, error) {
 	var resourceInterface dynamic.ResourceInterface
-	if namespace == "" {
+	if namespace == "" || namespace == "*" {
 		resourceInterface = l.client.Resource(gvr)
 	} else {
 		resourceInterface = l.client.Resource(gvr).Namespace(namespace)
@@ -132,8 +133,9 @@ func NewDefaultConditionMatcher() ConditionMatcher {
 }
 
 // MeetsConditions checks if a resource meets the given conditions.
+// Owner chain conditions need API lookups and never match here.
 func (m *DefaultConditionMatcher) MeetsConditions(resource *unstructured.Unstructured, conditions *v1alpha1.ConditionsSpec) bool {
-	return meetsConditionsShared(resource, conditions)
+	return meetsConditionsWithOwnersShared(resource, conditions, nil)
 }
 
 // DefaultRateLimiterProvider implements RateLimiterProvider.
@@ -54,6 +54,13 @@ type ResourceLister interface {
 	ListResources(ctx context.Context, gvr schema.GroupVersionResource, namespace string) ([]*unstructured.Unstructured, error)
 }
 
+// PolicyResourceLister is implemented by ResourceListers that can narrow a listing to
+// the resources a policy can match, e.g. using informer indexes.
+type PolicyResourceLister interface {
+	// ListPolicyResources lists the candidate resources for the policy.
+	ListPolicyResources(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy) ([]*unstructured.Unstructured, error)
+}
+
 // SelectorMatcher checks if a resource matches the given selectors.
 // This interface allows us to test selector logic independently.
 type SelectorMatcher interface {
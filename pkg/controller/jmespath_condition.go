@@ -0,0 +1,89 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/jmespath/go-jmespath"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+// jmespathExpressions caches compiled JMESPath expressions by source string.
+var jmespathExpressions sync.Map
+
+// compileJMESPath returns the compiled expression, compiling it on first use.
+func compileJMESPath(expression string) (*jmespath.JMESPath, error) {
+	if compiled, ok := jmespathExpressions.Load(expression); ok {
+		return compiled.(*jmespath.JMESPath), nil
+	}
+	compiled, err := jmespath.Compile(expression)
+	if err != nil {
+		return nil, err
+	}
+	jmespathExpressions.Store(expression, compiled)
+	return compiled, nil
+}
+
+// meetsJMESPathConditionShared checks if a JMESPath expression evaluated against the
+// resource returns a truthy value. Invalid expressions and evaluation errors do not match.
+func meetsJMESPathConditionShared(resource *unstructured.Unstructured, expression string) bool {
+	compiled, err := compileJMESPath(expression)
+	if err != nil {
+		logger := sdklog.NewLogger("zen-gc")
+		logger.Warn("Invalid JMESPath expression", sdklog.Operation("meets_conditions"), sdklog.String("expression", expression), sdklog.Error(err))
+		return false
+	}
+
+	// JMESPath compares numbers as float64, so evaluate against the resource's JSON form
+	raw, err := json.Marshal(resource.Object)
+	if err != nil {
+		return false
+	}
+	var data interface{}
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return false
+	}
+
+	result, err := compiled.Search(data)
+	if err != nil {
+		return false
+	}
+	return jmespathTruthy(result)
+}
+
+// jmespathTruthy applies JMESPath truthiness: null, false, empty strings, empty arrays,
+// and empty objects are false; everything else (including 0) is true.
+func jmespathTruthy(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		return v != ""
+	case []interface{}:
+		return len(v) > 0
+	case map[string]interface{}:
+		return len(v) > 0
+	default:
+		return true
+	}
+}
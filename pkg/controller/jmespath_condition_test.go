@@ -0,0 +1,91 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+var testJMESPathPod = &unstructured.Unstructured{
+	Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]interface{}{
+			"name":   "worker",
+			"labels": map[string]interface{}{"app": "batch"},
+		},
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "main", "image": "registry.example.com/worker:1.2"},
+				map[string]interface{}{"name": "sidecar", "image": "docker.io/proxy:latest"},
+			},
+		},
+		"status": map[string]interface{}{
+			"phase": "Failed",
+			"containerStatuses": []interface{}{
+				map[string]interface{}{"name": "main", "restartCount": int64(7)},
+				map[string]interface{}{"name": "sidecar", "restartCount": int64(0)},
+			},
+		},
+	},
+}
+
+func TestMeetsJMESPathConditionShared(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		expected   bool
+	}{
+		{name: "nested field equals", expression: "status.phase == 'Failed'", expected: true},
+		{name: "nested field not equal", expression: "status.phase == 'Running'", expected: false},
+		{name: "label lookup", expression: "metadata.labels.app == 'batch'", expected: true},
+		{name: "array filter on numbers", expression: "status.containerStatuses[?restartCount > `5`]", expected: true},
+		{name: "array filter with no match", expression: "status.containerStatuses[?restartCount > `10`]", expected: false},
+		{name: "array projection with function", expression: "contains(spec.containers[].name, 'sidecar')", expected: true},
+		{name: "any image with latest tag", expression: "length(spec.containers[?ends_with(image, ':latest')]) > `0`", expected: true},
+		{name: "missing field is null", expression: "status.reason", expected: false},
+		{name: "zero is truthy", expression: "status.containerStatuses[1].restartCount", expected: true},
+		{name: "invalid expression", expression: "status.[", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := meetsJMESPathConditionShared(testJMESPathPod, tt.expression); got != tt.expected {
+				t.Errorf("meetsJMESPathConditionShared(%q) = %v, want %v", tt.expression, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMeetsConditionsShared_JMESPath(t *testing.T) {
+	conditions := &v1alpha1.ConditionsSpec{
+		Phase:    []string{"Failed"},
+		JMESPath: "status.containerStatuses[?name == 'main'].restartCount | [0] > `5`",
+	}
+	if !meetsConditionsShared(testJMESPathPod, conditions) {
+		t.Error("expected pod to meet phase and jmespath conditions")
+	}
+
+	conditions.JMESPath = "status.containerStatuses[?name == 'sidecar'].restartCount | [0] > `5`"
+	if meetsConditionsShared(testJMESPathPod, conditions) {
+		t.Error("expected pod not to meet jmespath condition")
+	}
+}
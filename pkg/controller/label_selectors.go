@@ -17,7 +17,16 @@ limitations under the License.
 package controller
 
 import (
+	"sort"
+	"strings"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
 )
 
 // labelSelectorsEqual compares two label selectors for equality.
@@ -75,3 +84,147 @@ func labelSelectorRequirementEqual(a, b metav1.LabelSelectorRequirement) bool {
 	}
 	return true
 }
+
+// labelSelectorListsEqual compares two lists of label selectors for equality.
+func labelSelectorListsEqual(a, b []metav1.LabelSelector) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !labelSelectorsEqual(&a[i], &b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesAnyLabelSelector reports whether the labels satisfy at least one of the selectors.
+// Invalid selectors never match.
+func matchesAnyLabelSelector(resourceLabels labels.Set, selectors []metav1.LabelSelector) bool {
+	for i := range selectors {
+		selector, err := metav1.LabelSelectorAsSelector(&selectors[i])
+		if err != nil {
+			logger := sdklog.NewLogger("zen-gc")
+			logger.Warn("Skipping invalid label selector", sdklog.Operation("matches_selectors"), sdklog.Int("index", i), sdklog.Error(err))
+			continue
+		}
+		if selector.Matches(resourceLabels) {
+			return true
+		}
+	}
+	return false
+}
+
+// listLabelSelector returns the label selector pushed down to the API server for a target.
+// labelSelector is always pushed down. The labelSelectors union is pushed down only when it can
+// be expressed as a single selector; otherwise it is applied in-memory by matchesSelectorsShared.
+// Selectors that reference virtual label annotations are never pushed down.
+func listLabelSelector(target *v1alpha1.TargetResourceSpec) string {
+	parts := make([]string, 0, 2)
+	if target.LabelSelector != nil && !referencesVirtualLabels(target.LabelSelector, target.VirtualLabelAnnotations) {
+		if selector, err := metav1.LabelSelectorAsSelector(target.LabelSelector); err == nil && !selector.Empty() {
+			parts = append(parts, selector.String())
+		}
+	}
+	for i := range target.LabelSelectors {
+		if referencesVirtualLabels(&target.LabelSelectors[i], target.VirtualLabelAnnotations) {
+			return strings.Join(parts, ",")
+		}
+	}
+	if union := unionLabelSelector(target.LabelSelectors); union != "" {
+		parts = append(parts, union)
+	}
+	return strings.Join(parts, ",")
+}
+
+// selectorLabels returns the label set a resource is matched against: its labels plus the
+// listed annotations projected as virtual labels. Real labels take precedence.
+func selectorLabels(resource *unstructured.Unstructured, virtualLabelAnnotations []string) labels.Set {
+	resourceLabels := resource.GetLabels()
+	if len(virtualLabelAnnotations) == 0 {
+		return labels.Set(resourceLabels)
+	}
+
+	annotations := resource.GetAnnotations()
+	merged := make(labels.Set, len(resourceLabels)+len(virtualLabelAnnotations))
+	for _, key := range virtualLabelAnnotations {
+		if value, ok := annotations[key]; ok {
+			merged[key] = value
+		}
+	}
+	for k, v := range resourceLabels {
+		merged[k] = v
+	}
+	return merged
+}
+
+// referencesVirtualLabels reports whether a selector has a requirement on any of the virtual label keys.
+func referencesVirtualLabels(selector *metav1.LabelSelector, virtualLabelAnnotations []string) bool {
+	for _, key := range virtualLabelAnnotations {
+		if _, ok := selector.MatchLabels[key]; ok {
+			return true
+		}
+		for _, expr := range selector.MatchExpressions {
+			if expr.Key == key {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// unionLabelSelector merges any-of label selectors into one server-side selector.
+// A single selector is used as is; several selectors are merged into "key in (...)" when each
+// is a single equality or In requirement on the same key. Returns "" when no merge is possible.
+func unionLabelSelector(selectors []metav1.LabelSelector) string {
+	if len(selectors) == 0 {
+		return ""
+	}
+	if len(selectors) == 1 {
+		selector, err := metav1.LabelSelectorAsSelector(&selectors[0])
+		if err != nil || selector.Empty() {
+			return ""
+		}
+		return selector.String()
+	}
+
+	key := ""
+	values := make(map[string]struct{})
+	for i := range selectors {
+		selKey, selValues, ok := singleInRequirement(&selectors[i])
+		if !ok || (key != "" && selKey != key) {
+			return ""
+		}
+		key = selKey
+		for _, v := range selValues {
+			values[v] = struct{}{}
+		}
+	}
+
+	merged := make([]string, 0, len(values))
+	for v := range values {
+		merged = append(merged, v)
+	}
+	sort.Strings(merged)
+	requirement, err := labels.NewRequirement(key, selection.In, merged)
+	if err != nil {
+		return ""
+	}
+	return requirement.String()
+}
+
+// singleInRequirement returns the key and values of a selector consisting of exactly one
+// matchLabels entry or one In expression.
+func singleInRequirement(selector *metav1.LabelSelector) (string, []string, bool) {
+	if len(selector.MatchLabels)+len(selector.MatchExpressions) != 1 {
+		return "", nil, false
+	}
+	for k, v := range selector.MatchLabels {
+		return k, []string{v}, true
+	}
+	expr := selector.MatchExpressions[0]
+	if expr.Operator != metav1.LabelSelectorOpIn || len(expr.Values) == 0 {
+		return "", nil, false
+	}
+	return expr.Key, expr.Values, true
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+// maxDeletionsBatchEndShared trims the batch resourcesToDelete[start:end] so that a run
+// deletes at most the policy's maxDeletionsPerRun resources. It returns start once the
+// cap has been reached, and end unchanged when the policy has no cap.
+func maxDeletionsBatchEndShared(policy *v1alpha1.GarbageCollectionPolicy, start, end int, deletedCount int64) int {
+	limit := int64(policy.Spec.Behavior.MaxDeletionsPerRun)
+	if limit <= 0 {
+		return end
+	}
+	remaining := limit - deletedCount
+	if remaining <= 0 {
+		return start
+	}
+	if int64(end-start) > remaining {
+		return start + int(remaining)
+	}
+	return end
+}
+
+// recordMaxDeletionsPerRunReachedShared reports that a run stopped at the policy's
+// maxDeletionsPerRun cap, leaving deferred candidates for the next run.
+func recordMaxDeletionsPerRunReachedShared(eventRecorder *EventRecorder, policy *v1alpha1.GarbageCollectionPolicy, deferred int64) {
+	recordMaxDeletionsPerRunReached(policy.Namespace, policy.Name)
+	sdklog.NewLogger("zen-gc").Info("Max deletions per run reached, deferring remaining deletions", sdklog.Operation("delete_batch"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Int("max_deletions_per_run", policy.Spec.Behavior.MaxDeletionsPerRun), sdklog.Int64("deferred", deferred))
+	if eventRecorder != nil {
+		eventRecorder.RecordMaxDeletionsPerRunReached(policy, deferred)
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func TestMaxDeletionsBatchEndShared(t *testing.T) {
+	tests := []struct {
+		name    string
+		limit   int
+		start   int
+		end     int
+		deleted int64
+		want    int
+	}{
+		{name: "no cap", limit: 0, start: 0, end: 50, deleted: 0, want: 50},
+		{name: "batch within cap", limit: 100, start: 0, end: 50, deleted: 0, want: 50},
+		{name: "batch trimmed to cap", limit: 60, start: 50, end: 100, deleted: 50, want: 60},
+		{name: "cap reached", limit: 50, start: 50, end: 100, deleted: 50, want: 50},
+		{name: "failures do not count toward cap", limit: 50, start: 50, end: 100, deleted: 40, want: 60},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &v1alpha1.GarbageCollectionPolicy{
+				Spec: v1alpha1.GarbageCollectionPolicySpec{
+					Behavior: v1alpha1.BehaviorSpec{MaxDeletionsPerRun: tt.limit},
+				},
+			}
+			if got := maxDeletionsBatchEndShared(policy, tt.start, tt.end, tt.deleted); got != tt.want {
+				t.Errorf("maxDeletionsBatchEndShared() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluatePolicy_MaxDeletionsPerRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reconciler, dynamicClient, policy := setupObservePausedTest(t, false)
+	defer reconciler.cleanupResourceInformer(policy.UID)
+
+	// Both seeded configmaps are expired; only one may be deleted per run
+	policy.Spec.Paused = false
+	policy.Spec.Behavior.MaxDeletionsPerRun = 1
+
+	if err := reconciler.evaluatePolicy(ctx, policy); err != nil {
+		t.Fatalf("evaluatePolicy() returned error: %v", err)
+	}
+	if deleted, _ := policyStatusCount(t, dynamicClient, "resourcesDeleted"); deleted != 1 {
+		t.Errorf("resourcesDeleted = %d, want 1", deleted)
+	}
+	if pending, _ := policyStatusCount(t, dynamicClient, "resourcesPending"); pending != 1 {
+		t.Errorf("resourcesPending = %d, want 1 deferred resource", pending)
+	}
+
+	list, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("Failed to list configmaps: %v", err)
+	}
+	if len(list.Items) != 1 {
+		t.Errorf("%d configmaps remain, want 1 deferred to the next run", len(list.Items))
+	}
+}
@@ -0,0 +1,264 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	gcerrors "github.com/kube-zen/zen-gc/pkg/errors"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+// Defaults for metric threshold queries.
+const (
+	// DefaultMetricQueryTimeout is the default timeout for a single metric query.
+	DefaultMetricQueryTimeout = 5 * time.Second
+
+	// DefaultMetricQueryCacheTTL is the default time a metric query result is cached.
+	DefaultMetricQueryCacheTTL = 30 * time.Second
+
+	// metricMaxResponseBytes bounds the size of a metrics backend response body.
+	metricMaxResponseBytes = 1 << 20
+)
+
+// Metric threshold condition operators.
+const (
+	MetricOperatorGreaterThan        = "GreaterThan"
+	MetricOperatorGreaterThanOrEqual = "GreaterThanOrEqual"
+	MetricOperatorLessThan           = "LessThan"
+	MetricOperatorLessThanOrEqual    = "LessThanOrEqual"
+)
+
+var (
+	// ErrMetricUnexpectedStatus indicates the metrics backend returned a non-200 HTTP status.
+	ErrMetricUnexpectedStatus = errors.New("unexpected metrics backend response status")
+
+	// ErrMetricQueryError indicates the metrics backend reported the query as failed.
+	ErrMetricQueryError = errors.New("metrics backend rejected query")
+
+	// ErrMetricNotSingleSample indicates the query result is not a scalar or a single-sample vector.
+	ErrMetricNotSingleSample = errors.New("metric query did not return a single sample")
+
+	// ErrNoMetricProvider indicates no metric provider is available.
+	ErrNoMetricProvider = errors.New("no metric provider available")
+)
+
+// MetricProvider queries a metrics backend for the current value of a query.
+type MetricProvider interface {
+	// Query returns the single value the query evaluates to at the backend at baseURL.
+	Query(ctx context.Context, baseURL, query string) (float64, error)
+}
+
+// PrometheusMetricProvider queries the Prometheus HTTP API (/api/v1/query).
+type PrometheusMetricProvider struct {
+	httpClient *http.Client
+}
+
+// NewPrometheusMetricProvider creates a new Prometheus metric provider.
+// If httpClient is nil, http.DefaultClient is used.
+func NewPrometheusMetricProvider(httpClient *http.Client) *PrometheusMetricProvider {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &PrometheusMetricProvider{httpClient: httpClient}
+}
+
+// Query runs an instant query and returns its value, which must be a scalar or a
+// vector with exactly one sample.
+func (p *PrometheusMetricProvider) Query(ctx context.Context, baseURL, query string) (float64, error) {
+	endpoint := strings.TrimSuffix(baseURL, "/") + "/api/v1/query?" + url.Values{"query": {query}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, http.NoBody)
+	if err != nil {
+		return 0, fmt.Errorf("failed to build metric query request: %w", err)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("metric query request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var body struct {
+		Status string `json:"status"`
+		Error  string `json:"error"`
+		Data   struct {
+			ResultType string          `json:"resultType"`
+			Result     json.RawMessage `json:"result"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, metricMaxResponseBytes)).Decode(&body); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return 0, fmt.Errorf("%w: %d", ErrMetricUnexpectedStatus, resp.StatusCode)
+		}
+		return 0, fmt.Errorf("failed to decode metric query response: %w", err)
+	}
+	if body.Status != "success" {
+		return 0, fmt.Errorf("%w: %s", ErrMetricQueryError, body.Error)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("%w: %d", ErrMetricUnexpectedStatus, resp.StatusCode)
+	}
+
+	// A sample is [<unix time>, "<value>"]
+	var sample []interface{}
+	switch body.Data.ResultType {
+	case "scalar":
+		if err := json.Unmarshal(body.Data.Result, &sample); err != nil {
+			return 0, fmt.Errorf("%w: %v", ErrMetricNotSingleSample, err)
+		}
+	case "vector":
+		var vector []struct {
+			Value []interface{} `json:"value"`
+		}
+		if err := json.Unmarshal(body.Data.Result, &vector); err != nil {
+			return 0, fmt.Errorf("%w: %v", ErrMetricNotSingleSample, err)
+		}
+		if len(vector) != 1 {
+			return 0, fmt.Errorf("%w: got %d samples", ErrMetricNotSingleSample, len(vector))
+		}
+		sample = vector[0].Value
+	default:
+		return 0, fmt.Errorf("%w: result type %q", ErrMetricNotSingleSample, body.Data.ResultType)
+	}
+
+	if len(sample) != 2 {
+		return 0, fmt.Errorf("%w: malformed sample", ErrMetricNotSingleSample)
+	}
+	raw, ok := sample[1].(string)
+	if !ok {
+		return 0, fmt.Errorf("%w: malformed sample value", ErrMetricNotSingleSample)
+	}
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrMetricNotSingleSample, err)
+	}
+	return value, nil
+}
+
+// metricCacheEntry is a cached metric query result.
+type metricCacheEntry struct {
+	value     float64
+	expiresAt time.Time
+}
+
+// MetricThresholdCache runs metric threshold queries through a MetricProvider and caches
+// the results briefly, so policies sharing a query do not hit the backend on every evaluation.
+type MetricThresholdCache struct {
+	provider MetricProvider
+	entries  map[string]metricCacheEntry
+	mu       sync.Mutex
+	now      func() time.Time
+}
+
+// NewMetricThresholdCache creates a new MetricThresholdCache.
+func NewMetricThresholdCache(provider MetricProvider) *MetricThresholdCache {
+	return &MetricThresholdCache{
+		provider: provider,
+		entries:  make(map[string]metricCacheEntry),
+		now:      time.Now,
+	}
+}
+
+// Value returns the current value of the condition's query. Query failures are not cached.
+func (c *MetricThresholdCache) Value(ctx context.Context, cond *v1alpha1.MetricThresholdCondition) (float64, error) {
+	if c == nil || c.provider == nil {
+		return 0, ErrNoMetricProvider
+	}
+	key := cond.URL + "\x00" + cond.Query
+
+	c.mu.Lock()
+	if entry, ok := c.entries[key]; ok && c.now().Before(entry.expiresAt) {
+		c.mu.Unlock()
+		return entry.value, nil
+	}
+	c.mu.Unlock()
+
+	timeout := DefaultMetricQueryTimeout
+	if cond.TimeoutSeconds != nil && *cond.TimeoutSeconds > 0 {
+		timeout = time.Duration(*cond.TimeoutSeconds) * time.Second
+	}
+	queryCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	value, err := c.provider.Query(queryCtx, cond.URL, cond.Query)
+	if err != nil {
+		return 0, err
+	}
+
+	cacheTTL := DefaultMetricQueryCacheTTL
+	if cond.CacheTTLSeconds != nil {
+		cacheTTL = time.Duration(*cond.CacheTTLSeconds) * time.Second
+	}
+	if cacheTTL > 0 {
+		c.mu.Lock()
+		c.entries[key] = metricCacheEntry{value: value, expiresAt: c.now().Add(cacheTTL)}
+		c.mu.Unlock()
+	}
+	return value, nil
+}
+
+// metricCrossesThreshold compares a metric value with a threshold.
+func metricCrossesThreshold(value float64, operator string, threshold float64) bool {
+	switch operator {
+	case MetricOperatorGreaterThan:
+		return value > threshold
+	case MetricOperatorGreaterThanOrEqual:
+		return value >= threshold
+	case MetricOperatorLessThan:
+		return value < threshold
+	case MetricOperatorLessThanOrEqual:
+		return value <= threshold
+	default:
+		return false
+	}
+}
+
+// metricThresholdAllowsDeletionShared reports whether the policy's metric threshold is
+// crossed. Policies without one always allow deletion; if the query or the threshold
+// cannot be evaluated, deletion is held.
+func metricThresholdAllowsDeletionShared(ctx context.Context, cache *MetricThresholdCache, policy *v1alpha1.GarbageCollectionPolicy) bool {
+	if policy.Spec.Conditions == nil || policy.Spec.Conditions.MetricThreshold == nil {
+		return true
+	}
+	cond := policy.Spec.Conditions.MetricThreshold
+	logger := sdklog.NewLogger("zen-gc")
+
+	threshold, err := strconv.ParseFloat(cond.Value, 64)
+	if err != nil {
+		logger.Warn("Invalid metric threshold, holding deletions", sdklog.Operation("metric_threshold"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Error(err))
+		return false
+	}
+
+	value, err := cache.Value(ctx, cond)
+	if err != nil {
+		recordError(policy.Namespace, policy.Name, gcerrors.TypeMetricQueryFailed)
+		logger.Warn("Metric query failed, holding deletions", sdklog.Operation("metric_threshold"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Error(err))
+		return false
+	}
+	return metricCrossesThreshold(value, cond.Operator, threshold)
+}
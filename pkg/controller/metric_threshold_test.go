@@ -0,0 +1,242 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// fakeMetricProvider returns a fixed value or error and counts queries.
+type fakeMetricProvider struct {
+	value float64
+	err   error
+	calls int
+}
+
+func (p *fakeMetricProvider) Query(_ context.Context, _, _ string) (float64, error) {
+	p.calls++
+	return p.value, p.err
+}
+
+var testMetricThreshold = v1alpha1.MetricThresholdCondition{
+	URL:      "http://prometheus.monitoring:9090",
+	Query:    "avg(node_memory_usage_ratio)",
+	Operator: MetricOperatorGreaterThan,
+	Value:    "0.8",
+}
+
+func TestMetricThresholdAllowsDeletionShared(t *testing.T) {
+	tests := []struct {
+		name     string
+		operator string
+		value    float64
+		expected bool
+	}{
+		{name: "above GreaterThan threshold", operator: MetricOperatorGreaterThan, value: 0.85, expected: true},
+		{name: "below GreaterThan threshold", operator: MetricOperatorGreaterThan, value: 0.5, expected: false},
+		{name: "at GreaterThan threshold", operator: MetricOperatorGreaterThan, value: 0.8, expected: false},
+		{name: "at GreaterThanOrEqual threshold", operator: MetricOperatorGreaterThanOrEqual, value: 0.8, expected: true},
+		{name: "below LessThan threshold", operator: MetricOperatorLessThan, value: 0.5, expected: true},
+		{name: "above LessThan threshold", operator: MetricOperatorLessThan, value: 0.9, expected: false},
+		{name: "at LessThanOrEqual threshold", operator: MetricOperatorLessThanOrEqual, value: 0.8, expected: true},
+		{name: "unknown operator", operator: "Equals", value: 0.8, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := NewMetricThresholdCache(&fakeMetricProvider{value: tt.value})
+			cond := testMetricThreshold
+			cond.Operator = tt.operator
+			policy := newTestPolicy("cache-cleanup")
+			policy.Spec.Conditions = &v1alpha1.ConditionsSpec{MetricThreshold: &cond}
+			got := metricThresholdAllowsDeletionShared(context.Background(), cache, policy)
+			if got != tt.expected {
+				t.Errorf("metricThresholdAllowsDeletionShared() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMetricThresholdAllowsDeletionShared_FailsClosed(t *testing.T) {
+	ctx := context.Background()
+	cond := testMetricThreshold
+	policy := newTestPolicy("cache-cleanup")
+	policy.Spec.Conditions = &v1alpha1.ConditionsSpec{MetricThreshold: &cond}
+
+	failing := NewMetricThresholdCache(&fakeMetricProvider{err: errors.New("connection refused")})
+	if metricThresholdAllowsDeletionShared(ctx, failing, policy) {
+		t.Error("expected a failing query to hold deletions")
+	}
+	if metricThresholdAllowsDeletionShared(ctx, nil, policy) {
+		t.Error("expected a missing provider to hold deletions")
+	}
+	above := NewMetricThresholdCache(&fakeMetricProvider{value: 1})
+	cond.Value = "high"
+	if metricThresholdAllowsDeletionShared(ctx, above, policy) {
+		t.Error("expected an invalid threshold to hold deletions")
+	}
+
+	policy.Spec.Conditions.MetricThreshold = nil
+	if !metricThresholdAllowsDeletionShared(ctx, nil, policy) {
+		t.Error("expected a policy without metricThreshold to allow deletions")
+	}
+}
+
+func TestMetricThresholdCache_Value(t *testing.T) {
+	ctx := context.Background()
+	provider := &fakeMetricProvider{value: 0.9}
+	cache := NewMetricThresholdCache(provider)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+	cond := testMetricThreshold
+
+	for i := 0; i < 2; i++ {
+		if got, err := cache.Value(ctx, &cond); err != nil || got != 0.9 {
+			t.Fatalf("Value() = %v, %v, want 0.9", got, err)
+		}
+	}
+	if provider.calls != 1 {
+		t.Errorf("provider queried %d times, want 1 (cached)", provider.calls)
+	}
+
+	// Expired results are queried again, and failures are not cached
+	now = now.Add(DefaultMetricQueryCacheTTL)
+	provider.err = errors.New("timeout")
+	if _, err := cache.Value(ctx, &cond); err == nil {
+		t.Fatal("expected Value() to return the provider error")
+	}
+	provider.err = nil
+	provider.value = 0.4
+	if got, err := cache.Value(ctx, &cond); err != nil || got != 0.4 {
+		t.Errorf("Value() = %v, %v, want 0.4", got, err)
+	}
+	if provider.calls != 3 {
+		t.Errorf("provider queried %d times, want 3", provider.calls)
+	}
+}
+
+func TestPrometheusMetricProvider_Query(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		body      string
+		expected  float64
+		expectErr error
+	}{
+		{
+			name:     "single-sample vector",
+			status:   http.StatusOK,
+			body:     `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000,"0.83"]}]}}`,
+			expected: 0.83,
+		},
+		{
+			name:     "scalar",
+			status:   http.StatusOK,
+			body:     `{"status":"success","data":{"resultType":"scalar","result":[1700000000,"42"]}}`,
+			expected: 42,
+		},
+		{
+			name:      "empty vector",
+			status:    http.StatusOK,
+			body:      `{"status":"success","data":{"resultType":"vector","result":[]}}`,
+			expectErr: ErrMetricNotSingleSample,
+		},
+		{
+			name:      "multi-sample vector",
+			status:    http.StatusOK,
+			body:      `{"status":"success","data":{"resultType":"vector","result":[{"value":[1,"1"]},{"value":[1,"2"]}]}}`,
+			expectErr: ErrMetricNotSingleSample,
+		},
+		{
+			name:      "query error",
+			status:    http.StatusBadRequest,
+			body:      `{"status":"error","errorType":"bad_data","error":"parse error"}`,
+			expectErr: ErrMetricQueryError,
+		},
+		{
+			name:      "unexpected status",
+			status:    http.StatusBadGateway,
+			body:      `bad gateway`,
+			expectErr: ErrMetricUnexpectedStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v1/query" || r.URL.Query().Get("query") != "up" {
+					t.Errorf("unexpected request %s", r.URL.String())
+				}
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			got, err := NewPrometheusMetricProvider(server.Client()).Query(context.Background(), server.URL+"/", "up")
+			if tt.expectErr != nil {
+				if !errors.Is(err, tt.expectErr) {
+					t.Errorf("Query() error = %v, want %v", err, tt.expectErr)
+				}
+				return
+			}
+			if err != nil || got != tt.expected {
+				t.Errorf("Query() = %v, %v, want %v", got, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEvaluatePolicy_MetricThresholdHoldsDeletions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reconciler, dynamicClient, policy := setupObservePausedTest(t, false)
+	defer reconciler.cleanupResourceInformer(policy.UID)
+
+	policy.Spec.Paused = false
+	cond := testMetricThreshold
+	policy.Spec.Conditions = &v1alpha1.ConditionsSpec{MetricThreshold: &cond}
+	provider := &fakeMetricProvider{value: 0.5}
+	reconciler.metricThreshold = NewMetricThresholdCache(provider)
+
+	// Below the threshold both expired configmaps stay pending
+	if err := reconciler.evaluatePolicy(ctx, policy); err != nil {
+		t.Fatalf("evaluatePolicy() returned error: %v", err)
+	}
+	if pending, _ := policyStatusCount(t, dynamicClient, "resourcesPending"); pending != 2 {
+		t.Errorf("resourcesPending = %d, want 2", pending)
+	}
+	if deleted, _ := policyStatusCount(t, dynamicClient, "resourcesDeleted"); deleted != 0 {
+		t.Errorf("resourcesDeleted = %d, want 0", deleted)
+	}
+
+	// Above the threshold they are deleted
+	reconciler.metricThreshold = NewMetricThresholdCache(&fakeMetricProvider{value: 0.9})
+	reconciler.evaluationService.metricThreshold = reconciler.metricThreshold
+	if err := reconciler.evaluatePolicy(ctx, policy); err != nil {
+		t.Fatalf("evaluatePolicy() returned error: %v", err)
+	}
+	if deleted, _ := policyStatusCount(t, dynamicClient, "resourcesDeleted"); deleted != 2 {
+		t.Errorf("resourcesDeleted = %d, want 2", deleted)
+	}
+}
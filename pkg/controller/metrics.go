@@ -1,6 +1,8 @@
 package controller
 
 import (
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
@@ -43,6 +45,16 @@ var (
 		[]string{"policy_namespace", "policy_name", "resource_api_version", "resource_kind"},
 	)
 
+	// GcResourceEligibilityLatencySeconds is a histogram that tracks how long deleted resources were eligible for deletion.
+	gcResourceEligibilityLatencySeconds = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "gc_resource_eligibility_latency_seconds",
+			Help:    "Time between a deleted resource's TTL expiration and its deletion",
+			Buckets: []float64{1, 5, 15, 30, 60, 300, 900, 1800, 3600, 21600, 86400},
+		},
+		[]string{"policy_namespace", "policy_name", "resource_api_version", "resource_kind"},
+	)
+
 	// GcErrorsTotal is a counter that tracks the total number of GC errors.
 	gcErrorsTotal = promauto.NewCounterVec(
 		prometheus.CounterOpts{
@@ -87,6 +99,15 @@ var (
 		[]string{"policy_namespace", "policy_name", "resource_api_version", "resource_kind"},
 	)
 
+	// GcOldestPendingResourceAgeSeconds is a gauge that tracks the age of the oldest pending resource per policy.
+	gcOldestPendingResourceAgeSeconds = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "gc_oldest_pending_resource_age_seconds",
+			Help: "Age of the oldest resource matched by a policy but not deleted (0 if none)",
+		},
+		[]string{"policy_namespace", "policy_name", "resource_api_version", "resource_kind"},
+	)
+
 	// GcLeaderElectionStatus is a gauge that tracks leader election status (1 = leader, 0 = follower).
 	gcLeaderElectionStatus = promauto.NewGauge(
 		prometheus.GaugeOpts{
@@ -102,6 +123,136 @@ var (
 			Help: "Total number of leader election transitions (becoming leader or losing leadership)",
 		},
 	)
+
+	// GcBuildInfo is an info metric carrying the controller's build information (always 1).
+	gcBuildInfo = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "gc_build_info",
+			Help: "Build information of the GC controller (always 1)",
+		},
+		[]string{"version", "commit", "build_date"},
+	)
+
+	// GcLastSweepTimestamp is a gauge that tracks when the controller last reconciled a policy.
+	gcLastSweepTimestamp = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "gc_last_sweep_timestamp",
+			Help: "Unix time in seconds of the controller's last policy reconcile",
+		},
+	)
+
+	// GcSweepDurationSeconds is a histogram that tracks the time taken by each policy reconcile.
+	gcSweepDurationSeconds = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "gc_sweep_duration_seconds",
+			Help:    "Time taken by each policy reconcile, including evaluation and deletions",
+			Buckets: []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1.0, 5.0, 30.0},
+		},
+	)
+
+	// GcDeletionCooldownActive is a gauge that tracks whether deletions are paused by the error rate breaker.
+	gcDeletionCooldownActive = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "gc_deletion_cooldown_active",
+			Help: "Whether all deletions are deferred because the API error rate exceeded its threshold (1 = cooling down)",
+		},
+	)
+
+	// GcDeletionsSuspended is a gauge that tracks whether deletions are suspended by the deletion sentinel.
+	gcDeletionsSuspended = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "gc_deletions_suspended",
+			Help: "Whether all deletions are suspended because the deletion sentinel is unreachable or disabled (1 = suspended)",
+		},
+	)
+
+	// GcAuditRecordsDroppedTotal is a counter that tracks deletion audit records that could not be written.
+	gcAuditRecordsDroppedTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "gc_audit_records_dropped_total",
+			Help: "Total number of deletion audit records dropped because the audit buffer was full or the audit log could not be written",
+		},
+	)
+
+	// GcDeletionCooldownsTotal is a counter that tracks how often the error rate breaker paused deletions.
+	gcDeletionCooldownsTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "gc_deletion_cooldowns_total",
+			Help: "Total number of times deletions were paused because the API error rate exceeded its threshold",
+		},
+	)
+
+	// GcResourcesAlreadyGoneTotal is a counter that tracks resources that were already gone when deleted.
+	gcResourcesAlreadyGoneTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gc_resources_already_gone_total",
+			Help: "Total number of resources already gone (NotFound) when GC deleted them, e.g. deleted by another actor",
+		},
+		[]string{"policy_namespace", "policy_name", "resource_api_version", "resource_kind"},
+	)
+
+	// GcResourcesModifiedBeforeDeletionTotal is a counter that tracks deletes rejected by their resourceVersion precondition.
+	gcResourcesModifiedBeforeDeletionTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gc_resources_modified_before_deletion_total",
+			Help: "Total number of deletions skipped because the resource changed since it was evaluated (behavior.preconditions)",
+		},
+		[]string{"policy_namespace", "policy_name", "resource_api_version", "resource_kind"},
+	)
+
+	// GcMaxDeletionsPerRunReachedTotal is a counter that tracks how often a run stopped at its policy's maxDeletionsPerRun cap.
+	gcMaxDeletionsPerRunReachedTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gc_max_deletions_per_run_reached_total",
+			Help: "Total number of evaluations that stopped deleting at the policy's maxDeletionsPerRun cap",
+		},
+		[]string{"policy_namespace", "policy_name"},
+	)
+
+	// GcAPICallBudgetExhaustedTotal is a counter that tracks how often a run stopped at its policy's maxApiCalls budget.
+	gcAPICallBudgetExhaustedTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gc_api_call_budget_exhausted_total",
+			Help: "Total number of evaluations that stopped at the policy's maxApiCalls budget",
+		},
+		[]string{"policy_namespace", "policy_name"},
+	)
+
+	// GcPolicyStale is a gauge that tracks whether a policy has matched nothing for several consecutive runs.
+	gcPolicyStale = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "gc_policy_stale",
+			Help: "Whether a policy matched no resources for the configured number of consecutive evaluations (1 = stale)",
+		},
+		[]string{"policy_namespace", "policy_name"},
+	)
+
+	// GcPolicyMatched is a gauge that tracks the number of resources a policy matched in its last run.
+	gcPolicyMatched = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "gc_policy_matched",
+			Help: "Number of resources matched by the policy's last evaluation",
+		},
+		[]string{"policy_namespace", "policy_name"},
+	)
+
+	// GcPolicyPending is a gauge that tracks the number of resources a policy left pending in its last run.
+	gcPolicyPending = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "gc_policy_pending",
+			Help: "Number of resources matched but not deleted by the policy's last evaluation",
+		},
+		[]string{"policy_namespace", "policy_name"},
+	)
+
+	// GcPolicyDeletedTotal is a gauge that tracks the number of resources a policy deleted since the controller started.
+	gcPolicyDeletedTotal = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "gc_policy_deleted_total",
+			Help: "Number of resources deleted by the policy since the controller started, removed with the policy",
+		},
+		[]string{"policy_namespace", "policy_name"},
+	)
 )
 
 // recordPolicyPhase records the current phase of a policy.
@@ -122,6 +273,21 @@ func recordResourceDeleted(policyNamespace, policyName, resourceAPIVersion, reso
 	gcDeletionDurationSeconds.WithLabelValues(policyNamespace, policyName, resourceAPIVersion, resourceKind).Observe(duration)
 }
 
+// recordEligibilityLatency records how long a deleted resource was eligible for deletion.
+func recordEligibilityLatency(policyNamespace, policyName, resourceAPIVersion, resourceKind string, latency float64) {
+	gcResourceEligibilityLatencySeconds.WithLabelValues(policyNamespace, policyName, resourceAPIVersion, resourceKind).Observe(latency)
+}
+
+// recordResourceAlreadyGone records a resource that was already gone when deleted.
+func recordResourceAlreadyGone(policyNamespace, policyName, resourceAPIVersion, resourceKind string) {
+	gcResourcesAlreadyGoneTotal.WithLabelValues(policyNamespace, policyName, resourceAPIVersion, resourceKind).Inc()
+}
+
+// recordResourceModifiedBeforeDeletion records a delete rejected by its resourceVersion precondition.
+func recordResourceModifiedBeforeDeletion(policyNamespace, policyName, resourceAPIVersion, resourceKind string) {
+	gcResourcesModifiedBeforeDeletionTotal.WithLabelValues(policyNamespace, policyName, resourceAPIVersion, resourceKind).Inc()
+}
+
 // recordError records an error that occurred during GC.
 func recordError(policyNamespace, policyName, errorType string) {
 	gcErrorsTotal.WithLabelValues(policyNamespace, policyName, errorType).Inc()
@@ -147,6 +313,11 @@ func recordResourcesPending(policyNamespace, policyName, resourceAPIVersion, res
 	gcResourcesPendingTotal.WithLabelValues(policyNamespace, policyName, resourceAPIVersion, resourceKind).Set(float64(count))
 }
 
+// recordOldestPendingAge records the age of the oldest resource pending deletion.
+func recordOldestPendingAge(policyNamespace, policyName, resourceAPIVersion, resourceKind string, ageSeconds int64) {
+	gcOldestPendingResourceAgeSeconds.WithLabelValues(policyNamespace, policyName, resourceAPIVersion, resourceKind).Set(float64(ageSeconds))
+}
+
 // recordLeaderElectionStatus records the current leader election status.
 func recordLeaderElectionStatus(isLeader bool) {
 	if isLeader {
@@ -160,3 +331,82 @@ func recordLeaderElectionStatus(isLeader bool) {
 func recordLeaderElectionTransition() {
 	gcLeaderElectionTransitionsTotal.Inc()
 }
+
+// RecordBuildInfo publishes the controller's build information as gc_build_info.
+func RecordBuildInfo(version, commit, buildDate string) {
+	gcBuildInfo.Reset()
+	gcBuildInfo.WithLabelValues(version, commit, buildDate).Set(1)
+}
+
+// recordSweep records that the controller reconciled a policy, as a heartbeat.
+func recordSweep(now time.Time) {
+	gcLastSweepTimestamp.Set(float64(now.Unix()))
+}
+
+// recordSweepDuration records how long a policy reconcile took.
+func recordSweepDuration(seconds float64) {
+	gcSweepDurationSeconds.Observe(seconds)
+}
+
+// recordAuditRecordDropped records a deletion audit record that could not be written.
+func recordAuditRecordDropped() {
+	gcAuditRecordsDroppedTotal.Inc()
+}
+
+// recordDeletionCooldown records whether the error rate breaker is deferring deletions.
+func recordDeletionCooldown(active bool) {
+	if active {
+		gcDeletionCooldownActive.Set(1)
+		gcDeletionCooldownsTotal.Inc()
+	} else {
+		gcDeletionCooldownActive.Set(0)
+	}
+}
+
+// recordDeletionsSuspended records whether the deletion sentinel is suspending deletions.
+func recordDeletionsSuspended(suspended bool) {
+	if suspended {
+		gcDeletionsSuspended.Set(1)
+	} else {
+		gcDeletionsSuspended.Set(0)
+	}
+}
+
+// recordMaxDeletionsPerRunReached records that an evaluation stopped at its maxDeletionsPerRun cap.
+func recordMaxDeletionsPerRunReached(policyNamespace, policyName string) {
+	gcMaxDeletionsPerRunReachedTotal.WithLabelValues(policyNamespace, policyName).Inc()
+}
+
+// recordAPICallBudgetExhausted records that an evaluation stopped at its maxApiCalls budget.
+func recordAPICallBudgetExhausted(policyNamespace, policyName string) {
+	gcAPICallBudgetExhaustedTotal.WithLabelValues(policyNamespace, policyName).Inc()
+}
+
+// recordPolicyStale records whether a policy keeps matching no resources.
+func recordPolicyStale(policyNamespace, policyName string, stale bool) {
+	if stale {
+		gcPolicyStale.WithLabelValues(policyNamespace, policyName).Set(1)
+	} else {
+		gcPolicyStale.WithLabelValues(policyNamespace, policyName).Set(0)
+	}
+}
+
+// forgetPolicyStale drops the stale gauge of a deleted policy.
+func forgetPolicyStale(policyNamespace, policyName string) {
+	gcPolicyStale.DeleteLabelValues(policyNamespace, policyName)
+}
+
+// recordPolicyCounts records the resources a policy's run matched and left pending, and
+// adds those it deleted to the policy's total.
+func recordPolicyCounts(policyNamespace, policyName string, matched, pending, deleted int64) {
+	gcPolicyMatched.WithLabelValues(policyNamespace, policyName).Set(float64(matched))
+	gcPolicyPending.WithLabelValues(policyNamespace, policyName).Set(float64(pending))
+	gcPolicyDeletedTotal.WithLabelValues(policyNamespace, policyName).Add(float64(deleted))
+}
+
+// forgetPolicyCounts drops the count gauges of a deleted policy.
+func forgetPolicyCounts(policyNamespace, policyName string) {
+	gcPolicyMatched.DeleteLabelValues(policyNamespace, policyName)
+	gcPolicyPending.DeleteLabelValues(policyNamespace, policyName)
+	gcPolicyDeletedTotal.DeleteLabelValues(policyNamespace, policyName)
+}
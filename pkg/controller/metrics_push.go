@@ -0,0 +1,55 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/push"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// DefaultPushJob is the Pushgateway job metrics are pushed under.
+const DefaultPushJob = "zen-gc"
+
+// gcMetricPrefix prefixes every metric the controller defines.
+const gcMetricPrefix = "gc_"
+
+// PushMetrics pushes a snapshot of the gc_* metrics to a Prometheus Pushgateway, replacing
+// the job's previous snapshot. Short-lived runs, such as drain jobs built on the evaluation
+// service, call it on completion since they exit before they can be scraped. Pushing is
+// best-effort: callers should log the error rather than fail on it.
+func PushMetrics(ctx context.Context, url, job string) error {
+	return push.New(url, job).Gatherer(gcMetricsGatherer(prometheus.DefaultGatherer)).PushContext(ctx)
+}
+
+// gcMetricsGatherer returns a gatherer of the controller's own metric families, leaving out
+// the Go runtime and process metrics a scrape of the process would also report.
+func gcMetricsGatherer(gatherer prometheus.Gatherer) prometheus.Gatherer {
+	return prometheus.GathererFunc(func() ([]*dto.MetricFamily, error) {
+		families, err := gatherer.Gather()
+		gcFamilies := make([]*dto.MetricFamily, 0, len(families))
+		for _, family := range families {
+			if strings.HasPrefix(family.GetName(), gcMetricPrefix) {
+				gcFamilies = append(gcFamilies, family)
+			}
+		}
+		return gcFamilies, err
+	})
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+// fakePushgateway records the metric families pushed to it.
+type fakePushgateway struct {
+	mu       sync.Mutex
+	method   string
+	path     string
+	families map[string]*dto.MetricFamily
+}
+
+func (g *fakePushgateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.method = r.Method
+	g.path = r.URL.Path
+	g.families = map[string]*dto.MetricFamily{}
+
+	decoder := expfmt.NewDecoder(r.Body, expfmt.ResponseFormat(r.Header))
+	for {
+		family := &dto.MetricFamily{}
+		if err := decoder.Decode(family); err != nil {
+			if !errors.Is(err, io.EOF) {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			break
+		}
+		g.families[family.GetName()] = family
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func TestPushMetrics_PushesGCMetricFamilies(t *testing.T) {
+	recordResourceMatched("push-ns", "push-policy", "v1", "ConfigMap")
+	recordError("push-ns", "push-policy", "deletion_failed")
+
+	gateway := &fakePushgateway{}
+	server := httptest.NewServer(gateway)
+	defer server.Close()
+
+	if err := PushMetrics(context.Background(), server.URL, DefaultPushJob); err != nil {
+		t.Fatalf("PushMetrics() returned error: %v", err)
+	}
+
+	gateway.mu.Lock()
+	defer gateway.mu.Unlock()
+	if gateway.method != http.MethodPut {
+		t.Errorf("Expected PUT to replace the job's snapshot, got %s", gateway.method)
+	}
+	if gateway.path != "/metrics/job/"+DefaultPushJob {
+		t.Errorf("Expected push to /metrics/job/%s, got %s", DefaultPushJob, gateway.path)
+	}
+
+	for _, name := range []string{"gc_resources_matched_total", "gc_errors_total"} {
+		if _, ok := gateway.families[name]; !ok {
+			t.Errorf("Expected metric family %s to be pushed", name)
+		}
+	}
+	for name := range gateway.families {
+		if !strings.HasPrefix(name, gcMetricPrefix) {
+			t.Errorf("Expected only gc_* metric families to be pushed, got %s", name)
+		}
+	}
+
+	matched := gateway.families["gc_resources_matched_total"]
+	found := false
+	for _, metric := range matched.GetMetric() {
+		for _, label := range metric.GetLabel() {
+			if label.GetName() == "policy_name" && label.GetValue() == "push-policy" {
+				found = metric.GetCounter().GetValue() >= 1
+			}
+		}
+	}
+	if !found {
+		t.Error("Expected pushed gc_resources_matched_total to include the push-policy series")
+	}
+}
+
+func TestPushMetrics_RejectedPushReturnsError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	if err := PushMetrics(context.Background(), server.URL, DefaultPushJob); err == nil {
+		t.Error("Expected PushMetrics() to return an error when the Pushgateway rejects the push")
+	}
+}
@@ -1,7 +1,14 @@
 package controller
 
 import (
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
 )
 
 func TestRecordPolicyPhase(t *testing.T) {
@@ -100,3 +107,71 @@ func TestMetrics_AllFunctions(t *testing.T) {
 		recordLeaderElectionTransition()
 	})
 }
+
+func TestRecordBuildInfo(t *testing.T) {
+	RecordBuildInfo("v1.2.3", "abc1234", "2024-01-01T00:00:00Z")
+	if got := testutil.ToFloat64(gcBuildInfo.WithLabelValues("v1.2.3", "abc1234", "2024-01-01T00:00:00Z")); got != 1 {
+		t.Errorf("gc_build_info = %v, want 1", got)
+	}
+
+	// Re-recording replaces the previous label set rather than adding a second series
+	RecordBuildInfo("v1.2.4", "def5678", "2024-02-01T00:00:00Z")
+	if got := testutil.CollectAndCount(gcBuildInfo); got != 1 {
+		t.Errorf("gc_build_info series = %d, want 1", got)
+	}
+	if got := testutil.ToFloat64(gcBuildInfo.WithLabelValues("v1.2.4", "def5678", "2024-02-01T00:00:00Z")); got != 1 {
+		t.Errorf("gc_build_info = %v, want 1", got)
+	}
+}
+
+func TestRecordSweep(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	recordSweep(now)
+	if got := testutil.ToFloat64(gcLastSweepTimestamp); got != float64(now.Unix()) {
+		t.Errorf("gc_last_sweep_timestamp = %v, want %v", got, float64(now.Unix()))
+	}
+}
+
+func TestRecordEligibilityLatencyShared(t *testing.T) {
+	ttl := int64(60)
+	policy := &v1alpha1.GarbageCollectionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "latency-policy", Namespace: "default"},
+		Spec: v1alpha1.GarbageCollectionPolicySpec{
+			TargetResource: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap"},
+			TTL:            v1alpha1.TTLSpec{SecondsAfterCreation: &ttl},
+		},
+	}
+	// Creation timestamps have second precision
+	now := time.Now().Truncate(time.Second)
+	gcResourceEligibilityLatencySeconds.Reset()
+
+	// Not yet expired: nothing is recorded
+	recordEligibilityLatencyShared(policy, newTestConfigMap("default", "fresh", now.Add(-30*time.Second)), now)
+	if got := testutil.CollectAndCount(gcResourceEligibilityLatencySeconds); got != 0 {
+		t.Errorf("series = %d after an unexpired resource, want 0", got)
+	}
+
+	// Expired 90 seconds ago
+	recordEligibilityLatencyShared(policy, newTestConfigMap("default", "expired", now.Add(-150*time.Second)), now)
+	expected := `
+		# HELP gc_resource_eligibility_latency_seconds Time between a deleted resource's TTL expiration and its deletion
+		# TYPE gc_resource_eligibility_latency_seconds histogram
+		gc_resource_eligibility_latency_seconds_bucket{policy_name="latency-policy",policy_namespace="default",resource_api_version="v1",resource_kind="ConfigMap",le="1"} 0
+		gc_resource_eligibility_latency_seconds_bucket{policy_name="latency-policy",policy_namespace="default",resource_api_version="v1",resource_kind="ConfigMap",le="5"} 0
+		gc_resource_eligibility_latency_seconds_bucket{policy_name="latency-policy",policy_namespace="default",resource_api_version="v1",resource_kind="ConfigMap",le="15"} 0
+		gc_resource_eligibility_latency_seconds_bucket{policy_name="latency-policy",policy_namespace="default",resource_api_version="v1",resource_kind="ConfigMap",le="30"} 0
+		gc_resource_eligibility_latency_seconds_bucket{policy_name="latency-policy",policy_namespace="default",resource_api_version="v1",resource_kind="ConfigMap",le="60"} 0
+		gc_resource_eligibility_latency_seconds_bucket{policy_name="latency-policy",policy_namespace="default",resource_api_version="v1",resource_kind="ConfigMap",le="300"} 1
+		gc_resource_eligibility_latency_seconds_bucket{policy_name="latency-policy",policy_namespace="default",resource_api_version="v1",resource_kind="ConfigMap",le="900"} 1
+		gc_resource_eligibility_latency_seconds_bucket{policy_name="latency-policy",policy_namespace="default",resource_api_version="v1",resource_kind="ConfigMap",le="1800"} 1
+		gc_resource_eligibility_latency_seconds_bucket{policy_name="latency-policy",policy_namespace="default",resource_api_version="v1",resource_kind="ConfigMap",le="3600"} 1
+		gc_resource_eligibility_latency_seconds_bucket{policy_name="latency-policy",policy_namespace="default",resource_api_version="v1",resource_kind="ConfigMap",le="21600"} 1
+		gc_resource_eligibility_latency_seconds_bucket{policy_name="latency-policy",policy_namespace="default",resource_api_version="v1",resource_kind="ConfigMap",le="86400"} 1
+		gc_resource_eligibility_latency_seconds_bucket{policy_name="latency-policy",policy_namespace="default",resource_api_version="v1",resource_kind="ConfigMap",le="+Inf"} 1
+		gc_resource_eligibility_latency_seconds_sum{policy_name="latency-policy",policy_namespace="default",resource_api_version="v1",resource_kind="ConfigMap"} 90
+		gc_resource_eligibility_latency_seconds_count{policy_name="latency-policy",policy_namespace="default",resource_api_version="v1",resource_kind="ConfigMap"} 1
+	`
+	if err := testutil.CollectAndCompare(gcResourceEligibilityLatencySeconds, strings.NewReader(expected)); err != nil {
+		t.Errorf("unexpected eligibility latency: %v", err)
+	}
+}
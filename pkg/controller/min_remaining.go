@@ -0,0 +1,92 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sort"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// minRemainingGroupKey returns the resource's minRemaining group. Resources missing a key
+// label or field share one group per scope, so they are still protected.
+func minRemainingGroupKey(resource *unstructured.Unstructured, spec *v1alpha1.MinRemainingSpec) string {
+	if key, ok := groupKey(resource, spec.KeyLabels, spec.KeyFields, spec.Scope); ok {
+		return key
+	}
+	unkeyed, _ := groupKey(resource, nil, nil, spec.Scope)
+	return "unkeyed:" + unkeyed
+}
+
+// applyMinRemainingShared drops deletions that would leave a group with fewer than the
+// policy's minRemaining resources. matched holds every matched resource and sets the
+// group sizes; within a group the oldest resources are deleted first. Returns the
+// resources still to delete and the number held back.
+func applyMinRemainingShared(
+	policy *v1alpha1.GarbageCollectionPolicy,
+	matched, resourcesToDelete []*unstructured.Unstructured,
+) (allowed []*unstructured.Unstructured, held int64) {
+	spec := policy.Spec.Behavior.MinRemaining
+	if spec == nil || len(resourcesToDelete) == 0 {
+		return resourcesToDelete, 0
+	}
+
+	groupSizes := make(map[string]int)
+	for _, resource := range matched {
+		groupSizes[minRemainingGroupKey(resource, spec)]++
+	}
+
+	// Oldest first, so the newest resources of a group are the ones kept
+	ordered := make([]*unstructured.Unstructured, len(resourcesToDelete))
+	copy(ordered, resourcesToDelete)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return newerForDedup(ordered[j], ordered[i])
+	})
+
+	deletable := make(map[string]int, len(groupSizes))
+	for key, size := range groupSizes {
+		minimum, err := intstr.GetScaledValueFromIntOrPercent(&spec.Value, size, true)
+		if err != nil {
+			// An unparseable minimum protects the whole group
+			minimum = size
+		}
+		deletable[key] = max(size-minimum, 0)
+	}
+
+	deleting := make(map[*unstructured.Unstructured]struct{}, len(ordered))
+	for _, resource := range ordered {
+		key := minRemainingGroupKey(resource, spec)
+		if deletable[key] > 0 {
+			deletable[key]--
+			deleting[resource] = struct{}{}
+		}
+	}
+
+	// Preserve the evaluation order of the deletion list
+	allowed = resourcesToDelete[:0:0]
+	for _, resource := range resourcesToDelete {
+		if _, ok := deleting[resource]; ok {
+			allowed = append(allowed, resource)
+		} else {
+			held++
+		}
+	}
+	return allowed, held
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func TestApplyMinRemainingShared(t *testing.T) {
+	// Each cohort's first resource is its oldest
+	var web, db []*unstructured.Unstructured
+	for i := range 4 {
+		resource := newTestConfigMap("default", fmt.Sprintf("web-%c", 'a'+i), time.Now().Add(-time.Duration(10-i)*time.Hour))
+		resource.SetLabels(map[string]string{"cohort": "web"})
+		web = append(web, resource)
+	}
+	for i := range 2 {
+		resource := newTestConfigMap("default", fmt.Sprintf("db-%c", 'a'+i), time.Now().Add(-time.Duration(10-i)*time.Hour))
+		resource.SetLabels(map[string]string{"cohort": "db"})
+		db = append(db, resource)
+	}
+	matched := append(append([]*unstructured.Unstructured{}, web...), db...)
+
+	tests := []struct {
+		name       string
+		value      intstr.IntOrString
+		toDelete   []*unstructured.Unstructured
+		wantNames  []string
+		wantHeldCt int64
+	}{
+		{
+			name:       "absolute minimum keeps the newest of each group",
+			value:      intstr.FromInt32(2),
+			toDelete:   matched,
+			wantNames:  []string{"web-a", "web-b"},
+			wantHeldCt: 4,
+		},
+		{
+			name:       "percentage of group size rounds up",
+			value:      intstr.FromString("50%"),
+			toDelete:   matched,
+			wantNames:  []string{"db-a", "web-a", "web-b"},
+			wantHeldCt: 3,
+		},
+		{
+			name:       "resources not due for deletion count toward the minimum",
+			value:      intstr.FromInt32(3),
+			toDelete:   []*unstructured.Unstructured{web[0]},
+			wantNames:  []string{"web-a"},
+			wantHeldCt: 0,
+		},
+		{
+			name:       "minimum larger than the group protects it entirely",
+			value:      intstr.FromInt32(5),
+			toDelete:   matched,
+			wantNames:  []string{},
+			wantHeldCt: 6,
+		},
+		{
+			name:       "zero minimum allows everything",
+			value:      intstr.FromInt32(0),
+			toDelete:   matched,
+			wantNames:  []string{"db-a", "db-b", "web-a", "web-b", "web-c", "web-d"},
+			wantHeldCt: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := newTestPolicy("min-remaining")
+			policy.Spec.Behavior.MinRemaining = &v1alpha1.MinRemainingSpec{Value: tt.value, KeyLabels: []string{"cohort"}}
+			allowed, held := applyMinRemainingShared(policy, matched, tt.toDelete)
+			got := resourceNames(allowed)
+			if held != tt.wantHeldCt || len(got) != len(tt.wantNames) {
+				t.Fatalf("allowed=%v held=%d, want %v held=%d", got, held, tt.wantNames, tt.wantHeldCt)
+			}
+			for i := range got {
+				if got[i] != tt.wantNames[i] {
+					t.Fatalf("allowed=%v, want %v", got, tt.wantNames)
+				}
+			}
+		})
+	}
+}
+
+func TestApplyMinRemainingShared_UnkeyedResourcesShareAGroup(t *testing.T) {
+	var unkeyed []*unstructured.Unstructured
+	for i := range 3 {
+		unkeyed = append(unkeyed, newTestConfigMap("default", fmt.Sprintf("cm-%d", i), time.Now().Add(-time.Duration(10-i)*time.Hour)))
+	}
+	policy := newTestPolicy("min-remaining")
+	policy.Spec.Behavior.MinRemaining = &v1alpha1.MinRemainingSpec{Value: intstr.FromInt32(2), KeyLabels: []string{"cohort"}}
+	allowed, held := applyMinRemainingShared(policy, unkeyed, unkeyed)
+	if len(allowed) != 1 || allowed[0] != unkeyed[0] || held != 2 {
+		t.Errorf("allowed=%v held=%d, want only the oldest unkeyed resource", resourceNames(allowed), held)
+	}
+}
+
+func TestEvaluatePolicy_MinRemainingEnforcedAcrossGroup(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reconciler, dynamicClient, policy := setupObservePausedTest(t, false)
+	defer reconciler.cleanupResourceInformer(policy.UID)
+
+	// Both seeded configmaps are expired and unlabelled, so they form one group
+	policy.Spec.Paused = false
+	policy.Spec.Behavior.MinRemaining = &v1alpha1.MinRemainingSpec{Value: intstr.FromInt32(1)}
+
+	if err := reconciler.evaluatePolicy(ctx, policy); err != nil {
+		t.Fatalf("evaluatePolicy() returned error: %v", err)
+	}
+	if deleted, _ := policyStatusCount(t, dynamicClient, "resourcesDeleted"); deleted != 1 {
+		t.Errorf("resourcesDeleted = %d, want 1", deleted)
+	}
+	if pending, _ := policyStatusCount(t, dynamicClient, "resourcesPending"); pending != 1 {
+		t.Errorf("resourcesPending = %d, want 1 protected resource", pending)
+	}
+
+	// The last resource of the group is never deleted
+	if err := reconciler.evaluatePolicy(ctx, policy); err != nil {
+		t.Fatalf("evaluatePolicy() returned error: %v", err)
+	}
+	list, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("Failed to list configmaps: %v", err)
+	}
+	if len(list.Items) != 1 {
+		t.Errorf("%d configmaps remain, want 1", len(list.Items))
+	}
+}
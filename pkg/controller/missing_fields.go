@@ -0,0 +1,143 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+const (
+	// MissingFieldsSkip leaves resources missing a field the TTL or a field condition reads
+	// alone: the TTL is not computed and the condition does not match.
+	MissingFieldsSkip = "Skip"
+
+	// MissingFieldsDefault reads fields a resource lacks as their zero value.
+	MissingFieldsDefault = "Default"
+)
+
+// missingFieldDefault is a field a resource lacks and the zero value it is read as.
+type missingFieldDefault struct {
+	path  []string
+	value interface{}
+}
+
+// missingFieldsViewShared returns the resource as the policy's TTL and field conditions see
+// it. Under MissingFieldsDefault, the fields they read that the resource lacks are set to
+// their zero value on a copy; otherwise, or when nothing is missing, the resource itself is
+// returned. The copy is only for evaluation and is never written back.
+func missingFieldsViewShared(resource *unstructured.Unstructured, policy *v1alpha1.GarbageCollectionPolicy) *unstructured.Unstructured {
+	if policy.Spec.MissingFields != MissingFieldsDefault {
+		return resource
+	}
+	defaults := missingFieldDefaultsShared(resource, policy)
+	if len(defaults) == 0 {
+		return resource
+	}
+	view := resource.DeepCopy()
+	for _, d := range defaults {
+		// A path through a non-object value cannot be set and stays missing
+		_ = unstructured.SetNestedField(view.Object, d.value, d.path...)
+	}
+	return view
+}
+
+// missingFieldDefaultsShared returns the fields the policy's TTL and field conditions read
+// that the resource lacks, with their zero values: 0 for TTL seconds and comparison operators,
+// the creation time for relativeTo, and an empty string for the other operators. Fields
+// tested with Exists are left missing, since their presence is what is being tested; so is
+// a fieldPath with mappings or a default, which already covers missing fields.
+func missingFieldDefaultsShared(resource *unstructured.Unstructured, policy *v1alpha1.GarbageCollectionPolicy) []missingFieldDefault {
+	refs := ttlFieldRefs(&policy.Spec.TTL, resource.GetCreationTimestamp().UTC().Format(time.RFC3339))
+	if policy.Spec.Conditions != nil {
+		refs = appendConditionFieldRefs(refs, policy.Spec.Conditions)
+	}
+
+	skip := make(map[string]bool, len(refs))
+	for _, ref := range refs {
+		if ref.value == nil {
+			skip[ref.fieldPath] = true
+		}
+	}
+	var defaults []missingFieldDefault
+	for _, ref := range refs {
+		if ref.fieldPath == "" || skip[ref.fieldPath] {
+			continue
+		}
+		skip[ref.fieldPath] = true
+		if _, found, _ := nestedFieldNoCopy(resource.Object, ref.fieldPath); found {
+			continue
+		}
+		// A path into an array cannot be set and stays missing
+		if path, ok := fieldPathKeys(ref.fieldPath); ok {
+			defaults = append(defaults, missingFieldDefault{path: path, value: ref.value})
+		}
+	}
+	return defaults
+}
+
+// fieldRef is a field path read by a policy and its zero value; nil marks a path whose
+// absence must be preserved.
+type fieldRef struct {
+	fieldPath string
+	value     interface{}
+}
+
+// ttlFieldRefs returns the fields a TTL reads.
+func ttlFieldRefs(ttl *v1alpha1.TTLSpec, creationTimestamp string) []fieldRef {
+	switch {
+	case ttl.Earliest != nil:
+		if ttl.Earliest.Field == nil {
+			return nil
+		}
+		return ttlFieldRefs(ttl.Earliest.Field, creationTimestamp)
+	case ttl.Range != nil:
+		return []fieldRef{{ttl.Range.MinFieldPath, int64(0)}, {ttl.Range.MaxFieldPath, int64(0)}}
+	}
+	var refs []fieldRef
+	if ttl.FieldPath != "" && len(ttl.Mappings) == 0 && ttl.Default == nil {
+		refs = append(refs, fieldRef{ttl.FieldPath, int64(0)})
+	}
+	if ttl.RelativeTo != "" {
+		refs = append(refs, fieldRef{ttl.RelativeTo, creationTimestamp})
+	}
+	return refs
+}
+
+// appendConditionFieldRefs appends the fields read by field conditions, including those in
+// anyOf groups.
+func appendConditionFieldRefs(refs []fieldRef, conditions *v1alpha1.ConditionsSpec) []fieldRef {
+	for _, fieldConds := range [][]v1alpha1.FieldCondition{conditions.And, conditions.Or} {
+		for _, cond := range fieldConds {
+			switch {
+			case cond.Operator == "Exists":
+				refs = append(refs, fieldRef{cond.FieldPath, nil})
+			case isFieldComparisonOperator(cond.Operator):
+				refs = append(refs, fieldRef{cond.FieldPath, int64(0)})
+			default:
+				refs = append(refs, fieldRef{cond.FieldPath, ""})
+			}
+		}
+	}
+	for i := range conditions.AnyOf {
+		refs = appendConditionFieldRefs(refs, &conditions.AnyOf[i])
+	}
+	return refs
+}
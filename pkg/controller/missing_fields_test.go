@@ -0,0 +1,157 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+func TestGCPolicyReconciler_shouldDelete_MissingFields(t *testing.T) {
+	reconciler := &GCPolicyReconciler{logger: sdklog.NewLogger("zen-gc")}
+	expiredTTL := v1alpha1.TTLSpec{SecondsAfterCreation: int64Ptr(60)}
+
+	tests := []struct {
+		name        string
+		ttl         v1alpha1.TTLSpec
+		conditions  *v1alpha1.ConditionsSpec
+		skipWant    string
+		defaultWant string
+	}{
+		{
+			name:        "ttl fieldPath reads as 0",
+			ttl:         v1alpha1.TTLSpec{FieldPath: "spec.ttlSeconds"},
+			skipWant:    ReasonNoTTL,
+			defaultWant: ReasonTTLExpired,
+		},
+		{
+			name:        "ttl fieldPath with default keeps its default",
+			ttl:         v1alpha1.TTLSpec{FieldPath: "spec.ttlSeconds", Default: int64Ptr(86400)},
+			skipWant:    ReasonNotExpired,
+			defaultWant: ReasonNotExpired,
+		},
+		{
+			name:        "relativeTo reads as the creation time",
+			ttl:         v1alpha1.TTLSpec{RelativeTo: "status.finishedAt", SecondsAfter: int64Ptr(86400)},
+			skipWant:    ReasonNoTTL,
+			defaultWant: ReasonNotExpired,
+		},
+		{
+			name:        "range bounds read as 0",
+			ttl:         v1alpha1.TTLSpec{Range: &v1alpha1.RangeTTLSpec{MinFieldPath: "spec.minTtl", MaxFieldPath: "spec.maxTtl"}},
+			skipWant:    ReasonNoTTL,
+			defaultWant: ReasonTTLExpired,
+		},
+		{
+			name:        "NotEquals reads an empty string",
+			ttl:         expiredTTL,
+			conditions:  &v1alpha1.ConditionsSpec{And: []v1alpha1.FieldCondition{{FieldPath: "status.phase", Operator: "NotEquals", Value: "Running"}}},
+			skipWant:    ReasonConditionNotMet,
+			defaultWant: ReasonTTLExpired,
+		},
+		{
+			name:        "comparison reads 0",
+			ttl:         expiredTTL,
+			conditions:  &v1alpha1.ConditionsSpec{Or: []v1alpha1.FieldCondition{{FieldPath: "spec.replicas", Operator: "LessThanOrEqual", Value: "0"}}},
+			skipWant:    ReasonConditionNotMet,
+			defaultWant: ReasonTTLExpired,
+		},
+		{
+			name: "anyOf group fields are read as well",
+			ttl:  expiredTTL,
+			conditions: &v1alpha1.ConditionsSpec{AnyOf: []v1alpha1.ConditionsSpec{
+				{And: []v1alpha1.FieldCondition{{FieldPath: "spec.owner", Operator: "In", Values: []string{"", "nobody"}}}},
+			}},
+			skipWant:    ReasonConditionNotMet,
+			defaultWant: ReasonTTLExpired,
+		},
+		{
+			name:        "Exists still requires the field",
+			ttl:         expiredTTL,
+			conditions:  &v1alpha1.ConditionsSpec{And: []v1alpha1.FieldCondition{{FieldPath: "spec.keep", Operator: "Exists"}}},
+			skipWant:    ReasonConditionNotMet,
+			defaultWant: ReasonConditionNotMet,
+		},
+	}
+
+	for _, tt := range tests {
+		for mode, want := range map[string]string{"": tt.skipWant, MissingFieldsSkip: tt.skipWant, MissingFieldsDefault: tt.defaultWant} {
+			t.Run(tt.name+"/"+mode, func(t *testing.T) {
+				policy := &v1alpha1.GarbageCollectionPolicy{
+					Spec: v1alpha1.GarbageCollectionPolicySpec{TTL: tt.ttl, Conditions: tt.conditions, MissingFields: mode},
+				}
+				resource := newTestConfigMap("default", "cm", time.Now().Add(-time.Hour))
+				if _, reason := reconciler.shouldDelete(resource, policy); reason != want {
+					t.Errorf("shouldDelete() reason = %q, want %q", reason, want)
+				}
+				if _, found, _ := unstructured.NestedFieldNoCopy(resource.Object, "spec"); found {
+					t.Error("shouldDelete() wrote default fields to the resource")
+				}
+			})
+		}
+	}
+}
+
+func TestMissingFieldsViewShared_PresentFieldsUnchanged(t *testing.T) {
+	policy := &v1alpha1.GarbageCollectionPolicy{
+		Spec: v1alpha1.GarbageCollectionPolicySpec{
+			TTL:           v1alpha1.TTLSpec{FieldPath: "spec.ttlSeconds"},
+			MissingFields: MissingFieldsDefault,
+		},
+	}
+	resource := newTestConfigMap("default", "cm", time.Now())
+	if err := unstructured.SetNestedField(resource.Object, int64(3600), "spec", "ttlSeconds"); err != nil {
+		t.Fatalf("SetNestedField() returned error: %v", err)
+	}
+
+	// Nothing is missing, so the resource is evaluated as it is, without a copy
+	if view := missingFieldsViewShared(resource, policy); view != resource {
+		t.Error("missingFieldsViewShared() copied a resource with no missing fields")
+	}
+}
+
+func TestPolicyEvaluationService_evaluateResources_MissingFields(t *testing.T) {
+	service := NewPolicyEvaluationService(nil, NewDefaultSelectorMatcher(), NewDefaultConditionMatcher(), nil, nil, nil, nil, nil, nil)
+
+	for mode, wantDeleted := range map[string]int{MissingFieldsSkip: 0, MissingFieldsDefault: 1} {
+		t.Run(mode, func(t *testing.T) {
+			policy := &v1alpha1.GarbageCollectionPolicy{
+				Spec: v1alpha1.GarbageCollectionPolicySpec{
+					TTL:           v1alpha1.TTLSpec{SecondsAfterCreation: int64Ptr(60)},
+					Conditions:    &v1alpha1.ConditionsSpec{And: []v1alpha1.FieldCondition{{FieldPath: "status.phase", Operator: "NotEquals", Value: "Running"}}},
+					MissingFields: mode,
+				},
+			}
+			resources := []*unstructured.Unstructured{newTestConfigMap("default", "cm", time.Now().Add(-time.Hour))}
+
+			var toDelete []*unstructured.Unstructured
+			var oldest oldestPending
+			service.evaluateResources(t.Context(), resources, policy, &toDelete, map[string]string{}, "v1", "ConfigMap", &oldest, &decisionLog{})
+			if len(toDelete) != wantDeleted {
+				t.Fatalf("toDelete = %d, want %d", len(toDelete), wantDeleted)
+			}
+			if wantDeleted > 0 && toDelete[0] != resources[0] {
+				t.Error("evaluateResources() queued the evaluation copy instead of the resource")
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// meetsMissingLabelConditionShared checks if a resource lacks the required label key and
+// is older than the condition's grace period.
+func meetsMissingLabelConditionShared(resource *unstructured.Unstructured, cond *v1alpha1.MissingLabelCondition) bool {
+	return meetsMissingLabelConditionAt(resource, cond, time.Now())
+}
+
+// meetsMissingLabelConditionAt evaluates a missing label condition at the given time.
+// Resources without a creationTimestamp, or a condition without an age, do not match.
+func meetsMissingLabelConditionAt(resource *unstructured.Unstructured, cond *v1alpha1.MissingLabelCondition, now time.Time) bool {
+	if _, labeled := resource.GetLabels()[cond.Key]; labeled {
+		return false
+	}
+	created := resource.GetCreationTimestamp()
+	if created.IsZero() || cond.OlderThanSeconds == nil {
+		return false
+	}
+	return now.Sub(created.Time) >= time.Duration(*cond.OlderThanSeconds)*time.Second
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func TestMeetsMissingLabelConditionAt(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	cond := &v1alpha1.MissingLabelCondition{Key: "team", OlderThanSeconds: int64Ptr(3600)}
+
+	tests := []struct {
+		name    string
+		labels  map[string]string
+		created time.Time
+		want    bool
+	}{
+		{name: "unlabeled and old", created: now.Add(-2 * time.Hour), want: true},
+		{name: "other labels only and old", labels: map[string]string{"app": "web"}, created: now.Add(-2 * time.Hour), want: true},
+		{name: "unlabeled at exactly the grace period", created: now.Add(-time.Hour), want: true},
+		{name: "unlabeled but within the grace period", created: now.Add(-30 * time.Minute), want: false},
+		{name: "labeled and old", labels: map[string]string{"team": "payments"}, created: now.Add(-2 * time.Hour), want: false},
+		{name: "labeled with empty value", labels: map[string]string{"team": ""}, created: now.Add(-2 * time.Hour), want: false},
+		{name: "unlabeled without creationTimestamp", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := newTestConfigMap("default", "cm", tt.created)
+			resource.SetLabels(tt.labels)
+			if got := meetsMissingLabelConditionAt(resource, cond, now); got != tt.want {
+				t.Errorf("meetsMissingLabelConditionAt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMeetsConditionsShared_MissingLabel(t *testing.T) {
+	conditions := &v1alpha1.ConditionsSpec{
+		MissingLabel: &v1alpha1.MissingLabelCondition{Key: "team", OlderThanSeconds: int64Ptr(3600)},
+	}
+	if !meetsConditionsShared(newTestConfigMap("default", "old", time.Now().Add(-2*time.Hour)), conditions) {
+		t.Error("expected an old unlabeled resource to meet the missing label condition")
+	}
+	if meetsConditionsShared(newTestConfigMap("default", "new", time.Now()), conditions) {
+		t.Error("expected a new unlabeled resource not to meet the missing label condition")
+	}
+}
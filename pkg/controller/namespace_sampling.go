@@ -0,0 +1,161 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"hash/fnv"
+	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// namespacePosition is a namespace's place in the hash order namespaces are sampled in.
+type namespacePosition struct {
+	hash uint64
+	name string
+}
+
+// after reports whether p comes after o in sampling order. Names break hash ties.
+func (p namespacePosition) after(o namespacePosition) bool {
+	if p.hash != o.hash {
+		return p.hash > o.hash
+	}
+	return p.name > o.name
+}
+
+// newNamespacePosition hashes a namespace into its sampling position.
+func newNamespacePosition(namespace string) namespacePosition {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(namespace))
+	return namespacePosition{hash: h.Sum64(), name: namespace}
+}
+
+// NamespaceSampler rotates cluster-wide policies with namespacesPerRun through their
+// namespaces. Namespaces are ordered by hash, and each run continues after the last
+// namespace the policy's previous run evaluated, so namespaces created or deleted between
+// runs do not shift the others and every namespace is reached within a few runs.
+// Cursors are kept in memory; after a restart sampling starts over.
+// A nil *NamespaceSampler samples nothing and evaluates every namespace.
+type NamespaceSampler struct {
+	cursors map[types.UID]namespacePosition
+	mu      sync.Mutex
+}
+
+// NewNamespaceSampler creates a new NamespaceSampler.
+func NewNamespaceSampler() *NamespaceSampler {
+	return &NamespaceSampler{
+		cursors: make(map[types.UID]namespacePosition),
+	}
+}
+
+// Sample returns up to perRun of the namespaces for the policy's next run and advances
+// its cursor. All namespaces are returned when they fit in a single run.
+func (s *NamespaceSampler) Sample(policyUID types.UID, namespaces []string, perRun int) []string {
+	if s == nil || perRun <= 0 || len(namespaces) <= perRun {
+		return namespaces
+	}
+
+	positions := make([]namespacePosition, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		positions = append(positions, newNamespacePosition(namespace))
+	}
+	sort.Slice(positions, func(i, j int) bool { return positions[j].after(positions[i]) })
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Continue after the cursor, wrapping around to the start
+	start := 0
+	if cursor, ok := s.cursors[policyUID]; ok {
+		start = sort.Search(len(positions), func(i int) bool { return positions[i].after(cursor) })
+	}
+	sample := make([]string, 0, perRun)
+	for i := range perRun {
+		sample = append(sample, positions[(start+i)%len(positions)].name)
+	}
+	s.cursors[policyUID] = newNamespacePosition(sample[len(sample)-1])
+	return sample
+}
+
+// Forget drops the cursor of a deleted policy.
+func (s *NamespaceSampler) Forget(policyUID types.UID) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.cursors, policyUID)
+}
+
+// sampleNamespacesShared keeps the resources in the namespaces a policy with
+// namespacesPerRun evaluates this run. Other policies keep every resource.
+func sampleNamespacesShared(sampler *NamespaceSampler, policy *v1alpha1.GarbageCollectionPolicy, resources []*unstructured.Unstructured) []*unstructured.Unstructured {
+	perRun := policy.Spec.Behavior.NamespacesPerRun
+	if sampler == nil || perRun <= 0 || len(resources) == 0 {
+		return resources
+	}
+
+	seen := make(map[string]struct{})
+	namespaces := make([]string, 0)
+	for _, resource := range resources {
+		namespace := resource.GetNamespace()
+		if _, ok := seen[namespace]; !ok {
+			seen[namespace] = struct{}{}
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	if len(namespaces) <= perRun {
+		return resources
+	}
+
+	sampled := make(map[string]struct{}, perRun)
+	for _, namespace := range sampler.Sample(policy.UID, namespaces, perRun) {
+		sampled[namespace] = struct{}{}
+	}
+	kept := make([]*unstructured.Unstructured, 0, len(resources))
+	for _, resource := range resources {
+		if _, ok := sampled[resource.GetNamespace()]; ok {
+			kept = append(kept, resource)
+		}
+	}
+	return kept
+}
+
+// sampleNamespaceObjectsShared is sampleNamespacesShared for objects listed from an informer store.
+func sampleNamespaceObjectsShared(sampler *NamespaceSampler, policy *v1alpha1.GarbageCollectionPolicy, objs []interface{}) []interface{} {
+	if policy.Spec.Behavior.NamespacesPerRun <= 0 {
+		return objs
+	}
+
+	resources := make([]*unstructured.Unstructured, 0, len(objs))
+	for _, obj := range objs {
+		if resource, ok := obj.(*unstructured.Unstructured); ok {
+			resources = append(resources, resource)
+		}
+	}
+	sampled := sampleNamespacesShared(sampler, policy, resources)
+	out := make([]interface{}, 0, len(sampled))
+	for _, resource := range sampled {
+		out = append(out, resource)
+	}
+	return out
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func newSamplingTestNamespaces(count int) []string {
+	namespaces := make([]string, 0, count)
+	for i := range count {
+		namespaces = append(namespaces, fmt.Sprintf("team-%02d", i))
+	}
+	return namespaces
+}
+
+func TestNamespaceSampler_CoversAllNamespacesAcrossRuns(t *testing.T) {
+	sampler := NewNamespaceSampler()
+	namespaces := newSamplingTestNamespaces(10)
+	uid := types.UID("policy-uid")
+
+	// 10 namespaces at 3 per run are all covered within 4 runs
+	seen := map[string]int{}
+	for run := range 4 {
+		sample := sampler.Sample(uid, namespaces, 3)
+		if len(sample) != 3 {
+			t.Fatalf("run %d sampled %d namespaces, want 3", run, len(sample))
+		}
+		for _, namespace := range sample {
+			seen[namespace]++
+		}
+	}
+	for _, namespace := range namespaces {
+		if seen[namespace] == 0 {
+			t.Errorf("namespace %s was never sampled", namespace)
+		}
+	}
+
+	// Each namespace is sampled once per full rotation
+	sampler.Forget(uid)
+	seen = map[string]int{}
+	for range 10 {
+		for _, namespace := range sampler.Sample(uid, namespaces, 3) {
+			seen[namespace]++
+		}
+	}
+	for _, namespace := range namespaces {
+		if seen[namespace] != 3 {
+			t.Errorf("namespace %s sampled %d times in 3 rotations, want 3", namespace, seen[namespace])
+		}
+	}
+}
+
+func TestNamespaceSampler_NewNamespacesDoNotSkipOthers(t *testing.T) {
+	sampler := NewNamespaceSampler()
+	namespaces := newSamplingTestNamespaces(6)
+	uid := types.UID("policy-uid")
+
+	seen := map[string]bool{}
+	for _, namespace := range sampler.Sample(uid, namespaces, 2) {
+		seen[namespace] = true
+	}
+
+	// Namespaces created between runs join the rotation without skipping existing ones
+	namespaces = append(namespaces, newSamplingTestNamespaces(9)[6:]...)
+	for range 5 {
+		for _, namespace := range sampler.Sample(uid, namespaces, 2) {
+			seen[namespace] = true
+		}
+	}
+	for _, namespace := range namespaces {
+		if !seen[namespace] {
+			t.Errorf("namespace %s was never sampled", namespace)
+		}
+	}
+}
+
+func TestNamespaceSampler_SmallOrDisabled(t *testing.T) {
+	namespaces := newSamplingTestNamespaces(3)
+
+	if got := NewNamespaceSampler().Sample("uid", namespaces, 5); len(got) != 3 {
+		t.Errorf("Sample() = %v, want every namespace when they fit in one run", got)
+	}
+	if got := NewNamespaceSampler().Sample("uid", namespaces, 0); len(got) != 3 {
+		t.Errorf("Sample() = %v, want every namespace when sampling is disabled", got)
+	}
+	var sampler *NamespaceSampler
+	if got := sampler.Sample("uid", namespaces, 1); len(got) != 3 {
+		t.Errorf("nil sampler Sample() = %v, want every namespace", got)
+	}
+}
+
+func TestSampleNamespacesShared(t *testing.T) {
+	policy := &v1alpha1.GarbageCollectionPolicy{
+		ObjectMeta: metav1.ObjectMeta{UID: "policy-uid"},
+		Spec: v1alpha1.GarbageCollectionPolicySpec{
+			TargetResource: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap", Namespace: "*"},
+			Behavior:       v1alpha1.BehaviorSpec{NamespacesPerRun: 2},
+		},
+	}
+	var resources []*unstructured.Unstructured
+	for _, namespace := range newSamplingTestNamespaces(5) {
+		for _, name := range []string{"cm-a", "cm-b"} {
+			resources = append(resources, newTestConfigMap(namespace, name, time.Time{}))
+		}
+	}
+
+	sampler := NewNamespaceSampler()
+	covered := map[string]bool{}
+	for run := range 3 {
+		kept := sampleNamespacesShared(sampler, policy, resources)
+		namespaces := map[string]bool{}
+		for _, resource := range kept {
+			namespaces[resource.GetNamespace()] = true
+			covered[resource.GetNamespace()+"/"+resource.GetName()] = true
+		}
+		if len(namespaces) > 2 {
+			t.Errorf("run %d evaluated %d namespaces, want at most 2", run, len(namespaces))
+		}
+		if len(kept) != 2*len(namespaces) {
+			t.Errorf("run %d kept %d resources from %d namespaces, want every resource in them", run, len(kept), len(namespaces))
+		}
+	}
+	if len(covered) != len(resources) {
+		t.Errorf("covered %d of %d resources in 3 runs", len(covered), len(resources))
+	}
+
+	policy.Spec.Behavior.NamespacesPerRun = 0
+	if kept := sampleNamespacesShared(sampler, policy, resources); len(kept) != len(resources) {
+		t.Errorf("kept %d resources without sampling, want all %d", len(kept), len(resources))
+	}
+}
+
+func TestEvaluatePolicy_NamespacesPerRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reconciler := setupInformerTestReconciler(t)
+	policy := &v1alpha1.GarbageCollectionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default", UID: types.UID("policy-uid")},
+		Spec: v1alpha1.GarbageCollectionPolicySpec{
+			TargetResource: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap", Namespace: "*"},
+			TTL:            v1alpha1.TTLSpec{SecondsAfterCreation: int64Ptr(3600)},
+			Behavior:       v1alpha1.BehaviorSpec{NamespacesPerRun: 1},
+		},
+	}
+	defer reconciler.cleanupResourceInformer(policy.UID)
+
+	informer, err := reconciler.getOrCreateResourceInformer(ctx, policy)
+	if err != nil {
+		t.Fatalf("getOrCreateResourceInformer() returned error: %v", err)
+	}
+
+	// team-a and team-b are evaluated in alternate runs
+	seen := map[string]bool{}
+	for run := range 2 {
+		result := evaluatePolicyResourcesShared(ctx, reconciler, policy, informer)
+		if result.MatchedCount != 1 || len(result.ResourcesToDelete) != 1 {
+			t.Fatalf("run %d matched %d and would delete %d resources, want the 1 in its sampled namespace", run, result.MatchedCount, len(result.ResourcesToDelete))
+		}
+		seen[result.ResourcesToDelete[0].GetNamespace()] = true
+	}
+	if !seen["team-a"] || !seen["team-b"] {
+		t.Errorf("namespaces evaluated across runs = %v, want team-a and team-b", seen)
+	}
+}
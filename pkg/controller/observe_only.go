@@ -0,0 +1,42 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strconv"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// observeOnlyShared reports whether the policy carries the observe-only annotation.
+// Values other than a true boolean string are ignored.
+func observeOnlyShared(policy *v1alpha1.GarbageCollectionPolicy) bool {
+	value, ok := policy.GetAnnotations()[v1alpha1.ObserveOnlyAnnotation]
+	if !ok {
+		return false
+	}
+	observeOnly, err := strconv.ParseBool(value)
+	return err == nil && observeOnly
+}
+
+// dryRunShared reports whether the policy's deletions are suppressed, by behavior.dryRun
+// or by the observe-only annotation. The annotation wins over dryRun: false without the
+// policy object being changed, so status derived from the spec (e.g. the dry-run impact)
+// still reflects what is stored.
+func dryRunShared(policy *v1alpha1.GarbageCollectionPolicy) bool {
+	return policy.Spec.Behavior.DryRun || observeOnlyShared(policy)
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func TestDryRunShared(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		dryRun      bool
+		expected    bool
+	}{
+		{name: "annotation true overrides dryRun false", annotations: map[string]string{v1alpha1.ObserveOnlyAnnotation: "true"}, expected: true},
+		{name: "annotation false keeps dryRun false", annotations: map[string]string{v1alpha1.ObserveOnlyAnnotation: "false"}, expected: false},
+		{name: "annotation false does not disable dryRun", annotations: map[string]string{v1alpha1.ObserveOnlyAnnotation: "false"}, dryRun: true, expected: true},
+		{name: "invalid annotation ignored", annotations: map[string]string{v1alpha1.ObserveOnlyAnnotation: "yes please"}, expected: false},
+		{name: "no annotation", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &v1alpha1.GarbageCollectionPolicy{
+				ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations},
+				Spec:       v1alpha1.GarbageCollectionPolicySpec{Behavior: v1alpha1.BehaviorSpec{DryRun: tt.dryRun}},
+			}
+			if got := dryRunShared(policy); got != tt.expected {
+				t.Errorf("dryRunShared() = %v, want %v", got, tt.expected)
+			}
+			if policy.Spec.Behavior.DryRun != tt.dryRun {
+				t.Errorf("Spec.Behavior.DryRun changed to %v", policy.Spec.Behavior.DryRun)
+			}
+		})
+	}
+}
+
+func TestEvaluatePolicy_ObserveOnlyAnnotationWinsOverDryRunFalse(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reconciler, dynamicClient, policy := setupObservePausedTest(t, false)
+	defer reconciler.cleanupResourceInformer(policy.UID)
+
+	policy.Spec.Paused = false
+	policy.Spec.Behavior.DryRun = false
+	policy.Annotations = map[string]string{v1alpha1.ObserveOnlyAnnotation: "true"}
+
+	if err := reconciler.evaluatePolicy(ctx, policy); err != nil {
+		t.Fatalf("evaluatePolicy() returned error: %v", err)
+	}
+
+	list, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("Failed to list configmaps: %v", err)
+	}
+	if len(list.Items) != 2 {
+		t.Errorf("observe-only policy deleted resources: %d configmaps remain, want 2", len(list.Items))
+	}
+
+	obj, err := dynamicClient.Resource(observeTestPolicyGVR).Namespace("default").Get(ctx, policy.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get policy: %v", err)
+	}
+	if observeOnly, _, _ := unstructured.NestedBool(obj.Object, "status", "observeOnly"); !observeOnly {
+		t.Error("status.observeOnly should be true")
+	}
+	if policy.Spec.Behavior.DryRun {
+		t.Error("observe-only should not change the policy's behavior.dryRun")
+	}
+	if _, found, _ := unstructured.NestedMap(obj.Object, "status", "dryRunImpact"); found {
+		t.Error("status.dryRunImpact should not be recorded for a policy whose spec has dryRun: false")
+	}
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	found := false
+	for _, c := range conditions {
+		if cond, ok := c.(map[string]interface{}); ok && cond["type"] == "ObserveOnly" && cond["status"] == "True" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("expected an ObserveOnly=True status condition")
+	}
+
+	// Removing the annotation clears the status field and resumes deletions
+	policy.Annotations = nil
+	policy.Spec.Behavior.DryRun = false
+	if err := reconciler.evaluatePolicy(ctx, policy); err != nil {
+		t.Fatalf("evaluatePolicy() returned error: %v", err)
+	}
+	obj, err = dynamicClient.Resource(observeTestPolicyGVR).Namespace("default").Get(ctx, policy.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get policy: %v", err)
+	}
+	if _, found, _ := unstructured.NestedBool(obj.Object, "status", "observeOnly"); found {
+		t.Error("status.observeOnly should be cleared once the annotation is removed")
+	}
+	if deleted, _ := policyStatusCount(t, dynamicClient, "resourcesDeleted"); deleted != 2 {
+		t.Errorf("resourcesDeleted = %d, want 2 after the annotation is removed", deleted)
+	}
+}
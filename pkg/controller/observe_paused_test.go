@@ -0,0 +1,151 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic/fake"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/config"
+)
+
+var (
+	observeTestPolicyGVR    = schema.GroupVersionResource{Group: "gc.kube-zen.io", Version: "v1alpha1", Resource: "garbagecollectionpolicies"}
+	observeTestConfigMapGVR = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+)
+
+func setupObservePausedTest(t *testing.T, observe bool) (*GCPolicyReconciler, *fake.FakeDynamicClient, *v1alpha1.GarbageCollectionPolicy) {
+	t.Helper()
+	ttl := int64(3600)
+	policy := &v1alpha1.GarbageCollectionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "paused-policy", Namespace: "default", UID: types.UID("paused-uid")},
+		Spec: v1alpha1.GarbageCollectionPolicySpec{
+			TargetResource:    v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default"},
+			TTL:               v1alpha1.TTLSpec{SecondsAfterCreation: &ttl},
+			Paused:            true,
+			ObserveWhenPaused: observe,
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	dynamicClient := fake.NewSimpleDynamicClientWithCustomListKinds(
+		scheme,
+		map[schema.GroupVersionResource]string{
+			observeTestConfigMapGVR: "ConfigMapList",
+			observeTestPolicyGVR:    "GarbageCollectionPolicyList",
+		},
+		newTestConfigMap("default", "cm-1", time.Now().Add(-2*time.Hour)),
+		newTestConfigMap("default", "cm-2", time.Now().Add(-2*time.Hour)),
+	)
+
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(policy)
+	if err != nil {
+		t.Fatalf("Failed to convert policy to unstructured: %v", err)
+	}
+	if _, err := dynamicClient.Resource(observeTestPolicyGVR).Namespace("default").Create(context.Background(), &unstructured.Unstructured{Object: obj}, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create policy: %v", err)
+	}
+
+	reconciler := NewGCPolicyReconcilerWithRESTMapper(
+		clientfake.NewClientBuilder().WithScheme(scheme).Build(),
+		scheme,
+		dynamicClient,
+		nil,
+		NewStatusUpdater(dynamicClient),
+		NewEventRecorder(nil),
+		config.NewControllerConfig(),
+	)
+	return reconciler, dynamicClient, policy
+}
+
+func policyStatusCount(t *testing.T, dynamicClient *fake.FakeDynamicClient, field string) (int64, bool) {
+	t.Helper()
+	obj, err := dynamicClient.Resource(observeTestPolicyGVR).Namespace("default").Get(context.Background(), "paused-policy", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get policy: %v", err)
+	}
+	value, found, _ := unstructured.NestedInt64(obj.Object, "status", field)
+	return value, found
+}
+
+func TestHandlePausedPolicy_ObserveWhenPaused(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reconciler, dynamicClient, policy := setupObservePausedTest(t, true)
+	defer reconciler.cleanupResourceInformer(policy.UID)
+
+	if _, err := reconciler.handlePausedPolicy(ctx, policy); err != nil {
+		t.Fatalf("handlePausedPolicy() returned error: %v", err)
+	}
+	if matched, _ := policyStatusCount(t, dynamicClient, "resourcesMatched"); matched != 2 {
+		t.Errorf("resourcesMatched = %d, want 2", matched)
+	}
+	if pending, _ := policyStatusCount(t, dynamicClient, "resourcesPending"); pending != 2 {
+		t.Errorf("resourcesPending = %d, want 2", pending)
+	}
+
+	// Counts follow the cluster while still paused
+	if _, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").Create(ctx, newTestConfigMap("default", "cm-3", time.Now().Add(-2*time.Hour)), metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create configmap: %v", err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if _, err := reconciler.handlePausedPolicy(ctx, policy); err != nil {
+			t.Fatalf("handlePausedPolicy() returned error: %v", err)
+		}
+		if matched, _ := policyStatusCount(t, dynamicClient, "resourcesMatched"); matched == 3 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("expected resourcesMatched to reach 3 while paused")
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	// Nothing is ever deleted
+	list, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("Failed to list configmaps: %v", err)
+	}
+	if len(list.Items) != 3 {
+		t.Errorf("expected all 3 configmaps to survive, got %d", len(list.Items))
+	}
+	if deleted, _ := policyStatusCount(t, dynamicClient, "resourcesDeleted"); deleted != 0 {
+		t.Errorf("resourcesDeleted = %d, want 0", deleted)
+	}
+}
+
+func TestHandlePausedPolicy_ObserveDisabled(t *testing.T) {
+	reconciler, dynamicClient, policy := setupObservePausedTest(t, false)
+
+	if _, err := reconciler.handlePausedPolicy(context.Background(), policy); err != nil {
+		t.Fatalf("handlePausedPolicy() returned error: %v", err)
+	}
+	if _, found := policyStatusCount(t, dynamicClient, "resourcesMatched"); found {
+		t.Error("expected paused policy without observeWhenPaused to leave status untouched")
+	}
+}
@@ -0,0 +1,275 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	gcerrors "github.com/kube-zen/zen-gc/pkg/errors"
+	"github.com/kube-zen/zen-gc/pkg/validation"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+// Defaults for OPA decision queries.
+const (
+	// DefaultOPATimeout is the default timeout for a single OPA decision query.
+	DefaultOPATimeout = 5 * time.Second
+
+	// DefaultOPACacheTTL is the default time a decision is cached for an unchanged resource.
+	DefaultOPACacheTTL = 30 * time.Second
+
+	// opaCacheSweepThreshold is the cache size above which expired entries are pruned.
+	opaCacheSweepThreshold = 1024
+
+	// opaMaxResponseBytes bounds the size of an OPA response body.
+	opaMaxResponseBytes = 1 << 20
+)
+
+// ReasonOPAURLNotAllowed is the status condition reason for a policy whose OPA condition
+// queries a URL outside the controller's allowlist.
+const ReasonOPAURLNotAllowed = "OPAURLNotAllowed"
+
+var (
+	// ErrOPAUnexpectedStatus indicates OPA returned a non-200 HTTP status.
+	ErrOPAUnexpectedStatus = errors.New("unexpected OPA response status")
+
+	// ErrOPAUndefinedDecision indicates the OPA decision was undefined or not a boolean.
+	ErrOPAUndefinedDecision = errors.New("OPA decision is undefined or not a boolean")
+)
+
+// opaDataFields are the top-level resource fields holding ConfigMap and Secret contents,
+// stripped from OPA input unless data is included.
+var opaDataFields = []string{"data", "stringData", "binaryData"}
+
+// opaCacheEntry is a cached OPA decision.
+type opaCacheEntry struct {
+	allowed   bool
+	expiresAt time.Time
+}
+
+// OPAClient queries Open Policy Agent for deletion decisions.
+// Only allowed decision URLs are queried, and resources are sent without their data unless
+// configured otherwise. Decisions are cached briefly, keyed by decision URL and resource
+// content hash, so unchanged resources are not re-sent on every evaluation.
+type OPAClient struct {
+	httpClient  *http.Client
+	allowedURLs []string
+	includeData bool
+	cache       map[string]opaCacheEntry
+	mu          sync.Mutex
+	now         func() time.Time
+}
+
+// NewOPAClient creates a new OPA client that queries no URL until configured.
+// If httpClient is nil, http.DefaultClient is used.
+func NewOPAClient(httpClient *http.Client) *OPAClient {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &OPAClient{
+		httpClient: httpClient,
+		cache:      make(map[string]opaCacheEntry),
+		now:        time.Now,
+	}
+}
+
+// defaultOPAClient is the shared OPA client used by condition evaluation.
+var defaultOPAClient = NewOPAClient(nil)
+
+// ConfigureOPA sets the decision URLs the shared OPA client may query, where nil allows
+// none, and whether resources are sent with their data fields.
+func ConfigureOPA(allowedURLs []string, includeData bool) {
+	defaultOPAClient.Configure(allowedURLs, includeData)
+}
+
+// Configure sets the decision URLs the client may query, where nil allows none, and
+// whether resources are sent with their data fields.
+func (c *OPAClient) Configure(allowedURLs []string, includeData bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.allowedURLs = allowedURLs
+	c.includeData = includeData
+}
+
+// Allowed returns the OPA decision for a resource.
+// Errors are returned to the caller, which is expected to fail closed.
+func (c *OPAClient) Allowed(ctx context.Context, resource *unstructured.Unstructured, cond *v1alpha1.OPACondition) (bool, error) {
+	c.mu.Lock()
+	urlAllowed, includeData := slices.Contains(c.allowedURLs, cond.URL), c.includeData
+	c.mu.Unlock()
+	if !urlAllowed {
+		return false, fmt.Errorf("%w: %q", validation.ErrOPAURLNotAllowed, cond.URL)
+	}
+
+	input, err := json.Marshal(map[string]interface{}{"input": opaInput(resource, includeData)})
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal OPA input: %w", err)
+	}
+
+	sum := sha256.Sum256(append([]byte(cond.URL+"\x00"), input...))
+	key := hex.EncodeToString(sum[:])
+
+	c.mu.Lock()
+	if entry, ok := c.cache[key]; ok && c.now().Before(entry.expiresAt) {
+		c.mu.Unlock()
+		return entry.allowed, nil
+	}
+	c.mu.Unlock()
+
+	allowed, err := c.query(ctx, cond.URL, input)
+	if err != nil {
+		return false, err
+	}
+
+	cacheTTL := DefaultOPACacheTTL
+	if cond.CacheTTLSeconds != nil {
+		cacheTTL = time.Duration(*cond.CacheTTLSeconds) * time.Second
+	}
+	if cacheTTL > 0 {
+		c.store(key, allowed, cacheTTL)
+	}
+	return allowed, nil
+}
+
+// opaInput returns the document a resource is sent to OPA as. Unless includeData is set,
+// data fields are stripped, along with the last-applied-configuration annotation that
+// repeats them.
+func opaInput(resource *unstructured.Unstructured, includeData bool) map[string]interface{} {
+	if includeData {
+		return resource.Object
+	}
+	input := resource.DeepCopy()
+	for _, field := range opaDataFields {
+		unstructured.RemoveNestedField(input.Object, field)
+	}
+	if annotations := input.GetAnnotations(); annotations != nil {
+		if _, ok := annotations[corev1.LastAppliedConfigAnnotation]; ok {
+			delete(annotations, corev1.LastAppliedConfigAnnotation)
+			input.SetAnnotations(annotations)
+		}
+	}
+	return input.Object
+}
+
+// query sends the input document to OPA and parses the decision.
+func (c *OPAClient) query(ctx context.Context, url string, input []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(input))
+	if err != nil {
+		return false, fmt.Errorf("failed to build OPA request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("OPA request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("%w: %d", ErrOPAUnexpectedStatus, resp.StatusCode)
+	}
+
+	var body struct {
+		Result interface{} `json:"result"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, opaMaxResponseBytes)).Decode(&body); err != nil {
+		return false, fmt.Errorf("failed to decode OPA response: %w", err)
+	}
+
+	// Accept either a boolean rule ("allow") or a package document with an "allow" field.
+	switch result := body.Result.(type) {
+	case bool:
+		return result, nil
+	case map[string]interface{}:
+		if allow, ok := result["allow"].(bool); ok {
+			return allow, nil
+		}
+	}
+	return false, ErrOPAUndefinedDecision
+}
+
+// store caches a decision and prunes expired entries once the cache grows large.
+func (c *OPAClient) store(key string, allowed bool, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if len(c.cache) >= opaCacheSweepThreshold {
+		for k, entry := range c.cache {
+			if !now.Before(entry.expiresAt) {
+				delete(c.cache, k)
+			}
+		}
+	}
+	c.cache[key] = opaCacheEntry{allowed: allowed, expiresAt: now.Add(ttl)}
+}
+
+// meetsOPAConditionShared checks the OPA decision for a resource, failing closed on errors.
+func meetsOPAConditionShared(resource *unstructured.Unstructured, cond *v1alpha1.OPACondition) bool {
+	return meetsOPAConditionWithClient(defaultOPAClient, resource, cond)
+}
+
+// meetsOPAConditionWithClient checks the OPA decision using the given client.
+func meetsOPAConditionWithClient(client *OPAClient, resource *unstructured.Unstructured, cond *v1alpha1.OPACondition) bool {
+	timeout := DefaultOPATimeout
+	if cond.TimeoutSeconds != nil && *cond.TimeoutSeconds > 0 {
+		timeout = time.Duration(*cond.TimeoutSeconds) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	allowed, err := client.Allowed(ctx, resource, cond)
+	if err != nil {
+		logger := sdklog.NewLogger("zen-gc")
+		logger.Warn("OPA decision query failed, skipping deletion", sdklog.Operation("meets_opa_condition"), sdklog.String("resource", fmt.Sprintf("%s/%s", resource.GetNamespace(), resource.GetName())), sdklog.Error(err))
+		return false
+	}
+	return allowed
+}
+
+// allowedOPAURLs returns the OPA decision URLs policies may query, or nil for none.
+func (r *GCPolicyReconciler) allowedOPAURLs() []string {
+	if r.config == nil {
+		return nil
+	}
+	return r.config.OPAAllowedURLs
+}
+
+// handleOPAURLNotAllowed marks a policy querying a disallowed OPA URL as Error instead of
+// evaluating it.
+func (r *GCPolicyReconciler) handleOPAURLNotAllowed(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy, err error) (ctrl.Result, error) {
+	r.logger.Warn("Policy queries a disallowed OPA URL, skipping evaluation", sdklog.Operation("reconcile"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Error(err))
+	r.markPolicyError(ctx, policy, gcerrors.Wrap(err, gcerrors.TypeOPAURLNotAllowed, "OPA URL not allowed"), ReasonOPAURLNotAllowed)
+	// A spec change triggers a new reconcile; the allowlist only changes on restart
+	return ctrl.Result{RequeueAfter: r.getRequeueIntervalForPolicy(policy)}, nil
+}
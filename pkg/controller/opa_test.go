@@ -0,0 +1,254 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/validation"
+)
+
+// newFakeOPAServer returns a server that answers with the given decision body and counts queries.
+func newFakeOPAServer(t *testing.T, status int, body string, queries *int32) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(queries, 1)
+		var req map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode OPA request: %v", err)
+		}
+		if _, ok := req["input"]; !ok {
+			t.Error("OPA request missing input document")
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+}
+
+// allowDefaultOPAURL lets the shared OPA client query url for the duration of the test.
+func allowDefaultOPAURL(t *testing.T, url string) {
+	t.Helper()
+	ConfigureOPA([]string{url}, false)
+	t.Cleanup(func() { ConfigureOPA(nil, false) })
+}
+
+func TestMeetsOPACondition_Decisions(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		body     string
+		expected bool
+	}{
+		{name: "boolean allow", status: http.StatusOK, body: `{"result": true}`, expected: true},
+		{name: "boolean deny", status: http.StatusOK, body: `{"result": false}`, expected: false},
+		{name: "document allow", status: http.StatusOK, body: `{"result": {"allow": true}}`, expected: true},
+		{name: "undefined decision fails closed", status: http.StatusOK, body: `{}`, expected: false},
+		{name: "non-boolean decision fails closed", status: http.StatusOK, body: `{"result": "yes"}`, expected: false},
+		{name: "server error fails closed", status: http.StatusInternalServerError, body: `{"result": true}`, expected: false},
+		{name: "malformed response fails closed", status: http.StatusOK, body: `not json`, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var queries int32
+			server := newFakeOPAServer(t, tt.status, tt.body, &queries)
+			defer server.Close()
+
+			client := NewOPAClient(server.Client())
+			cond := &v1alpha1.OPACondition{URL: server.URL + "/v1/data/gc/allow"}
+			client.Configure([]string{cond.URL}, false)
+
+			if got := meetsOPAConditionWithClient(client, newTestConfigMap("default", "cm", time.Time{}), cond); got != tt.expected {
+				t.Errorf("meetsOPAConditionWithClient() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMeetsOPACondition_Unreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	client := NewOPAClient(nil)
+	cond := &v1alpha1.OPACondition{URL: url + "/v1/data/gc/allow"}
+	client.Configure([]string{cond.URL}, false)
+	if meetsOPAConditionWithClient(client, newTestConfigMap("default", "cm", time.Time{}), cond) {
+		t.Error("expected unreachable OPA to fail closed")
+	}
+}
+
+func TestOPAClient_CachesDecisionByContent(t *testing.T) {
+	var queries int32
+	server := newFakeOPAServer(t, http.StatusOK, `{"result": true}`, &queries)
+	defer server.Close()
+
+	client := NewOPAClient(server.Client())
+	now := time.Now()
+	client.now = func() time.Time { return now }
+	cond := &v1alpha1.OPACondition{URL: server.URL + "/v1/data/gc/allow"}
+	client.Configure([]string{cond.URL}, false)
+
+	resource := newTestConfigMap("default", "cm", time.Time{})
+	for i := 0; i < 3; i++ {
+		if !meetsOPAConditionWithClient(client, resource, cond) {
+			t.Fatal("expected decision to allow deletion")
+		}
+	}
+	if got := atomic.LoadInt32(&queries); got != 1 {
+		t.Errorf("expected 1 OPA query for unchanged resource, got %d", got)
+	}
+
+	// Changing the resource content invalidates the cached decision
+	resource.SetLabels(map[string]string{"changed": "true"})
+	meetsOPAConditionWithClient(client, resource, cond)
+	if got := atomic.LoadInt32(&queries); got != 2 {
+		t.Errorf("expected 2 OPA queries after resource change, got %d", got)
+	}
+
+	// Expired entries are re-queried
+	now = now.Add(DefaultOPACacheTTL + time.Second)
+	meetsOPAConditionWithClient(client, resource, cond)
+	if got := atomic.LoadInt32(&queries); got != 3 {
+		t.Errorf("expected 3 OPA queries after cache expiry, got %d", got)
+	}
+}
+
+func TestOPAClient_CacheDisabled(t *testing.T) {
+	var queries int32
+	server := newFakeOPAServer(t, http.StatusOK, `{"result": false}`, &queries)
+	defer server.Close()
+
+	client := NewOPAClient(server.Client())
+	zero := int64(0)
+	cond := &v1alpha1.OPACondition{URL: server.URL, CacheTTLSeconds: &zero}
+	client.Configure([]string{cond.URL}, false)
+
+	resource := newTestConfigMap("default", "cm", time.Time{})
+	meetsOPAConditionWithClient(client, resource, cond)
+	meetsOPAConditionWithClient(client, resource, cond)
+	if got := atomic.LoadInt32(&queries); got != 2 {
+		t.Errorf("expected 2 OPA queries with caching disabled, got %d", got)
+	}
+}
+
+func TestMeetsConditions_OPA(t *testing.T) {
+	var queries int32
+	server := newFakeOPAServer(t, http.StatusOK, `{"result": false}`, &queries)
+	defer server.Close()
+
+	conditions := &v1alpha1.ConditionsSpec{
+		OPA: &v1alpha1.OPACondition{URL: server.URL + "/v1/data/gc/allow"},
+	}
+	allowDefaultOPAURL(t, conditions.OPA.URL)
+	if meetsConditionsShared(newTestConfigMap("default", "denied", time.Time{}), conditions) {
+		t.Error("expected OPA deny decision to block deletion")
+	}
+	if atomic.LoadInt32(&queries) != 1 {
+		t.Error("expected OPA to be queried once")
+	}
+}
+
+func TestOPAClient_RefusesURLsOutsideAllowlist(t *testing.T) {
+	var queries int32
+	server := newFakeOPAServer(t, http.StatusOK, `{"result": true}`, &queries)
+	defer server.Close()
+
+	client := NewOPAClient(server.Client())
+	cond := &v1alpha1.OPACondition{URL: server.URL + "/v1/data/gc/allow"}
+	resource := newTestConfigMap("default", "cm", time.Time{})
+
+	if meetsOPAConditionWithClient(client, resource, cond) {
+		t.Error("expected an unconfigured client to fail closed")
+	}
+	client.Configure([]string{server.URL + "/v1/data/gc/other"}, false)
+	if _, err := client.Allowed(context.Background(), resource, cond); !errors.Is(err, validation.ErrOPAURLNotAllowed) {
+		t.Errorf("Allowed() error = %v, want %v", err, validation.ErrOPAURLNotAllowed)
+	}
+	if got := atomic.LoadInt32(&queries); got != 0 {
+		t.Errorf("expected no OPA queries to a disallowed URL, got %d", got)
+	}
+}
+
+func TestOPAClient_StripsDataUnlessIncluded(t *testing.T) {
+	var inputs []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Input map[string]interface{} `json:"input"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode OPA request: %v", err)
+		}
+		inputs = append(inputs, req.Input)
+		_, _ = w.Write([]byte(`{"result": true}`))
+	}))
+	defer server.Close()
+
+	secret := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata": map[string]interface{}{
+			"name":        "token",
+			"namespace":   "default",
+			"annotations": map[string]interface{}{corev1.LastAppliedConfigAnnotation: `{"data":{"token":"c2VjcmV0"}}`, "team": "payments"},
+		},
+		"data":       map[string]interface{}{"token": "c2VjcmV0"},
+		"stringData": map[string]interface{}{"password": "secret"},
+	}}
+	zero := int64(0)
+	cond := &v1alpha1.OPACondition{URL: server.URL, CacheTTLSeconds: &zero}
+	client := NewOPAClient(server.Client())
+
+	client.Configure([]string{cond.URL}, false)
+	meetsOPAConditionWithClient(client, secret, cond)
+	client.Configure([]string{cond.URL}, true)
+	meetsOPAConditionWithClient(client, secret, cond)
+
+	if len(inputs) != 2 {
+		t.Fatalf("expected 2 OPA queries, got %d", len(inputs))
+	}
+	stripped := &unstructured.Unstructured{Object: inputs[0]}
+	if _, found := stripped.Object["data"]; found {
+		t.Error("expected data to be stripped from OPA input")
+	}
+	if _, found := stripped.Object["stringData"]; found {
+		t.Error("expected stringData to be stripped from OPA input")
+	}
+	if _, found := stripped.GetAnnotations()[corev1.LastAppliedConfigAnnotation]; found {
+		t.Error("expected the last-applied-configuration annotation to be stripped from OPA input")
+	}
+	if stripped.GetName() != "token" || stripped.GetAnnotations()["team"] != "payments" {
+		t.Errorf("expected metadata to be kept in OPA input, got %v", stripped.Object["metadata"])
+	}
+	if _, found := secret.GetAnnotations()[corev1.LastAppliedConfigAnnotation]; !found {
+		t.Error("expected the resource itself to be left unchanged")
+	}
+	if _, found := inputs[1]["data"]; !found {
+		t.Error("expected data in OPA input when included")
+	}
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+const (
+	// MaxOwnerChainDepth bounds how many owners are followed for an owner chain condition.
+	MaxOwnerChainDepth = 5
+
+	// DefaultOwnerLookupCacheTTL is how long an owner lookup is cached.
+	DefaultOwnerLookupCacheTTL = 60 * time.Second
+
+	// ownerLookupTimeout bounds a single owner lookup.
+	ownerLookupTimeout = 10 * time.Second
+
+	// maxOwnerLookupEntries bounds the owner lookup cache; expired entries are pruned past it.
+	maxOwnerLookupEntries = 10000
+)
+
+// ErrNoOwnerLookupClient indicates no client is available to look up owners.
+var ErrNoOwnerLookupClient = errors.New("no client available to look up owners")
+
+// ownerEntry is a cached owner lookup. A nil controller means the owner has no controller;
+// found is false if the owner does not exist (or was replaced by an object with another UID).
+type ownerEntry struct {
+	found      bool
+	controller *metav1.OwnerReference
+	expiresAt  time.Time
+}
+
+// OwnerLookupCache looks up the owners of resources and caches what owner chain and dangling
+// owner conditions need (whether the owner exists and its own controller), so sibling resources sharing
+// owners do not each hit the API server.
+type OwnerLookupCache struct {
+	dynClient dynamic.Interface
+	entries   map[string]ownerEntry
+	mu        sync.Mutex
+	now       func() time.Time
+}
+
+// NewOwnerLookupCache creates a new OwnerLookupCache.
+func NewOwnerLookupCache(dynClient dynamic.Interface) *OwnerLookupCache {
+	return &OwnerLookupCache{
+		dynClient: dynClient,
+		entries:   make(map[string]ownerEntry),
+		now:       time.Now,
+	}
+}
+
+// meetsConditionsWithOwnersShared checks if a resource meets all deletion conditions,
+// looking up owners for owner chain and dangling owner conditions. Without a cache, neither
+// condition matches.
+func meetsConditionsWithOwnersShared(resource *unstructured.Unstructured, conditions *v1alpha1.ConditionsSpec, owners *OwnerLookupCache) bool {
+	if !meetsConditionsShared(resource, conditions) {
+		return false
+	}
+	// Checked last since they may need API lookups
+	if conditions.OwnerChain == nil && !conditions.DanglingOwner {
+		return true
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), ownerLookupTimeout)
+	defer cancel()
+	logger := sdklog.NewLogger("zen-gc")
+	if conditions.OwnerChain != nil {
+		matched, err := owners.MatchesChain(ctx, resource, conditions.OwnerChain)
+		if err != nil {
+			logger.Debug("Owner lookup failed, owner chain not matched", sdklog.Operation("meets_conditions"), sdklog.String("resource", fmt.Sprintf("%s/%s", resource.GetNamespace(), resource.GetName())), sdklog.Error(err))
+		}
+		if !matched {
+			return false
+		}
+	}
+	if conditions.DanglingOwner {
+		dangling, err := owners.HasDanglingOwners(ctx, resource)
+		if err != nil {
+			logger.Debug("Owner lookup failed, dangling owner not matched", sdklog.Operation("meets_conditions"), sdklog.String("resource", fmt.Sprintf("%s/%s", resource.GetNamespace(), resource.GetName())), sdklog.Error(err))
+		}
+		return dangling
+	}
+	return true
+}
+
+// MatchesChain checks if the resource's controllers, followed upwards, reach an owner of the
+// condition's root kind at exactly the condition's depth, and that owner has no controller.
+// Owners are looked up in the resource's namespace.
+func (c *OwnerLookupCache) MatchesChain(ctx context.Context, resource *unstructured.Unstructured, cond *v1alpha1.OwnerChainCondition) (bool, error) {
+	if cond.Depth < 1 || cond.Depth > MaxOwnerChainDepth {
+		return false, nil
+	}
+	if c == nil || c.dynClient == nil {
+		return false, ErrNoOwnerLookupClient
+	}
+
+	ref := metav1.GetControllerOf(resource)
+	for hop := int32(1); ; hop++ {
+		if ref == nil {
+			return false, nil
+		}
+		// Only the root's kind is constrained, so a wrong kind at the root needs no lookup
+		if hop == cond.Depth && ref.Kind != cond.RootKind {
+			return false, nil
+		}
+		entry, err := c.lookup(ctx, resource.GetNamespace(), ref)
+		if err != nil || !entry.found {
+			return false, err
+		}
+		if hop == cond.Depth {
+			return entry.controller == nil, nil
+		}
+		ref = entry.controller
+	}
+}
+
+// HasDanglingOwners checks if every ownerReference of the resource points at an owner that
+// no longer exists (or was replaced by an object with another UID). Resources without
+// ownerReferences have nothing dangling and never match; a single live owner keeps the
+// resource, as it does for the Kubernetes garbage collector.
+func (c *OwnerLookupCache) HasDanglingOwners(ctx context.Context, resource *unstructured.Unstructured) (bool, error) {
+	refs := resource.GetOwnerReferences()
+	if len(refs) == 0 {
+		return false, nil
+	}
+	if c == nil || c.dynClient == nil {
+		return false, ErrNoOwnerLookupClient
+	}
+	for i := range refs {
+		found, err := c.ownerExists(ctx, resource.GetNamespace(), &refs[i])
+		if err != nil || found {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// ownerExists checks if the owner a reference points at exists. Namespaced resources may be
+// owned by cluster-scoped objects such as Nodes, so an owner missing from the resource's
+// namespace is also looked up at cluster scope before it is reported absent.
+func (c *OwnerLookupCache) ownerExists(ctx context.Context, namespace string, ref *metav1.OwnerReference) (bool, error) {
+	entry, err := c.lookup(ctx, namespace, ref)
+	if err != nil || entry.found || namespace == "" {
+		return entry.found, err
+	}
+	entry, err = c.lookup(ctx, "", ref)
+	return entry.found, err
+}
+
+// lookup returns the cached lookup of an owner, reading it from the API server when missing
+// or expired. Lookup failures other than NotFound are not cached.
+func (c *OwnerLookupCache) lookup(ctx context.Context, namespace string, ref *metav1.OwnerReference) (ownerEntry, error) {
+	key := fmt.Sprintf("%s/%s/%s/%s/%s", namespace, ref.APIVersion, ref.Kind, ref.Name, ref.UID)
+
+	c.mu.Lock()
+	if entry, ok := c.entries[key]; ok && c.now().Before(entry.expiresAt) {
+		c.mu.Unlock()
+		return entry, nil
+	}
+	c.mu.Unlock()
+
+	gvr, err := parseGVR(ref.APIVersion, ref.Kind)
+	if err != nil {
+		return ownerEntry{}, err
+	}
+	var owner *unstructured.Unstructured
+	if namespace == "" {
+		owner, err = c.dynClient.Resource(gvr).Get(ctx, ref.Name, metav1.GetOptions{})
+	} else {
+		owner, err = c.dynClient.Resource(gvr).Namespace(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	}
+
+	var entry ownerEntry
+	switch {
+	case k8serrors.IsNotFound(err):
+	case err != nil:
+		return ownerEntry{}, fmt.Errorf("failed to get owner %s %s/%s: %w", ref.Kind, namespace, ref.Name, err)
+	case ref.UID != "" && owner.GetUID() != ref.UID:
+		// Recreated under the same name; the reference points at an object that is gone
+	default:
+		entry.found = true
+		entry.controller = metav1.GetControllerOf(owner)
+	}
+	entry.expiresAt = c.now().Add(DefaultOwnerLookupCacheTTL)
+
+	c.mu.Lock()
+	if len(c.entries) >= maxOwnerLookupEntries {
+		c.pruneLocked()
+	}
+	c.entries[key] = entry
+	c.mu.Unlock()
+	return entry, nil
+}
+
+// pruneLocked drops expired entries, and all entries if the cache is still full.
+func (c *OwnerLookupCache) pruneLocked() {
+	now := c.now()
+	for key, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+	if len(c.entries) >= maxOwnerLookupEntries {
+		c.entries = make(map[string]ownerEntry)
+	}
+}
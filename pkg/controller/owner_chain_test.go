@@ -0,0 +1,237 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func newOwnerChainTestObject(apiVersion, kind, name string, owner *unstructured.Unstructured) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace("default")
+	obj.SetName(name)
+	obj.SetUID(types.UID(name + "-uid"))
+	if owner != nil {
+		controller := true
+		obj.SetOwnerReferences([]metav1.OwnerReference{{
+			APIVersion: owner.GetAPIVersion(),
+			Kind:       owner.GetKind(),
+			Name:       owner.GetName(),
+			UID:        owner.GetUID(),
+			Controller: &controller,
+		}})
+	}
+	return obj
+}
+
+func TestOwnerLookupCache_MatchesChain(t *testing.T) {
+	cronJob := newOwnerChainTestObject("batch/v1", "CronJob", "nightly", nil)
+	scheduledJob := newOwnerChainTestObject("batch/v1", "Job", "nightly-1", cronJob)
+	manualJob := newOwnerChainTestObject("batch/v1", "Job", "manual", nil)
+
+	grandchild := newOwnerChainTestObject("v1", "Pod", "grandchild", scheduledJob)
+	direct := newOwnerChainTestObject("v1", "Pod", "direct", manualJob)
+	orphan := newOwnerChainTestObject("v1", "Pod", "orphan", nil)
+	// Owned by a Job that was deleted and recreated under the same name
+	stale := newOwnerChainTestObject("v1", "Pod", "stale", manualJob)
+	refs := stale.GetOwnerReferences()
+	refs[0].UID = "old-uid"
+	stale.SetOwnerReferences(refs)
+	// Owned by a Job that no longer exists
+	missing := newOwnerChainTestObject("v1", "Pod", "missing", newOwnerChainTestObject("batch/v1", "Job", "gone", nil))
+
+	dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme(), cronJob, scheduledJob, manualJob)
+
+	tests := []struct {
+		name     string
+		resource *unstructured.Unstructured
+		cond     v1alpha1.OwnerChainCondition
+		want     bool
+	}{
+		{name: "grandchild of CronJob", resource: grandchild, cond: v1alpha1.OwnerChainCondition{RootKind: "CronJob", Depth: 2}, want: true},
+		{name: "grandchild is not rooted at its Job", resource: grandchild, cond: v1alpha1.OwnerChainCondition{RootKind: "Job", Depth: 1}, want: false},
+		{name: "grandchild is not three levels deep", resource: grandchild, cond: v1alpha1.OwnerChainCondition{RootKind: "CronJob", Depth: 3}, want: false},
+		{name: "directly owned by Job", resource: direct, cond: v1alpha1.OwnerChainCondition{RootKind: "Job", Depth: 1}, want: true},
+		{name: "directly owned is not a CronJob grandchild", resource: direct, cond: v1alpha1.OwnerChainCondition{RootKind: "CronJob", Depth: 2}, want: false},
+		{name: "orphan", resource: orphan, cond: v1alpha1.OwnerChainCondition{RootKind: "Job", Depth: 1}, want: false},
+		{name: "stale owner UID", resource: stale, cond: v1alpha1.OwnerChainCondition{RootKind: "Job", Depth: 1}, want: false},
+		{name: "missing owner", resource: missing, cond: v1alpha1.OwnerChainCondition{RootKind: "Job", Depth: 1}, want: false},
+		{name: "depth beyond bound", resource: grandchild, cond: v1alpha1.OwnerChainCondition{RootKind: "CronJob", Depth: MaxOwnerChainDepth + 1}, want: false},
+	}
+
+	owners := NewOwnerLookupCache(dynamicClient)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := owners.MatchesChain(context.Background(), tt.resource, &tt.cond)
+			if err != nil {
+				t.Fatalf("MatchesChain() returned error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("MatchesChain() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOwnerLookupCache_CachesLookups(t *testing.T) {
+	cronJob := newOwnerChainTestObject("batch/v1", "CronJob", "nightly", nil)
+	job := newOwnerChainTestObject("batch/v1", "Job", "nightly-1", cronJob)
+	dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme(), cronJob, job)
+	owners := NewOwnerLookupCache(dynamicClient)
+	cond := &v1alpha1.OwnerChainCondition{RootKind: "CronJob", Depth: 2}
+
+	for _, name := range []string{"pod-1", "pod-2", "pod-3"} {
+		pod := newOwnerChainTestObject("v1", "Pod", name, job)
+		if matched, err := owners.MatchesChain(context.Background(), pod, cond); err != nil || !matched {
+			t.Fatalf("MatchesChain(%s) = %v, %v, want true", name, matched, err)
+		}
+	}
+	// Siblings share the Job and CronJob lookups
+	if gets := countActions(dynamicClient, "get", ""); gets != 2 {
+		t.Errorf("owner lookups = %d, want 2", gets)
+	}
+}
+
+func TestMeetsConditionsWithOwnersShared(t *testing.T) {
+	job := newOwnerChainTestObject("batch/v1", "Job", "manual", nil)
+	pod := newOwnerChainTestObject("v1", "Pod", "direct", job)
+	dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme(), job)
+	conditions := &v1alpha1.ConditionsSpec{OwnerChain: &v1alpha1.OwnerChainCondition{RootKind: "Job", Depth: 1}}
+
+	if !meetsConditionsWithOwnersShared(pod, conditions, NewOwnerLookupCache(dynamicClient)) {
+		t.Error("meetsConditionsWithOwnersShared() = false, want true")
+	}
+	if meetsConditionsWithOwnersShared(pod, conditions, nil) {
+		t.Error("meetsConditionsWithOwnersShared() without a cache = true, want false")
+	}
+
+	// Local conditions are checked before any owner lookup
+	conditions.Phase = []string{"Failed"}
+	before := countActions(dynamicClient, "get", "")
+	if meetsConditionsWithOwnersShared(pod, conditions, NewOwnerLookupCache(dynamicClient)) {
+		t.Error("meetsConditionsWithOwnersShared() = true for a resource failing its phase condition")
+	}
+	if gets := countActions(dynamicClient, "get", ""); gets != before {
+		t.Errorf("owner lookups = %d, want none for a resource failing local conditions", gets-before)
+	}
+}
+
+func TestOwnerLookupCache_HasDanglingOwners(t *testing.T) {
+	liveJob := newOwnerChainTestObject("batch/v1", "Job", "live", nil)
+	deletedJob := newOwnerChainTestObject("batch/v1", "Job", "deleted", nil)
+	node := newOwnerChainTestObject("v1", "Node", "node-1", nil)
+	node.SetNamespace("")
+
+	liveOwner := newOwnerChainTestObject("v1", "Pod", "live-owner", liveJob)
+	deletedOwner := newOwnerChainTestObject("v1", "Pod", "deleted-owner", deletedJob)
+	orphan := newOwnerChainTestObject("v1", "Pod", "orphan", nil)
+	// Owned by a Job that was deleted and recreated under the same name
+	stale := newOwnerChainTestObject("v1", "Pod", "stale", liveJob)
+	refs := stale.GetOwnerReferences()
+	refs[0].UID = "old-uid"
+	stale.SetOwnerReferences(refs)
+	// One owner deleted, the other still live
+	mixed := newOwnerChainTestObject("v1", "Pod", "mixed", deletedJob)
+	mixed.SetOwnerReferences(append(mixed.GetOwnerReferences(), liveOwner.GetOwnerReferences()...))
+	// Owned by a cluster-scoped object that still exists
+	nodeOwned := newOwnerChainTestObject("v1", "Pod", "node-owned", node)
+
+	dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme(), liveJob, node)
+
+	tests := []struct {
+		name     string
+		resource *unstructured.Unstructured
+		want     bool
+	}{
+		{name: "live owner", resource: liveOwner, want: false},
+		{name: "deleted owner", resource: deletedOwner, want: true},
+		{name: "no ownerReferences", resource: orphan, want: false},
+		{name: "stale owner UID", resource: stale, want: true},
+		{name: "one of two owners live", resource: mixed, want: false},
+		{name: "cluster-scoped owner live", resource: nodeOwned, want: false},
+	}
+
+	owners := NewOwnerLookupCache(dynamicClient)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := owners.HasDanglingOwners(context.Background(), tt.resource)
+			if err != nil {
+				t.Fatalf("HasDanglingOwners() returned error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("HasDanglingOwners() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMeetsConditionsWithOwnersShared_DanglingOwner(t *testing.T) {
+	liveJob := newOwnerChainTestObject("batch/v1", "Job", "live", nil)
+	owned := newOwnerChainTestObject("v1", "Pod", "owned", liveJob)
+	dangling := newOwnerChainTestObject("v1", "Pod", "dangling", newOwnerChainTestObject("batch/v1", "Job", "gone", nil))
+	dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme(), liveJob)
+	conditions := &v1alpha1.ConditionsSpec{DanglingOwner: true}
+	owners := NewOwnerLookupCache(dynamicClient)
+
+	if !meetsConditionsWithOwnersShared(dangling, conditions, owners) {
+		t.Error("meetsConditionsWithOwnersShared() = false for a resource with a deleted owner, want true")
+	}
+	if meetsConditionsWithOwnersShared(owned, conditions, owners) {
+		t.Error("meetsConditionsWithOwnersShared() = true for a resource with a live owner, want false")
+	}
+	if meetsConditionsWithOwnersShared(dangling, conditions, nil) {
+		t.Error("meetsConditionsWithOwnersShared() without a cache = true, want false")
+	}
+
+	// The dangling check is cached across evaluations
+	before := countActions(dynamicClient, "get", "")
+	meetsConditionsWithOwnersShared(dangling, conditions, owners)
+	if gets := countActions(dynamicClient, "get", ""); gets != before {
+		t.Errorf("owner lookups = %d, want none for a cached owner", gets-before)
+	}
+}
+
+func TestOwnerLookupCache_HasDanglingOwners_SharedOwner(t *testing.T) {
+	deletedJob := newOwnerChainTestObject("batch/v1", "Job", "deleted", nil)
+	dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme())
+	owners := NewOwnerLookupCache(dynamicClient)
+
+	// Siblings of one deleted owner share its lookups: the namespace and cluster-scope GETs
+	for _, name := range []string{"pod-1", "pod-2", "pod-3", "pod-4"} {
+		dangling, err := owners.HasDanglingOwners(context.Background(), newOwnerChainTestObject("v1", "Pod", name, deletedJob))
+		if err != nil {
+			t.Fatalf("HasDanglingOwners(%s) returned error: %v", name, err)
+		}
+		if !dangling {
+			t.Errorf("HasDanglingOwners(%s) = false, want true", name)
+		}
+	}
+	if gets := countActions(dynamicClient, "get", ""); gets != 2 {
+		t.Errorf("owner lookups = %d, want 2 for siblings sharing an owner", gets)
+	}
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// oldestPending tracks the creation time of the oldest pending (matched but not deleted)
+// resource seen during an evaluation run.
+type oldestPending struct {
+	created time.Time
+}
+
+// observe records a pending resource.
+func (o *oldestPending) observe(resource *unstructured.Unstructured) {
+	created := resource.GetCreationTimestamp().Time
+	if created.IsZero() {
+		return
+	}
+	if o.created.IsZero() || created.Before(o.created) {
+		o.created = created
+	}
+}
+
+// observeAll records all resources as pending.
+func (o *oldestPending) observeAll(resources []*unstructured.Unstructured) {
+	for _, resource := range resources {
+		o.observe(resource)
+	}
+}
+
+// observeHeld records the resources dropped from a deletion list by policy-level gates.
+func (o *oldestPending) observeHeld(before, after []*unstructured.Unstructured) {
+	if len(before) == len(after) {
+		return
+	}
+	kept := make(map[*unstructured.Unstructured]struct{}, len(after))
+	for _, resource := range after {
+		kept[resource] = struct{}{}
+	}
+	for _, resource := range before {
+		if _, ok := kept[resource]; !ok {
+			o.observe(resource)
+		}
+	}
+}
+
+// ageSeconds returns the age of the oldest pending resource in whole seconds, or 0 if none.
+func (o *oldestPending) ageSeconds(now time.Time) int64 {
+	if o.created.IsZero() || !now.After(o.created) {
+		return 0
+	}
+	return int64(now.Sub(o.created) / time.Second)
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestOldestPending(t *testing.T) {
+	now := time.Now()
+	oldCM := newTestConfigMap("default", "old", now.Add(-3*time.Hour))
+	newCM := newTestConfigMap("default", "new", now.Add(-10*time.Minute))
+	heldCM := newTestConfigMap("default", "held", now.Add(-5*time.Hour))
+	deletedCM := newTestConfigMap("default", "deleted", now.Add(-9*time.Hour))
+
+	var oldest oldestPending
+	if got := oldest.ageSeconds(now); got != 0 {
+		t.Errorf("ageSeconds() with no pending resources = %d, want 0", got)
+	}
+
+	oldest.observe(newCM)
+	oldest.observe(oldCM)
+	oldest.observe(newTestConfigMap("default", "no-timestamp", time.Time{}))
+	if got := oldest.ageSeconds(now); got != int64((3 * time.Hour).Seconds()) {
+		t.Errorf("ageSeconds() = %d, want %d", got, int64((3 * time.Hour).Seconds()))
+	}
+
+	// Only resources dropped from the deletion list count as pending
+	oldest.observeHeld([]*unstructured.Unstructured{deletedCM, heldCM}, []*unstructured.Unstructured{deletedCM})
+	if got := oldest.ageSeconds(now); got != int64((5 * time.Hour).Seconds()) {
+		t.Errorf("ageSeconds() after held = %d, want %d", got, int64((5 * time.Hour).Seconds()))
+	}
+}
+
+func TestObservePolicy_RecordsOldestPendingAge(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reconciler, dynamicClient, policy := setupObservePausedTest(t, true)
+	defer reconciler.cleanupResourceInformer(policy.UID)
+
+	oldest := newTestConfigMap("default", "cm-oldest", time.Now().Add(-2*time.Hour))
+	oldest.SetCreationTimestamp(metav1.NewTime(time.Now().Add(-6 * time.Hour)))
+	if _, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").Create(ctx, oldest, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create configmap: %v", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if _, err := reconciler.handlePausedPolicy(ctx, policy); err != nil {
+			t.Fatalf("handlePausedPolicy() returned error: %v", err)
+		}
+		if matched, _ := policyStatusCount(t, dynamicClient, "resourcesMatched"); matched == 3 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("expected resourcesMatched to reach 3")
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	minAge := (6 * time.Hour).Seconds()
+	maxAge := minAge + 60
+	gauge := testutil.ToFloat64(gcOldestPendingResourceAgeSeconds.WithLabelValues("default", "paused-policy", "v1", "ConfigMap"))
+	if gauge < minAge || gauge > maxAge {
+		t.Errorf("gc_oldest_pending_resource_age_seconds = %v, want about %v", gauge, minAge)
+	}
+	age, found := policyStatusCount(t, dynamicClient, "oldestPendingAgeSeconds")
+	if !found || float64(age) < minAge || float64(age) > maxAge {
+		t.Errorf("status.oldestPendingAgeSeconds = %d (found %v), want about %v", age, found, minAge)
+	}
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestRecordPolicyCounts(t *testing.T) {
+	recordPolicyCounts("default", "counts-policy", 10, 4, 6)
+	recordPolicyCounts("default", "counts-policy", 7, 3, 2)
+
+	if got := testutil.ToFloat64(gcPolicyMatched.WithLabelValues("default", "counts-policy")); got != 7 {
+		t.Errorf("gc_policy_matched = %v, want the last run's 7", got)
+	}
+	if got := testutil.ToFloat64(gcPolicyPending.WithLabelValues("default", "counts-policy")); got != 3 {
+		t.Errorf("gc_policy_pending = %v, want the last run's 3", got)
+	}
+	if got := testutil.ToFloat64(gcPolicyDeletedTotal.WithLabelValues("default", "counts-policy")); got != 8 {
+		t.Errorf("gc_policy_deleted_total = %v, want 8 across both runs", got)
+	}
+
+	forgetPolicyCounts("default", "counts-policy")
+	if gcPolicyMatched.DeleteLabelValues("default", "counts-policy") ||
+		gcPolicyPending.DeleteLabelValues("default", "counts-policy") ||
+		gcPolicyDeletedTotal.DeleteLabelValues("default", "counts-policy") {
+		t.Error("expected the policy's count gauges to be removed")
+	}
+}
+
+func TestEvaluatePolicy_RecordsPolicyCounts(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reconciler, _, policy := setupObservePausedTest(t, false)
+	defer reconciler.cleanupResourceInformer(policy.UID)
+	policy.Spec.Paused = false
+	forgetPolicyCounts(policy.Namespace, policy.Name)
+
+	// Both seeded configmaps are expired
+	if err := reconciler.evaluatePolicy(ctx, policy); err != nil {
+		t.Fatalf("evaluatePolicy() returned error: %v", err)
+	}
+	if got := testutil.ToFloat64(gcPolicyMatched.WithLabelValues(policy.Namespace, policy.Name)); got != 2 {
+		t.Errorf("gc_policy_matched = %v, want 2", got)
+	}
+	if got := testutil.ToFloat64(gcPolicyPending.WithLabelValues(policy.Namespace, policy.Name)); got != 0 {
+		t.Errorf("gc_policy_pending = %v, want 0", got)
+	}
+	if got := testutil.ToFloat64(gcPolicyDeletedTotal.WithLabelValues(policy.Namespace, policy.Name)); got != 2 {
+		t.Errorf("gc_policy_deleted_total = %v, want 2", got)
+	}
+
+	nn := types.NamespacedName{Namespace: policy.Namespace, Name: policy.Name}
+	reconciler.policyUIDs[nn] = policy.UID
+	reconciler.cleanupPolicyResources(nn)
+	if gcPolicyMatched.DeleteLabelValues(policy.Namespace, policy.Name) {
+		t.Error("expected cleanup to remove the policy's count gauges")
+	}
+}
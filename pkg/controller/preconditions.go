@@ -0,0 +1,41 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// applyDeletePreconditionsShared makes a delete conditional on the resource version that was
+// evaluated when the policy sets behavior.preconditions. The API server then rejects the delete
+// with a conflict if the resource changed in between.
+func applyDeletePreconditionsShared(deleteOptions *metav1.DeleteOptions, policy *v1alpha1.GarbageCollectionPolicy, resourceVersion string) {
+	if !policy.Spec.Behavior.Preconditions || resourceVersion == "" {
+		return
+	}
+	deleteOptions.Preconditions = &metav1.Preconditions{ResourceVersion: &resourceVersion}
+}
+
+// isResourceModified reports whether a delete was rejected by its resourceVersion
+// precondition because the resource changed since it was evaluated.
+func isResourceModified(err error) bool {
+	return errors.Is(err, ErrResourceModified)
+}
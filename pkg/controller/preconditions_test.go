@@ -0,0 +1,108 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-sdk/pkg/gc/ratelimiter"
+)
+
+func TestApplyDeletePreconditionsShared(t *testing.T) {
+	tests := []struct {
+		name            string
+		preconditions   bool
+		resourceVersion string
+		expected        string
+	}{
+		{name: "preconditions set", preconditions: true, resourceVersion: "42", expected: "42"},
+		{name: "preconditions unset", resourceVersion: "42"},
+		{name: "no resource version", preconditions: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &v1alpha1.GarbageCollectionPolicy{
+				Spec: v1alpha1.GarbageCollectionPolicySpec{Behavior: v1alpha1.BehaviorSpec{Preconditions: tt.preconditions}},
+			}
+			deleteOptions := &metav1.DeleteOptions{}
+			applyDeletePreconditionsShared(deleteOptions, policy, tt.resourceVersion)
+
+			var got string
+			if deleteOptions.Preconditions != nil && deleteOptions.Preconditions.ResourceVersion != nil {
+				got = *deleteOptions.Preconditions.ResourceVersion
+			}
+			if got != tt.expected {
+				t.Errorf("precondition resourceVersion = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDeleteResourceWithBackoff_ConflictIsResourceModified(t *testing.T) {
+	reconciler, dynamicClient, policy := setupObservePausedTest(t, false)
+	policy.Spec.Behavior.Preconditions = true
+
+	conflicts := 0
+	dynamicClient.PrependReactor("delete", "configmaps", func(clienttesting.Action) (bool, runtime.Object, error) {
+		conflicts++
+		return true, nil, k8serrors.NewConflict(observeTestConfigMapGVR.GroupResource(), "cm-1", errors.New("the object has been modified"))
+	})
+
+	resource, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").Get(context.Background(), "cm-1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get configmap: %v", err)
+	}
+	err = reconciler.DeleteResourceWithBackoff(context.Background(), resource, policy, ratelimiter.NewRateLimiter(10))
+	if !errors.Is(err, ErrResourceModified) {
+		t.Errorf("DeleteResourceWithBackoff() = %v, want ErrResourceModified", err)
+	}
+	if conflicts != 1 {
+		t.Errorf("%d delete attempts, want 1: a conflict is not retried", conflicts)
+	}
+}
+
+func TestDeleteBatch_ResourceModified(t *testing.T) {
+	coordinator := NewDeletionCoordinator()
+	deleter := &fakeBatchDeleter{coordinator: coordinator, deletedBy: map[string][]string{}, err: fmt.Errorf("%w: conflict", ErrResourceModified)}
+	limiter := ratelimiter.NewRateLimiter(1000)
+	policy := newTestPolicy("modified")
+	policy.Spec.Behavior.Preconditions = true
+	batch := []*unstructured.Unstructured{newTestConfigMap("default", "a", time.Now()), newTestConfigMap("default", "b", time.Now())}
+
+	modified := gcResourcesModifiedBeforeDeletionTotal.WithLabelValues("default", "modified", "v1", "ConfigMap")
+	before := testutil.ToFloat64(modified)
+
+	deleted, errs := deleteBatchShared(context.Background(), batch, policy, limiter, map[string]string{}, deleter)
+	if deleted != 0 || len(errs) != 0 {
+		t.Fatalf("deleted=%d errs=%v, want modified resources neither deleted nor failed", deleted, errs)
+	}
+	if got := testutil.ToFloat64(modified) - before; got != 2 {
+		t.Errorf("gc_resources_modified_before_deletion_total increased by %v, want 2", got)
+	}
+}
@@ -0,0 +1,34 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// policyChangedPredicate filters out status-only policy updates, including the controller's
+// own status writes after each evaluation, so they don't trigger evaluation storms.
+// Spec changes bump metadata.generation; label and annotation changes are passed through.
+// Create, delete, and generic events always pass. Periodic evaluation is driven by
+// RequeueAfter and is not affected.
+func policyChangedPredicate() predicate.Predicate {
+	return predicate.Or(
+		predicate.GenerationChangedPredicate{},
+		predicate.LabelChangedPredicate{},
+		predicate.AnnotationChangedPredicate{},
+	)
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func TestPolicyChangedPredicate_Update(t *testing.T) {
+	tests := []struct {
+		name     string
+		mutate   func(p *v1alpha1.GarbageCollectionPolicy)
+		expected bool
+	}{
+		{
+			name: "status-only change is filtered",
+			mutate: func(p *v1alpha1.GarbageCollectionPolicy) {
+				p.Status.ResourcesMatched = 10
+				p.Status.ResourcesDeleted = 4
+				p.Status.LastGCRun = &metav1.Time{}
+			},
+			expected: false,
+		},
+		{
+			name: "spec change bumps generation",
+			mutate: func(p *v1alpha1.GarbageCollectionPolicy) {
+				p.Spec.Paused = true
+				p.Generation = 2
+			},
+			expected: true,
+		},
+		{
+			name: "label change passes",
+			mutate: func(p *v1alpha1.GarbageCollectionPolicy) {
+				p.Labels = map[string]string{"team": "platform"}
+			},
+			expected: true,
+		},
+		{
+			name: "annotation change passes",
+			mutate: func(p *v1alpha1.GarbageCollectionPolicy) {
+				p.Annotations = map[string]string{"note": "x"}
+			},
+			expected: true,
+		},
+	}
+
+	pred := policyChangedPredicate()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldPolicy := newTestPolicy("test-policy")
+			oldPolicy.Generation = 1
+			oldPolicy.ResourceVersion = "1"
+			newPolicy := oldPolicy.DeepCopy()
+			newPolicy.ResourceVersion = "2"
+			tt.mutate(newPolicy)
+
+			if got := pred.Update(event.UpdateEvent{ObjectOld: oldPolicy, ObjectNew: newPolicy}); got != tt.expected {
+				t.Errorf("Update() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPolicyChangedPredicate_CreateAndDelete(t *testing.T) {
+	pred := policyChangedPredicate()
+	policy := newTestPolicy("test-policy")
+
+	if !pred.Create(event.CreateEvent{Object: policy}) {
+		t.Error("expected create events to pass")
+	}
+	if !pred.Delete(event.DeleteEvent{Object: policy}) {
+		t.Error("expected delete events to pass")
+	}
+}
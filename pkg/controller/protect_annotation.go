@@ -0,0 +1,48 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/config"
+)
+
+// protectedByAnnotationShared reports whether the resource carries the protection
+// annotation key with a true boolean value. An empty key disables protection.
+func protectedByAnnotationShared(resource *unstructured.Unstructured, key string) bool {
+	if key == "" {
+		return false
+	}
+	value, ok := resource.GetAnnotations()[key]
+	if !ok {
+		return false
+	}
+	protected, err := strconv.ParseBool(value)
+	return err == nil && protected
+}
+
+// protectAnnotation returns the annotation key that protects resources, the default if
+// the reconciler has no config.
+func (r *GCPolicyReconciler) protectAnnotation() string {
+	if r.config == nil {
+		return config.DefaultProtectAnnotation
+	}
+	return r.config.ProtectAnnotation
+}
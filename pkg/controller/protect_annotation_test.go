@@ -0,0 +1,109 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/config"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+func TestProtectedByAnnotationShared(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		key         string
+		want        bool
+	}{
+		{name: "true", annotations: map[string]string{config.DefaultProtectAnnotation: "true"}, key: config.DefaultProtectAnnotation, want: true},
+		{name: "boolean string", annotations: map[string]string{config.DefaultProtectAnnotation: "1"}, key: config.DefaultProtectAnnotation, want: true},
+		{name: "false", annotations: map[string]string{config.DefaultProtectAnnotation: "false"}, key: config.DefaultProtectAnnotation, want: false},
+		{name: "not a boolean", annotations: map[string]string{config.DefaultProtectAnnotation: "yes"}, key: config.DefaultProtectAnnotation, want: false},
+		{name: "missing", annotations: nil, key: config.DefaultProtectAnnotation, want: false},
+		{name: "protection disabled", annotations: map[string]string{config.DefaultProtectAnnotation: "true"}, key: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := newTestConfigMap("default", "cm", time.Now().Add(-2*time.Hour))
+			resource.SetAnnotations(tt.annotations)
+			if got := protectedByAnnotationShared(resource, tt.key); got != tt.want {
+				t.Errorf("protectedByAnnotationShared() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGCPolicyReconciler_shouldDelete_ProtectedByAnnotation(t *testing.T) {
+	reconciler := &GCPolicyReconciler{
+		logger: sdklog.NewLogger("zen-gc"),
+	}
+	protected := newTestConfigMap("default", "protected", time.Now().Add(-2*time.Hour))
+	protected.SetAnnotations(map[string]string{config.DefaultProtectAnnotation: "true"})
+
+	if shouldDelete, reason := reconciler.shouldDelete(protected, newTestPolicy("protect")); shouldDelete || reason != ReasonProtectedByAnnotation {
+		t.Errorf("shouldDelete() = %v, %q, want false, %q", shouldDelete, reason, ReasonProtectedByAnnotation)
+	}
+
+	// A configured key replaces the default
+	reconciler.config = config.NewControllerConfig().WithProtectAnnotation("example.com/keep")
+	if shouldDelete, reason := reconciler.shouldDelete(protected, newTestPolicy("protect")); !shouldDelete || reason != ReasonTTLExpired {
+		t.Errorf("shouldDelete() = %v, %q, want the default key ignored", shouldDelete, reason)
+	}
+	kept := newTestConfigMap("default", "kept", time.Now().Add(-2*time.Hour))
+	kept.SetAnnotations(map[string]string{"example.com/keep": "true"})
+	if shouldDelete, reason := reconciler.shouldDelete(kept, newTestPolicy("protect")); shouldDelete || reason != ReasonProtectedByAnnotation {
+		t.Errorf("shouldDelete() = %v, %q, want false, %q", shouldDelete, reason, ReasonProtectedByAnnotation)
+	}
+}
+
+func TestPolicyEvaluationService_shouldDelete_ProtectedByAnnotation(t *testing.T) {
+	service := NewPolicyEvaluationService(nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	protected := newTestConfigMap("default", "protected", time.Now().Add(-2*time.Hour))
+	protected.SetAnnotations(map[string]string{config.DefaultProtectAnnotation: "true"})
+
+	if shouldDelete, reason := service.shouldDelete(protected, newTestPolicy("protect")); shouldDelete || reason != ReasonProtectedByAnnotation {
+		t.Errorf("shouldDelete() = %v, %q, want false, %q", shouldDelete, reason, ReasonProtectedByAnnotation)
+	}
+	unprotected := newTestConfigMap("default", "unprotected", time.Now().Add(-2*time.Hour))
+	if shouldDelete, _ := service.shouldDelete(unprotected, newTestPolicy("protect")); !shouldDelete {
+		t.Error("shouldDelete() = false, want unprotected expired resource deleted")
+	}
+}
+
+func TestPolicyEvaluationService_evaluateResources_ProtectedCountedAsPending(t *testing.T) {
+	policy := newTestPolicy("protect")
+	decisions := &decisionLog{}
+	protected := newTestConfigMap("default", "protected", time.Now().Add(-2*time.Hour))
+	protected.SetAnnotations(map[string]string{config.DefaultProtectAnnotation: "true"})
+	resources := []*unstructured.Unstructured{protected, newTestConfigMap("default", "unprotected", time.Now().Add(-2*time.Hour))}
+	service := NewPolicyEvaluationService(nil, NewDefaultSelectorMatcher(), nil, nil, nil, nil, nil, nil, nil)
+
+	var toDelete []*unstructured.Unstructured
+	var oldest oldestPending
+	matched, pending := service.evaluateResources(t.Context(), resources, policy, &toDelete, map[string]string{}, "v1", "ConfigMap", &oldest, decisions)
+	if matched != 2 || pending != 1 || len(toDelete) != 1 {
+		t.Fatalf("matched=%d pending=%d toDelete=%d, want 2 matched, 1 pending, 1 to delete", matched, pending, len(toDelete))
+	}
+	if len(decisions.reasons) != 1 || decisions.reasons[0] != ReasonProtectedByAnnotation {
+		t.Errorf("decision reasons = %v, want [%s]", decisions.reasons, ReasonProtectedByAnnotation)
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// Gates that hold deletable resources, reported as heldBy for ReasonDeletionHeld.
+const (
+	HeldByConfirmation    = "confirmation"
+	HeldByCountTrend      = "countTrend"
+	HeldByMetricThreshold = "metricThreshold"
+	HeldByBackupGate      = "backupGate"
+	HeldByMinRemaining    = "minRemaining"
+)
+
+// DefaultProtectedReportLimit is the maximum number of resources listed per policy report.
+const DefaultProtectedReportLimit = 500
+
+// ProtectedReportPath is the path the protected resources report is served on.
+const ProtectedReportPath = "/debug/protected-resources"
+
+// ProtectedResource is a matched resource a policy deliberately did not delete.
+type ProtectedResource struct {
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	UID       string `json:"uid"`
+	Reason    string `json:"reason"`
+	HeldBy    string `json:"heldBy,omitempty"`
+}
+
+// ProtectedReport lists the resources a policy protected in its latest evaluation.
+// Total counts every protected resource; Resources is capped at DefaultProtectedReportLimit.
+type ProtectedReport struct {
+	Policy      string              `json:"policy"`
+	EvaluatedAt time.Time           `json:"evaluatedAt"`
+	Total       int                 `json:"total"`
+	Resources   []ProtectedResource `json:"resources"`
+}
+
+// protectiveReason reports whether a skip reason means the resource was deletable, or
+// would have been, but was kept on purpose, as opposed to simply not being due.
+func protectiveReason(reason string) bool {
+	switch reason {
+	case ReasonDedupKept, ReasonRetentionKept, ReasonNotAllowed, ReasonDeletionHeld, ReasonProtectedByAnnotation, ReasonDeletionNoticePending:
+		return true
+	}
+	return false
+}
+
+// ProtectedReports keeps the latest protected resources report of each policy with
+// behavior.reportProtected. A nil *ProtectedReports records nothing.
+type ProtectedReports struct {
+	reports map[types.UID]*ProtectedReport
+	limit   int
+	mu      sync.RWMutex
+}
+
+// NewProtectedReports creates an empty ProtectedReports.
+func NewProtectedReports() *ProtectedReports {
+	return &ProtectedReports{
+		reports: make(map[types.UID]*ProtectedReport),
+		limit:   DefaultProtectedReportLimit,
+	}
+}
+
+// Record replaces the policy's report with the protected resources in decisions.
+// The report is dropped if the policy no longer sets reportProtected.
+func (p *ProtectedReports) Record(policy *v1alpha1.GarbageCollectionPolicy, decisions *decisionLog, now time.Time) {
+	if p == nil {
+		return
+	}
+	if !policy.Spec.Behavior.ReportProtected || decisions == nil {
+		p.Forget(policy.UID)
+		return
+	}
+
+	report := &ProtectedReport{
+		Policy:      fmt.Sprintf("%s/%s", policy.Namespace, policy.Name),
+		EvaluatedAt: now.UTC(),
+		Resources:   []ProtectedResource{},
+	}
+	for i, resource := range decisions.resources {
+		reason := decisions.reasons[i]
+		if !protectiveReason(reason) {
+			continue
+		}
+		report.Total++
+		if len(report.Resources) >= p.limit {
+			continue
+		}
+		report.Resources = append(report.Resources, ProtectedResource{
+			Namespace: resource.GetNamespace(),
+			Name:      resource.GetName(),
+			UID:       string(resource.GetUID()),
+			Reason:    reason,
+			HeldBy:    decisions.heldBy[i],
+		})
+	}
+
+	p.mu.Lock()
+	p.reports[policy.UID] = report
+	p.mu.Unlock()
+}
+
+// Forget drops the report of a policy.
+func (p *ProtectedReports) Forget(uid types.UID) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	delete(p.reports, uid)
+	p.mu.Unlock()
+}
+
+// Reports returns the current reports sorted by policy.
+func (p *ProtectedReports) Reports() []ProtectedReport {
+	if p == nil {
+		return nil
+	}
+	p.mu.RLock()
+	out := make([]ProtectedReport, 0, len(p.reports))
+	for _, report := range p.reports {
+		out = append(out, *report)
+	}
+	p.mu.RUnlock()
+	sort.Slice(out, func(i, j int) bool { return out[i].Policy < out[j].Policy })
+	return out
+}
+
+// ServeHTTP writes the reports as JSON. The policy query parameter ("namespace/name")
+// limits the response to one policy.
+func (p *ProtectedReports) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	reports := p.Reports()
+	if policy := req.URL.Query().Get("policy"); policy != "" {
+		filtered := reports[:0]
+		for _, report := range reports {
+			if report.Policy == policy {
+				filtered = append(filtered, report)
+			}
+		}
+		if len(filtered) == 0 {
+			http.Error(w, fmt.Sprintf("no protected resources report for policy %q", policy), http.StatusNotFound)
+			return
+		}
+		reports = filtered
+	}
+	if reports == nil {
+		reports = []ProtectedReport{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(reports)
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func TestEvaluatePolicy_ReportsProtectedResources(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reconciler, dynamicClient, policy := setupObservePausedTest(t, false)
+	defer reconciler.cleanupResourceInformer(policy.UID)
+
+	// Both seeded configmaps are expired and form one group, so minRemaining keeps one
+	policy.Spec.Paused = false
+	policy.Spec.Behavior.ReportProtected = true
+	policy.Spec.Behavior.MinRemaining = &v1alpha1.MinRemainingSpec{Value: intstr.FromInt32(1)}
+
+	if err := reconciler.evaluatePolicy(ctx, policy); err != nil {
+		t.Fatalf("evaluatePolicy() returned error: %v", err)
+	}
+
+	reports := reconciler.GetProtectedReports().Reports()
+	if len(reports) != 1 {
+		t.Fatalf("got %d reports, want 1", len(reports))
+	}
+	report := reports[0]
+	if report.Policy != "default/paused-policy" || report.Total != 1 || len(report.Resources) != 1 {
+		t.Fatalf("report = %+v, want one protected resource for default/paused-policy", report)
+	}
+	if got := report.Resources[0]; got.Reason != ReasonDeletionHeld || got.HeldBy != HeldByMinRemaining {
+		t.Errorf("protected resource = %+v, want reason %s held by %s", got, ReasonDeletionHeld, HeldByMinRemaining)
+	}
+	if patches := countActions(dynamicClient, "patch", ""); patches != 0 {
+		t.Errorf("reportProtected alone patched %d resources, want 0", patches)
+	}
+
+	// Cleaning up the policy drops its report
+	reconciler.policyUIDs[types.NamespacedName{Namespace: policy.Namespace, Name: policy.Name}] = policy.UID
+	reconciler.cleanupPolicyResources(types.NamespacedName{Namespace: policy.Namespace, Name: policy.Name})
+	if reports := reconciler.GetProtectedReports().Reports(); len(reports) != 0 {
+		t.Errorf("got %d reports after cleanup, want 0", len(reports))
+	}
+}
+
+func TestProtectedReports_Record(t *testing.T) {
+	policy := newTestPolicy("protected")
+	policy.Spec.Behavior.ReportProtected = true
+	decisions := newDecisionLog(policy)
+	decisions.record(newTestConfigMap("default", "fresh", time.Now()), ReasonNotExpired)
+	decisions.record(newTestConfigMap("default", "failing", time.Now()), ReasonConditionNotMet)
+	decisions.record(newTestConfigMap("default", "newest", time.Now()), ReasonDedupKept)
+	decisions.record(newTestConfigMap("default", "disallowed", time.Now()), ReasonNotAllowed)
+	held := newTestConfigMap("default", "held", time.Now())
+	decisions.recordHeld([]*unstructured.Unstructured{held}, nil, HeldByBackupGate)
+
+	reports := NewProtectedReports()
+	reports.Record(policy, decisions, time.Now())
+
+	got := reports.Reports()
+	if len(got) != 1 {
+		t.Fatalf("got %d reports, want 1", len(got))
+	}
+	want := map[string]string{
+		"newest":     ReasonDedupKept,
+		"disallowed": ReasonNotAllowed,
+		"held":       ReasonDeletionHeld,
+	}
+	if got[0].Total != len(want) || len(got[0].Resources) != len(want) {
+		t.Fatalf("report lists %d of %d resources, want %d", len(got[0].Resources), got[0].Total, len(want))
+	}
+	for _, resource := range got[0].Resources {
+		if want[resource.Name] != resource.Reason {
+			t.Errorf("%s reason = %q, want %q", resource.Name, resource.Reason, want[resource.Name])
+		}
+		if resource.Name == "held" && resource.HeldBy != HeldByBackupGate {
+			t.Errorf("held heldBy = %q, want %q", resource.HeldBy, HeldByBackupGate)
+		}
+	}
+
+	// Turning reportProtected off drops the report
+	policy.Spec.Behavior.ReportProtected = false
+	reports.Record(policy, nil, time.Now())
+	if got := reports.Reports(); len(got) != 0 {
+		t.Errorf("got %d reports after disabling, want 0", len(got))
+	}
+}
+
+func TestProtectedReports_RecordLimit(t *testing.T) {
+	policy := newTestPolicy("protected")
+	policy.Spec.Behavior.ReportProtected = true
+	decisions := newDecisionLog(policy)
+	for _, name := range []string{"a", "b", "c"} {
+		decisions.record(newTestConfigMap("default", name, time.Now()), ReasonDedupKept)
+	}
+
+	reports := NewProtectedReports()
+	reports.limit = 2
+	reports.Record(policy, decisions, time.Now())
+
+	got := reports.Reports()[0]
+	if got.Total != 3 || len(got.Resources) != 2 {
+		t.Errorf("report lists %d of %d resources, want 2 of 3", len(got.Resources), got.Total)
+	}
+}
+
+func TestProtectedReports_ServeHTTP(t *testing.T) {
+	policy := newTestPolicy("protected")
+	policy.Spec.Behavior.ReportProtected = true
+	decisions := newDecisionLog(policy)
+	decisions.record(newTestConfigMap("default", "newest", time.Now()), ReasonDedupKept)
+	reports := NewProtectedReports()
+	reports.Record(policy, decisions, time.Now())
+
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		wantStatus int
+		wantCount  int
+	}{
+		{name: "all reports", method: http.MethodGet, target: ProtectedReportPath, wantStatus: http.StatusOK, wantCount: 1},
+		{name: "filtered by policy", method: http.MethodGet, target: ProtectedReportPath + "?policy=default/protected", wantStatus: http.StatusOK, wantCount: 1},
+		{name: "unknown policy", method: http.MethodGet, target: ProtectedReportPath + "?policy=default/other", wantStatus: http.StatusNotFound},
+		{name: "wrong method", method: http.MethodPost, target: ProtectedReportPath, wantStatus: http.StatusMethodNotAllowed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			reports.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.target, nil))
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var body []ProtectedReport
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+			if len(body) != tt.wantCount || body[0].Resources[0].Name != "newest" {
+				t.Errorf("response = %+v, want the newest configmap of default/protected", body)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/query"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+// queryConditions caches parsed query conditions by source string.
+var queryConditions sync.Map
+
+// parseQueryCondition returns the parsed query, parsing it on first use.
+func parseQueryCondition(source string) (*query.Node, error) {
+	if parsed, ok := queryConditions.Load(source); ok {
+		return parsed.(*query.Node), nil
+	}
+	parsed, err := query.Parse(source)
+	if err != nil {
+		return nil, err
+	}
+	queryConditions.Store(source, parsed)
+	return parsed, nil
+}
+
+// meetsQueryConditionShared checks if a query condition matches the resource. Each term is
+// evaluated as a field condition, so values compare against string fields only.
+// Invalid queries do not match.
+func meetsQueryConditionShared(resource *unstructured.Unstructured, source string) bool {
+	parsed, err := parseQueryCondition(source)
+	if err != nil {
+		logger := sdklog.NewLogger("zen-gc")
+		logger.Warn("Invalid query condition", sdklog.Operation("meets_conditions"), sdklog.String("query", source), sdklog.Error(err))
+		return false
+	}
+	return parsed.Eval(func(cond *v1alpha1.FieldCondition) bool {
+		return meetsFieldConditionsShared(resource, []v1alpha1.FieldCondition{*cond})
+	})
+}
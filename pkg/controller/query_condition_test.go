@@ -0,0 +1,87 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func TestMeetsQueryConditionShared(t *testing.T) {
+	pods := []*unstructured.Unstructured{
+		{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata":   map[string]interface{}{"name": "failed", "namespace": "default", "labels": map[string]interface{}{"app": "web"}},
+			"spec":       map[string]interface{}{"nodeName": "node-1"},
+			"status":     map[string]interface{}{"phase": "Failed"},
+		}},
+		{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata":   map[string]interface{}{"name": "kept", "namespace": "default", "labels": map[string]interface{}{"keep": "true"}},
+			"status":     map[string]interface{}{"phase": "Failed"},
+		}},
+		{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata":   map[string]interface{}{"name": "pending", "namespace": "default"},
+			"status":     map[string]interface{}{"phase": "Pending"},
+		}},
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  map[string]bool
+	}{
+		{
+			name:  "failed and not kept",
+			query: "status.phase:Failed AND NOT metadata.labels.keep:true",
+			want:  map[string]bool{"failed": true, "kept": false, "pending": false},
+		},
+		{
+			name:  "unscheduled",
+			query: "NOT spec.nodeName:*",
+			want:  map[string]bool{"failed": false, "kept": true, "pending": true},
+		},
+		{
+			name:  "phase group or label",
+			query: "status.phase:(Pending OR Unknown) OR metadata.labels.app:web",
+			want:  map[string]bool{"failed": true, "kept": false, "pending": true},
+		},
+		{
+			name:  "invalid query matches nothing",
+			query: "status.phase:Failed AND",
+			want:  map[string]bool{"failed": false, "kept": false, "pending": false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conditions := &v1alpha1.ConditionsSpec{Query: tt.query}
+			for _, pod := range pods {
+				if got := meetsConditionsShared(pod, conditions); got != tt.want[pod.GetName()] {
+					t.Errorf("meetsConditionsShared(%s) = %v, want %v", pod.GetName(), got, tt.want[pod.GetName()])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kube-zen/zen-sdk/pkg/gc/ratelimiter"
+)
+
+// namespaceLimiterIdleTimeout is how long a namespace's limiter is kept unused. An idle
+// limiter has refilled its bucket, so dropping it and creating a new one changes nothing.
+const namespaceLimiterIdleTimeout = time.Minute
+
+// RateLimiterHierarchy throttles deletions at three levels: globally, per namespace of
+// the deleted resources, and per policy. A deletion proceeds only after it has a token at
+// every level, so no single namespace can use up the global budget and no single policy
+// can use up a namespace's, wherever the policies live. Cluster-scoped resources skip the
+// namespace level. A level with a zero rate is unlimited.
+type RateLimiterHierarchy struct {
+	global        *ratelimiter.RateLimiter
+	namespaceRate int
+	namespaces    map[string]*namespaceLimiter
+	now           func() time.Time
+	mu            sync.Mutex
+}
+
+// namespaceLimiter is a namespace's limiter and when a deletion last asked it for a token.
+type namespaceLimiter struct {
+	limiter  *ratelimiter.RateLimiter
+	lastUsed time.Time
+}
+
+// NewRateLimiterHierarchy creates a RateLimiterHierarchy with the given global and
+// per-namespace rates in deletions per second.
+func NewRateLimiterHierarchy(globalPerSecond, namespacePerSecond int) *RateLimiterHierarchy {
+	h := &RateLimiterHierarchy{
+		namespaceRate: namespacePerSecond,
+		namespaces:    make(map[string]*namespaceLimiter),
+		now:           time.Now,
+	}
+	if globalPerSecond > 0 {
+		h.global = ratelimiter.NewRateLimiter(globalPerSecond)
+	}
+	return h
+}
+
+// Wait blocks until a token is available at the policy, namespace, and global levels.
+// namespace is the deleted resource's namespace, empty for cluster-scoped resources.
+// The most specific level is acquired first, so a deletion held back by its own
+// policy's rate does not hold shared tokens while it waits.
+func (h *RateLimiterHierarchy) Wait(ctx context.Context, namespace string, policyLimiter *ratelimiter.RateLimiter) error {
+	if policyLimiter != nil {
+		if err := policyLimiter.Wait(ctx); err != nil {
+			return err
+		}
+	}
+	if h == nil {
+		return nil
+	}
+	if limiter := h.namespaceLimiter(namespace); limiter != nil {
+		if err := limiter.Wait(ctx); err != nil {
+			return err
+		}
+	}
+	if h.global != nil {
+		return h.global.Wait(ctx)
+	}
+	return nil
+}
+
+// namespaceLimiter returns the namespace's limiter, creating it on first use. Limiters
+// idle for namespaceLimiterIdleTimeout are dropped when a new one is created, so
+// namespaces that no longer see deletions do not accumulate.
+func (h *RateLimiterHierarchy) namespaceLimiter(namespace string) *ratelimiter.RateLimiter {
+	if h.namespaceRate <= 0 || namespace == "" {
+		return nil
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	now := h.now()
+	entry, ok := h.namespaces[namespace]
+	if !ok {
+		for name, idle := range h.namespaces {
+			if now.Sub(idle.lastUsed) >= namespaceLimiterIdleTimeout {
+				delete(h.namespaces, name)
+			}
+		}
+		entry = &namespaceLimiter{limiter: ratelimiter.NewRateLimiter(h.namespaceRate)}
+		h.namespaces[namespace] = entry
+	}
+	entry.lastUsed = now
+	return entry.limiter
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/config"
+	"github.com/kube-zen/zen-sdk/pkg/gc/ratelimiter"
+)
+
+// waitBriefly waits on the hierarchy with a deadline much shorter than one token interval,
+// so it fails fast when any level is exhausted.
+func waitBriefly(h *RateLimiterHierarchy, namespace string, policyLimiter *ratelimiter.RateLimiter) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	return h.Wait(ctx, namespace, policyLimiter)
+}
+
+func TestRateLimiterHierarchy_WaitsWhenAnyLevelIsExhausted(t *testing.T) {
+	tests := []struct {
+		name            string
+		globalRate      int
+		namespaceRate   int
+		policyRate      int
+		secondNamespace string
+		expectWait      bool
+	}{
+		{name: "policy level exhausted", policyRate: 1, secondNamespace: "team-a", expectWait: true},
+		{name: "namespace level exhausted", namespaceRate: 1, secondNamespace: "team-a", expectWait: true},
+		{name: "other namespace has its own bucket", namespaceRate: 1, secondNamespace: "team-b", expectWait: false},
+		{name: "global level exhausted across namespaces", globalRate: 1, secondNamespace: "team-b", expectWait: true},
+		{name: "no limits", secondNamespace: "team-a", expectWait: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewRateLimiterHierarchy(tt.globalRate, tt.namespaceRate)
+			// Each deletion comes from a different policy unless the policy level is under test
+			firstPolicy, secondPolicy := ratelimiter.NewRateLimiter(100), ratelimiter.NewRateLimiter(100)
+			if tt.policyRate > 0 {
+				firstPolicy = ratelimiter.NewRateLimiter(tt.policyRate)
+				secondPolicy = firstPolicy
+			}
+
+			if err := waitBriefly(h, "team-a", firstPolicy); err != nil {
+				t.Fatalf("first Wait() returned error: %v", err)
+			}
+			err := waitBriefly(h, tt.secondNamespace, secondPolicy)
+			if tt.expectWait && err == nil {
+				t.Error("second Wait() should wait for a token")
+			}
+			if !tt.expectWait && err != nil {
+				t.Errorf("second Wait() returned error: %v", err)
+			}
+		})
+	}
+}
+
+func TestRateLimiterHierarchy_DropsIdleNamespaces(t *testing.T) {
+	h := NewRateLimiterHierarchy(0, 1)
+	now := time.Now()
+	h.now = func() time.Time { return now }
+	if err := waitBriefly(h, "team-a", nil); err != nil {
+		t.Fatalf("Wait() returned error: %v", err)
+	}
+
+	now = now.Add(namespaceLimiterIdleTimeout)
+	if err := waitBriefly(h, "team-b", nil); err != nil {
+		t.Fatalf("Wait() returned error: %v", err)
+	}
+	if _, ok := h.namespaces["team-a"]; ok {
+		t.Error("idle namespace limiter should be dropped when another namespace's is created")
+	}
+	if _, ok := h.namespaces["team-b"]; !ok {
+		t.Error("namespace limiter in use should be kept")
+	}
+}
+
+func TestRateLimiterHierarchy_ClusterScopedSkipsNamespaceLevel(t *testing.T) {
+	h := NewRateLimiterHierarchy(0, 1)
+	for i := 0; i < 2; i++ {
+		if err := waitBriefly(h, "", nil); err != nil {
+			t.Fatalf("Wait() %d for a cluster-scoped resource returned error: %v", i, err)
+		}
+	}
+}
+
+func TestRateLimiterHierarchy_NilIsPolicyOnly(t *testing.T) {
+	var h *RateLimiterHierarchy
+	policyLimiter := ratelimiter.NewRateLimiter(1)
+	if err := waitBriefly(h, "team-a", policyLimiter); err != nil {
+		t.Fatalf("Wait() returned error: %v", err)
+	}
+	if err := waitBriefly(h, "team-a", policyLimiter); err == nil {
+		t.Error("nil hierarchy should still apply the policy limiter")
+	}
+}
+
+func TestDeleteResource_NamespaceRateKeyedOnResourceNamespace(t *testing.T) {
+	newReconciler := func() *GCPolicyReconciler {
+		scheme := runtime.NewScheme()
+		dynamicClient := fake.NewSimpleDynamicClient(scheme,
+			newTestConfigMap("team-a", "cm-1", time.Time{}),
+			newTestConfigMap("team-a", "cm-2", time.Time{}),
+		)
+		return NewGCPolicyReconcilerWithRESTMapper(
+			clientfake.NewClientBuilder().WithScheme(scheme).Build(),
+			scheme,
+			dynamicClient,
+			nil,
+			NewStatusUpdater(dynamicClient),
+			NewEventRecorder(nil),
+			config.NewControllerConfig().WithHierarchicalRateLimits(0, 1),
+		)
+	}
+	newPolicy := func(namespace, name string, dryRun bool) *v1alpha1.GarbageCollectionPolicy {
+		return &v1alpha1.GarbageCollectionPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: v1alpha1.GarbageCollectionPolicySpec{
+				TargetResource: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap", Namespace: "*"},
+				Behavior:       v1alpha1.BehaviorSpec{DryRun: dryRun},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		second     *v1alpha1.GarbageCollectionPolicy
+		dryRun     bool
+		expectWait bool
+	}{
+		{name: "policies in other namespaces share the resource namespace's bucket", second: newPolicy("team-b", "second", false), expectWait: true},
+		{name: "dry runs take no shared tokens", second: newPolicy("team-b", "second", true), dryRun: true, expectWait: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reconciler := newReconciler()
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+			if err := reconciler.deleteResource(ctx, newTestConfigMap("team-a", "cm-1", time.Time{}), newPolicy("platform", "first", tt.dryRun), ratelimiter.NewRateLimiter(100)); err != nil {
+				t.Fatalf("first deleteResource() returned error: %v", err)
+			}
+			err := reconciler.deleteResource(ctx, newTestConfigMap("team-a", "cm-2", time.Time{}), tt.second, ratelimiter.NewRateLimiter(100))
+			if tt.expectWait && err == nil {
+				t.Error("second deletion in the namespace should wait for the namespace token")
+			}
+			if !tt.expectWait && err != nil {
+				t.Errorf("second deleteResource() returned error: %v", err)
+			}
+		})
+	}
+}
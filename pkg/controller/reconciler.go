@@ -19,6 +19,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
@@ -31,7 +32,9 @@ import (
 	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/tools/cache"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
 	"github.com/kube-zen/zen-gc/pkg/config"
@@ -55,15 +58,28 @@ type GCPolicyReconciler struct {
 	// Leader election is handled by controller-runtime Manager, so this always returns true.
 	shouldReconcile func() bool
 
-	// Resource informers (one per policy).
+	// The shared informer each policy evaluates against.
 	// Protected by resourceInformersMu mutex.
 	resourceInformers map[types.UID]cache.SharedInformer
 
-	// Resource informer factories (one per policy).
+	// Running informers, one per distinct watch, shared by the policies that need it.
 	// Protected by resourceInformersMu mutex.
-	resourceInformerFactories map[types.UID]dynamicinformer.DynamicSharedInformerFactory
+	sharedInformers map[informerKey]*sharedInformer
 
-	// Mutex to protect resourceInformers and resourceInformerFactories maps.
+	// The watch each policy references in sharedInformers.
+	// Protected by resourceInformersMu mutex.
+	policyInformerKeys map[types.UID]informerKey
+
+	// Policies waiting for informer capacity under the controller's informer cap, in arrival order.
+	// Protected by resourceInformersMu mutex.
+	informerWaitlist []informerWaiter
+
+	// Watches being started and synced outside the lock, closed once stored or failed.
+	// They count against the informer cap. Protected by resourceInformersMu mutex.
+	startingInformers map[informerKey]chan struct{}
+
+	// Mutex to protect resourceInformers, sharedInformers, policyInformerKeys, informerWaitlist
+	// and startingInformers.
 	resourceInformersMu sync.RWMutex
 
 	// Per-policy rate limiters (one per policy).
@@ -73,6 +89,9 @@ type GCPolicyReconciler struct {
 	// Mutex to protect rateLimiters map.
 	rateLimitersMu sync.RWMutex
 
+	// Global and per-namespace rate limits composed with the per-policy rate limiters.
+	rateHierarchy *RateLimiterHierarchy
+
 	// Track policy UIDs by NamespacedName for cleanup on deletion.
 	// Protected by policyUIDsMu mutex.
 	policyUIDs map[types.NamespacedName]types.UID
@@ -111,6 +130,54 @@ type GCPolicyReconciler struct {
 
 	// Mutex to protect evaluationService.
 	evaluationServiceMu sync.RWMutex
+
+	// Deletion coordinator ensures resources matched by several policies are deleted once.
+	deletionCoordinator *DeletionCoordinator
+
+	// Defers all deletions while the API error rate exceeds the configured threshold.
+	errorRateBreaker *ErrorRateBreaker
+
+	// Suspends all deletions while the deletion sentinel is unreachable or disabled.
+	deletionSentinel *DeletionSentinel
+
+	// Receives a record of every deletion; a no-op unless an audit log is configured.
+	auditSink AuditSink
+
+	// Previous would-delete sets per policy for confirmDeletions.
+	confirmations *DecisionConfirmations
+
+	// Recent matched counts per policy for count trend conditions.
+	countHistory *CountHistory
+
+	// Rotation cursors for cluster-wide policies with namespacesPerRun.
+	namespaceSampler *NamespaceSampler
+
+	// Cached last-successful-backup times for backup gate conditions.
+	backupStatus *BackupStatusCache
+
+	// Cached metric query results for metric threshold conditions.
+	metricThreshold *MetricThresholdCache
+
+	// Cached owner lookups for owner chain conditions.
+	ownerLookup *OwnerLookupCache
+
+	// Writes last-evaluated annotations for policies with annotateDecisions.
+	decisionAnnotator *DecisionAnnotator
+
+	// Stamps delete-after annotations for policies with deletionNoticeSeconds.
+	deletionNotifier *DeletionNotifier
+
+	// Latest protected resources reports for policies with reportProtected.
+	protectedReports *ProtectedReports
+
+	// Consecutive failed evaluations per policy, for marking persistent failures Degraded.
+	evaluationFailures *EvaluationFailures
+
+	// Consecutive evaluations per policy that matched nothing, for reporting stale policies.
+	stalePolicies *StalePolicies
+
+	// Totals of the policy reconciles of each GC interval, logged once per interval.
+	sweepSummary *SweepSummary
 }
 
 // NewGCPolicyReconciler creates a new GC policy reconciler.
@@ -144,22 +211,43 @@ func NewGCPolicyReconcilerWithRESTMapper(
 	// Create GVRResolver with RESTMapper (nil is OK, will use pluralization fallback)
 	gvrResolver := NewGVRResolver(restMapper)
 
+	// Charge API calls made during an evaluation to the policy's maxApiCalls budget
+	budgetedClient := newBudgetedDynamicClient(dynamicClient)
+
 	return &GCPolicyReconciler{
-		Client:                    client,
-		Scheme:                    scheme,
-		dynamicClient:             dynamicClient,
-		config:                    cfg,
-		shouldReconcile:           func() bool { return true }, // Default: always reconcile
-		resourceInformers:         make(map[types.UID]cache.SharedInformer),
-		resourceInformerFactories: make(map[types.UID]dynamicinformer.DynamicSharedInformerFactory),
-		rateLimiters:              make(map[types.UID]*ratelimiter.RateLimiter),
-		policyUIDs:                make(map[types.NamespacedName]types.UID),
-		policySpecs:               make(map[types.UID]*v1alpha1.GarbageCollectionPolicySpec),
-		statusUpdater:             statusUpdater,
-		eventRecorder:             eventRecorder,
-		logger:                    sdklog.NewLogger("zen-gc"),
-		restMapper:                restMapper,
-		gvrResolver:               gvrResolver,
+		Client:              client,
+		Scheme:              scheme,
+		dynamicClient:       budgetedClient,
+		config:              cfg,
+		shouldReconcile:     func() bool { return true }, // Default: always reconcile
+		resourceInformers:   make(map[types.UID]cache.SharedInformer),
+		sharedInformers:     make(map[informerKey]*sharedInformer),
+		policyInformerKeys:  make(map[types.UID]informerKey),
+		rateLimiters:        make(map[types.UID]*ratelimiter.RateLimiter),
+		rateHierarchy:       NewRateLimiterHierarchy(cfg.GlobalMaxDeletionsPerSecond, cfg.NamespaceMaxDeletionsPerSecond),
+		errorRateBreaker:    NewErrorRateBreaker(cfg.ErrorRateThresholdPercent, cfg.ErrorRateWindow, cfg.ErrorRateMinAttempts),
+		deletionSentinel:    NewDeletionSentinel(cfg, dynamicClient, nil),
+		auditSink:           NoopAuditSink{},
+		policyUIDs:          make(map[types.NamespacedName]types.UID),
+		policySpecs:         make(map[types.UID]*v1alpha1.GarbageCollectionPolicySpec),
+		statusUpdater:       statusUpdater,
+		eventRecorder:       eventRecorder,
+		logger:              sdklog.NewLogger("zen-gc"),
+		restMapper:          restMapper,
+		gvrResolver:         gvrResolver,
+		deletionCoordinator: NewDeletionCoordinator(),
+		confirmations:       NewDecisionConfirmations(),
+		countHistory:        NewCountHistory(),
+		namespaceSampler:    NewNamespaceSampler(),
+		backupStatus:        NewBackupStatusCache(budgetedClient),
+		metricThreshold:     NewMetricThresholdCache(NewPrometheusMetricProvider(nil)),
+		ownerLookup:         NewOwnerLookupCache(budgetedClient),
+		decisionAnnotator:   NewDecisionAnnotator(budgetedClient),
+		deletionNotifier:    NewDeletionNotifier(budgetedClient),
+		protectedReports:    NewProtectedReports(),
+		evaluationFailures:  NewEvaluationFailures(),
+		stalePolicies:       NewStalePolicies(),
+		sweepSummary:        NewSweepSummary(cfg.GCInterval, sdklog.NewLogger("zen-gc")),
 	}
 }
 
@@ -179,22 +267,43 @@ func NewGCPolicyReconcilerWithLeaderCheck(
 		cfg = config.NewControllerConfig()
 	}
 
+	// Charge API calls made during an evaluation to the policy's maxApiCalls budget
+	budgetedClient := newBudgetedDynamicClient(dynamicClient)
+
 	// Leader election is handled by controller-runtime Manager.
 	// Manager only calls Reconcile on the leader.
 	return &GCPolicyReconciler{
-		Client:                    client,
-		Scheme:                    scheme,
-		dynamicClient:             dynamicClient,
-		config:                    cfg,
-		shouldReconcile:           func() bool { return true }, // Always true (Manager handles leader election)
-		resourceInformers:         make(map[types.UID]cache.SharedInformer),
-		resourceInformerFactories: make(map[types.UID]dynamicinformer.DynamicSharedInformerFactory),
-		rateLimiters:              make(map[types.UID]*ratelimiter.RateLimiter),
-		policyUIDs:                make(map[types.NamespacedName]types.UID),
-		policySpecs:               make(map[types.UID]*v1alpha1.GarbageCollectionPolicySpec),
-		statusUpdater:             statusUpdater,
-		eventRecorder:             eventRecorder,
-		logger:                    sdklog.NewLogger("zen-gc"),
+		Client:              client,
+		Scheme:              scheme,
+		dynamicClient:       budgetedClient,
+		config:              cfg,
+		shouldReconcile:     func() bool { return true }, // Always true (Manager handles leader election)
+		resourceInformers:   make(map[types.UID]cache.SharedInformer),
+		sharedInformers:     make(map[informerKey]*sharedInformer),
+		policyInformerKeys:  make(map[types.UID]informerKey),
+		rateLimiters:        make(map[types.UID]*ratelimiter.RateLimiter),
+		rateHierarchy:       NewRateLimiterHierarchy(cfg.GlobalMaxDeletionsPerSecond, cfg.NamespaceMaxDeletionsPerSecond),
+		errorRateBreaker:    NewErrorRateBreaker(cfg.ErrorRateThresholdPercent, cfg.ErrorRateWindow, cfg.ErrorRateMinAttempts),
+		deletionSentinel:    NewDeletionSentinel(cfg, dynamicClient, nil),
+		auditSink:           NoopAuditSink{},
+		policyUIDs:          make(map[types.NamespacedName]types.UID),
+		policySpecs:         make(map[types.UID]*v1alpha1.GarbageCollectionPolicySpec),
+		statusUpdater:       statusUpdater,
+		eventRecorder:       eventRecorder,
+		logger:              sdklog.NewLogger("zen-gc"),
+		deletionCoordinator: NewDeletionCoordinator(),
+		confirmations:       NewDecisionConfirmations(),
+		countHistory:        NewCountHistory(),
+		namespaceSampler:    NewNamespaceSampler(),
+		backupStatus:        NewBackupStatusCache(budgetedClient),
+		metricThreshold:     NewMetricThresholdCache(NewPrometheusMetricProvider(nil)),
+		ownerLookup:         NewOwnerLookupCache(budgetedClient),
+		decisionAnnotator:   NewDecisionAnnotator(budgetedClient),
+		deletionNotifier:    NewDeletionNotifier(budgetedClient),
+		protectedReports:    NewProtectedReports(),
+		evaluationFailures:  NewEvaluationFailures(),
+		stalePolicies:       NewStalePolicies(),
+		sweepSummary:        NewSweepSummary(cfg.GCInterval, sdklog.NewLogger("zen-gc")),
 	}
 }
 
@@ -214,24 +323,76 @@ func (r *GCPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		return r.handlePolicyFetchError(err)
 	}
 
+	// Heartbeat for dashboards alerting on a stalled controller, and totals for the sweep summary
+	sweepStart := time.Now()
+	summary := &sweepTotals{}
+	ctx = withSweepTotals(ctx, summary)
+	defer func() { r.finishSweep(policy.UID, summary, sweepStart) }()
+
+	// Resolve an empty target namespace before the informer and evaluation read it
+	resolveTargetNamespaceShared(r.restMapper, policy, r.defaultTargetNamespaceMode())
+
 	// Track policy UID for cleanup on deletion
 	r.trackPolicyUID(req.NamespacedName, policy.UID)
 
 	// Handle informer recreation if policy spec changed
-	r.handleInformerRecreation(policy)
+	r.handleInformerRecreation(ctx, policy)
 
 	// Store current spec for future comparison
 	r.trackPolicySpec(policy.UID, &policy.Spec)
 
 	// Skip paused policies
 	if policy.Spec.Paused {
-		return r.handlePausedPolicy()
+		return r.handlePausedPolicy(ctx, policy)
+	}
+
+	// A namespace on a cluster-scoped kind would silently match nothing
+	if err := checkTargetScopeShared(r.restMapper, &policy.Spec.TargetResource); err != nil {
+		return r.handleTargetScopeMismatch(ctx, policy, err)
+	}
+
+	// Refuse targets outside the controller's allowed API groups
+	if err := validation.CheckAllowedAPIGroups(&policy.Spec, r.allowedAPIGroups()); err != nil {
+		return r.handleAPIGroupNotAllowed(ctx, policy, err)
+	}
+
+	// Refuse OPA conditions querying URLs outside the controller's allowlist
+	if err := validation.CheckAllowedOPAURLs(&policy.Spec, r.allowedOPAURLs()); err != nil {
+		return r.handleOPAURLNotAllowed(ctx, policy, err)
+	}
+
+	// Refuse result webhooks outside the controller's allowlist
+	if err := validation.CheckAllowedResultWebhookURLs(&policy.Spec, r.allowedResultWebhookURLs()); err != nil {
+		return r.handleResultWebhookURLNotAllowed(ctx, policy, err)
+	}
+
+	// An invalid selector would silently match nothing
+	if err := checkLabelSelectorsShared(&policy.Spec.TargetResource); err != nil {
+		return r.handleInvalidSelector(ctx, policy, err)
+	}
+
+	// Skip policies outside their schedule, requeued for when it opens
+	now := time.Now()
+	schedule, err := policyScheduleStateShared(policy.Spec.Schedule, now)
+	if err != nil {
+		return r.handleInvalidSchedule(ctx, policy, err)
+	}
+	if schedule.Reason != "" {
+		return r.handleOutsideSchedule(ctx, policy, schedule, now)
 	}
 
 	// Evaluate the policy
 	if err := r.evaluatePolicy(ctx, policy); err != nil {
-		return r.handleEvaluationError(err, policy)
+		summary.errors++
+		if isInformerLimitReached(err) {
+			return r.handleInformerLimitReached(ctx, policy, err)
+		}
+		if isInformerSyncFailed(err) {
+			return r.handleInformerSyncFailed(ctx, policy, err)
+		}
+		return r.handleEvaluationError(ctx, err, policy)
 	}
+	r.evaluationFailures.Forget(policy.UID)
 
 	// Record policy phase metrics periodically
 	r.recordPolicyPhaseMetrics(ctx)
@@ -241,6 +402,14 @@ func (r *GCPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
+// defaultTargetNamespaceMode returns how an empty target namespace is resolved.
+func (r *GCPolicyReconciler) defaultTargetNamespaceMode() string {
+	if r.config != nil && r.config.DefaultTargetNamespaceMode != "" {
+		return r.config.DefaultTargetNamespaceMode
+	}
+	return config.DefaultTargetNamespaceMode
+}
+
 // getRequeueInterval returns the requeue interval for a policy.
 // Uses policy-specific evaluation interval if configured, otherwise uses default.
 func (r *GCPolicyReconciler) getRequeueInterval() time.Duration {
@@ -294,7 +463,7 @@ func (r *GCPolicyReconciler) getOrCreateEvaluationService(ctx context.Context, p
 	// Create adapter
 	adapter := NewGCPolicyReconcilerAdapter(r)
 
-	// Get resource lister for this policy
+	// The lister resolves each policy's informer per call, so the cached service survives informer recreation
 	resourceLister, err := adapter.GetResourceListerForPolicy(ctx, policy)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create resource lister: %w", err)
@@ -313,6 +482,18 @@ func (r *GCPolicyReconciler) getOrCreateEvaluationService(ctx context.Context, p
 		r.eventRecorder,
 		r.logger,
 	)
+	r.evaluationService.confirmations = r.confirmations
+	r.evaluationService.countHistory = r.countHistory
+	r.evaluationService.namespaceSampler = r.namespaceSampler
+	r.evaluationService.protectAnnotation = r.protectAnnotation()
+	r.evaluationService.evaluationWorkers = r.evaluationWorkers()
+	r.evaluationService.backupStatus = r.backupStatus
+	r.evaluationService.metricThreshold = r.metricThreshold
+	r.evaluationService.decisionAnnotator = r.decisionAnnotator
+	r.evaluationService.deletionNotifier = r.deletionNotifier
+	r.evaluationService.protectedReports = r.protectedReports
+	r.evaluationService.stalePolicies = r.stalePolicies
+	r.evaluationService.staleAfterEmptyRuns = r.staleAfterEmptyRuns()
 
 	return r.evaluationService, nil
 }
@@ -320,6 +501,30 @@ func (r *GCPolicyReconciler) getOrCreateEvaluationService(ctx context.Context, p
 // evaluatePolicy evaluates a single policy.
 // Uses PolicyEvaluationService for evaluation with dependency injection.
 func (r *GCPolicyReconciler) evaluatePolicy(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy) error {
+	// Bound the API calls this run makes by the policy's maxApiCalls
+	ctx = withAPICallBudgetShared(ctx, policy)
+
+	// Reconcile resolves the target namespace first; repeat it for other callers
+	resolveTargetNamespaceShared(r.restMapper, policy, r.defaultTargetNamespaceMode())
+
+	// Resolve the golden object of a data drift condition; nothing is deleted if it cannot be read
+	if err := resolveDataDriftReferenceShared(ctx, r.dynamicClient, policy); err != nil {
+		gcErr := gcerrors.Wrap(err, gcerrors.TypeDataDriftReferenceFailed, "failed to resolve data drift reference")
+		gcErr = gcErr.WithContext("policy_namespace", policy.Namespace)
+		gcErr = gcErr.WithContext("policy_name", policy.Name)
+		recordError(policy.Namespace, policy.Name, gcerrors.TypeDataDriftReferenceFailed)
+		return gcErr
+	}
+
+	// Read the bad hashes ConfigMaps of specHash conditions; nothing is deleted if one cannot be read
+	if err := resolveBadHashesConfigMapsShared(ctx, r.dynamicClient, policy); err != nil {
+		gcErr := gcerrors.Wrap(err, gcerrors.TypeSpecHashReferenceFailed, "failed to read bad hashes ConfigMap")
+		gcErr = gcErr.WithContext("policy_namespace", policy.Namespace)
+		gcErr = gcErr.WithContext("policy_name", policy.Name)
+		recordError(policy.Namespace, policy.Name, gcerrors.TypeSpecHashReferenceFailed)
+		return gcErr
+	}
+
 	// Use PolicyEvaluationService for evaluation.
 	// The service uses dependency injection for better testability.
 	service, err := r.getOrCreateEvaluationService(ctx, policy)
@@ -340,10 +545,10 @@ func (r *GCPolicyReconciler) evaluatePolicy(ctx context.Context, policy *v1alpha
 	// Get or create resource informer for this policy
 	informer, err := r.getOrCreateResourceInformer(ctx, policy)
 	if err != nil {
-		gcErr := gcerrors.Wrap(err, "informer_creation_failed", "failed to get resource informer")
+		gcErr := gcerrors.Wrap(err, gcerrors.TypeInformerCreationFailed, "failed to get resource informer")
 		gcErr = gcErr.WithContext("policy_namespace", policy.Namespace)
 		gcErr = gcErr.WithContext("policy_name", policy.Name)
-		recordError(policy.Namespace, policy.Name, "informer_creation_failed")
+		recordError(policy.Namespace, policy.Name, gcerrors.TypeInformerCreationFailed)
 		r.logger.Error(gcErr, "Error creating resource informer for policy", sdklog.Operation("evaluate_policy"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.ErrorCode("INFORMER_CREATION_FAILED"))
 		return gcErr
 	}
@@ -351,20 +556,82 @@ func (r *GCPolicyReconciler) evaluatePolicy(ctx context.Context, policy *v1alpha
 	// Evaluate resources and collect those to delete
 	evalResult := evaluatePolicyResourcesShared(ctx, r, policy, informer)
 
+	evaluated := evalResult.ResourcesToDelete
+
+	// Surface policies that keep matching nothing
+	recordMatchedCountShared(r.stalePolicies, r.eventRecorder, policy, evalResult.MatchedCount, r.staleAfterEmptyRuns())
+
+	// Hold deletions that were not also decided in the previous run
+	var unconfirmed int64
+	beforeGate := evalResult.ResourcesToDelete
+	evalResult.ResourcesToDelete, unconfirmed = applyDeletionConfirmationShared(r.confirmations, policy, evalResult.ResourcesToDelete)
+	evalResult.PendingCount += unconfirmed
+	evalResult.Decisions.recordHeld(beforeGate, evalResult.ResourcesToDelete, HeldByConfirmation)
+
+	// Hold deletions until the matched count follows the configured trend
+	if !countTrendAllowsDeletionShared(r.countHistory, policy, evalResult.MatchedCount) {
+		evalResult.PendingCount += int64(len(evalResult.ResourcesToDelete))
+		evalResult.Decisions.recordHeld(evalResult.ResourcesToDelete, nil, HeldByCountTrend)
+		evalResult.ResourcesToDelete = nil
+	}
+
+	// Hold deletions until the external metric crosses its threshold
+	if len(evalResult.ResourcesToDelete) > 0 && !metricThresholdAllowsDeletionShared(ctx, r.metricThreshold, policy) {
+		evalResult.PendingCount += int64(len(evalResult.ResourcesToDelete))
+		evalResult.Decisions.recordHeld(evalResult.ResourcesToDelete, nil, HeldByMetricThreshold)
+		evalResult.ResourcesToDelete = nil
+	}
+
+	// Hold deletions of resources not yet covered by a successful backup
+	var backupHeld int64
+	beforeGate = evalResult.ResourcesToDelete
+	evalResult.ResourcesToDelete, backupHeld = applyBackupGateShared(ctx, r.backupStatus, policy, evalResult.ResourcesToDelete)
+	evalResult.PendingCount += backupHeld
+	evalResult.Decisions.recordHeld(beforeGate, evalResult.ResourcesToDelete, HeldByBackupGate)
+
+	// Hold deletions that would leave a group below its minimum
+	var minHeld int64
+	beforeGate = evalResult.ResourcesToDelete
+	evalResult.ResourcesToDelete, minHeld = applyMinRemainingShared(policy, evalResult.Matched, evalResult.ResourcesToDelete)
+	evalResult.PendingCount += minHeld
+	evalResult.Decisions.recordHeld(beforeGate, evalResult.ResourcesToDelete, HeldByMinRemaining)
+
+	// Warn before deleting: resources due for the first time are noticed and held
+	var noticeHeld int64
+	evalResult.ResourcesToDelete, noticeHeld = applyDeletionNoticeShared(ctx, r.deletionNotifier, r.eventRecorder, policy, evalResult.ResourcesToDelete, evalResult.Decisions)
+	evalResult.PendingCount += noticeHeld
+	evalResult.OldestPending.observeHeld(evaluated, evalResult.ResourcesToDelete)
+	evalResult.ResourcesToDelete = orderDeletionsShared(policy, evalResult.ResourcesToDelete)
+
 	resourceAPIVersion := policy.Spec.TargetResource.APIVersion
 	resourceKind := policy.Spec.TargetResource.Kind
 
+	// Record what a dry-run policy would delete so arming it can be acknowledged
+	dryRunImpact := dryRunImpactShared(policy, evalResult.ResourcesToDelete)
+
 	// Delete resources in batches
-	deletedCount := deleteResourcesInBatchesShared(ctx, r, policy, evalResult.ResourcesToDelete, evalResult.ResourcesToDeleteReasons)
+	deletedCount, failedCount, deferredCount := deleteResourcesInBatchesShared(ctx, r, policy, evalResult.ResourcesToDelete, evalResult.ResourcesToDeleteReasons)
 	evalResult.DeletedCount = deletedCount
+	evalResult.PendingCount += deferredCount
+
+	// Report the run to the result webhook (non-blocking)
+	notifyResultWebhookShared(policy, evalResult.MatchedCount, evalResult.DeletedCount, evalResult.PendingCount, failedCount)
+	addToSweepSummary(ctx, evalResult.MatchedCount, evalResult.DeletedCount, failedCount)
+
+	// Stamp skipped resources with the latest decision
+	r.decisionAnnotator.Annotate(ctx, policy, evalResult.Decisions)
+	r.protectedReports.Record(policy, evalResult.Decisions, time.Now())
 
 	// Record pending resources metric
 	if evalResult.PendingCount > 0 {
 		recordResourcesPending(policy.Namespace, policy.Name, resourceAPIVersion, resourceKind, evalResult.PendingCount)
 	}
+	oldestPendingAge := evalResult.OldestPending.ageSeconds(time.Now())
+	recordOldestPendingAge(policy.Namespace, policy.Name, resourceAPIVersion, resourceKind, oldestPendingAge)
+	recordPolicyCounts(policy.Namespace, policy.Name, evalResult.MatchedCount, evalResult.PendingCount, evalResult.DeletedCount)
 
 	// Update policy status
-	if err := updatePolicyStatusShared(ctx, r, policy, evalResult.MatchedCount, evalResult.DeletedCount, evalResult.PendingCount); err != nil {
+	if err := updatePolicyStatusShared(ctx, r, policy, evalResult.MatchedCount, evalResult.DeletedCount, evalResult.PendingCount, oldestPendingAge, deferredCount, dryRunImpact); err != nil {
 		return err
 	}
 
@@ -381,13 +648,25 @@ func (r *GCPolicyReconciler) getStatusUpdater() *StatusUpdater {
 	return r.statusUpdater
 }
 
+// getNamespaceSampler returns the namespace sampler (implements PolicyEvaluator).
+func (r *GCPolicyReconciler) getNamespaceSampler() *NamespaceSampler {
+	return r.namespaceSampler
+}
+
 // matchesSelectors checks if a resource matches the target resource selectors.
+// Namespaces protected by the controller config are excluded regardless of the policy.
 func (r *GCPolicyReconciler) matchesSelectors(resource *unstructured.Unstructured, target *v1alpha1.TargetResourceSpec) bool {
+	if namespaceExcludedShared(resource.GetNamespace(), r.protectedNamespaces()) {
+		return false
+	}
 	return matchesSelectorsShared(resource, target)
 }
 
 // shouldDelete determines if a resource should be deleted based on TTL and conditions.
 func (r *GCPolicyReconciler) shouldDelete(resource *unstructured.Unstructured, policy *v1alpha1.GarbageCollectionPolicy) (shouldDelete bool, reason string) {
+	// TTL and conditions read missing fields as the policy's missingFields says
+	resource = missingFieldsViewShared(resource, policy)
+
 	// Check conditions first
 	if policy.Spec.Conditions != nil {
 		if !r.meetsConditions(resource, policy.Spec.Conditions) {
@@ -395,6 +674,11 @@ func (r *GCPolicyReconciler) shouldDelete(resource *unstructured.Unstructured, p
 		}
 	}
 
+	// Resource owners can protect individual objects from every policy
+	if protectedByAnnotationShared(resource, r.protectAnnotation()) {
+		return false, ReasonProtectedByAnnotation
+	}
+
 	// Calculate expiration time
 	expirationTime, err := r.calculateExpirationTime(resource, &policy.Spec.TTL)
 	if err != nil {
@@ -408,7 +692,12 @@ func (r *GCPolicyReconciler) shouldDelete(resource *unstructured.Unstructured, p
 	}
 
 	// Check if expired
-	if time.Now().After(expirationTime) {
+	now := time.Now()
+	if now.After(expirationTime) {
+		// A noticed resource is only deleted once its notice window has passed
+		if deletionNoticePendingShared(resource, policy, now) {
+			return false, ReasonDeletionNoticePending
+		}
 		return true, ReasonTTLExpired
 	}
 
@@ -423,18 +712,26 @@ func (r *GCPolicyReconciler) calculateExpirationTime(resource *unstructured.Unst
 
 // meetsConditions checks if a resource meets the deletion conditions.
 func (r *GCPolicyReconciler) meetsConditions(resource *unstructured.Unstructured, conditions *v1alpha1.ConditionsSpec) bool {
-	return meetsConditionsShared(resource, conditions)
+	return meetsConditionsWithOwnersShared(resource, conditions, r.ownerLookup)
 }
 
 // deleteResource deletes a resource based on policy behavior.
 func (r *GCPolicyReconciler) deleteResource(ctx context.Context, resource *unstructured.Unstructured, policy *v1alpha1.GarbageCollectionPolicy, rateLimiter *ratelimiter.RateLimiter) error {
-	// Rate limiting
-	if err := rateLimiter.Wait(ctx); err != nil {
+	// Rate limiting at the policy, resource namespace, and global levels. Dry runs delete
+	// nothing, so they only take their own policy's tokens, not those shared with other policies
+	rateHierarchy := r.rateHierarchy
+	if dryRunShared(policy) {
+		rateHierarchy = nil
+	}
+	if err := rateHierarchy.Wait(ctx, resource.GetNamespace(), rateLimiter); err != nil {
 		return err
 	}
 
-	// Dry run check
-	if policy.Spec.Behavior.DryRun {
+	// Dry run check, including observe-only policies; dependents are only logged
+	if dryRunShared(policy) {
+		if err := r.deleteDependentsOf(ctx, resource, policy); err != nil {
+			return err
+		}
 		r.logger.Info("[DRY RUN] Would delete resource", sdklog.Operation("delete_resource"), sdklog.String("resource", fmt.Sprintf("%s/%s", resource.GetNamespace(), resource.GetName())))
 		return nil
 	}
@@ -442,14 +739,49 @@ func (r *GCPolicyReconciler) deleteResource(ctx context.Context, resource *unstr
 	// Resolve GVR for deletion
 	gvr := r.resolveGVRForDeletion(resource)
 
+	// A stuck terminating resource is already deleted, so its dependents may go first;
+	// removing allowlisted finalizers completes it
+	dependentsDeleted := false
+	if conditions := policy.Spec.Conditions; conditions != nil && conditions.StuckTerminating != nil &&
+		len(conditions.StuckTerminating.RemoveFinalizers) > 0 && resource.GetDeletionTimestamp() != nil {
+		if err := r.deleteDependentsOf(ctx, resource, policy); err != nil {
+			return err
+		}
+		dependentsDeleted = true
+		if removed, err := r.removeStuckFinalizers(ctx, resource, gvr, policy); err != nil || removed {
+			return err
+		}
+	}
+
+	// Finalizer mode: mark the resource with the policy's finalizer and delete it only once
+	// that finalizer is the last one, leaving final cleanup to whoever removes it
+	resourceVersion := resource.GetResourceVersion()
+	if policy.Spec.Behavior.Finalizer != "" {
+		var err error
+		if resourceVersion, err = r.prepareFinalizerDeletion(ctx, resource, gvr, policy); err != nil {
+			return err
+		}
+	}
+
+	// Delete explicitly listed dependents once the parent may go; a failure leaves the parent
+	// in place to retry
+	if !dependentsDeleted {
+		if err := r.deleteDependentsOf(ctx, resource, policy); err != nil {
+			return err
+		}
+	}
+
 	// Build delete options
-	deleteOptions := buildDeleteOptions(policy)
+	deleteOptions := buildDeleteOptions(policy, resource.GetKind())
+	applyDeletePreconditionsShared(deleteOptions, policy, resourceVersion)
 
 	// Perform deletion
 	return r.performResourceDeletion(ctx, resource, gvr, deleteOptions)
 }
 
-// getOrCreateResourceInformer gets or creates a resource informer for a policy.
+// getOrCreateResourceInformer gets the informer a policy evaluates against. Policies
+// watching the same GVR, namespace, and label selector share one informer, so only the
+// first of them starts a watch.
 func (r *GCPolicyReconciler) getOrCreateResourceInformer(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy) (cache.SharedInformer, error) {
 	// Check if informer already exists (with read lock)
 	r.resourceInformersMu.RLock()
@@ -459,19 +791,99 @@ func (r *GCPolicyReconciler) getOrCreateResourceInformer(ctx context.Context, po
 	}
 	r.resourceInformersMu.RUnlock()
 
+	// Never watch, and so never act on, a target outside the allowed API groups
+	if err := validation.CheckAllowedAPIGroups(&policy.Spec, r.allowedAPIGroups()); err != nil {
+		return nil, err
+	}
+	key, err := informerKeyForPolicy(policy)
+	if err != nil {
+		return nil, err
+	}
+
 	// Acquire write lock for creating new informer
 	r.resourceInformersMu.Lock()
-	defer r.resourceInformersMu.Unlock()
 
 	// Double-check after acquiring write lock (another goroutine might have created it)
 	if informer, ok := r.resourceInformers[policy.UID]; ok {
+		r.resourceInformersMu.Unlock()
 		return informer, nil
 	}
 
+	// Join a running watch; this adds no informer, so the cap does not apply
+	if shared, ok := r.sharedInformers[key]; ok {
+		defer r.resourceInformersMu.Unlock()
+		if err := addMissingIndexers(shared.informer, indexersForPolicy(policy)); err != nil {
+			return nil, err
+		}
+		r.forgetInformerWaiterLocked(policy.UID)
+		r.logger.Debug("Sharing resource informer with other policies", sdklog.Operation("get_or_create_informer"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.String("informer", key.String()))
+		return r.acquireSharedInformerLocked(policy.UID, key, shared), nil
+	}
+
+	// Another policy is starting the same watch; wait for it rather than start a second one
+	if starting, ok := r.startingInformers[key]; ok {
+		r.resourceInformersMu.Unlock()
+		select {
+		case <-starting:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		return r.getOrCreateResourceInformer(ctx, policy)
+	}
+
+	// Respect the controller-wide informer cap
+	if err := r.reserveInformerLocked(policy.UID); err != nil {
+		r.resourceInformersMu.Unlock()
+		return nil, err
+	}
+
+	// Reserve the watch, then start and sync it without the lock, so a slow cache sync
+	// does not block reconciles of other policies
+	if r.startingInformers == nil {
+		r.startingInformers = make(map[informerKey]chan struct{})
+	}
+	started := make(chan struct{})
+	r.startingInformers[key] = started
+	r.resourceInformersMu.Unlock()
+
+	informer, factory, cancel, err := r.startResourceInformer(ctx, policy)
+
+	r.resourceInformersMu.Lock()
+	defer r.resourceInformersMu.Unlock()
+	delete(r.startingInformers, key)
+	close(started)
+	if err != nil {
+		return nil, err
+	}
+	if shared, ok := r.sharedInformers[key]; ok {
+		// A recreated policy started the same watch meanwhile; use it and drop ours
+		cancel()
+		if err := addMissingIndexers(shared.informer, indexersForPolicy(policy)); err != nil {
+			return nil, err
+		}
+		return r.acquireSharedInformerLocked(policy.UID, key, shared), nil
+	}
+
+	// Store informer and factory
+	r.storeResourceInformerLocked(policy.UID, key, informer, factory, cancel)
+
+	// Use struct logger to avoid allocations
+	r.logger.Debug("Created resource informer for policy", sdklog.Operation("get_or_create_informer"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.String("uid", string(policy.UID)), sdklog.String("informer", key.String()))
+	return informer, nil
+}
+
+// startResourceInformer creates and starts a resource informer for a policy and waits for its cache to sync.
+// The returned cancel function stops the informer.
+func (r *GCPolicyReconciler) startResourceInformer(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy) (cache.SharedInformer, dynamicinformer.DynamicSharedInformerFactory, context.CancelFunc, error) {
 	// Create GVR
 	gvr, err := validation.ParseGVR(policy.Spec.TargetResource.APIVersion, policy.Spec.TargetResource.Kind)
 	if err != nil {
-		return nil, fmt.Errorf("invalid target resource: %w", err)
+		return nil, nil, nil, fmt.Errorf("invalid target resource: %w", err)
+	}
+
+	// Never watch, and so never act on, a target outside the allowed API groups
+	if err := validation.CheckAllowedAPIGroups(&policy.Spec, r.allowedAPIGroups()); err != nil {
+		return nil, nil, nil, err
 	}
 
 	// Normalize namespace for informer creation
@@ -491,18 +903,17 @@ func (r *GCPolicyReconciler) getOrCreateResourceInformer(ctx context.Context, po
 		buildLabelSelectorFilter(policy),
 	)
 
-	// Create informer
+	// Create informer with indexers for the policy's selective conditions
 	informer := factory.ForResource(gvr).Informer()
+	if indexers := indexersForPolicy(policy); len(indexers) > 0 {
+		if err := informer.AddIndexers(indexers); err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to add informer indexers: %w", err)
+		}
+	}
 
-	// Store informer and factory
-	r.resourceInformers[policy.UID] = informer
-	r.resourceInformerFactories[policy.UID] = factory
-
-	// Update metrics
-	recordInformerCount(len(r.resourceInformers))
-
-	// Start informer factory
-	factory.Start(ctx.Done())
+	// Start informer factory with its own stop signal so it can be replaced independently
+	informerCtx, cancel := context.WithCancel(ctx)
+	factory.Start(informerCtx.Done())
 
 	// Wait for cache sync with timeout
 	syncCtx, syncCancel := context.WithTimeout(ctx, DefaultCacheSyncTimeout)
@@ -510,17 +921,90 @@ func (r *GCPolicyReconciler) getOrCreateResourceInformer(ctx context.Context, po
 
 	if !cache.WaitForCacheSync(syncCtx.Done(), informer.HasSynced) {
 		// Clean up on failure
-		delete(r.resourceInformers, policy.UID)
-		delete(r.resourceInformerFactories, policy.UID)
+		cancel()
 		if syncCtx.Err() != nil {
-			return nil, fmt.Errorf("resource informer cache sync timed out: %w", syncCtx.Err())
+			return nil, nil, nil, fmt.Errorf("%w: timed out: %w", ErrResourceInformerCacheSyncFailed, syncCtx.Err())
 		}
-		return nil, fmt.Errorf("%w", ErrResourceInformerCacheSyncFailed)
+		return nil, nil, nil, fmt.Errorf("%w", ErrResourceInformerCacheSyncFailed)
 	}
 
-	// Use struct logger to avoid allocations
-	r.logger.Debug("Created resource informer for policy", sdklog.Operation("get_or_create_informer"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.String("uid", string(policy.UID)))
-	return informer, nil
+	return informer, factory, cancel, nil
+}
+
+// storeResourceInformerLocked tracks a newly started informer as the shared informer for
+// key and points the policy at it, releasing the informer the policy referenced before.
+// Caller must hold resourceInformersMu.
+func (r *GCPolicyReconciler) storeResourceInformerLocked(
+	policyUID types.UID,
+	key informerKey,
+	informer cache.SharedInformer,
+	factory dynamicinformer.DynamicSharedInformerFactory,
+	cancel context.CancelFunc,
+) {
+	if r.sharedInformers == nil {
+		r.sharedInformers = make(map[informerKey]*sharedInformer)
+	}
+	shared := &sharedInformer{
+		informer: informer,
+		factory:  factory,
+		cancel:   cancel,
+		policies: make(map[types.UID]struct{}),
+	}
+	r.sharedInformers[key] = shared
+	r.acquireSharedInformerLocked(policyUID, key, shared)
+
+	// Update metrics
+	recordInformerCount(len(r.sharedInformers))
+}
+
+// recreateResourceInformer moves a policy to the informer for its changed spec
+// make-before-break: the new informer is started and synced, or a running one joined,
+// before it is swapped in and the old one released, so evaluations always see a synced
+// cache. The old watch stops only if no other policy still shares it.
+func (r *GCPolicyReconciler) recreateResourceInformer(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy) error {
+	if err := validation.CheckAllowedAPIGroups(&policy.Spec, r.allowedAPIGroups()); err != nil {
+		return err
+	}
+	key, err := informerKeyForPolicy(policy)
+	if err != nil {
+		return err
+	}
+
+	// The same watch, or one another policy already runs, only needs the policy's indexers
+	r.resourceInformersMu.Lock()
+	if shared, ok := r.sharedInformers[key]; ok {
+		defer r.resourceInformersMu.Unlock()
+		if err := addMissingIndexers(shared.informer, indexersForPolicy(policy)); err != nil {
+			return err
+		}
+		r.acquireSharedInformerLocked(policy.UID, key, shared)
+		recordInformerCount(len(r.sharedInformers))
+		r.logger.Debug("Moved policy to shared resource informer", sdklog.Operation("update_informer"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.String("informer", key.String()))
+		return nil
+	}
+	r.resourceInformersMu.Unlock()
+
+	informer, factory, cancel, err := r.startResourceInformer(ctx, policy)
+	if err != nil {
+		return err
+	}
+
+	r.resourceInformersMu.Lock()
+	defer r.resourceInformersMu.Unlock()
+	if shared, ok := r.sharedInformers[key]; ok {
+		// Another policy started the same watch meanwhile; use it and drop ours
+		cancel()
+		if err := addMissingIndexers(shared.informer, indexersForPolicy(policy)); err != nil {
+			return err
+		}
+		r.acquireSharedInformerLocked(policy.UID, key, shared)
+		recordInformerCount(len(r.sharedInformers))
+		return nil
+	}
+	r.storeResourceInformerLocked(policy.UID, key, informer, factory, cancel)
+
+	r.logger.Debug("Recreated resource informer for policy", sdklog.Operation("update_informer"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.String("uid", string(policy.UID)), sdklog.String("informer", key.String()))
+	return nil
 }
 
 // getOrCreateRateLimiter gets or creates a rate limiter for a policy.
@@ -556,7 +1040,8 @@ func (r *GCPolicyReconciler) getBatchSize(policy *v1alpha1.GarbageCollectionPoli
 }
 
 // deleteBatch deletes a batch of resources.
-// Returns the number of successfully deleted resources and any errors encountered.
+// Returns the number of successfully deleted resources and any errors encountered; a batch
+// stopped by paused deletions ends its errors with one wrapping ErrDeletionsDeferred.
 func (r *GCPolicyReconciler) deleteBatch(
 	ctx context.Context,
 	batch []*unstructured.Unstructured,
@@ -577,6 +1062,54 @@ func (r *GCPolicyReconciler) GetEventRecorder() *EventRecorder {
 	return r.eventRecorder
 }
 
+// GetDeletionCoordinator returns the deletion coordinator (implements BatchDeleter).
+func (r *GCPolicyReconciler) GetDeletionCoordinator() *DeletionCoordinator {
+	return r.deletionCoordinator
+}
+
+// GetProtectedReports returns the protected resources reports of policies with reportProtected.
+func (r *GCPolicyReconciler) GetProtectedReports() *ProtectedReports {
+	return r.protectedReports
+}
+
+// GetErrorRateBreaker returns the error rate breaker (implements BatchDeleter).
+func (r *GCPolicyReconciler) GetErrorRateBreaker() *ErrorRateBreaker {
+	return r.errorRateBreaker
+}
+
+// GetDeletionSentinel returns the deletion sentinel (implements BatchDeleter).
+func (r *GCPolicyReconciler) GetDeletionSentinel() *DeletionSentinel {
+	return r.deletionSentinel
+}
+
+// GetSweepSummary returns the sweep summary, which logs the totals of each GC interval.
+func (r *GCPolicyReconciler) GetSweepSummary() *SweepSummary {
+	return r.sweepSummary
+}
+
+// GetSnapshotDir returns the controller's snapshot directory, or "" if snapshots are
+// disabled (implements BatchDeleter).
+func (r *GCPolicyReconciler) GetSnapshotDir() string {
+	if r.config == nil {
+		return ""
+	}
+	return r.config.SnapshotDir
+}
+
+// GetAuditSink returns the audit sink (implements BatchDeleter).
+func (r *GCPolicyReconciler) GetAuditSink() AuditSink {
+	return r.auditSink
+}
+
+// SetAuditSink sets the sink receiving a record of every deletion. It must be called
+// before the reconciler starts.
+func (r *GCPolicyReconciler) SetAuditSink(sink AuditSink) {
+	if sink == nil {
+		sink = NoopAuditSink{}
+	}
+	r.auditSink = sink
+}
+
 // GetStatusUpdater returns the status updater (for testing).
 func (r *GCPolicyReconciler) GetStatusUpdater() *StatusUpdater {
 	return r.statusUpdater
@@ -608,8 +1141,11 @@ func (r *GCPolicyReconciler) EvaluatePolicyForTesting(ctx context.Context, polic
 }
 
 // deleteResourceWithBackoff deletes a resource with exponential backoff retry logic.
+// Resources already gone count as deleted unless the controller counts them separately,
+// in which case ErrResourceAlreadyGone is returned.
 func (r *GCPolicyReconciler) deleteResourceWithBackoff(ctx context.Context, resource *unstructured.Unstructured, policy *v1alpha1.GarbageCollectionPolicy, rateLimiter *ratelimiter.RateLimiter) error {
-	return deleteResourceWithBackoffShared(ctx, resource, policy, rateLimiter, r, nil)
+	err := deleteResourceWithBackoffShared(ctx, resource, policy, rateLimiter, r, nil, deleteBackoffFromConfig(r.config))
+	return alreadyGoneAsDeletedShared(err, r.config.CountAlreadyGoneSeparately)
 }
 
 // DeleteResourceWithContext deletes a resource with context (implements ResourceDeleterWithContext).
@@ -651,7 +1187,14 @@ func (r *GCPolicyReconciler) shouldRecreateInformer(policy *v1alpha1.GarbageColl
 	if oldTarget.APIVersion != newSpec.APIVersion ||
 		oldTarget.Kind != newSpec.Kind ||
 		oldTarget.Namespace != newSpec.Namespace ||
-		!labelSelectorsEqual(oldTarget.LabelSelector, newSpec.LabelSelector) {
+		!labelSelectorsEqual(oldTarget.LabelSelector, newSpec.LabelSelector) ||
+		!labelSelectorListsEqual(oldTarget.LabelSelectors, newSpec.LabelSelectors) ||
+		!slices.Equal(oldTarget.VirtualLabelAnnotations, newSpec.VirtualLabelAnnotations) {
+		return true
+	}
+
+	// A policy that newly needs an index has it added to its watch
+	if needsPhaseIndex(oldSpec) != needsPhaseIndex(&policy.Spec) {
 		return true
 	}
 
@@ -685,38 +1228,45 @@ func (r *GCPolicyReconciler) cleanupPolicyResources(nn types.NamespacedName) {
 	r.policySpecsMu.Lock()
 	delete(r.policySpecs, uid)
 	r.policySpecsMu.Unlock()
+
+	// Clean up count history, previous would-delete sets, and namespace sampling cursors
+	r.countHistory.Forget(uid)
+	r.confirmations.Forget(uid)
+	r.namespaceSampler.Forget(uid)
+
+	// Drop the protected resources report and failure count
+	r.protectedReports.Forget(uid)
+	r.evaluationFailures.Forget(uid)
+
+	// Drop the stale count and gauge
+	r.stalePolicies.Forget(uid)
+	forgetPolicyStale(nn.Namespace, nn.Name)
+
+	// Drop the matched, pending and deleted gauges so no stale series remain
+	forgetPolicyCounts(nn.Namespace, nn.Name)
 }
 
-// cleanupResourceInformer cleans up a resource informer for a given policy UID.
+// cleanupResourceInformer releases a policy's resource informer. The watch is stopped
+// once no other policy shares it.
 func (r *GCPolicyReconciler) cleanupResourceInformer(policyUID types.UID) {
 	r.resourceInformersMu.Lock()
 	defer r.resourceInformersMu.Unlock()
 
-	_, informerExists := r.resourceInformers[policyUID]
-	_, factoryExists := r.resourceInformerFactories[policyUID]
+	r.forgetInformerWaiterLocked(policyUID)
 
-	if !informerExists && !factoryExists {
+	_, informerExists := r.resourceInformers[policyUID]
+	_, keyExists := r.policyInformerKeys[policyUID]
+	if !informerExists && !keyExists {
 		// Already cleaned up or never existed
 		return
 	}
 
-	// Stop the informer factory (which will stop all informers created by it)
-	if factoryExists {
-		// DynamicSharedInformerFactory doesn't have a Stop method,
-		// but stopping is handled by context cancellation.
-		// We just need to remove it from our tracking.
-		delete(r.resourceInformerFactories, policyUID)
-	}
-
-	// Remove informer from map
-	if informerExists {
-		delete(r.resourceInformers, policyUID)
-		// Use struct logger to avoid allocations
-		r.logger.Debug("Cleaned up resource informer for policy", sdklog.Operation("cleanup_informer"), sdklog.String("uid", string(policyUID)))
-	}
+	r.releaseSharedInformerLocked(policyUID)
+	// Use struct logger to avoid allocations
+	r.logger.Debug("Cleaned up resource informer for policy", sdklog.Operation("cleanup_informer"), sdklog.String("uid", string(policyUID)))
 
 	// Update metrics
-	recordInformerCount(len(r.resourceInformers))
+	recordInformerCount(len(r.sharedInformers))
 }
 
 // cleanupRateLimiter cleans up a rate limiter for a given policy UID.
@@ -767,7 +1317,7 @@ func (r *GCPolicyReconciler) recordPolicyPhaseMetrics(ctx context.Context) {
 	}
 
 	// Reset phases that are no longer present
-	knownPhases := []string{PolicyPhaseActive, PolicyPhasePaused, PolicyPhaseError}
+	knownPhases := []string{PolicyPhaseActive, PolicyPhasePaused, PolicyPhaseError, PolicyPhaseScheduled}
 	for _, phase := range knownPhases {
 		if _, exists := phaseCounts[phase]; !exists {
 			recordPolicyPhase(phase, 0)
@@ -778,6 +1328,7 @@ func (r *GCPolicyReconciler) recordPolicyPhaseMetrics(ctx context.Context) {
 // SetupWithManager sets up the controller with the Manager.
 func (r *GCPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&v1alpha1.GarbageCollectionPolicy{}).
+		For(&v1alpha1.GarbageCollectionPolicy{}, builder.WithPredicates(policyChangedPredicate())).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.maxConcurrentReconciles()}).
 		Complete(r)
 }
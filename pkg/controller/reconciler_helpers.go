@@ -21,13 +21,13 @@ import (
 	"fmt"
 	"time"
 
-	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/config"
 	gcerrors "github.com/kube-zen/zen-gc/pkg/errors"
 	"github.com/kube-zen/zen-gc/pkg/validation"
 	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
@@ -48,13 +48,26 @@ func (r *GCPolicyReconciler) handlePolicyFetchError(err error) (ctrl.Result, err
 }
 
 // handleInformerRecreation handles informer recreation when policy spec changes.
-func (r *GCPolicyReconciler) handleInformerRecreation(policy *v1alpha1.GarbageCollectionPolicy) {
+// An existing informer keeps serving until its replacement has synced.
+func (r *GCPolicyReconciler) handleInformerRecreation(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy) {
 	if !r.shouldRecreateInformer(policy) {
 		return
 	}
 
 	r.logger.Debug("Policy spec changed, recreating informer", sdklog.Operation("update_informer"))
-	r.cleanupResourceInformer(policy.UID)
+
+	r.resourceInformersMu.RLock()
+	_, hasInformer := r.resourceInformers[policy.UID]
+	r.resourceInformersMu.RUnlock()
+
+	if !hasInformer || policy.Spec.Paused {
+		// Nothing to keep serving; the next evaluation creates the informer on demand
+		r.cleanupResourceInformer(policy.UID)
+	} else if err := r.recreateResourceInformer(ctx, policy); err != nil {
+		// Fall back to break-before-make so the next evaluation retries creation
+		r.logger.Warn("Failed to recreate resource informer, dropping old informer", sdklog.Operation("update_informer"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Error(err))
+		r.cleanupResourceInformer(policy.UID)
+	}
 	// Clear old spec to allow new one to be tracked
 	r.policySpecsMu.Lock()
 	delete(r.policySpecs, policy.UID)
@@ -62,22 +75,78 @@ func (r *GCPolicyReconciler) handleInformerRecreation(policy *v1alpha1.GarbageCo
 }
 
 // handlePausedPolicy handles paused policies.
-func (r *GCPolicyReconciler) handlePausedPolicy() (ctrl.Result, error) {
-	r.logger.Debug("Policy is paused, skipping evaluation", sdklog.Operation("reconcile"))
-	return ctrl.Result{RequeueAfter: r.getRequeueInterval()}, nil
+// With spec.observeWhenPaused, matched and pending counts are still refreshed (never deleting).
+func (r *GCPolicyReconciler) handlePausedPolicy(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy) (ctrl.Result, error) {
+	if !policy.Spec.ObserveWhenPaused {
+		r.logger.Debug("Policy is paused, skipping evaluation", sdklog.Operation("reconcile"))
+		return ctrl.Result{RequeueAfter: r.getRequeueInterval()}, nil
+	}
+
+	if err := resolveDataDriftReferenceShared(ctx, r.dynamicClient, policy); err != nil {
+		r.logger.Warn("Failed to resolve data drift reference, skipping paused observation", sdklog.Operation("observe_policy"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Error(err))
+		return ctrl.Result{RequeueAfter: r.getRequeueIntervalForPolicy(policy)}, nil
+	}
+	if err := resolveBadHashesConfigMapsShared(ctx, r.dynamicClient, policy); err != nil {
+		r.logger.Warn("Failed to read bad hashes ConfigMap, skipping paused observation", sdklog.Operation("observe_policy"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Error(err))
+		return ctrl.Result{RequeueAfter: r.getRequeueIntervalForPolicy(policy)}, nil
+	}
+
+	service, err := r.getOrCreateEvaluationService(ctx, policy)
+	if err != nil {
+		r.logger.Debug("Evaluation service unavailable, skipping paused observation", sdklog.Operation("observe_policy"), sdklog.Error(err))
+	} else if err := service.ObservePolicy(ctx, policy); err != nil {
+		r.logger.Warn("Failed to observe paused policy", sdklog.Operation("observe_policy"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Error(err))
+	}
+	return ctrl.Result{RequeueAfter: r.getRequeueIntervalForPolicy(policy)}, nil
 }
 
-// handleEvaluationError handles errors during policy evaluation.
-func (r *GCPolicyReconciler) handleEvaluationError(err error, policy *v1alpha1.GarbageCollectionPolicy) (ctrl.Result, error) {
+// handleEvaluationError handles errors during policy evaluation. Once the policy has failed
+// DegradedAfterFailures evaluations in a row it is marked Degraded, with a reason derived
+// from the error type; the next successful evaluation clears it.
+func (r *GCPolicyReconciler) handleEvaluationError(ctx context.Context, err error, policy *v1alpha1.GarbageCollectionPolicy) (ctrl.Result, error) {
 	gcErr := gcerrors.WithPolicy(err, policy.Namespace, policy.Name)
 	if gcErr.Type == "" {
-		gcErr.Type = ErrorTypeEvaluationFailed
+		gcErr.Type = gcerrors.TypeEvaluationFailed
+	}
+	r.logger.Error(gcErr, "Error evaluating policy", sdklog.Operation("evaluate_policy"), sdklog.ErrorCode("EVALUATE_POLICY_FAILED"), sdklog.String("error_type", gcErr.Type))
+
+	failures := r.evaluationFailures.Record(policy.UID)
+	if r.statusUpdater != nil && failures >= r.degradedAfterFailures() {
+		if statusErr := r.statusUpdater.SetError(ctx, policy, evaluationErrorReasonShared(gcErr.Type), gcerrors.Format(gcErr)); statusErr != nil {
+			r.logger.Warn("Failed to set policy Degraded status", sdklog.Operation("update_status"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Error(statusErr))
+		}
 	}
-	r.logger.Error(gcErr, "Error evaluating policy", sdklog.Operation("evaluate_policy"), sdklog.ErrorCode("EVALUATE_POLICY_FAILED"))
 	// Requeue with backoff on error
 	return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
 }
 
+// degradedAfterFailures returns the number of consecutive failed evaluations after which a
+// policy is marked Degraded.
+func (r *GCPolicyReconciler) degradedAfterFailures() int {
+	if r.config != nil && r.config.DegradedAfterFailures > 0 {
+		return r.config.DegradedAfterFailures
+	}
+	return config.DefaultDegradedAfterFailures
+}
+
+// staleAfterEmptyRuns returns the number of consecutive evaluations matching nothing after
+// which a policy is reported stale.
+func (r *GCPolicyReconciler) staleAfterEmptyRuns() int {
+	if r.config != nil && r.config.StaleAfterEmptyRuns > 0 {
+		return r.config.StaleAfterEmptyRuns
+	}
+	return config.DefaultStaleAfterEmptyRuns
+}
+
+// maxConcurrentReconciles returns how many policies are evaluated concurrently. Each
+// reconcile evaluates one policy, so a slow policy only holds up one worker.
+func (r *GCPolicyReconciler) maxConcurrentReconciles() int {
+	if r.config != nil && r.config.MaxConcurrentEvaluations > 0 {
+		return r.config.MaxConcurrentEvaluations
+	}
+	return config.DefaultMaxConcurrentEvaluations
+}
+
 // resolveGVRForDeletion resolves the GVR for a resource deletion.
 func (r *GCPolicyReconciler) resolveGVRForDeletion(resource *unstructured.Unstructured) schema.GroupVersionResource {
 	if r.gvrResolver != nil {
@@ -97,34 +166,32 @@ func (r *GCPolicyReconciler) resolveGVRForDeletion(resource *unstructured.Unstru
 	}
 }
 
-// buildDeleteOptions builds delete options from policy behavior.
-func buildDeleteOptions(policy *v1alpha1.GarbageCollectionPolicy) *metav1.DeleteOptions {
+// buildDeleteOptions builds delete options from policy behavior for a resource of the given kind.
+// A propagationByKind entry for the kind overrides the policy's propagation policy.
+func buildDeleteOptions(policy *v1alpha1.GarbageCollectionPolicy, kind string) *metav1.DeleteOptions {
 	deleteOptions := &metav1.DeleteOptions{}
 	if policy.Spec.Behavior.GracePeriodSeconds != nil {
 		deleteOptions.GracePeriodSeconds = policy.Spec.Behavior.GracePeriodSeconds
 	}
 
-	propagationPolicy := getDeletionPropagationPolicy(policy.Spec.Behavior.PropagationPolicy)
+	propagation := policy.Spec.Behavior.PropagationPolicy
+	if override, ok := policy.Spec.Behavior.PropagationByKind[kind]; ok {
+		propagation = override
+	}
+	propagationPolicy := getDeletionPropagationPolicy(propagation)
 	deleteOptions.PropagationPolicy = &propagationPolicy
 
 	return deleteOptions
 }
 
 // performResourceDeletion performs the actual resource deletion.
+// A NotFound error is returned as is, so callers can tell resources that were already gone.
 func (r *GCPolicyReconciler) performResourceDeletion(ctx context.Context, resource *unstructured.Unstructured, gvr schema.GroupVersionResource, deleteOptions *metav1.DeleteOptions) error {
 	namespace := resource.GetNamespace()
-	var err error
 	if namespace == "" {
-		err = r.dynamicClient.Resource(gvr).Delete(ctx, resource.GetName(), *deleteOptions)
-	} else {
-		err = r.dynamicClient.Resource(gvr).Namespace(namespace).Delete(ctx, resource.GetName(), *deleteOptions)
+		return r.dynamicClient.Resource(gvr).Delete(ctx, resource.GetName(), *deleteOptions)
 	}
-
-	if err != nil && !errors.IsNotFound(err) {
-		return err
-	}
-
-	return nil
+	return r.dynamicClient.Resource(gvr).Namespace(namespace).Delete(ctx, resource.GetName(), *deleteOptions)
 }
 
 // normalizeNamespace normalizes namespace for informer creation.
@@ -143,11 +210,8 @@ func normalizeNamespace(namespace string) string {
 // buildLabelSelectorFilter builds a label selector filter function for informer factory.
 func buildLabelSelectorFilter(policy *v1alpha1.GarbageCollectionPolicy) func(options *metav1.ListOptions) {
 	return func(options *metav1.ListOptions) {
-		if policy.Spec.TargetResource.LabelSelector != nil {
-			selector, err := metav1.LabelSelectorAsSelector(policy.Spec.TargetResource.LabelSelector)
-			if err == nil {
-				options.LabelSelector = selector.String()
-			}
+		if selector := listLabelSelector(&policy.Spec.TargetResource); selector != "" {
+			options.LabelSelector = selector
 		}
 	}
 }
@@ -104,7 +104,7 @@ func TestBuildDeleteOptions(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			opts := buildDeleteOptions(tt.policy)
+			opts := buildDeleteOptions(tt.policy, "ConfigMap")
 			if !tt.check(opts) {
 				t.Errorf("buildDeleteOptions() did not produce expected options")
 			}
@@ -112,6 +112,39 @@ func TestBuildDeleteOptions(t *testing.T) {
 	}
 }
 
+// TestBuildDeleteOptions_PropagationByKind tests per-kind propagation overrides.
+func TestBuildDeleteOptions_PropagationByKind(t *testing.T) {
+	policy := &v1alpha1.GarbageCollectionPolicy{
+		Spec: v1alpha1.GarbageCollectionPolicySpec{
+			Behavior: v1alpha1.BehaviorSpec{
+				PropagationPolicy: PropagationPolicyBackground,
+				PropagationByKind: map[string]string{
+					"Job":        PropagationPolicyForeground,
+					"ReplicaSet": PropagationPolicyOrphan,
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		kind string
+		want metav1.DeletionPropagation
+	}{
+		{kind: "Job", want: metav1.DeletePropagationForeground},
+		{kind: "ReplicaSet", want: metav1.DeletePropagationOrphan},
+		{kind: "ConfigMap", want: metav1.DeletePropagationBackground},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.kind, func(t *testing.T) {
+			opts := buildDeleteOptions(policy, tt.kind)
+			if opts.PropagationPolicy == nil || *opts.PropagationPolicy != tt.want {
+				t.Errorf("buildDeleteOptions(%q) propagation = %v, want %q", tt.kind, opts.PropagationPolicy, tt.want)
+			}
+		})
+	}
+}
+
 // TestResolveGVRForDeletion tests GVR resolution for deletion.
 func TestResolveGVRForDeletion(t *testing.T) {
 	scheme := runtime.NewScheme()
@@ -288,6 +288,14 @@ func TestGCPolicyReconciler_shouldRecreateInformer(t *testing.T) {
 	if !reconciler.shouldRecreateInformer(policy) {
 		t.Error("shouldRecreateInformer() should return true when Namespace changes")
 	}
+
+	// Reset and add phase conditions, which need a phase index
+	policy.Spec.TargetResource.Namespace = "default"
+	reconciler.trackPolicySpec(policy.UID, &policy.Spec)
+	policy.Spec.Conditions = &v1alpha1.ConditionsSpec{Phase: []string{"Failed"}}
+	if !reconciler.shouldRecreateInformer(policy) {
+		t.Error("shouldRecreateInformer() should return true when phase conditions are added")
+	}
 }
 
 func TestGCPolicyReconciler_trackPolicyUID(t *testing.T) {
@@ -333,7 +341,6 @@ func TestGCPolicyReconciler_cleanupResourceInformer(t *testing.T) {
 	// Create a mock informer entry
 	reconciler.resourceInformersMu.Lock()
 	reconciler.resourceInformers[uid] = nil // nil is OK for this test
-	reconciler.resourceInformerFactories[uid] = nil
 	initialCount := len(reconciler.resourceInformers)
 	reconciler.resourceInformersMu.Unlock()
 
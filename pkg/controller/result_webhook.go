@@ -0,0 +1,194 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	gcerrors "github.com/kube-zen/zen-gc/pkg/errors"
+	"github.com/kube-zen/zen-gc/pkg/validation"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+// DefaultResultWebhookTimeout is the default timeout for a single result webhook delivery.
+const DefaultResultWebhookTimeout = 5 * time.Second
+
+// ReasonResultWebhookURLNotAllowed is the status condition reason for a policy whose result
+// webhook URL is outside the controller's allowlist.
+const ReasonResultWebhookURLNotAllowed = "ResultWebhookURLNotAllowed"
+
+// ErrResultWebhookUnexpectedStatus indicates the result webhook returned a non-2xx HTTP status.
+var ErrResultWebhookUnexpectedStatus = errors.New("unexpected result webhook response status")
+
+// EvaluationSummary is the payload POSTed to a policy's result webhook after each evaluation.
+type EvaluationSummary struct {
+	Namespace   string    `json:"namespace"`
+	Name        string    `json:"name"`
+	UID         string    `json:"uid"`
+	APIVersion  string    `json:"apiVersion"`
+	Kind        string    `json:"kind"`
+	DryRun      bool      `json:"dryRun"`
+	Matched     int64     `json:"matched"`
+	Deleted     int64     `json:"deleted"`
+	Pending     int64     `json:"pending"`
+	Errors      int64     `json:"errors"`
+	EvaluatedAt time.Time `json:"evaluatedAt"`
+}
+
+// newEvaluationSummary builds the summary of an evaluation run.
+func newEvaluationSummary(policy *v1alpha1.GarbageCollectionPolicy, matched, deleted, pending, errorCount int64) *EvaluationSummary {
+	return &EvaluationSummary{
+		Namespace:   policy.Namespace,
+		Name:        policy.Name,
+		UID:         string(policy.UID),
+		APIVersion:  policy.Spec.TargetResource.APIVersion,
+		Kind:        policy.Spec.TargetResource.Kind,
+		DryRun:      dryRunShared(policy),
+		Matched:     matched,
+		Deleted:     deleted,
+		Pending:     pending,
+		Errors:      errorCount,
+		EvaluatedAt: time.Now().UTC(),
+	}
+}
+
+// ResultWebhookClient delivers evaluation summaries to result webhooks at allowed URLs.
+type ResultWebhookClient struct {
+	httpClient  *http.Client
+	allowedURLs []string
+	mu          sync.RWMutex
+}
+
+// NewResultWebhookClient creates a new result webhook client that delivers to no URL until
+// configured. If httpClient is nil, http.DefaultClient is used.
+func NewResultWebhookClient(httpClient *http.Client) *ResultWebhookClient {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &ResultWebhookClient{httpClient: httpClient}
+}
+
+// defaultResultWebhookClient is the shared client used after policy evaluation.
+var defaultResultWebhookClient = NewResultWebhookClient(nil)
+
+// ConfigureResultWebhooks sets the URLs the shared result webhook client may deliver to,
+// where nil allows none.
+func ConfigureResultWebhooks(allowedURLs []string) {
+	defaultResultWebhookClient.Configure(allowedURLs)
+}
+
+// Configure sets the URLs the client may deliver to, where nil allows none.
+func (c *ResultWebhookClient) Configure(allowedURLs []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.allowedURLs = allowedURLs
+}
+
+// Send POSTs the summary to the webhook URL and waits for the response.
+func (c *ResultWebhookClient) Send(ctx context.Context, webhook *v1alpha1.ResultWebhookSpec, summary *EvaluationSummary) error {
+	c.mu.RLock()
+	urlAllowed := slices.Contains(c.allowedURLs, webhook.URL)
+	c.mu.RUnlock()
+	if !urlAllowed {
+		return fmt.Errorf("%w: %q", validation.ErrResultWebhookURLNotAllowed, webhook.URL)
+	}
+
+	timeout := DefaultResultWebhookTimeout
+	if webhook.TimeoutSeconds != nil && *webhook.TimeoutSeconds > 0 {
+		timeout = time.Duration(*webhook.TimeoutSeconds) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	body, err := json.Marshal(summary)
+	if err != nil {
+		return fmt.Errorf("failed to marshal evaluation summary: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build result webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("result webhook request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%w: %d", ErrResultWebhookUnexpectedStatus, resp.StatusCode)
+	}
+	return nil
+}
+
+// Notify sends the summary in the background so evaluation is never blocked.
+// Failures are logged and counted, never returned.
+func (c *ResultWebhookClient) Notify(policy *v1alpha1.GarbageCollectionPolicy, summary *EvaluationSummary) {
+	webhook := policy.Spec.Behavior.ResultWebhook
+	if webhook == nil || webhook.URL == "" {
+		return
+	}
+	webhook = webhook.DeepCopy()
+
+	go func() {
+		if err := c.Send(context.Background(), webhook, summary); err != nil {
+			recordError(summary.Namespace, summary.Name, gcerrors.TypeResultWebhookFailed)
+			logger := sdklog.NewLogger("zen-gc")
+			logger.Warn("Failed to deliver evaluation result webhook", sdklog.Operation("result_webhook"), sdklog.String("policy", fmt.Sprintf("%s/%s", summary.Namespace, summary.Name)), sdklog.Error(err))
+		}
+	}()
+}
+
+// notifyResultWebhookShared reports an evaluation summary to the policy's result webhook, if configured.
+func notifyResultWebhookShared(policy *v1alpha1.GarbageCollectionPolicy, matched, deleted, pending, errorCount int64) {
+	if policy.Spec.Behavior.ResultWebhook == nil {
+		return
+	}
+	defaultResultWebhookClient.Notify(policy, newEvaluationSummary(policy, matched, deleted, pending, errorCount))
+}
+
+// allowedResultWebhookURLs returns the URLs result webhooks may be delivered to, or nil for none.
+func (r *GCPolicyReconciler) allowedResultWebhookURLs() []string {
+	if r.config == nil {
+		return nil
+	}
+	return r.config.ResultWebhookAllowedURLs
+}
+
+// handleResultWebhookURLNotAllowed marks a policy whose result webhook URL is not allowed as
+// Error instead of evaluating it.
+func (r *GCPolicyReconciler) handleResultWebhookURLNotAllowed(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy, err error) (ctrl.Result, error) {
+	r.logger.Warn("Policy has a disallowed result webhook URL, skipping evaluation", sdklog.Operation("reconcile"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Error(err))
+	r.markPolicyError(ctx, policy, gcerrors.Wrap(err, gcerrors.TypeResultWebhookURLNotAllowed, "result webhook URL not allowed"), ReasonResultWebhookURLNotAllowed)
+	// A spec change triggers a new reconcile; the allowlist only changes on restart
+	return ctrl.Result{RequeueAfter: r.getRequeueIntervalForPolicy(policy)}, nil
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/validation"
+)
+
+func TestResultWebhookClient_NotifyPostsSummary(t *testing.T) {
+	received := make(chan EvaluationSummary, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("unexpected request %s with content type %q", r.Method, r.Header.Get("Content-Type"))
+		}
+		var summary EvaluationSummary
+		if err := json.NewDecoder(r.Body).Decode(&summary); err != nil {
+			t.Errorf("failed to decode summary: %v", err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+		received <- summary
+	}))
+	defer server.Close()
+
+	policy := newTestPolicy("results")
+	policy.Spec.Behavior.DryRun = true
+	policy.Spec.Behavior.ResultWebhook = &v1alpha1.ResultWebhookSpec{URL: server.URL}
+
+	client := NewResultWebhookClient(server.Client())
+	client.Configure([]string{server.URL})
+	client.Notify(policy, newEvaluationSummary(policy, 10, 4, 5, 1))
+
+	select {
+	case summary := <-received:
+		if summary.Namespace != policy.Namespace || summary.Name != policy.Name || summary.UID != string(policy.UID) {
+			t.Errorf("unexpected policy identity in summary: %+v", summary)
+		}
+		if summary.Matched != 10 || summary.Deleted != 4 || summary.Pending != 5 || summary.Errors != 1 {
+			t.Errorf("unexpected counts in summary: %+v", summary)
+		}
+		if !summary.DryRun {
+			t.Error("expected dryRun to be reported")
+		}
+		if summary.EvaluatedAt.IsZero() {
+			t.Error("expected evaluatedAt to be set")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("result webhook was not called")
+	}
+}
+
+func TestResultWebhookClient_SendErrors(t *testing.T) {
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+
+	unblock := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-unblock:
+		case <-r.Context().Done():
+		}
+	}))
+	defer slow.Close()
+	defer close(unblock)
+
+	policy := newTestPolicy("results")
+	summary := newEvaluationSummary(policy, 1, 0, 1, 0)
+	client := NewResultWebhookClient(nil)
+	client.Configure([]string{failing.URL, slow.URL})
+
+	err := client.Send(context.Background(), &v1alpha1.ResultWebhookSpec{URL: failing.URL}, summary)
+	if !errors.Is(err, ErrResultWebhookUnexpectedStatus) {
+		t.Errorf("Send() error = %v, want ErrResultWebhookUnexpectedStatus", err)
+	}
+
+	timeout := int64(1)
+	start := time.Now()
+	if err := client.Send(context.Background(), &v1alpha1.ResultWebhookSpec{URL: slow.URL, TimeoutSeconds: &timeout}, summary); err == nil {
+		t.Error("expected slow webhook to time out")
+	}
+	if elapsed := time.Since(start); elapsed > 3*time.Second {
+		t.Errorf("expected delivery to be bounded by the timeout, took %v", elapsed)
+	}
+}
+
+func TestResultWebhookClient_RefusesURLsOutsideAllowlist(t *testing.T) {
+	called := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		called <- struct{}{}
+	}))
+	defer server.Close()
+
+	policy := newTestPolicy("results")
+	summary := newEvaluationSummary(policy, 1, 0, 1, 0)
+	client := NewResultWebhookClient(server.Client())
+
+	if err := client.Send(context.Background(), &v1alpha1.ResultWebhookSpec{URL: server.URL}, summary); !errors.Is(err, validation.ErrResultWebhookURLNotAllowed) {
+		t.Errorf("Send() error = %v, want %v", err, validation.ErrResultWebhookURLNotAllowed)
+	}
+	client.Configure([]string{server.URL + "/other"})
+	if err := client.Send(context.Background(), &v1alpha1.ResultWebhookSpec{URL: server.URL}, summary); !errors.Is(err, validation.ErrResultWebhookURLNotAllowed) {
+		t.Errorf("Send() error = %v, want %v", err, validation.ErrResultWebhookURLNotAllowed)
+	}
+	select {
+	case <-called:
+		t.Error("expected no delivery to a disallowed URL")
+	default:
+	}
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sort"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// retentionGroupKey returns the resource's retention group. Resources missing a groupBy
+// field share one group per namespace, so they are still retained.
+func retentionGroupKey(resource *unstructured.Unstructured, spec *v1alpha1.RetentionSpec) string {
+	if key, ok := groupKey(resource, nil, spec.GroupBy, DedupScopeNamespace); ok {
+		return key
+	}
+	unkeyed, _ := groupKey(resource, nil, nil, DedupScopeNamespace)
+	return "unkeyed:" + unkeyed
+}
+
+// retainedShared returns the matched resources kept by retention: the newest keepLast of
+// each group by creation time. Only the other resources may be deleted.
+func retainedShared(resources []*unstructured.Unstructured, spec *v1alpha1.RetentionSpec) map[*unstructured.Unstructured]struct{} {
+	groups := make(map[string][]*unstructured.Unstructured)
+	for _, resource := range resources {
+		key := retentionGroupKey(resource, spec)
+		groups[key] = append(groups[key], resource)
+	}
+
+	retained := make(map[*unstructured.Unstructured]struct{}, min(len(resources), len(groups)*max(spec.KeepLast, 0)))
+	for _, group := range groups {
+		// Newest first, ties broken by name so the kept set is stable across evaluations
+		sort.Slice(group, func(i, j int) bool {
+			return newerForDedup(group[i], group[j])
+		})
+		for _, resource := range group[:min(len(group), max(spec.KeepLast, 0))] {
+			retained[resource] = struct{}{}
+		}
+	}
+	return retained
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func TestRetainedShared_GroupByLabel(t *testing.T) {
+	resources := make([]*unstructured.Unstructured, 0, 7)
+	for _, cm := range []struct {
+		name, app string
+		age       time.Duration
+	}{
+		{"web-1", "web", 3 * time.Hour},
+		{"web-2", "web", 2 * time.Hour},
+		{"web-3", "web", 1 * time.Hour},
+		{"api-1", "api", 5 * time.Hour},
+		{"unlabeled-1", "", 4 * time.Hour},
+		{"unlabeled-2", "", 6 * time.Hour},
+		{"unlabeled-3", "", 7 * time.Hour},
+	} {
+		resource := newTestConfigMap("default", cm.name, time.Now().Add(-cm.age))
+		if cm.app != "" {
+			resource.SetLabels(map[string]string{"app": cm.app})
+		}
+		resources = append(resources, resource)
+	}
+	spec := &v1alpha1.RetentionSpec{KeepLast: 2, GroupBy: []string{"metadata.labels['app']"}}
+
+	// Resources without the label share a group of their own
+	got := dedupNames(retainedShared(resources, spec))
+	want := []string{"default/api-1", "default/unlabeled-1", "default/unlabeled-2", "default/web-2", "default/web-3"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("retained = %v, want %v", got, want)
+	}
+}
+
+func TestRetainedShared_GroupByOwnerReference(t *testing.T) {
+	resources := make([]*unstructured.Unstructured, 0, 5)
+	for _, cm := range []struct {
+		name, owner string
+		age         time.Duration
+	}{
+		{"nightly-1", "nightly", 3 * time.Hour},
+		{"nightly-2", "nightly", 2 * time.Hour},
+		{"nightly-3", "nightly", 1 * time.Hour},
+		{"hourly-1", "hourly", 30 * time.Minute},
+		{"hourly-2", "hourly", 10 * time.Minute},
+	} {
+		resource := newTestConfigMap("default", cm.name, time.Now().Add(-cm.age))
+		resource.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "batch/v1", Kind: "CronJob", Name: cm.owner, UID: types.UID(cm.owner)}})
+		resources = append(resources, resource)
+	}
+	spec := &v1alpha1.RetentionSpec{KeepLast: 1, GroupBy: []string{"metadata.ownerReferences[0].uid"}}
+
+	got := dedupNames(retainedShared(resources, spec))
+	want := []string{"default/hourly-2", "default/nightly-3"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("retained = %v, want %v", got, want)
+	}
+}
+
+func TestRetainedShared_GroupsByNamespaceWithoutGroupBy(t *testing.T) {
+	resources := []*unstructured.Unstructured{
+		newTestConfigMap("team-a", "a-1", time.Now().Add(-3*time.Hour)),
+		newTestConfigMap("team-a", "a-2", time.Now().Add(-2*time.Hour)),
+		newTestConfigMap("team-b", "b-1", time.Now().Add(-1*time.Hour)),
+	}
+
+	got := dedupNames(retainedShared(resources, &v1alpha1.RetentionSpec{KeepLast: 1}))
+	want := []string{"team-a/a-2", "team-b/b-1"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("retained = %v, want %v", got, want)
+	}
+}
+
+func TestEvaluateResources_RetentionKeepsNewest(t *testing.T) {
+	service := NewPolicyEvaluationService(nil, NewDefaultSelectorMatcher(), NewDefaultConditionMatcher(), nil, nil, nil, nil, nil, nil)
+	policy := &v1alpha1.GarbageCollectionPolicy{
+		Spec: v1alpha1.GarbageCollectionPolicySpec{
+			TargetResource: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap"},
+			TTL:            v1alpha1.TTLSpec{SecondsAfterCreation: int64Ptr(60)},
+			Retention:      &v1alpha1.RetentionSpec{KeepLast: 2, GroupBy: []string{"metadata.ownerReferences[0].uid"}},
+		},
+	}
+	resources := make([]*unstructured.Unstructured, 0, 7)
+	for _, cm := range []struct {
+		name, owner string
+		age         time.Duration
+	}{
+		{"nightly-1", "nightly", 4 * time.Hour},
+		{"nightly-2", "nightly", 3 * time.Hour},
+		{"nightly-3", "nightly", 2 * time.Hour},
+		{"nightly-4", "nightly", 1 * time.Hour},
+		// Retained or not, a resource is only deleted once its TTL has expired
+		{"hourly-1", "hourly", 40 * time.Second},
+		{"hourly-2", "hourly", 20 * time.Second},
+		{"hourly-3", "hourly", 0},
+	} {
+		resource := newTestConfigMap("default", cm.name, time.Now().Add(-cm.age))
+		resource.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "batch/v1", Kind: "CronJob", Name: cm.owner, UID: types.UID(cm.owner)}})
+		resources = append(resources, resource)
+	}
+
+	var oldest oldestPending
+	toDelete := make([]*unstructured.Unstructured, 0)
+	matched, _ := service.evaluateResources(context.Background(), resources, policy, &toDelete, make(map[string]string), "v1", "ConfigMap", &oldest, nil)
+	if matched != 7 {
+		t.Errorf("matched = %d, want 7", matched)
+	}
+	names := make([]string, 0, len(toDelete))
+	for _, resource := range toDelete {
+		names = append(names, resource.GetName())
+	}
+	sort.Strings(names)
+	want := []string{"nightly-1", "nightly-2"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("deleted = %v, want %v", names, want)
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	gcerrors "github.com/kube-zen/zen-gc/pkg/errors"
+	"github.com/kube-zen/zen-gc/pkg/validation"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+// Schedule condition reasons.
+const (
+	// ReasonScheduled is the Ready reason of a policy whose schedule activeAfter has not
+	// been reached.
+	ReasonScheduled = "Scheduled"
+
+	// ReasonOutsideWindow is the Ready reason of a policy outside all of its schedule
+	// activeWindows.
+	ReasonOutsideWindow = "OutsideWindow"
+
+	// ReasonInvalidSchedule is the Degraded reason of a policy whose schedule cannot be parsed.
+	ReasonInvalidSchedule = "InvalidSchedule"
+)
+
+// scheduleState is where a policy's schedule stands at a point in time.
+type scheduleState struct {
+	// Reason is ReasonScheduled or ReasonOutsideWindow while the policy must not delete,
+	// empty while it is active
+	Reason string
+
+	// OpensAt is when the policy becomes active next; zero while it is active
+	OpensAt time.Time
+}
+
+// policyScheduleStateShared returns whether a policy's schedule lets it delete at now and,
+// if not, when it next does. A policy without a schedule is always active.
+func policyScheduleStateShared(schedule *v1alpha1.ScheduleSpec, now time.Time) (scheduleState, error) {
+	if schedule == nil {
+		return scheduleState{}, nil
+	}
+	if schedule.ActiveAfter != nil && now.Before(schedule.ActiveAfter.Time) {
+		// The windows are checked once activeAfter has passed
+		state, err := policyScheduleStateShared(&v1alpha1.ScheduleSpec{ActiveWindows: schedule.ActiveWindows, TimeZone: schedule.TimeZone}, schedule.ActiveAfter.Time)
+		if err != nil {
+			return state, err
+		}
+		if state.OpensAt.IsZero() {
+			state.OpensAt = schedule.ActiveAfter.Time
+		}
+		state.Reason = ReasonScheduled
+		return state, nil
+	}
+	if len(schedule.ActiveWindows) == 0 {
+		return scheduleState{}, nil
+	}
+
+	loc, err := loadDateLocation(schedule.TimeZone)
+	if err != nil {
+		return scheduleState{}, fmt.Errorf("%w: %q", validation.ErrInvalidScheduleTimeZone, schedule.TimeZone)
+	}
+	local := now.In(loc)
+	var opensAt time.Time
+	for i, raw := range schedule.ActiveWindows {
+		window, err := validation.ParseActiveWindow(raw)
+		if err != nil {
+			return scheduleState{}, fmt.Errorf("activeWindows[%d]: %w", i, err)
+		}
+		if window.Contains(local) {
+			return scheduleState{}, nil
+		}
+		if next := window.NextStart(local); opensAt.IsZero() || next.Before(opensAt) {
+			opensAt = next
+		}
+	}
+	return scheduleState{Reason: ReasonOutsideWindow, OpensAt: opensAt}, nil
+}
+
+// handleOutsideSchedule marks a policy its schedule keeps from deleting as Scheduled and
+// requeues it for when the schedule opens, or the evaluation interval if sooner.
+func (r *GCPolicyReconciler) handleOutsideSchedule(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy, state scheduleState, now time.Time) (ctrl.Result, error) {
+	message := fmt.Sprintf("Outside the policy schedule until %s", state.OpensAt.UTC().Format(time.RFC3339))
+	if state.Reason == ReasonScheduled {
+		message = fmt.Sprintf("Not active before %s", state.OpensAt.UTC().Format(time.RFC3339))
+	}
+	r.logger.Debug("Policy is outside its schedule, skipping evaluation", sdklog.Operation("reconcile"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.String("reason", state.Reason))
+	if r.statusUpdater != nil {
+		if statusErr := r.statusUpdater.SetPending(ctx, policy, state.Reason, message); statusErr != nil {
+			r.logger.Warn("Failed to set policy Scheduled status", sdklog.Operation("update_status"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Error(statusErr))
+		}
+	}
+
+	requeueAfter := r.getRequeueIntervalForPolicy(policy)
+	if untilOpen := state.OpensAt.Sub(now); untilOpen > 0 && untilOpen < requeueAfter {
+		requeueAfter = untilOpen
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// handleInvalidSchedule marks a policy whose schedule cannot be parsed as Degraded instead
+// of evaluating it, so a schedule admitted without validation never deletes out of window.
+func (r *GCPolicyReconciler) handleInvalidSchedule(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy, err error) (ctrl.Result, error) {
+	r.logger.Warn("Policy has an invalid schedule, skipping evaluation", sdklog.Operation("reconcile"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Error(err))
+	r.markPolicyError(ctx, policy, gcerrors.Wrap(err, gcerrors.TypeInvalidSchedule, "invalid schedule"), ReasonInvalidSchedule)
+	// A spec change triggers a new reconcile
+	return ctrl.Result{RequeueAfter: r.getRequeueIntervalForPolicy(policy)}, nil
+}
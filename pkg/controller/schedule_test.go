@@ -0,0 +1,166 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func TestPolicyScheduleStateShared(t *testing.T) {
+	activeAfter := metav1.NewTime(time.Date(2026, time.March, 6, 12, 0, 0, 0, time.UTC))
+	// 09:00-17:00 in New York is 14:00-22:00 UTC until DST starts on 2026-03-08, then 13:00-21:00 UTC
+	newYorkHours := []string{"* 09:00-17:00"}
+
+	tests := []struct {
+		name      string
+		schedule  *v1alpha1.ScheduleSpec
+		now       time.Time
+		reason    string
+		opensAt   time.Time
+		expectErr bool
+	}{
+		{name: "no schedule", now: activeAfter.Time},
+		{
+			name:     "just before activeAfter",
+			schedule: &v1alpha1.ScheduleSpec{ActiveAfter: &activeAfter},
+			now:      activeAfter.Add(-time.Second),
+			reason:   ReasonScheduled,
+			opensAt:  activeAfter.Time,
+		},
+		{
+			name:     "at activeAfter",
+			schedule: &v1alpha1.ScheduleSpec{ActiveAfter: &activeAfter},
+			now:      activeAfter.Time,
+		},
+		{
+			name:     "before activeAfter, opening with the first window after it",
+			schedule: &v1alpha1.ScheduleSpec{ActiveAfter: &activeAfter, ActiveWindows: newYorkHours, TimeZone: "America/New_York"},
+			now:      activeAfter.Add(-time.Hour),
+			reason:   ReasonScheduled,
+			opensAt:  time.Date(2026, time.March, 6, 14, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "after activeAfter, outside the window",
+			schedule: &v1alpha1.ScheduleSpec{ActiveAfter: &activeAfter, ActiveWindows: newYorkHours, TimeZone: "America/New_York"},
+			now:      activeAfter.Add(time.Hour),
+			reason:   ReasonOutsideWindow,
+			opensAt:  time.Date(2026, time.March, 6, 14, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "window opening in its time zone",
+			schedule: &v1alpha1.ScheduleSpec{ActiveWindows: newYorkHours, TimeZone: "America/New_York"},
+			now:      time.Date(2026, time.March, 6, 14, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "window closing in its time zone",
+			schedule: &v1alpha1.ScheduleSpec{ActiveWindows: newYorkHours, TimeZone: "America/New_York"},
+			now:      time.Date(2026, time.March, 6, 22, 0, 0, 0, time.UTC),
+			reason:   ReasonOutsideWindow,
+			opensAt:  time.Date(2026, time.March, 7, 14, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "window follows daylight saving time",
+			schedule: &v1alpha1.ScheduleSpec{ActiveWindows: newYorkHours, TimeZone: "America/New_York"},
+			now:      time.Date(2026, time.March, 9, 13, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "same hours in UTC",
+			schedule: &v1alpha1.ScheduleSpec{ActiveWindows: newYorkHours},
+			now:      time.Date(2026, time.March, 6, 14, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "any window suffices",
+			schedule: &v1alpha1.ScheduleSpec{ActiveWindows: []string{"Mon 01:00-02:00", "Fri 22:00-02:00"}},
+			now:      time.Date(2026, time.March, 7, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "invalid window",
+			schedule:  &v1alpha1.ScheduleSpec{ActiveWindows: []string{"weekends"}},
+			now:       activeAfter.Time,
+			expectErr: true,
+		},
+		{
+			name:      "unknown time zone",
+			schedule:  &v1alpha1.ScheduleSpec{ActiveWindows: newYorkHours, TimeZone: "Mars/Olympus"},
+			now:       activeAfter.Time,
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, err := policyScheduleStateShared(tt.schedule, tt.now)
+			if tt.expectErr {
+				if err == nil {
+					t.Error("policyScheduleStateShared() expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("policyScheduleStateShared() returned error: %v", err)
+			}
+			if state.Reason != tt.reason || !state.OpensAt.Equal(tt.opensAt) {
+				t.Errorf("policyScheduleStateShared() = %q opening at %v, want %q opening at %v", state.Reason, state.OpensAt, tt.reason, tt.opensAt)
+			}
+		})
+	}
+}
+
+func TestHandleOutsideSchedule(t *testing.T) {
+	ctx := context.Background()
+	reconciler, dynamicClient, policy := setupObservePausedTest(t, false)
+	policy.Spec.Paused = false
+	now := time.Now()
+
+	result, err := reconciler.handleOutsideSchedule(ctx, policy, scheduleState{Reason: ReasonOutsideWindow, OpensAt: now.Add(10 * time.Second)}, now)
+	if err != nil {
+		t.Fatalf("handleOutsideSchedule() returned error: %v", err)
+	}
+	if result.RequeueAfter != 10*time.Second {
+		t.Errorf("RequeueAfter = %v, want the time until the window opens", result.RequeueAfter)
+	}
+
+	obj, err := dynamicClient.Resource(observeTestPolicyGVR).Namespace("default").Get(ctx, policy.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get policy: %v", err)
+	}
+	if phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase"); phase != PolicyPhaseScheduled {
+		t.Errorf("phase = %q, want %s", phase, PolicyPhaseScheduled)
+	}
+	list, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("Failed to list configmaps: %v", err)
+	}
+	if len(list.Items) != 2 {
+		t.Errorf("%d configmaps remain, want nothing deleted outside the schedule", len(list.Items))
+	}
+
+	// A schedule opening later than the evaluation interval requeues at the interval
+	result, err = reconciler.handleOutsideSchedule(ctx, policy, scheduleState{Reason: ReasonScheduled, OpensAt: now.Add(24 * time.Hour)}, now)
+	if err != nil {
+		t.Fatalf("handleOutsideSchedule() returned error: %v", err)
+	}
+	if result.RequeueAfter != reconciler.getRequeueIntervalForPolicy(policy) {
+		t.Errorf("RequeueAfter = %v, want the evaluation interval", result.RequeueAfter)
+	}
+}
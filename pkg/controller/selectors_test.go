@@ -2,9 +2,11 @@ package controller
 
 import (
 	"testing"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
 )
@@ -76,6 +78,232 @@ func TestMatchesSelectorsShared_LabelSelector(t *testing.T) {
 	}
 }
 
+func TestMatchesSelectorsShared_LabelSelectors(t *testing.T) {
+	target := &v1alpha1.TargetResourceSpec{
+		LabelSelectors: []metav1.LabelSelector{
+			{MatchLabels: map[string]string{"app": "foo"}},
+			{MatchLabels: map[string]string{"app": "bar"}},
+			{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "scratch", Operator: metav1.LabelSelectorOpExists}}},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		labels        map[string]string
+		target        *v1alpha1.TargetResourceSpec
+		expectedMatch bool
+	}{
+		{name: "matches first selector", labels: map[string]string{"app": "foo"}, target: target, expectedMatch: true},
+		{name: "matches second selector", labels: map[string]string{"app": "bar"}, target: target, expectedMatch: true},
+		{name: "matches expression selector", labels: map[string]string{"scratch": ""}, target: target, expectedMatch: true},
+		{name: "matches none", labels: map[string]string{"app": "baz"}, target: target, expectedMatch: false},
+		{
+			name:   "labelSelector is ANDed with the union",
+			labels: map[string]string{"app": "foo"},
+			target: &v1alpha1.TargetResourceSpec{
+				LabelSelector:  &metav1.LabelSelector{MatchLabels: map[string]string{"temporary": "true"}},
+				LabelSelectors: target.LabelSelectors,
+			},
+			expectedMatch: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := newTestConfigMap("default", "cm", time.Time{})
+			resource.SetLabels(tt.labels)
+			if got := matchesSelectorsShared(resource, tt.target); got != tt.expectedMatch {
+				t.Errorf("matchesSelectorsShared() = %v, want %v", got, tt.expectedMatch)
+			}
+		})
+	}
+}
+
+func TestMatchesSelectorsShared_VirtualLabelAnnotations(t *testing.T) {
+	target := &v1alpha1.TargetResourceSpec{
+		LabelSelector:           &metav1.LabelSelector{MatchLabels: map[string]string{"example.com/team": "payments"}},
+		VirtualLabelAnnotations: []string{"example.com/team"},
+	}
+
+	tests := []struct {
+		name          string
+		labels        map[string]string
+		annotations   map[string]string
+		target        *v1alpha1.TargetResourceSpec
+		expectedMatch bool
+	}{
+		{
+			name:          "matches annotation-derived label",
+			annotations:   map[string]string{"example.com/team": "payments"},
+			target:        target,
+			expectedMatch: true,
+		},
+		{
+			name:          "annotation value does not match",
+			annotations:   map[string]string{"example.com/team": "billing"},
+			target:        target,
+			expectedMatch: false,
+		},
+		{
+			name:          "real label takes precedence over annotation",
+			labels:        map[string]string{"example.com/team": "billing"},
+			annotations:   map[string]string{"example.com/team": "payments"},
+			target:        target,
+			expectedMatch: false,
+		},
+		{
+			name:        "unlisted annotations are not projected",
+			annotations: map[string]string{"example.com/team": "payments"},
+			target: &v1alpha1.TargetResourceSpec{
+				LabelSelector: target.LabelSelector,
+			},
+			expectedMatch: false,
+		},
+		{
+			name:        "any-of selectors match virtual labels",
+			labels:      map[string]string{"app": "foo"},
+			annotations: map[string]string{"example.com/team": "billing"},
+			target: &v1alpha1.TargetResourceSpec{
+				LabelSelectors: []metav1.LabelSelector{
+					{MatchLabels: map[string]string{"example.com/team": "payments"}},
+					{MatchLabels: map[string]string{"example.com/team": "billing"}},
+				},
+				VirtualLabelAnnotations: []string{"example.com/team"},
+			},
+			expectedMatch: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := newTestConfigMap("default", "cm", time.Time{})
+			resource.SetLabels(tt.labels)
+			resource.SetAnnotations(tt.annotations)
+			if got := matchesSelectorsShared(resource, tt.target); got != tt.expectedMatch {
+				t.Errorf("matchesSelectorsShared() = %v, want %v", got, tt.expectedMatch)
+			}
+		})
+	}
+}
+
+func TestMatchesSelectorsShared_UIDs(t *testing.T) {
+	tests := []struct {
+		name          string
+		uid           string
+		labels        map[string]string
+		target        *v1alpha1.TargetResourceSpec
+		expectedMatch bool
+	}{
+		{
+			name:          "empty list places no restriction",
+			uid:           "uid-1",
+			target:        &v1alpha1.TargetResourceSpec{},
+			expectedMatch: true,
+		},
+		{
+			name:          "listed uid matches",
+			uid:           "uid-2",
+			target:        &v1alpha1.TargetResourceSpec{UIDs: []string{"uid-1", "uid-2"}},
+			expectedMatch: true,
+		},
+		{
+			name:          "unlisted uid does not match",
+			uid:           "uid-3",
+			target:        &v1alpha1.TargetResourceSpec{UIDs: []string{"uid-1", "uid-2"}},
+			expectedMatch: false,
+		},
+		{
+			name:   "listed uid is ANDed with labelSelector",
+			uid:    "uid-1",
+			labels: map[string]string{"app": "bar"},
+			target: &v1alpha1.TargetResourceSpec{
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+				UIDs:          []string{"uid-1"},
+			},
+			expectedMatch: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := newTestConfigMap("default", "cm", time.Time{})
+			resource.SetUID(types.UID(tt.uid))
+			resource.SetLabels(tt.labels)
+			if got := matchesSelectorsShared(resource, tt.target); got != tt.expectedMatch {
+				t.Errorf("matchesSelectorsShared() = %v, want %v", got, tt.expectedMatch)
+			}
+		})
+	}
+}
+
+func TestListLabelSelector(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   *v1alpha1.TargetResourceSpec
+		expected string
+	}{
+		{name: "no selectors", target: &v1alpha1.TargetResourceSpec{}, expected: ""},
+		{
+			name: "single any-of selector pushed down as is",
+			target: &v1alpha1.TargetResourceSpec{
+				LabelSelectors: []metav1.LabelSelector{{MatchLabels: map[string]string{"app": "foo"}}},
+			},
+			expected: "app=foo",
+		},
+		{
+			name: "same-key selectors merged into In",
+			target: &v1alpha1.TargetResourceSpec{
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"temporary": "true"}},
+				LabelSelectors: []metav1.LabelSelector{
+					{MatchLabels: map[string]string{"app": "foo"}},
+					{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: metav1.LabelSelectorOpIn, Values: []string{"baz", "bar"}}}},
+				},
+			},
+			expected: "temporary=true,app in (bar,baz,foo)",
+		},
+		{
+			name: "unmergeable union only pushes labelSelector",
+			target: &v1alpha1.TargetResourceSpec{
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"temporary": "true"}},
+				LabelSelectors: []metav1.LabelSelector{
+					{MatchLabels: map[string]string{"app": "foo"}},
+					{MatchLabels: map[string]string{"team": "bar"}},
+				},
+			},
+			expected: "temporary=true",
+		},
+		{
+			name: "selectors on virtual labels are not pushed down",
+			target: &v1alpha1.TargetResourceSpec{
+				LabelSelector:           &metav1.LabelSelector{MatchLabels: map[string]string{"example.com/team": "payments"}},
+				LabelSelectors:          []metav1.LabelSelector{{MatchLabels: map[string]string{"app": "foo"}}},
+				VirtualLabelAnnotations: []string{"example.com/team"},
+			},
+			expected: "app=foo",
+		},
+		{
+			name: "union on virtual labels is not pushed down",
+			target: &v1alpha1.TargetResourceSpec{
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"temporary": "true"}},
+				LabelSelectors: []metav1.LabelSelector{
+					{MatchLabels: map[string]string{"example.com/team": "payments"}},
+					{MatchLabels: map[string]string{"example.com/team": "billing"}},
+				},
+				VirtualLabelAnnotations: []string{"example.com/team"},
+			},
+			expected: "temporary=true",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := listLabelSelector(tt.target); got != tt.expected {
+				t.Errorf("listLabelSelector() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
 func TestMatchesSelectorsShared_Namespace(t *testing.T) {
 	tests := []struct {
 		name          string
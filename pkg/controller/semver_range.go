@@ -0,0 +1,66 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/validation"
+)
+
+// meetsSemverConditionsShared checks that all semver conditions match the resource.
+func meetsSemverConditionsShared(resource *unstructured.Unstructured, conds []v1alpha1.SemverCondition) bool {
+	for i := range conds {
+		if !meetsSemverCondition(resource, &conds[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// meetsSemverCondition checks that the version in the condition's label or field satisfies
+// its range. Missing or unparseable versions, and invalid ranges, never match.
+func meetsSemverCondition(resource *unstructured.Unstructured, cond *v1alpha1.SemverCondition) bool {
+	value, ok := semverSourceValue(resource, cond)
+	if !ok {
+		return false
+	}
+	v, err := validation.ParseSemver(value)
+	if err != nil {
+		return false
+	}
+	r, err := validation.ParseSemverRange(cond.Range)
+	if err != nil {
+		return false
+	}
+	return r.Contains(v)
+}
+
+// semverSourceValue returns the label or string field value holding the condition's version.
+func semverSourceValue(resource *unstructured.Unstructured, cond *v1alpha1.SemverCondition) (string, bool) {
+	if cond.LabelKey != "" {
+		value, ok := resource.GetLabels()[cond.LabelKey]
+		return value, ok
+	}
+	value, found, err := nestedFieldNoCopy(resource.Object, cond.FieldPath)
+	if err != nil || !found {
+		return "", false
+	}
+	s, ok := value.(string)
+	return s, ok
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func TestMeetsSemverCondition(t *testing.T) {
+	tests := []struct {
+		name         string
+		labelVersion string
+		fieldVersion interface{}
+		cond         v1alpha1.SemverCondition
+		want         bool
+	}{
+		{name: "label below range", labelVersion: "1.4.2", cond: v1alpha1.SemverCondition{LabelKey: "app.kubernetes.io/version", Range: "<2.0.0"}, want: true},
+		{name: "label with v prefix", labelVersion: "v1.4.2", cond: v1alpha1.SemverCondition{LabelKey: "app.kubernetes.io/version", Range: "<2.0.0"}, want: true},
+		{name: "label at upper bound", labelVersion: "2.0.0", cond: v1alpha1.SemverCondition{LabelKey: "app.kubernetes.io/version", Range: "<2.0.0"}, want: false},
+		{name: "label above range", labelVersion: "3.1.0", cond: v1alpha1.SemverCondition{LabelKey: "app.kubernetes.io/version", Range: "<2.0.0"}, want: false},
+		{name: "pre-release below its release", labelVersion: "2.0.0-rc.1", cond: v1alpha1.SemverCondition{LabelKey: "app.kubernetes.io/version", Range: "<2.0.0"}, want: true},
+		{name: "field within compound range", fieldVersion: "1.2.0", cond: v1alpha1.SemverCondition{FieldPath: "spec.version", Range: ">=1.0.0, <2.0.0"}, want: true},
+		{name: "field outside compound range", fieldVersion: "0.9.0", cond: v1alpha1.SemverCondition{FieldPath: "spec.version", Range: ">=1.0.0, <2.0.0"}, want: false},
+		{name: "invalid label version", labelVersion: "latest", cond: v1alpha1.SemverCondition{LabelKey: "app.kubernetes.io/version", Range: "<2.0.0"}, want: false},
+		{name: "partial label version", labelVersion: "1.4", cond: v1alpha1.SemverCondition{LabelKey: "app.kubernetes.io/version", Range: "<2.0.0"}, want: false},
+		{name: "non-string field", fieldVersion: int64(1), cond: v1alpha1.SemverCondition{FieldPath: "spec.version", Range: "<2.0.0"}, want: false},
+		{name: "missing label", cond: v1alpha1.SemverCondition{LabelKey: "app.kubernetes.io/version", Range: "<2.0.0"}, want: false},
+		{name: "missing field", cond: v1alpha1.SemverCondition{FieldPath: "spec.version", Range: "<2.0.0"}, want: false},
+		{name: "invalid range", labelVersion: "1.0.0", cond: v1alpha1.SemverCondition{LabelKey: "app.kubernetes.io/version", Range: "~2"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := newTestConfigMap("default", "cm", time.Time{})
+			if tt.labelVersion != "" {
+				resource.SetLabels(map[string]string{"app.kubernetes.io/version": tt.labelVersion})
+			}
+			if tt.fieldVersion != nil {
+				_ = unstructured.SetNestedField(resource.Object, tt.fieldVersion, "spec", "version")
+			}
+			if got := meetsSemverCondition(resource, &tt.cond); got != tt.want {
+				t.Errorf("meetsSemverCondition() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMeetsConditions_Semver(t *testing.T) {
+	resource := newTestConfigMap("default", "cm", time.Time{})
+	resource.SetLabels(map[string]string{"app.kubernetes.io/version": "1.4.2"})
+	_ = unstructured.SetNestedField(resource.Object, "1.9.0", "spec", "version")
+	conditions := &v1alpha1.ConditionsSpec{
+		Semver: []v1alpha1.SemverCondition{
+			{LabelKey: "app.kubernetes.io/version", Range: "<2.0.0"},
+			{FieldPath: "spec.version", Range: ">=1.5.0"},
+		},
+	}
+	if !meetsConditionsShared(resource, conditions) {
+		t.Error("meetsConditionsShared() = false, want true when every version is in range")
+	}
+
+	// Conditions are ANDed
+	conditions.Semver[1].Range = ">=2.0.0"
+	if meetsConditionsShared(resource, conditions) {
+		t.Error("meetsConditionsShared() = true, want false when one version is out of range")
+	}
+}
@@ -20,19 +20,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
 	"github.com/kube-zen/zen-gc/pkg/config"
 	gcerrors "github.com/kube-zen/zen-gc/pkg/errors"
-	"github.com/kube-zen/zen-sdk/pkg/gc/backoff"
+	"github.com/kube-zen/zen-gc/pkg/validation"
 	"github.com/kube-zen/zen-sdk/pkg/gc/ratelimiter"
 	sdkttl "github.com/kube-zen/zen-sdk/pkg/gc/ttl"
 	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
@@ -42,10 +43,52 @@ var (
 	// ErrNoDeleter indicates no deleter was provided.
 	ErrNoDeleter = errors.New("no deleter provided")
 
+	// ErrResourceAlreadyGone indicates a resource was not found when deleting it, e.g. because
+	// another actor deleted it first.
+	ErrResourceAlreadyGone = errors.New("resource already gone")
+
+	// ErrAwaitingFinalizers indicates a resource carries the policy's finalizer but is not
+	// deleted yet because other finalizers remain, or its deletion is already in progress.
+	ErrAwaitingFinalizers = errors.New("resource awaiting finalizers")
+
+	// ErrResourceModified indicates a delete with behavior.preconditions was rejected because
+	// the resource changed since it was evaluated.
+	ErrResourceModified = errors.New("resource modified since it was evaluated")
+
 	// ErrResourceInformerCacheSyncFailed indicates resource informer cache sync failed.
 	ErrResourceInformerCacheSyncFailed = errors.New("failed to sync resource informer cache")
+
+	// ErrDeletionsDeferred indicates a batch stopped early because deletions are paused; the
+	// resources it did not reach are retried on the next run.
+	ErrDeletionsDeferred = errors.New("deletions deferred")
 )
 
+// deferredBatchError is returned as the last of a batch's errors when deletions stop
+// mid-batch, carrying the number of the batch's resources left for the next run.
+type deferredBatchError struct {
+	deferred int64
+}
+
+func (e *deferredBatchError) Error() string {
+	return fmt.Sprintf("%s: %d resources left for the next run", ErrDeletionsDeferred, e.deferred)
+}
+
+func (e *deferredBatchError) Unwrap() error {
+	return ErrDeletionsDeferred
+}
+
+// splitDeferredShared separates a batch's deletion failures from the number of its
+// resources deferred by a deferredBatchError.
+func splitDeferredShared(errs []error) ([]error, int64) {
+	if n := len(errs); n > 0 {
+		var deferredErr *deferredBatchError
+		if errors.As(errs[n-1], &deferredErr) {
+			return errs[:n-1], deferredErr.deferred
+		}
+	}
+	return errs, 0
+}
+
 // Constants for deletion reasons and error types.
 const (
 	// ReasonTTLExpired indicates that a resource's TTL has expired.
@@ -60,6 +103,30 @@ const (
 	// ReasonConditionNotMet indicates that a resource does not meet the deletion conditions.
 	ReasonConditionNotMet = "condition_not_met"
 
+	// ReasonNotAllowed indicates that the deletion reason is not in the policy's allowedReasons.
+	ReasonNotAllowed = "reason_not_allowed"
+
+	// ReasonDedupKept indicates that a resource is the newest of its dedup group and is kept.
+	ReasonDedupKept = "dedup_kept"
+
+	// ReasonRetentionKept indicates that a resource is among the newest keepLast of its
+	// retention group and is kept.
+	ReasonRetentionKept = "retention_kept"
+
+	// ReasonProtectedByAnnotation indicates that a resource carries the protection annotation.
+	ReasonProtectedByAnnotation = "protected_by_annotation"
+
+	// ReasonDeletionNoticePending indicates that a resource was noticed for deletion and its
+	// deletionNoticeSeconds window has not elapsed yet.
+	ReasonDeletionNoticePending = "deletion_notice_pending"
+
+	// ReasonDependentOfDeleted indicates that a resource was deleted as an alsoDelete dependent
+	// of a resource the policy deleted.
+	ReasonDependentOfDeleted = "dependent_of_deleted"
+
+	// ReasonDeletionHeld indicates that a deletable resource was held by a count trend, backup gate, or minRemaining.
+	ReasonDeletionHeld = "deletion_held"
+
 	// DefaultGCInterval is the default interval for GC runs.
 	DefaultGCInterval = 1 * time.Minute
 
@@ -72,8 +139,13 @@ const (
 	// DefaultCacheSyncTimeout is the default timeout for cache synchronization.
 	DefaultCacheSyncTimeout = 30 * time.Second
 
+	// FieldManager is the field manager of the controller's patches to target resources, so
+	// its own writes can be told apart from other managers' in managedFields.
+	FieldManager = "zen-gc"
+
 	// ErrorTypeEvaluationFailed indicates that policy evaluation failed.
-	ErrorTypeEvaluationFailed = "evaluation_failed"
+	// Deprecated: use gcerrors.TypeEvaluationFailed.
+	ErrorTypeEvaluationFailed = gcerrors.TypeEvaluationFailed
 )
 
 // Constants for deletion propagation policies.
@@ -92,6 +164,18 @@ const (
 const (
 	// OperatorNotIn indicates a "NotIn" operator for field conditions.
 	OperatorNotIn = "NotIn"
+
+	// OperatorGreaterThan matches fields greater than the value, as numbers or RFC3339 timestamps.
+	OperatorGreaterThan = "GreaterThan"
+
+	// OperatorGreaterThanOrEqual matches fields greater than or equal to the value.
+	OperatorGreaterThanOrEqual = "GreaterThanOrEqual"
+
+	// OperatorLessThan matches fields less than the value, as numbers or RFC3339 timestamps.
+	OperatorLessThan = "LessThan"
+
+	// OperatorLessThanOrEqual matches fields less than or equal to the value.
+	OperatorLessThanOrEqual = "LessThanOrEqual"
 )
 
 // Constants for policy phases.
@@ -104,6 +188,12 @@ const (
 
 	// PolicyPhaseError indicates the policy encountered errors during evaluation.
 	PolicyPhaseError = "Error"
+
+	// PolicyPhasePending indicates the policy waits for controller capacity before evaluation.
+	PolicyPhasePending = "Pending"
+
+	// PolicyPhaseScheduled indicates the policy's schedule keeps it from deleting.
+	PolicyPhaseScheduled = "Scheduled"
 )
 
 // RateLimiterManager manages rate limiters for policies.
@@ -166,6 +256,11 @@ func getOrCreateRateLimiterShared(mgr RateLimiterManager, policy *v1alpha1.Garba
 type BatchDeleter interface {
 	DeleteResourceWithBackoff(ctx context.Context, resource *unstructured.Unstructured, policy *v1alpha1.GarbageCollectionPolicy, rateLimiter *ratelimiter.RateLimiter) error
 	GetEventRecorder() *EventRecorder
+	GetDeletionCoordinator() *DeletionCoordinator
+	GetErrorRateBreaker() *ErrorRateBreaker
+	GetDeletionSentinel() *DeletionSentinel
+	GetAuditSink() AuditSink
+	GetSnapshotDir() string
 }
 
 // deleteBatchShared is a shared implementation for deleting a batch of resources.
@@ -183,6 +278,16 @@ func deleteBatchShared(
 
 	resourceAPIVersion := policy.Spec.TargetResource.APIVersion
 	resourceKind := policy.Spec.TargetResource.Kind
+	coordinator := deleter.GetDeletionCoordinator()
+	if dryRunShared(policy) {
+		// Dry-run deletes nothing, so claiming would keep overlapping armed policies from deleting
+		coordinator = nil
+	}
+	breaker := deleter.GetErrorRateBreaker()
+	sentinel := deleter.GetDeletionSentinel()
+	audit := deleter.GetAuditSink()
+	// Audit records name the target's resource; its kind was parsed before listing
+	gvr, _ := validation.ParseGVR(resourceAPIVersion, resourceKind)
 
 	const contextCheckInterval = 50 // Check context every 50 iterations
 	for i, resource := range batch {
@@ -195,22 +300,88 @@ func deleteBatchShared(
 			}
 		}
 
+		// Defer the rest of the batch while the deletion sentinel is unreachable or disabled
+		if !sentinel.Allow() {
+			logger := sdklog.NewLogger("zen-gc")
+			logger.Debug("Deletions suspended by deletion sentinel, deferring batch", sdklog.Operation("delete_batch"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Int("deferred", len(batch)-i))
+			return deletedCount, append(errors, &deferredBatchError{deferred: int64(len(batch) - i)})
+		}
+
+		// Defer the rest of the batch once the run's API call budget is spent
+		if apiCallBudgetExhausted(ctx) {
+			return deletedCount, append(errors, &deferredBatchError{deferred: int64(len(batch) - i)})
+		}
+
+		// Defer the rest of the batch while the API error rate is too high
+		if !breaker.Allow() {
+			logger := sdklog.NewLogger("zen-gc")
+			logger.Debug("Deletions paused by error rate breaker, deferring batch", sdklog.Operation("delete_batch"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Int("deferred", len(batch)-i))
+			return deletedCount, append(errors, &deferredBatchError{deferred: int64(len(batch) - i)})
+		}
+
+		// Claim the resource so overlapping policies delete it only once
+		if ok, owner := coordinator.BeginDelete(resource.GetUID(), policy); !ok {
+			logger := sdklog.NewLogger("zen-gc")
+			logger.Debug("Resource claimed by another policy, skipping", sdklog.Operation("delete_batch"), sdklog.String("resource", fmt.Sprintf("%s/%s", resource.GetNamespace(), resource.GetName())), sdklog.String("claimed_by", owner.String()))
+			continue
+		}
+
 		// Rate limiting (per resource)
 		if err := rateLimiter.Wait(ctx); err != nil {
+			coordinator.FinishDelete(resource.GetUID(), false)
 			errors = append(errors, fmt.Errorf("rate limiter error: %w", err))
 			continue
 		}
 
+		// Snapshot for recovery if enabled (non-fatal)
+		snapshotResourceShared(deleter.GetSnapshotDir(), policy, resource)
+
 		// Delete the resource with exponential backoff
 		deleteStart := time.Now()
-		if err := deleter.DeleteResourceWithBackoff(ctx, resource, policy, rateLimiter); err != nil {
+		err := deleter.DeleteResourceWithBackoff(ctx, resource, policy, rateLimiter)
+		if isAPICallBudgetExhausted(err) {
+			// Not attempted; retried next run
+			coordinator.FinishDelete(resource.GetUID(), false)
+			return deletedCount, append(errors, &deferredBatchError{deferred: int64(len(batch) - i)})
+		}
+		alreadyGone := isResourceAlreadyGone(err)
+		awaitingFinalizers := isAwaitingFinalizers(err)
+		modified := isResourceModified(err)
+		if alreadyGone || awaitingFinalizers || modified {
+			err = nil
+		}
+		coordinator.FinishDelete(resource.GetUID(), err == nil && !awaitingFinalizers && !modified)
+		if ctx.Err() == nil {
+			breaker.Record(err)
+		}
+		if awaitingFinalizers {
+			// Finalizer mode: retried on the next evaluation, neither deleted nor failed
+			logger := sdklog.NewLogger("zen-gc")
+			logger.Debug("Resource awaiting finalizers, deferring deletion", sdklog.Operation("delete_batch"), sdklog.String("resource", fmt.Sprintf("%s/%s", resource.GetNamespace(), resource.GetName())), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)))
+			continue
+		}
+		if modified {
+			// Preconditions: the resource changed since evaluation and is re-evaluated next run
+			recordResourceModifiedBeforeDeletion(policy.Namespace, policy.Name, resourceAPIVersion, resourceKind)
+			logger := sdklog.NewLogger("zen-gc")
+			logger.Debug("Resource modified since evaluation, deferring deletion", sdklog.Operation("delete_batch"), sdklog.String("resource", fmt.Sprintf("%s/%s", resource.GetNamespace(), resource.GetName())), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)))
+			continue
+		}
+		if alreadyGone {
+			// Deleted by another actor before us; not counted as deleted
+			recordResourceAlreadyGone(policy.Namespace, policy.Name, resourceAPIVersion, resourceKind)
+			logger := sdklog.NewLogger("zen-gc")
+			logger.Debug("Resource already gone", sdklog.Operation("delete_batch"), sdklog.String("resource", fmt.Sprintf("%s/%s", resource.GetNamespace(), resource.GetName())), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)))
+			continue
+		}
+		if err != nil {
 			gcErr := gcerrors.WithResource(
 				gcerrors.WithPolicy(err, policy.Namespace, policy.Name),
 				resource.GetNamespace(),
 				resource.GetName(),
 			)
-			gcErr.Type = "deletion_failed"
-			recordError(policy.Namespace, policy.Name, "deletion_failed")
+			gcErr.Type = gcerrors.TypeDeletionFailed
+			recordError(policy.Namespace, policy.Name, gcerrors.TypeDeletionFailed)
 			errors = append(errors, gcErr)
 			continue
 		}
@@ -219,39 +390,143 @@ func deleteBatchShared(
 		duration := time.Since(deleteStart).Seconds()
 		reason := reasons[string(resource.GetUID())]
 		recordResourceDeleted(policy.Namespace, policy.Name, resourceAPIVersion, resourceKind, reason, duration)
+		recordEligibilityLatencyShared(policy, resource, time.Now())
 		if eventRecorder := deleter.GetEventRecorder(); eventRecorder != nil {
 			eventRecorder.RecordResourceDeleted(policy, resource, reason)
 		}
+		if audit != nil && !dryRunShared(policy) {
+			audit.Record(AuditRecord{
+				Time:            time.Now().UTC(),
+				PolicyNamespace: policy.Namespace,
+				PolicyName:      policy.Name,
+				Group:           gvr.Group,
+				Version:         gvr.Version,
+				Resource:        gvr.Resource,
+				Namespace:       resource.GetNamespace(),
+				Name:            resource.GetName(),
+				UID:             string(resource.GetUID()),
+				Reason:          reason,
+			})
+		}
 		// Logger creation here is acceptable as deletion logging is infrequent
 		// Future optimization: pass logger as parameter to avoid allocations
 		logger := sdklog.NewLogger("zen-gc")
-		logger.Info("Deleted resource", sdklog.Operation("delete_batch"), sdklog.String("resource", fmt.Sprintf("%s/%s", resource.GetNamespace(), resource.GetName())), sdklog.String("reason", reason))
+		logger.Info("Deleted resource", sdklog.Operation("delete_batch"), sdklog.String("resource", fmt.Sprintf("%s/%s", resource.GetNamespace(), resource.GetName())), sdklog.String("reason", reason), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)))
 	}
 
 	return deletedCount, errors
 }
 
+// recordEligibilityLatencyShared records the time between a deleted resource's TTL expiration
+// and now. Resources without a TTL expiration in the past are not recorded.
+func recordEligibilityLatencyShared(policy *v1alpha1.GarbageCollectionPolicy, resource *unstructured.Unstructured, now time.Time) {
+	expirationTime, err := calculateExpirationTimeShared(missingFieldsViewShared(resource, policy), &policy.Spec.TTL)
+	if err != nil || expirationTime.IsZero() || expirationTime.After(now) {
+		return
+	}
+	recordEligibilityLatency(policy.Namespace, policy.Name, policy.Spec.TargetResource.APIVersion, policy.Spec.TargetResource.Kind, now.Sub(expirationTime).Seconds())
+}
+
 // TTLCalculator provides methods needed for TTL calculation.
 type TTLCalculator interface{}
 
 // calculateExpirationTimeShared is a shared implementation for calculating expiration time.
 // This now delegates to zen-sdk/pkg/gc/ttl for the actual evaluation.
 func calculateExpirationTimeShared(resource *unstructured.Unstructured, ttlSpec *v1alpha1.TTLSpec) (time.Time, error) {
+	if ttlSpec.Earliest != nil {
+		return calculateEarliestExpirationShared(resource, ttlSpec.Earliest)
+	}
+	if ttlSpec.Range != nil {
+		return calculateRangeExpirationShared(resource, ttlSpec.Range)
+	}
+
+	return calculateSDKExpirationShared(resource, ttlSpec)
+}
+
+// calculateSDKExpirationShared calculates the expiration of the TTL options the zen-sdk
+// evaluator implements. A labelKey is evaluated as a fieldPath holding the label's value,
+// so its mappings and default apply exactly as they do to a field.
+func calculateSDKExpirationShared(resource *unstructured.Unstructured, ttlSpec *v1alpha1.TTLSpec) (time.Time, error) {
 	// Convert v1alpha1.TTLSpec to zen-sdk ttl.Spec
-	sdkSpec := convertToSDKTTLSpec(ttlSpec)
-	return sdkttl.CalculateExpirationTime(resource, sdkSpec)
+	sdkSpec, err := convertToSDKTTLSpec(ttlSpec)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if ttlSpec.LabelKey != "" {
+		return sdkttl.CalculateExpirationTime(resolveSDKTTLLabel(resource, sdkSpec, ttlSpec.LabelKey))
+	}
+	return sdkttl.CalculateExpirationTime(resolveSDKTTLFields(resource, sdkSpec))
+}
+
+// calculateEarliestExpirationShared returns the earlier of the creation-based and
+// field-based expirations. If the field-based rule cannot be computed, the
+// creation-based expiration is used on its own.
+func calculateEarliestExpirationShared(resource *unstructured.Unstructured, earliest *v1alpha1.EarliestTTLSpec) (time.Time, error) {
+	if earliest.SecondsAfterCreation == nil {
+		return time.Time{}, sdkttl.ErrNoValidTTLConfiguration
+	}
+	expiration := resource.GetCreationTimestamp().Add(time.Duration(*earliest.SecondsAfterCreation) * time.Second)
+
+	if earliest.Field == nil {
+		return expiration, nil
+	}
+	fieldExpiration, err := calculateSDKExpirationShared(resource, earliest.Field)
+	switch {
+	case errors.Is(err, sdkttl.ErrRelativeTTLExpired):
+		// The relative rule has already passed; it is the binding one
+		fieldExpiration = time.Now()
+	case err != nil:
+		return expiration, nil
+	}
+
+	if fieldExpiration.Before(expiration) {
+		return fieldExpiration, nil
+	}
+	return expiration, nil
 }
 
-// convertToSDKTTLSpec converts zen-gc's TTLSpec to zen-sdk's ttl.Spec.
-func convertToSDKTTLSpec(gcSpec *v1alpha1.TTLSpec) *sdkttl.Spec {
+// convertToSDKTTLSpec converts zen-gc's TTLSpec to zen-sdk's ttl.Spec, resolving the
+// duration forms to their seconds fields.
+func convertToSDKTTLSpec(gcSpec *v1alpha1.TTLSpec) (*sdkttl.Spec, error) {
+	secondsAfterCreation, err := ttlSecondsShared(gcSpec.SecondsAfterCreation, gcSpec.DurationAfterCreation)
+	if err != nil {
+		return nil, err
+	}
+	secondsAfter, err := ttlSecondsShared(gcSpec.SecondsAfter, gcSpec.DurationAfter)
+	if err != nil {
+		return nil, err
+	}
 	return &sdkttl.Spec{
-		SecondsAfterCreation: gcSpec.SecondsAfterCreation,
+		SecondsAfterCreation: secondsAfterCreation,
 		FieldPath:            gcSpec.FieldPath,
 		Mappings:             gcSpec.Mappings,
 		Default:              gcSpec.Default,
 		RelativeTo:           gcSpec.RelativeTo,
-		SecondsAfter:         gcSpec.SecondsAfter,
+		SecondsAfter:         secondsAfter,
+	}, nil
+}
+
+// ttlSecondsShared returns seconds if set, otherwise the duration in whole seconds,
+// or nil if neither is set.
+func ttlSecondsShared(seconds *int64, duration string) (*int64, error) {
+	if seconds != nil || duration == "" {
+		return seconds, nil
+	}
+	d, err := validation.ParseTTLDuration(duration)
+	if err != nil {
+		return nil, err
 	}
+	value := int64(d / time.Second)
+	return &value, nil
+}
+
+// reasonAllowedShared reports whether a deletion reason is actionable under the policy's
+// allowedReasons. An empty allowlist permits every reason.
+func reasonAllowedShared(behavior *v1alpha1.BehaviorSpec, reason string) bool {
+	if len(behavior.AllowedReasons) == 0 {
+		return true
+	}
+	return slices.Contains(behavior.AllowedReasons, reason)
 }
 
 // getDeletionPropagationPolicy converts a string policy to metav1.DeletionPropagation.
@@ -276,7 +551,8 @@ type ResourceDeleterWithoutContext interface {
 	DeleteResourceWithoutContext(resource *unstructured.Unstructured, policy *v1alpha1.GarbageCollectionPolicy, rateLimiter *ratelimiter.RateLimiter) error
 }
 
-// deleteResourceWithBackoffShared deletes a resource with exponential backoff retry.
+// deleteResourceWithBackoffShared deletes a resource, retrying transient API errors up to
+// retry.maxRetries times with jittered exponential backoff.
 func deleteResourceWithBackoffShared(
 	ctx context.Context,
 	resource *unstructured.Unstructured,
@@ -284,14 +560,11 @@ func deleteResourceWithBackoffShared(
 	rateLimiter *ratelimiter.RateLimiter,
 	deleterWithCtx ResourceDeleterWithContext,
 	deleterWithoutCtx ResourceDeleterWithoutContext,
+	retry deleteBackoff,
 ) error {
 	var lastErr error
 
-	// Use zen-sdk backoff
-	backoffConfig := backoff.DefaultConfig()
-	b := backoff.NewBackoff(backoffConfig)
-
-	for !b.IsExhausted() {
+	for attempt := 0; attempt <= retry.maxRetries; attempt++ {
 		// Check if context is canceled
 		select {
 		case <-ctx.Done():
@@ -316,46 +589,44 @@ func deleteResourceWithBackoffShared(
 		if k8serrors.IsTimeout(err) || k8serrors.IsServerTimeout(err) ||
 			k8serrors.IsTooManyRequests(err) || k8serrors.IsServiceUnavailable(err) {
 			lastErr = err
-			// Wait for backoff duration before retry
-			duration := b.Next()
-			if duration == 0 {
-				break // exhausted
+			if attempt == retry.maxRetries {
+				break // retries exhausted, no point waiting
 			}
 			select {
 			case <-ctx.Done():
 				return ctx.Err()
-			case <-time.After(duration):
+			case <-time.After(jitteredBackoff(retry.interval(attempt))):
 				// Continue to retry
 			}
 			continue
 		}
 
-		// For NotFound errors, consider it success (already deleted)
+		// NotFound means the resource is already gone; callers decide whether that counts as deleted
 		if k8serrors.IsNotFound(err) {
-			return nil // success
+			return fmt.Errorf("%w: %w", ErrResourceAlreadyGone, err)
+		}
+
+		// A failed resourceVersion precondition fails again until the resource is re-evaluated
+		if k8serrors.IsConflict(err) {
+			return fmt.Errorf("%w: %w", ErrResourceModified, err)
 		}
 
 		// Non-retryable error
 		return err
 	}
 
-	// Backoff exhausted
+	// Retries exhausted
 	return fmt.Errorf("deletion failed after retries: %w", lastErr)
 }
 
-// meetsConditionsShared checks if a resource meets the deletion conditions.
+// meetsConditionsShared checks if a resource meets the deletion conditions that can be
+// evaluated from the resource alone; see meetsConditionsWithOwnersShared for owner chains.
 func meetsConditionsShared(resource *unstructured.Unstructured, conditions *v1alpha1.ConditionsSpec) bool {
-	if !meetsPhaseConditionsShared(resource, conditions.Phase) {
-		return false
-	}
-	if !meetsLabelConditionsShared(resource, conditions.HasLabels) {
-		return false
-	}
-	if !meetsAnnotationConditionsShared(resource, conditions.HasAnnotations) {
-		return false
-	}
-	if !meetsFieldConditionsShared(resource, conditions.And) {
-		return false
+	// Checks run cheapest first, so an in-memory mismatch spares hashing and OPA round trips
+	for _, check := range conditionChecks {
+		if !check.meets(resource, conditions) {
+			return false
+		}
 	}
 	return true
 }
@@ -377,6 +648,30 @@ func meetsPhaseConditionsShared(resource *unstructured.Unstructured, phases []st
 	return false
 }
 
+// meetsNotPhaseConditionsShared checks that the resource phase is none of the excluded
+// phases. A resource without a phase is in none of them.
+func meetsNotPhaseConditionsShared(resource *unstructured.Unstructured, notPhases []string) bool {
+	if len(notPhases) == 0 {
+		return true
+	}
+	phase, found, _ := unstructured.NestedString(resource.Object, "status", "phase")
+	return !found || !slices.Contains(notPhases, phase)
+}
+
+// meetsLabelCountConditionShared checks if the number of labels with the given key prefix exceeds the threshold.
+func meetsLabelCountConditionShared(resource *unstructured.Unstructured, cond *v1alpha1.LabelCountCondition) bool {
+	if cond.Threshold == nil {
+		return false
+	}
+	count := 0
+	for key := range resource.GetLabels() {
+		if strings.HasPrefix(key, cond.Prefix) {
+			count++
+		}
+	}
+	return count > int(*cond.Threshold)
+}
+
 // meetsLabelConditionsShared checks if resource labels match the required conditions.
 func meetsLabelConditionsShared(resource *unstructured.Unstructured, labelConds []v1alpha1.LabelCondition) bool {
 	resourceLabels := resource.GetLabels()
@@ -414,6 +709,10 @@ func meetsLabelConditionsShared(resource *unstructured.Unstructured, labelConds
 			if value == labelCond.Value {
 				return false
 			}
+		case OperatorGreaterThan, OperatorGreaterThanOrEqual, OperatorLessThan, OperatorLessThanOrEqual:
+			if !exists || !meetsLabelComparisonShared(value, labelCond) {
+				return false
+			}
 		default:
 			// Unknown operator - fail safe by rejecting
 			logger := sdklog.NewLogger("zen-gc")
@@ -439,8 +738,13 @@ func meetsAnnotationConditionsShared(resource *unstructured.Unstructured, annCon
 // meetsFieldConditionsShared checks if resource fields match the required conditions.
 func meetsFieldConditionsShared(resource *unstructured.Unstructured, fieldConds []v1alpha1.FieldCondition) bool {
 	for _, fieldCond := range fieldConds {
-		fieldPath := parseFieldPath(fieldCond.FieldPath)
-		fieldValue, found, _ := unstructured.NestedString(resource.Object, fieldPath...)
+		if isFieldComparisonOperator(fieldCond.Operator) {
+			if !meetsFieldComparisonShared(resource, fieldCond) {
+				return false
+			}
+			continue
+		}
+		fieldValue, found, _ := nestedString(resource.Object, fieldCond.FieldPath)
 		if !found {
 			return false
 		}
@@ -451,6 +755,34 @@ func meetsFieldConditionsShared(resource *unstructured.Unstructured, fieldConds
 	return true
 }
 
+// meetsAnyFieldConditionShared checks if at least one field condition matches.
+// An empty list matches every resource.
+func meetsAnyFieldConditionShared(resource *unstructured.Unstructured, fieldConds []v1alpha1.FieldCondition) bool {
+	if len(fieldConds) == 0 {
+		return true
+	}
+	for i := range fieldConds {
+		if meetsFieldConditionsShared(resource, fieldConds[i:i+1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// meetsAnyOfConditionsShared checks if the resource meets at least one nested condition group.
+// An empty list matches every resource.
+func meetsAnyOfConditionsShared(resource *unstructured.Unstructured, groups []v1alpha1.ConditionsSpec) bool {
+	if len(groups) == 0 {
+		return true
+	}
+	for i := range groups {
+		if meetsConditionsShared(resource, &groups[i]) {
+			return true
+		}
+	}
+	return false
+}
+
 // matchesFieldOperatorShared checks if field value matches the operator condition.
 func matchesFieldOperatorShared(fieldValue string, fieldCond v1alpha1.FieldCondition) bool {
 	switch fieldCond.Operator {
@@ -458,6 +790,9 @@ func matchesFieldOperatorShared(fieldValue string, fieldCond v1alpha1.FieldCondi
 		return fieldValue == fieldCond.Value
 	case "NotEquals":
 		return fieldValue != fieldCond.Value
+	case "Exists":
+		// The field was found, which is all Exists requires
+		return true
 	case "In":
 		for _, v := range fieldCond.Values {
 			if fieldValue == v {
@@ -492,22 +827,39 @@ func matchesSelectorsShared(resource *unstructured.Unstructured, target *v1alpha
 		}
 	}
 
+	// Check excluded namespaces
+	if namespaceExcludedShared(resource.GetNamespace(), target.ExcludeNamespaces) {
+		return false
+	}
+
+	// Check UID list
+	if len(target.UIDs) > 0 && !slices.Contains(target.UIDs, string(resource.GetUID())) {
+		return false
+	}
+
+	// Labels used for selector matching, including annotation-derived virtual labels
+	resourceLabels := selectorLabels(resource, target.VirtualLabelAnnotations)
+
 	// Check label selector
 	if target.LabelSelector != nil {
 		selector, err := metav1.LabelSelectorAsSelector(target.LabelSelector)
 		if err != nil {
-			gcErr := gcerrors.Wrap(err, "invalid_label_selector", "invalid label selector")
+			gcErr := gcerrors.Wrap(err, gcerrors.TypeInvalidLabelSelector, "invalid label selector")
 			logger := sdklog.NewLogger("zen-gc")
 			logger.Error(gcErr, "Invalid label selector", sdklog.Operation("matches_selectors"), sdklog.ErrorCode("INVALID_LABEL_SELECTOR"))
 			return false
 		}
 
-		resourceLabels := labels.Set(resource.GetLabels())
 		if !selector.Matches(resourceLabels) {
 			return false
 		}
 	}
 
+	// Check any-of label selectors
+	if len(target.LabelSelectors) > 0 && !matchesAnyLabelSelector(resourceLabels, target.LabelSelectors) {
+		return false
+	}
+
 	// Check field selector
 	// Field selectors are evaluated in-memory only (not pushed down to API server).
 	// Unlike label selectors which are sent to the API server to reduce watch/list volume,
@@ -518,8 +870,7 @@ func matchesSelectorsShared(resource *unstructured.Unstructured, target *v1alpha
 	// For better performance, prefer label selectors when possible.
 	if target.FieldSelector != nil {
 		for key, value := range target.FieldSelector.MatchFields {
-			fieldPath := parseFieldPath(key)
-			fieldValue, found, err := unstructured.NestedString(resource.Object, fieldPath...)
+			fieldValue, found, err := nestedString(resource.Object, key)
 			if err != nil || !found || fieldValue != value {
 				return false
 			}
@@ -0,0 +1,133 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/validation"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+// informerKey identifies a watch: policies with the same key see the same objects and share
+// one informer.
+type informerKey struct {
+	gvr           schema.GroupVersionResource
+	namespace     string
+	labelSelector string
+}
+
+// String returns the key in a form suitable for logs.
+func (k informerKey) String() string {
+	return fmt.Sprintf("%s/%s namespace=%q labelSelector=%q", k.gvr.GroupVersion(), k.gvr.Resource, k.namespace, k.labelSelector)
+}
+
+// sharedInformer is a resource informer and the policies referencing it. The watch is
+// stopped when the last policy releases it.
+type sharedInformer struct {
+	informer cache.SharedInformer
+	factory  dynamicinformer.DynamicSharedInformerFactory
+	cancel   context.CancelFunc
+	policies map[types.UID]struct{}
+}
+
+// informerKeyForPolicy returns the key of the watch a policy needs.
+func informerKeyForPolicy(policy *v1alpha1.GarbageCollectionPolicy) (informerKey, error) {
+	gvr, err := validation.ParseGVR(policy.Spec.TargetResource.APIVersion, policy.Spec.TargetResource.Kind)
+	if err != nil {
+		return informerKey{}, fmt.Errorf("invalid target resource: %w", err)
+	}
+	return informerKey{
+		gvr:           gvr,
+		namespace:     normalizeNamespace(policy.Spec.TargetResource.Namespace),
+		labelSelector: listLabelSelector(&policy.Spec.TargetResource),
+	}, nil
+}
+
+// addMissingIndexers adds the indexers a policy needs that a shared informer does not have
+// yet. Indexes are keyed by name, so policies needing the same index share it.
+func addMissingIndexers(informer cache.SharedInformer, indexers cache.Indexers) error {
+	indexed, ok := informer.(cache.SharedIndexInformer)
+	if !ok || len(indexers) == 0 {
+		return nil
+	}
+	existing := indexed.GetIndexer().GetIndexers()
+	missing := cache.Indexers{}
+	for name, indexFunc := range indexers {
+		if _, ok := existing[name]; !ok {
+			missing[name] = indexFunc
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	if err := indexed.AddIndexers(missing); err != nil {
+		return fmt.Errorf("failed to add informer indexers: %w", err)
+	}
+	return nil
+}
+
+// acquireSharedInformerLocked points a policy at the shared informer for key, replacing
+// any informer it referenced before, and returns the informer. Caller must hold
+// resourceInformersMu for writing.
+func (r *GCPolicyReconciler) acquireSharedInformerLocked(policyUID types.UID, key informerKey, shared *sharedInformer) cache.SharedInformer {
+	if oldKey, ok := r.policyInformerKeys[policyUID]; ok && oldKey == key {
+		return shared.informer
+	}
+	r.releaseSharedInformerLocked(policyUID)
+
+	if r.policyInformerKeys == nil {
+		r.policyInformerKeys = make(map[types.UID]informerKey)
+	}
+	shared.policies[policyUID] = struct{}{}
+	r.policyInformerKeys[policyUID] = key
+	r.resourceInformers[policyUID] = shared.informer
+	return shared.informer
+}
+
+// releaseSharedInformerLocked drops a policy's reference to its shared informer and stops
+// the watch if no other policy references it. Caller must hold resourceInformersMu for
+// writing.
+func (r *GCPolicyReconciler) releaseSharedInformerLocked(policyUID types.UID) {
+	delete(r.resourceInformers, policyUID)
+	key, ok := r.policyInformerKeys[policyUID]
+	if !ok {
+		return
+	}
+	delete(r.policyInformerKeys, policyUID)
+
+	shared, ok := r.sharedInformers[key]
+	if !ok {
+		return
+	}
+	delete(shared.policies, policyUID)
+	if len(shared.policies) > 0 {
+		return
+	}
+	if shared.cancel != nil {
+		shared.cancel()
+	}
+	delete(r.sharedInformers, key)
+	r.logger.Debug("Stopped resource informer no longer referenced by any policy", sdklog.Operation("cleanup_informer"), sdklog.String("informer", key.String()))
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func waitInformerStopped(t *testing.T, informer cache.SharedInformer, want bool) {
+	t.Helper()
+	stoppable, ok := informer.(interface{ IsStopped() bool })
+	if !ok {
+		return
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for stoppable.IsStopped() != want && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if stoppable.IsStopped() != want {
+		t.Errorf("informer stopped = %v, want %v", stoppable.IsStopped(), want)
+	}
+}
+
+func TestGetOrCreateResourceInformer_SharesInformerForSameWatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reconciler := setupInformerTestReconciler(t)
+	first := newTestPolicy("first")
+	first.Spec.TargetResource.Namespace = "team-a"
+	second := newTestPolicy("second")
+	second.Spec.TargetResource.Namespace = "team-a"
+	defer reconciler.cleanupResourceInformer(first.UID)
+	defer reconciler.cleanupResourceInformer(second.UID)
+
+	firstInformer, err := reconciler.getOrCreateResourceInformer(ctx, first)
+	if err != nil {
+		t.Fatalf("getOrCreateResourceInformer(first) returned error: %v", err)
+	}
+	secondInformer, err := reconciler.getOrCreateResourceInformer(ctx, second)
+	if err != nil {
+		t.Fatalf("getOrCreateResourceInformer(second) returned error: %v", err)
+	}
+	if firstInformer != secondInformer {
+		t.Fatal("expected policies with the same watch to share one informer")
+	}
+	if n := len(reconciler.sharedInformers); n != 1 {
+		t.Fatalf("%d informers running, want 1", n)
+	}
+
+	// Releasing one policy keeps the watch for the other
+	reconciler.cleanupResourceInformer(first.UID)
+	if n := len(reconciler.sharedInformers); n != 1 {
+		t.Fatalf("%d informers running after releasing one policy, want 1", n)
+	}
+	waitInformerStopped(t, secondInformer, false)
+	if names := storeNames(secondInformer); !names["cm-a"] {
+		t.Errorf("shared informer store = %v, want cm-a", names)
+	}
+
+	// Releasing the last policy stops the watch
+	reconciler.cleanupResourceInformer(second.UID)
+	if n := len(reconciler.sharedInformers); n != 0 {
+		t.Fatalf("%d informers running after releasing every policy, want 0", n)
+	}
+	waitInformerStopped(t, secondInformer, true)
+}
+
+func TestGetOrCreateResourceInformer_SeparateInformersForDifferentWatches(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reconciler := setupInformerTestReconciler(t)
+	otherNamespace := newTestPolicy("namespace")
+	otherNamespace.Spec.TargetResource.Namespace = "team-b"
+	otherSelector := newTestPolicy("selector")
+	otherSelector.Spec.TargetResource.Namespace = "team-a"
+	otherSelector.Spec.TargetResource.LabelSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"temp": "true"}}
+	base := newTestPolicy("base")
+	base.Spec.TargetResource.Namespace = "team-a"
+
+	for _, policy := range []*v1alpha1.GarbageCollectionPolicy{base, otherNamespace, otherSelector} {
+		defer reconciler.cleanupResourceInformer(policy.UID)
+		if _, err := reconciler.getOrCreateResourceInformer(ctx, policy); err != nil {
+			t.Fatalf("getOrCreateResourceInformer(%s) returned error: %v", policy.Name, err)
+		}
+	}
+	if n := len(reconciler.sharedInformers); n != 3 {
+		t.Errorf("%d informers running, want one per distinct watch (3)", n)
+	}
+}
+
+func TestGetOrCreateResourceInformer_SharedInformerIgnoresCap(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reconciler := setupInformerTestReconciler(t)
+	reconciler.config.WithMaxInformers(1)
+	first := newTestPolicy("first")
+	first.Spec.TargetResource.Namespace = "team-a"
+	second := newTestPolicy("second")
+	second.Spec.TargetResource.Namespace = "team-a"
+	defer reconciler.cleanupResourceInformer(first.UID)
+	defer reconciler.cleanupResourceInformer(second.UID)
+
+	if _, err := reconciler.getOrCreateResourceInformer(ctx, first); err != nil {
+		t.Fatalf("first policy should be admitted: %v", err)
+	}
+	if _, err := reconciler.getOrCreateResourceInformer(ctx, second); err != nil {
+		t.Errorf("a policy joining a running watch should not count against the cap: %v", err)
+	}
+}
+
+func TestRecreateResourceInformer_JoinsRunningWatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reconciler := setupInformerTestReconciler(t)
+	stays := newTestPolicy("stays")
+	stays.Spec.TargetResource.Namespace = "team-b"
+	moves := newTestPolicy("moves")
+	moves.Spec.TargetResource.Namespace = "team-a"
+	defer reconciler.cleanupResourceInformer(stays.UID)
+	defer reconciler.cleanupResourceInformer(moves.UID)
+
+	target, err := reconciler.getOrCreateResourceInformer(ctx, stays)
+	if err != nil {
+		t.Fatalf("getOrCreateResourceInformer(stays) returned error: %v", err)
+	}
+	old, err := reconciler.getOrCreateResourceInformer(ctx, moves)
+	if err != nil {
+		t.Fatalf("getOrCreateResourceInformer(moves) returned error: %v", err)
+	}
+
+	moves.Spec.TargetResource.Namespace = "team-b"
+	if err := reconciler.recreateResourceInformer(ctx, moves); err != nil {
+		t.Fatalf("recreateResourceInformer() returned error: %v", err)
+	}
+
+	reconciler.resourceInformersMu.RLock()
+	current := reconciler.resourceInformers[moves.UID]
+	running := len(reconciler.sharedInformers)
+	reconciler.resourceInformersMu.RUnlock()
+	if current != target {
+		t.Error("expected the moved policy to join the running watch for its new namespace")
+	}
+	if running != 1 {
+		t.Errorf("%d informers running, want 1", running)
+	}
+	waitInformerStopped(t, old, true)
+}
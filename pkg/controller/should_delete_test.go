@@ -1,6 +1,7 @@
 package controller
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -136,3 +137,51 @@ func TestGCPolicyReconciler_shouldDelete_NoTTL(t *testing.T) {
 		t.Errorf("shouldDelete() reason = %q, want %q", reason, ReasonNoTTL)
 	}
 }
+
+func TestReasonAllowedShared(t *testing.T) {
+	tests := []struct {
+		name     string
+		allowed  []string
+		reason   string
+		expected bool
+	}{
+		{name: "empty allowlist allows all", allowed: nil, reason: ReasonTTLExpired, expected: true},
+		{name: "reason in allowlist", allowed: []string{ReasonTTLExpired}, reason: ReasonTTLExpired, expected: true},
+		{name: "reason not in allowlist", allowed: []string{"size_exceeded"}, reason: ReasonTTLExpired, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			behavior := &v1alpha1.BehaviorSpec{AllowedReasons: tt.allowed}
+			if got := reasonAllowedShared(behavior, tt.reason); got != tt.expected {
+				t.Errorf("reasonAllowedShared(%v, %q) = %v, want %v", tt.allowed, tt.reason, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEvaluateResources_ReasonOutsideAllowlistDefersDeletion(t *testing.T) {
+	service := NewPolicyEvaluationService(nil, NewDefaultSelectorMatcher(), NewDefaultConditionMatcher(), nil, nil, nil, nil, nil, nil)
+	expired := newTestConfigMap("default", "expired", time.Now().Add(-2*time.Hour))
+	policy := &v1alpha1.GarbageCollectionPolicy{
+		Spec: v1alpha1.GarbageCollectionPolicySpec{
+			TargetResource: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap"},
+			TTL:            v1alpha1.TTLSpec{SecondsAfterCreation: int64Ptr(3600)},
+			Behavior:       v1alpha1.BehaviorSpec{AllowedReasons: []string{"size_exceeded"}},
+		},
+	}
+
+	var oldest oldestPending
+	toDelete := make([]*unstructured.Unstructured, 0)
+	matched, pending := service.evaluateResources(context.Background(), []*unstructured.Unstructured{expired}, policy, &toDelete, make(map[string]string), "v1", "ConfigMap", &oldest, nil)
+	if matched != 1 || pending != 1 || len(toDelete) != 0 {
+		t.Errorf("matched=%d pending=%d toDelete=%d, want 1/1/0", matched, pending, len(toDelete))
+	}
+
+	// Allowing ttl_expired makes the same resource deletable
+	policy.Spec.Behavior.AllowedReasons = []string{ReasonTTLExpired}
+	matched, pending = service.evaluateResources(context.Background(), []*unstructured.Unstructured{expired}, policy, &toDelete, make(map[string]string), "v1", "ConfigMap", &oldest, nil)
+	if matched != 1 || pending != 0 || len(toDelete) != 1 {
+		t.Errorf("matched=%d pending=%d toDelete=%d, want 1/0/1", matched, pending, len(toDelete))
+	}
+}
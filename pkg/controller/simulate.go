@@ -0,0 +1,118 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/config"
+	"github.com/kube-zen/zen-sdk/pkg/gc/ratelimiter"
+)
+
+// SimulatedDeletion is a resource a simulated evaluation would delete.
+type SimulatedDeletion struct {
+	Namespace string
+	Name      string
+	Reason    string
+}
+
+// SimulatePolicy evaluates a policy against the live resources the client lists, through
+// the PolicyEvaluationService the controller evaluates policies with, and returns the
+// resources it would delete, sorted by namespace and name. The policy need not exist in
+// the cluster. Nothing is deleted or written: deletions are recorded instead, and the
+// policy's status, events, result webhook and decision annotations are skipped. Gates that
+// only delay deletions to a later run (confirmDeletions, deletionNoticeSeconds,
+// batchInterval) are not applied, so the result is what the policy deletes once they pass.
+func SimulatePolicy(ctx context.Context, client dynamic.Interface, policy *v1alpha1.GarbageCollectionPolicy) ([]SimulatedDeletion, error) {
+	simulated := policy.DeepCopy()
+	resolveTargetNamespaceShared(nil, simulated, config.DefaultTargetNamespaceMode)
+	if err := resolveDataDriftReferenceShared(ctx, client, simulated); err != nil {
+		return nil, err
+	}
+	if err := resolveBadHashesConfigMapsShared(ctx, client, simulated); err != nil {
+		return nil, err
+	}
+	behavior := &simulated.Spec.Behavior
+	behavior.ConfirmDeletions = false
+	behavior.DeletionNoticeSeconds = nil
+	behavior.BatchInterval = nil
+	behavior.ResultWebhook = nil
+	behavior.AnnotateDecisions = false
+
+	deleter := &recordingBatchDeleter{}
+	service := NewPolicyEvaluationService(
+		NewDefaultResourceLister(client),
+		NewDefaultSelectorMatcher(),
+		&simulationConditionMatcher{owners: NewOwnerLookupCache(client)},
+		nil, // TTLCalculator (using shared function for now)
+		NewDefaultRateLimiterProvider(config.NewControllerConfig()),
+		deleter,
+		nil, // No status updates
+		nil, // No events
+		nil,
+	)
+	if err := service.EvaluatePolicy(ctx, simulated); err != nil {
+		return nil, err
+	}
+
+	deletions := deleter.deletions
+	sort.Slice(deletions, func(i, j int) bool {
+		if deletions[i].Namespace != deletions[j].Namespace {
+			return deletions[i].Namespace < deletions[j].Namespace
+		}
+		return deletions[i].Name < deletions[j].Name
+	})
+	return deletions, nil
+}
+
+// simulationConditionMatcher matches conditions like the controller does, owner chain
+// conditions included, looking owners up through the client.
+type simulationConditionMatcher struct {
+	owners *OwnerLookupCache
+}
+
+// MeetsConditions checks if a resource meets the given conditions.
+func (m *simulationConditionMatcher) MeetsConditions(resource *unstructured.Unstructured, conditions *v1alpha1.ConditionsSpec) bool {
+	return meetsConditionsWithOwnersShared(resource, conditions, m.owners)
+}
+
+// recordingBatchDeleter records the resources it is asked to delete instead of deleting
+// them, reporting each as deleted so the evaluation proceeds as it would.
+type recordingBatchDeleter struct {
+	mu        sync.Mutex
+	deletions []SimulatedDeletion
+}
+
+// DeleteBatch records the batch and reports every resource as deleted.
+func (d *recordingBatchDeleter) DeleteBatch(ctx context.Context, batch []*unstructured.Unstructured, policy *v1alpha1.GarbageCollectionPolicy, rateLimiter *ratelimiter.RateLimiter, reasons map[string]string) (int64, []error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, resource := range batch {
+		d.deletions = append(d.deletions, SimulatedDeletion{
+			Namespace: resource.GetNamespace(),
+			Name:      resource.GetName(),
+			Reason:    reasons[string(resource.GetUID())],
+		})
+	}
+	return int64(len(batch)), nil
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func TestSimulatePolicy(t *testing.T) {
+	fresh := newTestConfigMap("team-a", "fresh", time.Now())
+	expiredB := newTestConfigMap("team-b", "expired-b", time.Now().Add(-2*time.Hour))
+	dynamicClient := fake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{observeTestConfigMapGVR: "ConfigMapList"},
+		newTestConfigMap("default", "expired-a", time.Now().Add(-2*time.Hour)),
+		expiredB,
+		fresh,
+	)
+
+	ttl := int64(3600)
+	policy := &v1alpha1.GarbageCollectionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "simulated", Namespace: "default"},
+		Spec: v1alpha1.GarbageCollectionPolicySpec{
+			TargetResource: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap"},
+			TTL:            v1alpha1.TTLSpec{SecondsAfterCreation: &ttl},
+			// Only delays deletions to a later run, so it does not hide them from a simulation
+			Behavior: v1alpha1.BehaviorSpec{ConfirmDeletions: true},
+		},
+	}
+
+	deletions, err := SimulatePolicy(context.Background(), dynamicClient, policy)
+	if err != nil {
+		t.Fatalf("SimulatePolicy() returned error: %v", err)
+	}
+	want := []SimulatedDeletion{
+		{Namespace: "default", Name: "expired-a", Reason: ReasonTTLExpired},
+		{Namespace: "team-b", Name: "expired-b", Reason: ReasonTTLExpired},
+	}
+	if len(deletions) != len(want) {
+		t.Fatalf("SimulatePolicy() = %+v, want %+v", deletions, want)
+	}
+	for i := range want {
+		if deletions[i] != want[i] {
+			t.Errorf("deletion %d = %+v, want %+v", i, deletions[i], want[i])
+		}
+	}
+
+	for _, action := range dynamicClient.Actions() {
+		if action.GetVerb() != "list" && action.GetVerb() != "get" {
+			t.Errorf("simulation made a %s call, want only reads", action.GetVerb())
+		}
+	}
+	if policy.Spec.TargetResource.Namespace != "" || !policy.Spec.Behavior.ConfirmDeletions {
+		t.Error("SimulatePolicy() modified the caller's policy")
+	}
+}
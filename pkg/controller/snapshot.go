@@ -0,0 +1,138 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	gcerrors "github.com/kube-zen/zen-gc/pkg/errors"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+const (
+	// DefaultSnapshotRetention is the default number of snapshots kept per policy.
+	DefaultSnapshotRetention = 100
+
+	// snapshotTimeFormat sorts lexically in chronological order.
+	snapshotTimeFormat = "20060102T150405.000000000Z"
+
+	// snapshotFileSuffix is the file extension of snapshot files.
+	snapshotFileSuffix = ".json"
+
+	// clusterScopedSnapshotNamespace replaces the empty namespace of cluster-scoped resources in file names.
+	clusterScopedSnapshotNamespace = "_cluster"
+)
+
+// snapshotPolicyDir returns the per-policy snapshot directory.
+func snapshotPolicyDir(baseDir string, policy *v1alpha1.GarbageCollectionPolicy) string {
+	return filepath.Join(baseDir, policy.Namespace, policy.Name)
+}
+
+// writeSnapshot writes the resource manifest to the policy's directory under the snapshot
+// root and prunes snapshots beyond the retention limit. Returns the snapshot path.
+func writeSnapshot(root string, policy *v1alpha1.GarbageCollectionPolicy, resource *unstructured.Unstructured, now time.Time) (string, error) {
+	dir := snapshotPolicyDir(root, policy)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("failed to create snapshot directory: %w", err)
+	}
+
+	data, err := resource.MarshalJSON()
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal resource: %w", err)
+	}
+
+	namespace := resource.GetNamespace()
+	if namespace == "" {
+		namespace = clusterScopedSnapshotNamespace
+	}
+	name := fmt.Sprintf("%s_%s_%s_%s%s", now.UTC().Format(snapshotTimeFormat), namespace, resource.GetName(), resource.GetUID(), snapshotFileSuffix)
+	path := filepath.Join(dir, name)
+
+	// Write to a temporary file first so a partial write never looks like a snapshot
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return "", fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return "", fmt.Errorf("failed to write snapshot: %w", err)
+	}
+
+	retention := policy.Spec.Behavior.SnapshotRetention
+	if retention <= 0 {
+		retention = DefaultSnapshotRetention
+	}
+	if err := pruneSnapshots(dir, retention); err != nil {
+		return path, err
+	}
+	return path, nil
+}
+
+// pruneSnapshots removes the oldest snapshots in dir so that at most retention remain.
+func pruneSnapshots(dir string, retention int) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("failed to list snapshots: %w", err)
+	}
+
+	snapshots := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Type().IsRegular() && strings.HasSuffix(entry.Name(), snapshotFileSuffix) {
+			snapshots = append(snapshots, entry.Name())
+		}
+	}
+	if len(snapshots) <= retention {
+		return nil
+	}
+
+	// File names start with a sortable timestamp
+	sort.Strings(snapshots)
+	for _, name := range snapshots[:len(snapshots)-retention] {
+		if err := os.Remove(filepath.Join(dir, name)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to prune snapshot: %w", err)
+		}
+	}
+	return nil
+}
+
+// isSecretShared reports whether resource is a core Secret.
+func isSecretShared(resource *unstructured.Unstructured) bool {
+	return resource.GetAPIVersion() == "v1" && resource.GetKind() == "Secret"
+}
+
+// snapshotResourceShared snapshots a resource under the controller's snapshot root before
+// deletion if the policy enables it. Secrets are never written to disk. Failures are logged
+// and counted but never block deletion.
+func snapshotResourceShared(root string, policy *v1alpha1.GarbageCollectionPolicy, resource *unstructured.Unstructured) {
+	if root == "" || !policy.Spec.Behavior.Snapshot || dryRunShared(policy) || isSecretShared(resource) {
+		return
+	}
+
+	if _, err := writeSnapshot(root, policy, resource, time.Now()); err != nil {
+		recordError(policy.Namespace, policy.Name, gcerrors.TypeSnapshotFailed)
+		logger := sdklog.NewLogger("zen-gc")
+		logger.Warn("Failed to snapshot resource before deletion, deleting anyway", sdklog.Operation("snapshot_resource"), sdklog.String("resource", fmt.Sprintf("%s/%s", resource.GetNamespace(), resource.GetName())), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Error(err))
+	}
+}
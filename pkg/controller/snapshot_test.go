@@ -0,0 +1,155 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-sdk/pkg/gc/ratelimiter"
+)
+
+func TestWriteSnapshot_WritesManifest(t *testing.T) {
+	root := t.TempDir()
+	policy := newTestPolicy("snap")
+	resource := newTestConfigMap("default", "cm", time.Now())
+	resource.SetLabels(map[string]string{"app": "test"})
+
+	path, err := writeSnapshot(root, policy, resource, time.Now())
+	if err != nil {
+		t.Fatalf("writeSnapshot() returned error: %v", err)
+	}
+	if filepath.Dir(path) != filepath.Join(root, "default", "snap") {
+		t.Errorf("snapshot written to %s, want per-policy directory", path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read snapshot: %v", err)
+	}
+	restored := &unstructured.Unstructured{}
+	if err := json.Unmarshal(data, &restored.Object); err != nil {
+		t.Fatalf("snapshot is not valid JSON: %v", err)
+	}
+	if restored.GetName() != "cm" || restored.GetLabels()["app"] != "test" {
+		t.Errorf("snapshot does not contain the full manifest: %v", restored.Object)
+	}
+}
+
+func TestWriteSnapshot_PrunesOldest(t *testing.T) {
+	root := t.TempDir()
+	policy := newTestPolicy("snap")
+	policy.Spec.Behavior.SnapshotRetention = 2
+
+	start := time.Now()
+	var paths []string
+	for i, name := range []string{"first", "second", "third"} {
+		path, err := writeSnapshot(root, policy, newTestConfigMap("default", name, time.Now()), start.Add(time.Duration(i)*time.Second))
+		if err != nil {
+			t.Fatalf("writeSnapshot(%s) returned error: %v", name, err)
+		}
+		paths = append(paths, path)
+	}
+
+	if _, err := os.Stat(paths[0]); !os.IsNotExist(err) {
+		t.Error("expected oldest snapshot to be pruned")
+	}
+	for _, path := range paths[1:] {
+		if _, err := os.Stat(path); err != nil {
+			t.Errorf("expected snapshot %s to be kept: %v", path, err)
+		}
+	}
+}
+
+func TestDeleteBatch_SnapshotFailureDoesNotBlockDeletion(t *testing.T) {
+	// A regular file where the snapshot directory should be makes every write fail
+	blocker := filepath.Join(t.TempDir(), "not-a-dir")
+	if err := os.WriteFile(blocker, nil, 0o600); err != nil {
+		t.Fatalf("failed to create blocker file: %v", err)
+	}
+
+	policy := newTestPolicy("snap")
+	policy.Spec.Behavior.Snapshot = true
+	deleter := &fakeBatchDeleter{deletedBy: map[string][]string{}, snapshotDir: blocker}
+	batch := []*unstructured.Unstructured{newTestConfigMap("default", "a", time.Now())}
+
+	deleted, errs := deleteBatchShared(context.Background(), batch, policy, ratelimiter.NewRateLimiter(1000), map[string]string{}, deleter)
+	if deleted != 1 || len(errs) != 0 {
+		t.Errorf("deleted=%d errs=%v, want deletion despite snapshot failure", deleted, errs)
+	}
+}
+
+func TestDeleteBatch_NoSnapshotInDryRun(t *testing.T) {
+	dir := t.TempDir()
+	policy := newTestPolicy("snap")
+	policy.Spec.Behavior.Snapshot = true
+	policy.Spec.Behavior.DryRun = true
+	deleter := &fakeBatchDeleter{deletedBy: map[string][]string{}, snapshotDir: dir}
+	batch := []*unstructured.Unstructured{newTestConfigMap("default", "a", time.Now())}
+
+	deleteBatchShared(context.Background(), batch, policy, ratelimiter.NewRateLimiter(1000), map[string]string{}, deleter)
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("failed to read snapshot dir: %v", err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("expected no snapshots in dry-run mode, found %d entries", len(entries))
+	}
+}
+
+func TestDeleteBatch_SnapshotsOnlyOptedInNonSecrets(t *testing.T) {
+	root := t.TempDir()
+	secret := newTestConfigMap("default", "secret", time.Now())
+	secret.SetKind("Secret")
+	batch := []*unstructured.Unstructured{newTestConfigMap("default", "a", time.Now()), secret}
+
+	// A policy that does not opt in writes nothing, even with a controller snapshot root
+	deleter := &fakeBatchDeleter{deletedBy: map[string][]string{}, snapshotDir: root}
+	deleteBatchShared(context.Background(), batch, newTestPolicy("plain"), ratelimiter.NewRateLimiter(1000), map[string]string{}, deleter)
+	if entries, _ := os.ReadDir(filepath.Join(root, "default", "plain")); len(entries) != 0 {
+		t.Errorf("found %d snapshots of a policy without snapshot, want none", len(entries))
+	}
+
+	// Without a controller snapshot root nothing is written even when the policy opts in
+	policy := newTestPolicy("snap")
+	policy.Spec.Behavior.Snapshot = true
+	deleteBatchShared(context.Background(), batch, policy, ratelimiter.NewRateLimiter(1000), map[string]string{}, &fakeBatchDeleter{deletedBy: map[string][]string{}})
+	if _, err := os.Stat(filepath.Join(root, "default", "snap")); !os.IsNotExist(err) {
+		t.Errorf("snapshot directory exists without a controller snapshot root: %v", err)
+	}
+
+	// The Secret is deleted but never written to disk
+	deleted, errs := deleteBatchShared(context.Background(), batch, policy, ratelimiter.NewRateLimiter(1000), map[string]string{}, deleter)
+	if deleted != 2 || len(errs) != 0 {
+		t.Fatalf("deleted=%d errs=%v, want both resources deleted", deleted, errs)
+	}
+	entries, err := os.ReadDir(filepath.Join(root, "default", "snap"))
+	if err != nil {
+		t.Fatalf("failed to read snapshot dir: %v", err)
+	}
+	if len(entries) != 1 || !strings.Contains(entries[0].Name(), "_a_") {
+		t.Errorf("snapshots = %v, want only the ConfigMap", entries)
+	}
+}
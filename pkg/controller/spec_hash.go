@@ -0,0 +1,165 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// computeSpecHash returns the hex SHA-256 of the selected fields of a resource.
+// The hashed document is the JSON array [[fieldPath, value], ...] in the given
+// field order; missing fields hash as null.
+func computeSpecHash(resource *unstructured.Unstructured, fields []string) (string, error) {
+	pairs := make([]interface{}, 0, len(fields))
+	for _, field := range fields {
+		value, found, err := nestedFieldNoCopy(resource.Object, field)
+		if err != nil || !found {
+			value = nil
+		}
+		pairs = append(pairs, []interface{}{field, value})
+	}
+
+	data, err := json.Marshal(pairs)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal spec fields: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// normalizeSpecHash lowercases a hash and strips an optional "sha256:" prefix.
+func normalizeSpecHash(hash string) string {
+	hash = strings.ToLower(strings.TrimSpace(hash))
+	return strings.TrimPrefix(hash, "sha256:")
+}
+
+// ErrNoSpecHashClient indicates no client is available to read a bad hashes ConfigMap.
+var ErrNoSpecHashClient = errors.New("no client available to read bad hashes ConfigMap")
+
+// parseBadHashes parses a list of hashes, one per line. Blank lines and lines starting
+// with '#' are ignored.
+func parseBadHashes(list string) []string {
+	var hashes []string
+	for _, line := range strings.Split(list, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		hashes = append(hashes, normalizeSpecHash(line))
+	}
+	return hashes
+}
+
+// resolveBadHashesConfigMapsShared reads the bad hashes ConfigMaps of a policy's specHash
+// conditions, including those in anyOf groups, from the policy's namespace and replaces the
+// in-memory conditions with ones listing their hashes inline, so condition evaluation needs
+// no API access. The stored policy is not modified.
+func resolveBadHashesConfigMapsShared(ctx context.Context, dynClient dynamic.Interface, policy *v1alpha1.GarbageCollectionPolicy) error {
+	if policy.Spec.Conditions == nil || !usesBadHashesConfigMap(policy.Spec.Conditions) {
+		return nil
+	}
+	if dynClient == nil {
+		return ErrNoSpecHashClient
+	}
+	conditions, err := resolveBadHashesConfigMaps(ctx, dynClient, policy.Namespace, policy.Spec.Conditions)
+	if err != nil {
+		return err
+	}
+	policy.Spec.Conditions = conditions
+	return nil
+}
+
+// usesBadHashesConfigMap reports whether a conditions group or its anyOf groups read a bad
+// hashes ConfigMap.
+func usesBadHashesConfigMap(conditions *v1alpha1.ConditionsSpec) bool {
+	if conditions.SpecHash != nil && conditions.SpecHash.BadHashesConfigMap != nil {
+		return true
+	}
+	for i := range conditions.AnyOf {
+		if usesBadHashesConfigMap(&conditions.AnyOf[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveBadHashesConfigMaps returns a copy of conditions with its bad hashes ConfigMaps read
+// into inline hashes.
+func resolveBadHashesConfigMaps(ctx context.Context, dynClient dynamic.Interface, namespace string, conditions *v1alpha1.ConditionsSpec) (*v1alpha1.ConditionsSpec, error) {
+	resolved := *conditions
+	if specHash := conditions.SpecHash; specHash != nil && specHash.BadHashesConfigMap != nil {
+		ref := specHash.BadHashesConfigMap
+		cm, err := dynClient.Resource(configMapGVR).Namespace(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get bad hashes ConfigMap %s/%s: %w", namespace, ref.Name, err)
+		}
+		data, _, _ := unstructured.NestedStringMap(cm.Object, "data")
+		inline := specHash.DeepCopy()
+		inline.BadHashesConfigMap = nil
+		if ref.Key != "" {
+			inline.BadHashes = append(inline.BadHashes, parseBadHashes(data[ref.Key])...)
+		} else {
+			for _, list := range data {
+				inline.BadHashes = append(inline.BadHashes, parseBadHashes(list)...)
+			}
+		}
+		resolved.SpecHash = inline
+	}
+	if len(conditions.AnyOf) > 0 {
+		resolved.AnyOf = make([]v1alpha1.ConditionsSpec, len(conditions.AnyOf))
+		for i := range conditions.AnyOf {
+			group, err := resolveBadHashesConfigMaps(ctx, dynClient, namespace, &conditions.AnyOf[i])
+			if err != nil {
+				return nil, err
+			}
+			resolved.AnyOf[i] = *group
+		}
+	}
+	return &resolved, nil
+}
+
+// meetsSpecHashConditionShared checks if the hash of the selected fields is a known-bad hash.
+// Hashes of a bad hashes ConfigMap are only seen once resolved; unresolved, they match nothing.
+func meetsSpecHashConditionShared(resource *unstructured.Unstructured, cond *v1alpha1.SpecHashCondition) bool {
+	if len(cond.Fields) == 0 {
+		return false
+	}
+
+	hash, err := computeSpecHash(resource, cond.Fields)
+	if err != nil {
+		return false
+	}
+
+	for _, bad := range cond.BadHashes {
+		if normalizeSpecHash(bad) == hash {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func newSpecHashTestResource(image string, replicas int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]interface{}{"name": "app", "namespace": "default"},
+			"spec": map[string]interface{}{
+				"replicas": replicas,
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{"name": "app", "image": image},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func mustSpecHash(t *testing.T, resource *unstructured.Unstructured, fields []string) string {
+	t.Helper()
+	hash, err := computeSpecHash(resource, fields)
+	if err != nil {
+		t.Fatalf("computeSpecHash() returned error: %v", err)
+	}
+	return hash
+}
+
+func TestComputeSpecHash(t *testing.T) {
+	fields := []string{"spec.template"}
+	bad := mustSpecHash(t, newSpecHashTestResource("generator:broken", 1), fields)
+
+	if got := mustSpecHash(t, newSpecHashTestResource("generator:broken", 3), fields); got != bad {
+		t.Error("expected hash to ignore fields that are not selected")
+	}
+	if got := mustSpecHash(t, newSpecHashTestResource("generator:fixed", 1), fields); got == bad {
+		t.Error("expected different template to produce a different hash")
+	}
+	if got := mustSpecHash(t, newSpecHashTestResource("generator:broken", 1), []string{"spec.template", "spec.replicas"}); got == bad {
+		t.Error("expected additional fields to change the hash")
+	}
+	if len(bad) != 64 {
+		t.Errorf("expected hex SHA-256 hash, got %q", bad)
+	}
+}
+
+func TestMeetsSpecHashCondition_InlineHashes(t *testing.T) {
+	fields := []string{"spec.template"}
+	bad := mustSpecHash(t, newSpecHashTestResource("generator:broken", 1), fields)
+
+	tests := []struct {
+		name     string
+		resource *unstructured.Unstructured
+		hashes   []string
+		expected bool
+	}{
+		{name: "matching hash", resource: newSpecHashTestResource("generator:broken", 2), hashes: []string{bad}, expected: true},
+		{name: "prefixed uppercase hash", resource: newSpecHashTestResource("generator:broken", 2), hashes: []string{"sha256:" + strings.ToUpper(bad)}, expected: true},
+		{name: "non-matching hash", resource: newSpecHashTestResource("generator:fixed", 2), hashes: []string{bad}, expected: false},
+		{name: "empty bad set", resource: newSpecHashTestResource("generator:broken", 2), hashes: nil, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conditions := &v1alpha1.ConditionsSpec{
+				SpecHash: &v1alpha1.SpecHashCondition{Fields: fields, BadHashes: tt.hashes},
+			}
+			if got := meetsConditionsShared(tt.resource, conditions); got != tt.expected {
+				t.Errorf("meetsConditionsShared() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestResolveBadHashesConfigMaps(t *testing.T) {
+	fields := []string{"spec.template"}
+	broken := newSpecHashTestResource("generator:broken", 1)
+	fixed := newSpecHashTestResource("generator:fixed", 1)
+
+	hashes := newTestConfigMap("default", "bad-hashes", time.Time{})
+	_ = unstructured.SetNestedStringMap(hashes.Object, map[string]string{
+		"hashes": "# hashes from generator v1.2\n\nSHA256:" + strings.ToUpper(mustSpecHash(t, broken, fields)) + "\n",
+		"other":  mustSpecHash(t, fixed, fields),
+	}, "data")
+	dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme(), hashes)
+
+	newPolicy := func(ref *v1alpha1.BadHashesConfigMapRef) *v1alpha1.GarbageCollectionPolicy {
+		policy := newTestPolicy("spec-hash")
+		policy.Spec.Conditions = &v1alpha1.ConditionsSpec{
+			AnyOf: []v1alpha1.ConditionsSpec{{SpecHash: &v1alpha1.SpecHashCondition{Fields: fields, BadHashesConfigMap: ref}}},
+		}
+		return policy
+	}
+
+	policy := newPolicy(&v1alpha1.BadHashesConfigMapRef{Name: "bad-hashes", Key: "hashes"})
+	stored := policy.DeepCopy()
+	if err := resolveBadHashesConfigMapsShared(context.Background(), dynamicClient, policy); err != nil {
+		t.Fatalf("resolveBadHashesConfigMapsShared() returned error: %v", err)
+	}
+	if !meetsConditionsShared(broken, policy.Spec.Conditions) {
+		t.Error("expected resource with a hash listed under the key to match")
+	}
+	if meetsConditionsShared(fixed, policy.Spec.Conditions) {
+		t.Error("expected resource with a hash under another key not to match")
+	}
+	if stored.Spec.Conditions.AnyOf[0].SpecHash.BadHashesConfigMap == nil || len(stored.Spec.Conditions.AnyOf[0].SpecHash.BadHashes) != 0 {
+		t.Error("expected the original conditions to be left unchanged")
+	}
+
+	// Without a key every key is read
+	policy = newPolicy(&v1alpha1.BadHashesConfigMapRef{Name: "bad-hashes"})
+	if err := resolveBadHashesConfigMapsShared(context.Background(), dynamicClient, policy); err != nil {
+		t.Fatalf("resolveBadHashesConfigMapsShared() returned error: %v", err)
+	}
+	if !meetsConditionsShared(broken, policy.Spec.Conditions) || !meetsConditionsShared(fixed, policy.Spec.Conditions) {
+		t.Error("expected hashes under every key to match")
+	}
+
+	// A missing ConfigMap fails the resolution rather than matching nothing silently
+	policy = newPolicy(&v1alpha1.BadHashesConfigMapRef{Name: "missing"})
+	if err := resolveBadHashesConfigMapsShared(context.Background(), dynamicClient, policy); err == nil {
+		t.Error("expected a missing ConfigMap to fail the resolution")
+	}
+	if meetsConditionsShared(broken, policy.Spec.Conditions) {
+		t.Error("expected an unresolved ConfigMap to match nothing")
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// StalePolicies counts consecutive evaluations per policy that matched no resources, so
+// policies with typo'd selectors are surfaced instead of failing silently. A nil
+// *StalePolicies counts nothing.
+type StalePolicies struct {
+	policies map[types.UID]int
+	mu       sync.Mutex
+}
+
+// NewStalePolicies creates a new StalePolicies.
+func NewStalePolicies() *StalePolicies {
+	return &StalePolicies{
+		policies: make(map[types.UID]int),
+	}
+}
+
+// Record counts an evaluation and returns the number of consecutive evaluations that
+// matched nothing, 0 as soon as one matches.
+func (s *StalePolicies) Record(policyUID types.UID, matched int64) int {
+	if s == nil {
+		return 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if matched > 0 {
+		delete(s.policies, policyUID)
+		return 0
+	}
+	s.policies[policyUID]++
+	return s.policies[policyUID]
+}
+
+// Forget drops the count of a deleted policy.
+func (s *StalePolicies) Forget(policyUID types.UID) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.policies, policyUID)
+}
+
+// recordMatchedCountShared tracks whether a policy keeps matching nothing. Once it has for
+// staleAfterEmptyRuns consecutive evaluations, the gc_policy_stale gauge is set and a
+// PolicyMatchedNothing warning is emitted; both clear on the next match. A threshold of
+// 0 disables tracking.
+func recordMatchedCountShared(stale *StalePolicies, eventRecorder *EventRecorder, policy *v1alpha1.GarbageCollectionPolicy, matched int64, staleAfterEmptyRuns int) {
+	if stale == nil || staleAfterEmptyRuns <= 0 {
+		return
+	}
+	emptyRuns := stale.Record(policy.UID, matched)
+	recordPolicyStale(policy.Namespace, policy.Name, emptyRuns >= staleAfterEmptyRuns)
+	// Warn once per stale streak, not on every evaluation
+	if emptyRuns == staleAfterEmptyRuns {
+		eventRecorder.RecordPolicyMatchedNothing(policy, emptyRuns)
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func TestStalePolicies_Record(t *testing.T) {
+	stale := NewStalePolicies()
+	uid := types.UID("policy-uid")
+
+	for want := 1; want <= 3; want++ {
+		if got := stale.Record(uid, 0); got != want {
+			t.Fatalf("Record() = %d empty runs, want %d", got, want)
+		}
+	}
+	if got := stale.Record(uid, 2); got != 0 {
+		t.Errorf("Record() after a match = %d, want the count reset to 0", got)
+	}
+	if got := stale.Record(uid, 0); got != 1 {
+		t.Errorf("Record() = %d, want counting to restart at 1", got)
+	}
+
+	stale.Forget(uid)
+	if got := stale.Record(uid, 0); got != 1 {
+		t.Errorf("Record() after Forget() = %d, want 1", got)
+	}
+
+	var nilStale *StalePolicies
+	if got := nilStale.Record(uid, 0); got != 0 {
+		t.Errorf("nil Record() = %d, want 0", got)
+	}
+	nilStale.Forget(uid)
+}
+
+func TestRecordMatchedCountShared_Gauge(t *testing.T) {
+	stale := NewStalePolicies()
+	policy := &v1alpha1.GarbageCollectionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "typo", Namespace: "stale-test", UID: types.UID("typo-uid")},
+	}
+	gauge := gcPolicyStale.WithLabelValues("stale-test", "typo")
+
+	for run := 1; run <= 3; run++ {
+		recordMatchedCountShared(stale, NewEventRecorder(nil), policy, 0, 3)
+		want := 0.0
+		if run == 3 {
+			want = 1
+		}
+		if got := testutil.ToFloat64(gauge); got != want {
+			t.Errorf("after %d empty runs gc_policy_stale = %v, want %v", run, got, want)
+		}
+	}
+
+	recordMatchedCountShared(stale, NewEventRecorder(nil), policy, 1, 3)
+	if got := testutil.ToFloat64(gauge); got != 0 {
+		t.Errorf("after a match gc_policy_stale = %v, want 0", got)
+	}
+	forgetPolicyStale("stale-test", "typo")
+}
+
+func TestEvaluatePolicy_ReportsStalePolicy(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reconciler, dynamicClient, policy := setupObservePausedTest(t, false)
+	defer reconciler.cleanupResourceInformer(policy.UID)
+	reconciler.config.WithStaleAfterEmptyRuns(2)
+
+	// A typo'd selector matches none of the seeded configmaps
+	policy.Spec.Paused = false
+	policy.Spec.TargetResource.LabelSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"ap": "web"}}
+	gauge := gcPolicyStale.WithLabelValues(policy.Namespace, policy.Name)
+	defer forgetPolicyStale(policy.Namespace, policy.Name)
+
+	for run := 1; run <= 2; run++ {
+		if err := reconciler.evaluatePolicy(ctx, policy); err != nil {
+			t.Fatalf("evaluatePolicy() returned error: %v", err)
+		}
+	}
+	if got := testutil.ToFloat64(gauge); got != 1 {
+		t.Errorf("gc_policy_stale = %v after 2 empty runs, want 1", got)
+	}
+
+	// A matching resource clears it on the next evaluation
+	cm := newTestConfigMap("default", "web", time.Time{})
+	cm.SetLabels(map[string]string{"ap": "web"})
+	if _, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").Create(ctx, cm, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create configmap: %v", err)
+	}
+	reconciler.resourceInformersMu.RLock()
+	informer := reconciler.resourceInformers[policy.UID]
+	reconciler.resourceInformersMu.RUnlock()
+	deadline := time.Now().Add(5 * time.Second)
+	for len(informer.GetStore().List()) != 1 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err := reconciler.evaluatePolicy(ctx, policy); err != nil {
+		t.Fatalf("evaluatePolicy() returned error: %v", err)
+	}
+	if got := testutil.ToFloat64(gauge); got != 0 {
+		t.Errorf("gc_policy_stale = %v after a match, want 0", got)
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	gcerrors "github.com/kube-zen/zen-gc/pkg/errors"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+// Policy status condition types.
+const (
+	// ConditionTypeReady is True while the policy is evaluated and acting on its resources.
+	ConditionTypeReady = "Ready"
+
+	// ConditionTypeProgressing is True while the policy has work it has yet to get to:
+	// deletions deferred to the next run, or an evaluation waiting for controller capacity or
+	// for the policy's schedule.
+	ConditionTypeProgressing = "Progressing"
+
+	// ConditionTypeDegraded is True while the policy cannot be evaluated as it stands.
+	ConditionTypeDegraded = "Degraded"
+
+	// ConditionTypeObserveOnly is True while the observe-only annotation forces dry-run.
+	ConditionTypeObserveOnly = "ObserveOnly"
+)
+
+// Policy status condition reasons.
+const (
+	// ReasonPolicyActive is the Ready reason of a policy evaluated without errors.
+	ReasonPolicyActive = "PolicyActive"
+
+	// ReasonPolicyPaused is the Ready reason of a paused policy.
+	ReasonPolicyPaused = "PolicyPaused"
+
+	// ReasonCaughtUp is the Progressing reason of a policy whose last run deferred nothing.
+	ReasonCaughtUp = "CaughtUp"
+
+	// ReasonRateLimited is the Progressing reason of a policy whose last run deferred
+	// deletions to the next run to stay within its deletion limits.
+	ReasonRateLimited = "RateLimited"
+
+	// ReasonEvaluationSucceeded is the Degraded reason of a policy evaluated without errors.
+	ReasonEvaluationSucceeded = "EvaluationSucceeded"
+
+	// ReasonInformerSyncFailed is the Degraded reason of a policy whose resource informer
+	// cache did not sync.
+	ReasonInformerSyncFailed = "InformerSyncFailed"
+
+	// ReasonInvalidSelector is the Degraded reason of a policy with an invalid label selector.
+	ReasonInvalidSelector = "InvalidSelector"
+)
+
+// applyPolicyConditionsShared sets conditions on an unstructured policy status, removes the
+// condition types in remove, and derives status.phase from the result. A condition keeps
+// its lastTransitionTime unless its status changes.
+func applyPolicyConditionsShared(status map[string]interface{}, generation int64, set []metav1.Condition, remove ...string) {
+	conditions := policyConditionsShared(status)
+	// Error and Pending conditions were replaced by Degraded and Progressing
+	for _, conditionType := range append([]string{PolicyPhaseError, PolicyPhasePending}, remove...) {
+		meta.RemoveStatusCondition(&conditions, conditionType)
+	}
+	for _, condition := range set {
+		condition.ObservedGeneration = generation
+		meta.SetStatusCondition(&conditions, condition)
+	}
+
+	items := make([]interface{}, 0, len(conditions))
+	for i := range conditions {
+		item, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&conditions[i])
+		if err != nil {
+			continue
+		}
+		items = append(items, item)
+	}
+	status["conditions"] = items
+	status["phase"] = policyPhaseFromConditionsShared(conditions)
+}
+
+// policyConditionsShared reads the conditions of an unstructured policy status, skipping
+// any that cannot be parsed.
+func policyConditionsShared(status map[string]interface{}) []metav1.Condition {
+	items, _ := status["conditions"].([]interface{})
+	conditions := make([]metav1.Condition, 0, len(items))
+	for _, item := range items {
+		object, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		var condition metav1.Condition
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object, &condition); err != nil {
+			continue
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions
+}
+
+// policyPhaseFromConditionsShared derives the phase kept for backward compatibility from a
+// policy's conditions: Error while Degraded, Active while Ready, Paused while not Ready
+// because the policy is paused, Scheduled while not Ready because of its schedule, and
+// Pending otherwise.
+func policyPhaseFromConditionsShared(conditions []metav1.Condition) string {
+	ready := meta.FindStatusCondition(conditions, ConditionTypeReady)
+	switch {
+	case meta.IsStatusConditionTrue(conditions, ConditionTypeDegraded):
+		return PolicyPhaseError
+	case ready == nil:
+		return ""
+	case ready.Status == metav1.ConditionTrue:
+		return PolicyPhaseActive
+	case ready.Reason == ReasonPolicyPaused:
+		return PolicyPhasePaused
+	case ready.Reason == ReasonScheduled || ready.Reason == ReasonOutsideWindow:
+		return PolicyPhaseScheduled
+	default:
+		return PolicyPhasePending
+	}
+}
+
+// isInformerSyncFailed reports whether err means the policy's resource informer did not sync.
+func isInformerSyncFailed(err error) bool {
+	return errors.Is(err, ErrResourceInformerCacheSyncFailed)
+}
+
+// checkLabelSelectorsShared returns an error if any of the target's label selectors is
+// invalid. Evaluation would treat the selector as matching nothing.
+func checkLabelSelectorsShared(target *v1alpha1.TargetResourceSpec) error {
+	if target.LabelSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(target.LabelSelector); err != nil {
+			return fmt.Errorf("invalid targetResource.labelSelector: %w", err)
+		}
+	}
+	for i := range target.LabelSelectors {
+		if _, err := metav1.LabelSelectorAsSelector(&target.LabelSelectors[i]); err != nil {
+			return fmt.Errorf("invalid targetResource.labelSelectors[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// handleInvalidSelector marks a policy with an invalid label selector as Degraded instead of
+// evaluating it.
+func (r *GCPolicyReconciler) handleInvalidSelector(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy, err error) (ctrl.Result, error) {
+	r.logger.Warn("Policy has an invalid label selector, skipping evaluation", sdklog.Operation("reconcile"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Error(err))
+	r.markPolicyError(ctx, policy, gcerrors.Wrap(err, gcerrors.TypeInvalidLabelSelector, "invalid label selector"), ReasonInvalidSelector)
+	// A spec change triggers a new reconcile
+	return ctrl.Result{RequeueAfter: r.getRequeueIntervalForPolicy(policy)}, nil
+}
+
+// handleInformerSyncFailed marks a policy whose resource informer did not sync as Degraded
+// and retries it with backoff.
+func (r *GCPolicyReconciler) handleInformerSyncFailed(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy, err error) (ctrl.Result, error) {
+	r.logger.Warn("Resource informer cache did not sync, skipping evaluation", sdklog.Operation("reconcile"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Error(err))
+	r.markPolicyError(ctx, policy, gcerrors.Wrap(err, gcerrors.TypeInformerCreationFailed, "resource informer cache sync failed"), ReasonInformerSyncFailed)
+	return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+}
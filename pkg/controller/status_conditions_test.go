@@ -0,0 +1,218 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func setupConditionsTest(t *testing.T) (*StatusUpdater, *fake.FakeDynamicClient, *v1alpha1.GarbageCollectionPolicy) {
+	t.Helper()
+	policy := newTestPolicy("conditions")
+	policy.Generation = 3
+	dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme())
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(policy)
+	if err != nil {
+		t.Fatalf("Failed to convert policy to unstructured: %v", err)
+	}
+	if _, err := dynamicClient.Resource(PolicyGVR).Namespace("default").Create(context.Background(), &unstructured.Unstructured{Object: obj}, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create policy: %v", err)
+	}
+	return NewStatusUpdater(dynamicClient), dynamicClient, policy
+}
+
+func getPolicyConditions(t *testing.T, dynamicClient *fake.FakeDynamicClient) (string, []metav1.Condition) {
+	t.Helper()
+	current, err := dynamicClient.Resource(PolicyGVR).Namespace("default").Get(context.Background(), "conditions", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get policy: %v", err)
+	}
+	status, _ := current.Object["status"].(map[string]interface{})
+	phase, _ := status["phase"].(string)
+	return phase, policyConditionsShared(status)
+}
+
+func TestPolicyPhaseFromConditionsShared(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions []metav1.Condition
+		expected   string
+	}{
+		{name: "no conditions", expected: ""},
+		{
+			name:       "ready",
+			conditions: []metav1.Condition{{Type: ConditionTypeReady, Status: metav1.ConditionTrue, Reason: ReasonPolicyActive}},
+			expected:   PolicyPhaseActive,
+		},
+		{
+			name: "ready while rate limited",
+			conditions: []metav1.Condition{
+				{Type: ConditionTypeReady, Status: metav1.ConditionTrue, Reason: ReasonPolicyActive},
+				{Type: ConditionTypeProgressing, Status: metav1.ConditionTrue, Reason: ReasonRateLimited},
+			},
+			expected: PolicyPhaseActive,
+		},
+		{
+			name:       "paused",
+			conditions: []metav1.Condition{{Type: ConditionTypeReady, Status: metav1.ConditionFalse, Reason: ReasonPolicyPaused}},
+			expected:   PolicyPhasePaused,
+		},
+		{
+			name:       "outside schedule",
+			conditions: []metav1.Condition{{Type: ConditionTypeReady, Status: metav1.ConditionFalse, Reason: ReasonOutsideWindow}},
+			expected:   PolicyPhaseScheduled,
+		},
+		{
+			name: "degraded",
+			conditions: []metav1.Condition{
+				{Type: ConditionTypeReady, Status: metav1.ConditionFalse, Reason: ReasonInvalidSelector},
+				{Type: ConditionTypeDegraded, Status: metav1.ConditionTrue, Reason: ReasonInvalidSelector},
+			},
+			expected: PolicyPhaseError,
+		},
+		{
+			name: "waiting",
+			conditions: []metav1.Condition{
+				{Type: ConditionTypeReady, Status: metav1.ConditionFalse, Reason: ReasonInformerLimitReached},
+				{Type: ConditionTypeProgressing, Status: metav1.ConditionTrue, Reason: ReasonInformerLimitReached},
+			},
+			expected: PolicyPhasePending,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policyPhaseFromConditionsShared(tt.conditions); got != tt.expected {
+				t.Errorf("policyPhaseFromConditionsShared() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestApplyPolicyConditionsShared_KeepsTransitionTimeWhileUnchanged(t *testing.T) {
+	earlier := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	status := map[string]interface{}{
+		"conditions": []interface{}{
+			map[string]interface{}{"type": ConditionTypeReady, "status": "True", "reason": ReasonPolicyActive, "lastTransitionTime": earlier.Format(time.RFC3339)},
+			map[string]interface{}{"type": PolicyPhaseError, "status": "True", "reason": "EvaluationFailed", "lastTransitionTime": earlier.Format(time.RFC3339)},
+		},
+	}
+
+	applyPolicyConditionsShared(status, 2, []metav1.Condition{
+		{Type: ConditionTypeReady, Status: metav1.ConditionTrue, Reason: ReasonPolicyActive},
+		{Type: ConditionTypeDegraded, Status: metav1.ConditionFalse, Reason: ReasonEvaluationSucceeded},
+	})
+
+	conditions := policyConditionsShared(status)
+	ready := meta.FindStatusCondition(conditions, ConditionTypeReady)
+	if ready == nil || !ready.LastTransitionTime.Equal(&earlier) || ready.ObservedGeneration != 2 {
+		t.Errorf("Ready = %+v, want an unchanged lastTransitionTime and observedGeneration 2", ready)
+	}
+	if meta.FindStatusCondition(conditions, PolicyPhaseError) != nil {
+		t.Error("expected the legacy Error condition to be removed")
+	}
+	if status["phase"] != PolicyPhaseActive {
+		t.Errorf("phase = %v, want %s", status["phase"], PolicyPhaseActive)
+	}
+}
+
+func TestStatusUpdater_Conditions(t *testing.T) {
+	ctx := context.Background()
+	updater, dynamicClient, policy := setupConditionsTest(t)
+
+	// Cannot be evaluated
+	if err := updater.SetError(ctx, policy, ReasonInformerSyncFailed, "cache did not sync"); err != nil {
+		t.Fatalf("SetError() returned error: %v", err)
+	}
+	phase, conditions := getPolicyConditions(t, dynamicClient)
+	if phase != PolicyPhaseError ||
+		!meta.IsStatusConditionFalse(conditions, ConditionTypeReady) ||
+		!meta.IsStatusConditionTrue(conditions, ConditionTypeDegraded) ||
+		meta.FindStatusCondition(conditions, ConditionTypeDegraded).Reason != ReasonInformerSyncFailed {
+		t.Errorf("after SetError: phase=%s conditions=%+v, want Error with Degraded=True (%s)", phase, conditions, ReasonInformerSyncFailed)
+	}
+
+	// Evaluated, deferring deletions
+	if err := updater.UpdateStatus(ctx, policy, 10, 5, 3, 0, 3, nil); err != nil {
+		t.Fatalf("UpdateStatus() returned error: %v", err)
+	}
+	phase, conditions = getPolicyConditions(t, dynamicClient)
+	progressing := meta.FindStatusCondition(conditions, ConditionTypeProgressing)
+	if phase != PolicyPhaseActive ||
+		!meta.IsStatusConditionTrue(conditions, ConditionTypeReady) ||
+		!meta.IsStatusConditionFalse(conditions, ConditionTypeDegraded) ||
+		progressing == nil || progressing.Status != metav1.ConditionTrue || progressing.Reason != ReasonRateLimited {
+		t.Errorf("after deferring: phase=%s conditions=%+v, want Active, Ready and Progressing (%s)", phase, conditions, ReasonRateLimited)
+	}
+	if progressing != nil && progressing.ObservedGeneration != policy.Generation {
+		t.Errorf("observedGeneration = %d, want %d", progressing.ObservedGeneration, policy.Generation)
+	}
+
+	// Caught up
+	if err := updater.UpdateStatus(ctx, policy, 10, 3, 0, 0, 0, nil); err != nil {
+		t.Fatalf("UpdateStatus() returned error: %v", err)
+	}
+	_, conditions = getPolicyConditions(t, dynamicClient)
+	if !meta.IsStatusConditionFalse(conditions, ConditionTypeProgressing) {
+		t.Errorf("after catching up: conditions=%+v, want Progressing=False", conditions)
+	}
+}
+
+func TestReconcile_InvalidSelectorDegradesPolicy(t *testing.T) {
+	reconciler, dynamicClient, _ := setupObservePausedTest(t, false)
+	policy := &v1alpha1.GarbageCollectionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "conditions", Namespace: "default"},
+		Spec: v1alpha1.GarbageCollectionPolicySpec{
+			TargetResource: v1alpha1.TargetResourceSpec{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+				LabelSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "app", Operator: "Bogus"},
+				}},
+			},
+		},
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(policy)
+	if err != nil {
+		t.Fatalf("Failed to convert policy to unstructured: %v", err)
+	}
+	if _, err := dynamicClient.Resource(PolicyGVR).Namespace("default").Create(context.Background(), &unstructured.Unstructured{Object: obj}, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create policy: %v", err)
+	}
+
+	selectorErr := checkLabelSelectorsShared(&policy.Spec.TargetResource)
+	if selectorErr == nil {
+		t.Fatal("expected the Bogus operator to be rejected")
+	}
+	if _, err := reconciler.handleInvalidSelector(context.Background(), policy, selectorErr); err != nil {
+		t.Fatalf("handleInvalidSelector() returned error: %v", err)
+	}
+	phase, conditions := getPolicyConditions(t, dynamicClient)
+	degraded := meta.FindStatusCondition(conditions, ConditionTypeDegraded)
+	if phase != PolicyPhaseError || degraded == nil || degraded.Status != metav1.ConditionTrue || degraded.Reason != ReasonInvalidSelector {
+		t.Errorf("phase=%s Degraded=%+v, want Error with Degraded=True (%s)", phase, degraded, ReasonInvalidSelector)
+	}
+}
@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
 
@@ -13,6 +16,7 @@ import (
 	"github.com/kube-zen/zen-gc/pkg/config"
 	gcerrors "github.com/kube-zen/zen-gc/pkg/errors"
 	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+	"github.com/kube-zen/zen-sdk/pkg/retry"
 )
 
 // PolicyGVR is the GroupVersionResource for GarbageCollectionPolicy CRDs.
@@ -47,18 +51,127 @@ func NewStatusUpdaterWithConfig(dynClient dynamic.Interface, cfg *config.Control
 	}
 }
 
-// UpdateStatus updates the GarbageCollectionPolicy CRD status subresource.
+// UpdateStatus updates the GarbageCollectionPolicy CRD status subresource after a
+// successful evaluation. deferred is the number of deletions the run left to the next run
+// to stay within its deletion limits; while it is non-zero the policy is Progressing.
+// Conflicts and transient API errors are retried with a short backoff that is
+// separate from deletion backoff; each attempt refetches the latest policy.
 func (s *StatusUpdater) UpdateStatus(
 	ctx context.Context,
 	policy *v1alpha1.GarbageCollectionPolicy,
-	matched, deleted, pending int64,
+	matched, deleted, pending, oldestPendingAgeSeconds, deferred int64,
+	dryRunImpact *v1alpha1.DryRunImpact,
 ) error {
-	// Get the current policy CRD
+	attempt := 0
+	return retry.Do(ctx, s.retryConfig(), func() error {
+		attempt++
+		if attempt > 1 {
+			logger := sdklog.NewLogger("zen-gc")
+			logger.Debug("Retrying GarbageCollectionPolicy status update", sdklog.Operation("update_status"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Int("attempt", attempt))
+		}
+		return s.updateStatusOnce(ctx, policy, matched, deleted, pending, oldestPendingAgeSeconds, deferred, dryRunImpact)
+	})
+}
+
+// SetError marks the policy as Degraded, and so in the Error phase, with a reason and
+// message explaining why it is not evaluated. The next successful evaluation clears it.
+func (s *StatusUpdater) SetError(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy, reason, message string) error {
+	return s.setNotReady(ctx, policy, ConditionTypeDegraded, reason, message)
+}
+
+// SetPending marks the policy as Progressing, and so in the Pending phase, with a reason and
+// message explaining what it waits for. The next successful evaluation clears it.
+func (s *StatusUpdater) SetPending(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy, reason, message string) error {
+	return s.setNotReady(ctx, policy, ConditionTypeProgressing, reason, message)
+}
+
+// setNotReady sets Ready=False and conditionType (Degraded or Progressing) to True, with
+// the other of the two False, all carrying the reason and message. The status is only
+// written if this changes it.
+func (s *StatusUpdater) setNotReady(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy, conditionType, reason, message string) error {
+	return retry.Do(ctx, s.retryConfig(), func() error {
+		unstructuredPolicy, err := s.dynClient.Resource(PolicyGVR).
+			Namespace(policy.Namespace).
+			Get(ctx, policy.Name, metav1.GetOptions{})
+		if err != nil {
+			gcErr := gcerrors.Wrap(err, gcerrors.TypeStatusGetFailed, "failed to get GarbageCollectionPolicy CRD")
+			gcErr = gcErr.WithContext("policy_namespace", policy.Namespace)
+			gcErr = gcErr.WithContext("policy_name", policy.Name)
+			return gcErr
+		}
+
+		status, _ := unstructuredPolicy.Object["status"].(map[string]interface{})
+		if status == nil {
+			status = map[string]interface{}{}
+		}
+		previous := runtime.DeepCopyJSONValue(status)
+		conditions := []metav1.Condition{
+			{Type: ConditionTypeReady, Status: metav1.ConditionFalse, Reason: reason, Message: message},
+			{Type: ConditionTypeProgressing, Status: metav1.ConditionFalse, Reason: reason, Message: message},
+			{Type: ConditionTypeDegraded, Status: metav1.ConditionFalse, Reason: reason, Message: message},
+		}
+		for i := range conditions {
+			if conditions[i].Type == conditionType {
+				conditions[i].Status = metav1.ConditionTrue
+			}
+		}
+		applyPolicyConditionsShared(status, unstructuredPolicy.GetGeneration(), conditions)
+		// Repeated failures with the same reason and message need no write
+		if equality.Semantic.DeepEqual(previous, status) {
+			return nil
+		}
+		unstructuredPolicy.Object["status"] = status
+
+		if _, err := s.dynClient.Resource(PolicyGVR).
+			Namespace(policy.Namespace).
+			UpdateStatus(ctx, unstructuredPolicy, metav1.UpdateOptions{}); err != nil {
+			gcErr := gcerrors.Wrap(err, gcerrors.TypeStatusUpdateFailed, "failed to update GarbageCollectionPolicy status")
+			gcErr = gcErr.WithContext("policy_namespace", policy.Namespace)
+			gcErr = gcErr.WithContext("policy_name", policy.Name)
+			return gcErr
+		}
+		return nil
+	})
+}
+
+// retryConfig returns the retry configuration for status updates.
+func (s *StatusUpdater) retryConfig() retry.Config {
+	retryConfig := retry.DefaultConfig()
+	retryConfig.RetryableErrors = isRetryableStatusUpdateError
+	if s.config != nil {
+		if s.config.StatusUpdateMaxAttempts > 0 {
+			retryConfig.MaxAttempts = s.config.StatusUpdateMaxAttempts
+		}
+		if s.config.StatusUpdateRetryDelay > 0 {
+			retryConfig.InitialDelay = s.config.StatusUpdateRetryDelay
+		}
+	}
+	return retryConfig
+}
+
+// isRetryableStatusUpdateError reports whether a status update error is worth retrying.
+func isRetryableStatusUpdateError(err error) bool {
+	return k8serrors.IsConflict(err) ||
+		k8serrors.IsServerTimeout(err) ||
+		k8serrors.IsTimeout(err) ||
+		k8serrors.IsTooManyRequests(err) ||
+		k8serrors.IsServiceUnavailable(err) ||
+		k8serrors.IsInternalError(err)
+}
+
+// updateStatusOnce fetches the latest policy and writes its status subresource.
+func (s *StatusUpdater) updateStatusOnce(
+	ctx context.Context,
+	policy *v1alpha1.GarbageCollectionPolicy,
+	matched, deleted, pending, oldestPendingAgeSeconds, deferred int64,
+	dryRunImpact *v1alpha1.DryRunImpact,
+) error {
+	// Get the current policy CRD (refetched on every attempt so conflicts resolve)
 	unstructuredPolicy, err := s.dynClient.Resource(PolicyGVR).
 		Namespace(policy.Namespace).
 		Get(ctx, policy.Name, metav1.GetOptions{})
 	if err != nil {
-		gcErr := gcerrors.Wrap(err, "status_get_failed", "failed to get GarbageCollectionPolicy CRD")
+		gcErr := gcerrors.Wrap(err, gcerrors.TypeStatusGetFailed, "failed to get GarbageCollectionPolicy CRD")
 		gcErr = gcErr.WithContext("policy_namespace", policy.Namespace)
 		gcErr = gcErr.WithContext("policy_name", policy.Name)
 		return gcErr
@@ -73,67 +186,35 @@ func (s *StatusUpdater) UpdateStatus(
 	nextRun := metav1.NewTime(now.Add(interval))
 
 	statusObj := map[string]interface{}{
-		"resourcesMatched": matched,
-		"resourcesDeleted": deleted,
-		"resourcesPending": pending,
-		"lastGCRun":        now.Format(time.RFC3339),
-		"nextGCRun":        nextRun.Format(time.RFC3339),
+		"resourcesMatched":        matched,
+		"resourcesDeleted":        deleted,
+		"resourcesPending":        pending,
+		"oldestPendingAgeSeconds": oldestPendingAgeSeconds,
+		"lastGCRun":               now.Format(time.RFC3339),
+		"nextGCRun":               nextRun.Format(time.RFC3339),
 	}
-
-	// Set phase based on spec.paused and evaluation state
-	// Phase is controller-owned output only, not user-settable
-	phase := PolicyPhaseActive
-	if policy.Spec.Paused {
-		phase = PolicyPhasePaused
-	}
-	// "Error" phase should be set by controller when evaluation fails consistently
-	// For now, we keep existing phase if it's "Error", otherwise use computed phase
-	if policy.Status.Phase == PolicyPhaseError {
-		phase = PolicyPhaseError // Preserve error state until cleared by successful evaluation
-	}
-	statusObj["phase"] = phase
-
-	// Set status conditions
-	conditions := []map[string]interface{}{}
-	nowStr := now.Format(time.RFC3339)
-
-	// Ready condition
-	readyCondition := map[string]interface{}{
-		"type":               "Ready",
-		"status":             "True",
-		"lastTransitionTime": nowStr,
-		"reason":             "PolicyActive",
-		"message":            "Policy is active and processing resources",
-	}
-	if phase == PolicyPhaseError {
-		readyCondition["status"] = "False"
-		readyCondition["reason"] = "PolicyError"
-		readyCondition["message"] = "Policy evaluation encountered errors"
-	} else if phase == PolicyPhasePaused {
-		readyCondition["status"] = "False"
-		readyCondition["reason"] = "PolicyPaused"
-		readyCondition["message"] = "Policy is paused"
-	}
-	conditions = append(conditions, readyCondition)
-
-	// Error condition (only set if there are errors)
-	if phase == PolicyPhaseError {
-		errorCondition := map[string]interface{}{
-			"type":               PolicyPhaseError,
-			"status":             "True",
-			"lastTransitionTime": nowStr,
-			"reason":             "EvaluationFailed",
-			"message":            "Policy evaluation failed - check logs for details",
+	if dryRunImpact != nil {
+		impact := map[string]interface{}{
+			"wouldDelete":        dryRunImpact.WouldDelete,
+			"hash":               dryRunImpact.Hash,
+			"observedGeneration": dryRunImpact.ObservedGeneration,
+		}
+		if dryRunImpact.ObservedAt != nil {
+			impact["observedAt"] = dryRunImpact.ObservedAt.Format(time.RFC3339)
+		}
+		if len(dryRunImpact.SampleNames) > 0 {
+			sampleNames := make([]interface{}, 0, len(dryRunImpact.SampleNames))
+			for _, name := range dryRunImpact.SampleNames {
+				sampleNames = append(sampleNames, name)
+			}
+			impact["sampleNames"] = sampleNames
 		}
-		conditions = append(conditions, errorCondition)
+		statusObj["dryRunImpact"] = impact
 	}
-
-	// Convert conditions to []interface{} to avoid deep copy issues with []map[string]interface{}
-	conditionsInterface := make([]interface{}, len(conditions))
-	for i, cond := range conditions {
-		conditionsInterface[i] = cond
+	observeOnly := observeOnlyShared(policy)
+	if observeOnly {
+		statusObj["observeOnly"] = true
 	}
-	statusObj["conditions"] = conditionsInterface
 
 	// Merge status (preserve existing fields, update only provided fields)
 	if existingStatus, ok := unstructuredPolicy.Object["status"].(map[string]interface{}); ok {
@@ -141,18 +222,31 @@ func (s *StatusUpdater) UpdateStatus(
 		for k, v := range statusObj {
 			existingStatus[k] = v
 		}
+		// The dry-run impact only describes dry-run policies
+		if dryRunImpact == nil && !policy.Spec.Behavior.DryRun {
+			delete(existingStatus, "dryRunImpact")
+		}
+		if !observeOnly {
+			delete(existingStatus, "observeOnly")
+		}
 		unstructuredPolicy.Object["status"] = existingStatus
 	} else {
 		// No existing status, set new status
 		unstructuredPolicy.Object["status"] = statusObj
 	}
 
+	// Set status conditions; the phase is derived from them. Phase is controller-owned output
+	// only, not user-settable. An Error or Pending phase set by SetError or SetPending is
+	// cleared here, since UpdateStatus is only called after a successful evaluation.
+	conditions, remove := evaluatedConditions(policy, deferred, observeOnly)
+	applyPolicyConditionsShared(unstructuredPolicy.Object["status"].(map[string]interface{}), unstructuredPolicy.GetGeneration(), conditions, remove...)
+
 	// Update status subresource
 	_, err = s.dynClient.Resource(PolicyGVR).
 		Namespace(policy.Namespace).
 		UpdateStatus(ctx, unstructuredPolicy, metav1.UpdateOptions{})
 	if err != nil {
-		gcErr := gcerrors.Wrap(err, "status_update_failed", "failed to update GarbageCollectionPolicy status")
+		gcErr := gcerrors.Wrap(err, gcerrors.TypeStatusUpdateFailed, "failed to update GarbageCollectionPolicy status")
 		gcErr = gcErr.WithContext("policy_namespace", policy.Namespace)
 		gcErr = gcErr.WithContext("policy_name", policy.Name)
 		logger := sdklog.NewLogger("zen-gc")
@@ -165,3 +259,50 @@ func (s *StatusUpdater) UpdateStatus(
 
 	return nil
 }
+
+// evaluatedConditions returns the conditions of a successfully evaluated policy, and the
+// condition types to remove.
+func evaluatedConditions(policy *v1alpha1.GarbageCollectionPolicy, deferred int64, observeOnly bool) ([]metav1.Condition, []string) {
+	ready := metav1.Condition{
+		Type:    ConditionTypeReady,
+		Status:  metav1.ConditionTrue,
+		Reason:  ReasonPolicyActive,
+		Message: "Policy is active and processing resources",
+	}
+	progressing := metav1.Condition{
+		Type:    ConditionTypeProgressing,
+		Status:  metav1.ConditionFalse,
+		Reason:  ReasonCaughtUp,
+		Message: "The last run deferred no deletions",
+	}
+	if policy.Spec.Paused {
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = ReasonPolicyPaused
+		ready.Message = "Policy is paused"
+	} else if deferred > 0 {
+		progressing.Status = metav1.ConditionTrue
+		progressing.Reason = ReasonRateLimited
+		progressing.Message = fmt.Sprintf("%d deletions deferred to the next run by the policy's deletion limits", deferred)
+	}
+	conditions := []metav1.Condition{
+		ready,
+		progressing,
+		{
+			Type:    ConditionTypeDegraded,
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonEvaluationSucceeded,
+			Message: "Policy evaluated without errors",
+		},
+	}
+
+	// ObserveOnly condition (only set while the annotation forces dry-run)
+	if !observeOnly {
+		return conditions, []string{ConditionTypeObserveOnly}
+	}
+	return append(conditions, metav1.Condition{
+		Type:    ConditionTypeObserveOnly,
+		Status:  metav1.ConditionTrue,
+		Reason:  "ObserveOnlyAnnotation",
+		Message: "Deletions are disabled by the " + v1alpha1.ObserveOnlyAnnotation + " annotation",
+	}), nil
+}
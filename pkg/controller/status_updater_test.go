@@ -21,11 +21,13 @@ import (
 	"testing"
 	"time"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
 
 	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
 	"github.com/kube-zen/zen-gc/pkg/config"
@@ -99,7 +101,7 @@ func TestStatusUpdater_UpdateStatus(t *testing.T) {
 	}
 
 	ctx := context.Background()
-	err = updater.UpdateStatus(ctx, policy, 10, 5, 3)
+	err = updater.UpdateStatus(ctx, policy, 10, 5, 3, 0, 0, nil)
 	if err != nil {
 		t.Errorf("UpdateStatus() returned error: %v", err)
 	}
@@ -150,7 +152,7 @@ func TestStatusUpdater_UpdateStatus_WithExistingStatus(t *testing.T) {
 	}
 
 	ctx := context.Background()
-	err = updater.UpdateStatus(ctx, policy, 10, 5, 3)
+	err = updater.UpdateStatus(ctx, policy, 10, 5, 3, 0, 0, nil)
 	if err != nil {
 		t.Errorf("UpdateStatus() returned error: %v", err)
 	}
@@ -195,8 +197,111 @@ func TestStatusUpdater_UpdateStatus_WithConfig(t *testing.T) {
 	}
 
 	ctx := context.Background()
-	err = updater.UpdateStatus(ctx, policy, 10, 5, 3)
+	err = updater.UpdateStatus(ctx, policy, 10, 5, 3, 0, 0, nil)
 	if err != nil {
 		t.Errorf("UpdateStatus() returned error: %v", err)
 	}
 }
+
+// newStatusRetryTestUpdater creates a status updater backed by a fake client holding the given policy.
+func newStatusRetryTestUpdater(t *testing.T, policy *v1alpha1.GarbageCollectionPolicy) (*StatusUpdater, *fake.FakeDynamicClient) {
+	t.Helper()
+	dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme())
+	unstructuredPolicy, err := runtime.DefaultUnstructuredConverter.ToUnstructured(policy)
+	if err != nil {
+		t.Fatalf("Failed to convert policy to unstructured: %v", err)
+	}
+	_, err = dynamicClient.Resource(PolicyGVR).Namespace(policy.Namespace).Create(
+		context.Background(),
+		&unstructured.Unstructured{Object: unstructuredPolicy},
+		metav1.CreateOptions{},
+	)
+	if err != nil {
+		t.Fatalf("Failed to create policy: %v", err)
+	}
+	cfg := config.NewControllerConfig().WithStatusUpdateRetry(3, time.Millisecond)
+	return NewStatusUpdaterWithConfig(dynamicClient, cfg), dynamicClient
+}
+
+func TestStatusUpdater_UpdateStatus_RetriesConflict(t *testing.T) {
+	policy := &v1alpha1.GarbageCollectionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Namespace: "default"},
+	}
+	updater, dynamicClient := newStatusRetryTestUpdater(t, policy)
+
+	updates := 0
+	dynamicClient.PrependReactor("update", "garbagecollectionpolicies", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		updates++
+		if updates == 1 {
+			return true, nil, apierrors.NewConflict(PolicyGVR.GroupResource(), policy.Name, nil)
+		}
+		return false, nil, nil
+	})
+
+	if err := updater.UpdateStatus(context.Background(), policy, 10, 5, 3, 0, 0, nil); err != nil {
+		t.Fatalf("UpdateStatus() returned error after conflict: %v", err)
+	}
+	if updates != 2 {
+		t.Errorf("expected 2 status update attempts, got %d", updates)
+	}
+
+	gets := 0
+	for _, action := range dynamicClient.Actions() {
+		if action.GetVerb() == "get" {
+			gets++
+		}
+	}
+	if gets != 2 {
+		t.Errorf("expected policy to be refetched after conflict (2 gets), got %d", gets)
+	}
+
+	stored, err := dynamicClient.Resource(PolicyGVR).Namespace("default").Get(context.Background(), policy.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get policy: %v", err)
+	}
+	if deleted, _, _ := unstructured.NestedInt64(stored.Object, "status", "resourcesDeleted"); deleted != 5 {
+		t.Errorf("expected status.resourcesDeleted=5, got %d", deleted)
+	}
+}
+
+func TestStatusUpdater_UpdateStatus_NonRetryableError(t *testing.T) {
+	policy := &v1alpha1.GarbageCollectionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Namespace: "default"},
+	}
+	updater, dynamicClient := newStatusRetryTestUpdater(t, policy)
+
+	updates := 0
+	dynamicClient.PrependReactor("update", "garbagecollectionpolicies", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		updates++
+		return true, nil, apierrors.NewForbidden(PolicyGVR.GroupResource(), policy.Name, nil)
+	})
+
+	err := updater.UpdateStatus(context.Background(), policy, 10, 5, 3, 0, 0, nil)
+	if !apierrors.IsForbidden(err) {
+		t.Fatalf("expected Forbidden error, got %v", err)
+	}
+	if updates != 1 {
+		t.Errorf("expected non-retryable error to stop after 1 attempt, got %d", updates)
+	}
+}
+
+func TestStatusUpdater_UpdateStatus_GivesUpAfterMaxAttempts(t *testing.T) {
+	policy := &v1alpha1.GarbageCollectionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Namespace: "default"},
+	}
+	updater, dynamicClient := newStatusRetryTestUpdater(t, policy)
+
+	updates := 0
+	dynamicClient.PrependReactor("update", "garbagecollectionpolicies", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		updates++
+		return true, nil, apierrors.NewConflict(PolicyGVR.GroupResource(), policy.Name, nil)
+	})
+
+	err := updater.UpdateStatus(context.Background(), policy, 10, 5, 3, 0, 0, nil)
+	if !apierrors.IsConflict(err) {
+		t.Fatalf("expected Conflict error after retries, got %v", err)
+	}
+	if updates != 3 {
+		t.Errorf("expected 3 status update attempts, got %d", updates)
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+// meetsStuckTerminatingConditionShared checks if a resource has been terminating for
+// longer than the condition's duration.
+func meetsStuckTerminatingConditionShared(resource *unstructured.Unstructured, cond *v1alpha1.StuckTerminatingCondition) bool {
+	return meetsStuckTerminatingConditionAt(resource, cond, time.Now())
+}
+
+// meetsStuckTerminatingConditionAt evaluates a stuck terminating condition at the given time.
+// Resources without a deletionTimestamp, or a condition without a duration, do not match.
+func meetsStuckTerminatingConditionAt(resource *unstructured.Unstructured, cond *v1alpha1.StuckTerminatingCondition, now time.Time) bool {
+	deletionTimestamp := resource.GetDeletionTimestamp()
+	if deletionTimestamp == nil || cond.OlderThanSeconds == nil {
+		return false
+	}
+	return now.Sub(deletionTimestamp.Time) >= time.Duration(*cond.OlderThanSeconds)*time.Second
+}
+
+// removableFinalizers splits a resource's finalizers into those on the allowlist and those kept.
+func removableFinalizers(resource *unstructured.Unstructured, allowlist []string) (removed, kept []string) {
+	allowed := make(map[string]bool, len(allowlist))
+	for _, finalizer := range allowlist {
+		allowed[finalizer] = true
+	}
+	for _, finalizer := range resource.GetFinalizers() {
+		if allowed[finalizer] {
+			removed = append(removed, finalizer)
+		} else {
+			kept = append(kept, finalizer)
+		}
+	}
+	return removed, kept
+}
+
+// removeStuckFinalizers removes the policy's allowlisted finalizers from a terminating resource
+// so the API server can complete its deletion. The patch tests the current finalizers first, so
+// a concurrent change fails the patch instead of being overwritten; the resource is retried on
+// the next evaluation. It reports whether any finalizer was removed.
+func (r *GCPolicyReconciler) removeStuckFinalizers(ctx context.Context, resource *unstructured.Unstructured, gvr schema.GroupVersionResource, policy *v1alpha1.GarbageCollectionPolicy) (bool, error) {
+	stuck := policy.Spec.Conditions.StuckTerminating
+	removed, kept := removableFinalizers(resource, stuck.RemoveFinalizers)
+	if len(removed) == 0 {
+		return false, nil
+	}
+	if kept == nil {
+		kept = []string{}
+	}
+
+	patch, err := json.Marshal([]map[string]interface{}{
+		{"op": "test", "path": "/metadata/finalizers", "value": resource.GetFinalizers()},
+		{"op": "replace", "path": "/metadata/finalizers", "value": kept},
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to build finalizer patch: %w", err)
+	}
+
+	namespace := resource.GetNamespace()
+	if namespace == "" {
+		_, err = r.dynamicClient.Resource(gvr).Patch(ctx, resource.GetName(), types.JSONPatchType, patch, metav1.PatchOptions{FieldManager: FieldManager})
+	} else {
+		_, err = r.dynamicClient.Resource(gvr).Namespace(namespace).Patch(ctx, resource.GetName(), types.JSONPatchType, patch, metav1.PatchOptions{FieldManager: FieldManager})
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to remove finalizers from %s/%s: %w", namespace, resource.GetName(), err)
+	}
+
+	r.logger.Info("Removed finalizers from stuck terminating resource", sdklog.Operation("remove_finalizers"), sdklog.String("resource", fmt.Sprintf("%s/%s", namespace, resource.GetName())), sdklog.Strings("finalizers", removed), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)))
+	return true, nil
+}
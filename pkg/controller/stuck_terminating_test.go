@@ -0,0 +1,132 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-sdk/pkg/gc/ratelimiter"
+)
+
+func TestMeetsStuckTerminatingConditionAt(t *testing.T) {
+	now := time.Now()
+	cond := &v1alpha1.StuckTerminatingCondition{OlderThanSeconds: int64Ptr(3600)}
+
+	tests := []struct {
+		name           string
+		terminatingFor time.Duration
+		cond           *v1alpha1.StuckTerminatingCondition
+		want           bool
+	}{
+		{name: "terminating past threshold matches", terminatingFor: 2 * time.Hour, cond: cond, want: true},
+		{name: "terminating within threshold does not match", terminatingFor: 10 * time.Minute, cond: cond, want: false},
+		{name: "resource not terminating does not match", cond: cond, want: false},
+		{name: "condition without duration does not match", terminatingFor: 2 * time.Hour, cond: &v1alpha1.StuckTerminatingCondition{}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := newTestConfigMap("default", "cm", now.Add(-3*time.Hour))
+			resource.SetFinalizers([]string{"example.com/cleanup"})
+			if tt.terminatingFor > 0 {
+				deletionTimestamp := metav1.NewTime(now.Add(-tt.terminatingFor))
+				resource.SetDeletionTimestamp(&deletionTimestamp)
+			}
+			if got := meetsStuckTerminatingConditionAt(resource, tt.cond, now); got != tt.want {
+				t.Errorf("meetsStuckTerminatingConditionAt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemovableFinalizers(t *testing.T) {
+	cm := newTestConfigMap("default", "stuck", time.Now().Add(-2*time.Hour))
+	cm.SetFinalizers([]string{"example.com/cleanup", "kubernetes.io/pvc-protection", "example.com/other"})
+
+	removed, kept := removableFinalizers(cm, []string{"example.com/cleanup", "example.com/other", "example.com/absent"})
+	if want := []string{"example.com/cleanup", "example.com/other"}; !reflect.DeepEqual(removed, want) {
+		t.Errorf("removed = %v, want %v", removed, want)
+	}
+	if want := []string{"kubernetes.io/pvc-protection"}; !reflect.DeepEqual(kept, want) {
+		t.Errorf("kept = %v, want %v", kept, want)
+	}
+}
+
+func TestDeleteResource_RemovesAllowlistedFinalizersFromStuckResource(t *testing.T) {
+	reconciler, dynamicClient, _ := setupObservePausedTest(t, false)
+	ctx := context.Background()
+	deletionTimestamp := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	stuck := newTestConfigMap("default", "stuck", time.Now().Add(-3*time.Hour))
+	stuck.SetDeletionTimestamp(&deletionTimestamp)
+	stuck.SetFinalizers([]string{"example.com/cleanup", "kubernetes.io/pvc-protection"})
+	if _, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").Create(ctx, stuck, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create stuck ConfigMap: %v", err)
+	}
+
+	policy := newTestPolicy("stuck-policy")
+	policy.Spec.Conditions = &v1alpha1.ConditionsSpec{
+		StuckTerminating: &v1alpha1.StuckTerminatingCondition{OlderThanSeconds: int64Ptr(3600), RemoveFinalizers: []string{"example.com/cleanup"}},
+	}
+	if err := reconciler.deleteResource(ctx, stuck, policy, ratelimiter.NewRateLimiter(100)); err != nil {
+		t.Fatalf("deleteResource() returned error: %v", err)
+	}
+
+	got, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").Get(ctx, "stuck", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get stuck ConfigMap: %v", err)
+	}
+	if want := []string{"kubernetes.io/pvc-protection"}; !reflect.DeepEqual(got.GetFinalizers(), want) {
+		t.Errorf("finalizers = %v, want %v", got.GetFinalizers(), want)
+	}
+}
+
+func TestDeleteResource_StuckFinalizerRemovalFailsOnConcurrentChange(t *testing.T) {
+	reconciler, dynamicClient, _ := setupObservePausedTest(t, false)
+	ctx := context.Background()
+	deletionTimestamp := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	stored := newTestConfigMap("default", "stuck", time.Now().Add(-3*time.Hour))
+	stored.SetDeletionTimestamp(&deletionTimestamp)
+	stored.SetFinalizers([]string{"example.com/cleanup", "example.com/added"})
+	if _, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").Create(ctx, stored, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create stuck ConfigMap: %v", err)
+	}
+
+	// The evaluated copy predates a finalizer added since
+	stale := stored.DeepCopy()
+	stale.SetFinalizers([]string{"example.com/cleanup"})
+	policy := newTestPolicy("stuck-policy")
+	policy.Spec.Conditions = &v1alpha1.ConditionsSpec{
+		StuckTerminating: &v1alpha1.StuckTerminatingCondition{OlderThanSeconds: int64Ptr(3600), RemoveFinalizers: []string{"example.com/cleanup"}},
+	}
+	if err := reconciler.deleteResource(ctx, stale, policy, ratelimiter.NewRateLimiter(100)); err == nil {
+		t.Fatal("deleteResource() should fail when finalizers changed since evaluation")
+	}
+
+	got, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace("default").Get(ctx, "stuck", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get stuck ConfigMap: %v", err)
+	}
+	if want := []string{"example.com/cleanup", "example.com/added"}; !reflect.DeepEqual(got.GetFinalizers(), want) {
+		t.Errorf("finalizers = %v, want unchanged %v", got.GetFinalizers(), want)
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+// sweepTotalsKey is the context key of a policy reconcile's running totals.
+type sweepTotalsKey struct{}
+
+// sweepTotals totals what one policy reconcile evaluated.
+type sweepTotals struct {
+	matched int64
+	deleted int64
+	errors  int64
+}
+
+// withSweepTotals returns a context whose evaluations add their results to totals.
+func withSweepTotals(ctx context.Context, totals *sweepTotals) context.Context {
+	return context.WithValue(ctx, sweepTotalsKey{}, totals)
+}
+
+// addToSweepSummary adds one policy evaluation to the context's reconcile totals.
+// Contexts without totals are ignored.
+func addToSweepSummary(ctx context.Context, matched, deleted, errors int64) {
+	totals, ok := ctx.Value(sweepTotalsKey{}).(*sweepTotals)
+	if !ok {
+		return
+	}
+	totals.matched += matched
+	totals.deleted += deleted
+	totals.errors += errors
+}
+
+// SweepSummary aggregates the policy reconciles of each GC interval and logs their totals
+// as a single line per interval. It implements manager.Runnable.
+type SweepSummary struct {
+	mu       sync.Mutex
+	interval time.Duration
+	logger   *sdklog.Logger
+	policies map[types.UID]struct{}
+	totals   sweepTotals
+	duration time.Duration
+}
+
+// NewSweepSummary creates a SweepSummary logging every interval, or every
+// DefaultGCInterval if interval is not positive.
+func NewSweepSummary(interval time.Duration, logger *sdklog.Logger) *SweepSummary {
+	if interval <= 0 {
+		interval = DefaultGCInterval
+	}
+	return &SweepSummary{
+		interval: interval,
+		logger:   logger,
+		policies: make(map[types.UID]struct{}),
+	}
+}
+
+// Add adds one policy reconcile, which took duration, to the current interval.
+func (s *SweepSummary) Add(policyUID types.UID, totals *sweepTotals, duration time.Duration) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.policies[policyUID] = struct{}{}
+	s.totals.matched += totals.matched
+	s.totals.deleted += totals.deleted
+	s.totals.errors += totals.errors
+	s.duration += duration
+}
+
+// Start logs the summary every interval until ctx is canceled, then logs the last partial
+// interval.
+func (s *SweepSummary) Start(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			s.Flush()
+			return nil
+		case <-ticker.C:
+			s.Flush()
+		}
+	}
+}
+
+// Flush logs the totals of the policies reconciled since the previous flush and resets
+// them. Intervals without reconciles log nothing.
+func (s *SweepSummary) Flush() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	policies, totals, duration := len(s.policies), s.totals, s.duration
+	s.policies = make(map[types.UID]struct{})
+	s.totals = sweepTotals{}
+	s.duration = 0
+	s.mu.Unlock()
+
+	if policies == 0 {
+		return
+	}
+	s.logger.Info("Sweep completed",
+		sdklog.Operation("sweep"),
+		sdklog.Int("policies", policies),
+		sdklog.Int64("matched", totals.matched),
+		sdklog.Int64("deleted", totals.deleted),
+		sdklog.Int64("errors", totals.errors),
+		sdklog.Duration("duration", duration))
+}
+
+// finishSweep records the sweep heartbeat and duration of a policy reconcile and adds its
+// totals to the interval's sweep summary.
+func (r *GCPolicyReconciler) finishSweep(policyUID types.UID, totals *sweepTotals, start time.Time) {
+	now := time.Now()
+	duration := now.Sub(start)
+	recordSweep(now)
+	recordSweepDuration(duration.Seconds())
+	r.sweepSummary.Add(policyUID, totals, duration)
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/config"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+func TestReconcile_LogsSweepSummaryOncePerInterval(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add v1alpha1 to scheme: %v", err)
+	}
+
+	policy := &v1alpha1.GarbageCollectionPolicy{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "gc.kube-zen.io/v1alpha1", Kind: "GarbageCollectionPolicy"},
+		ObjectMeta: metav1.ObjectMeta{Name: "summary-policy", Namespace: "default", UID: types.UID("summary-uid")},
+		Spec: v1alpha1.GarbageCollectionPolicySpec{
+			TargetResource: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default"},
+			TTL:            v1alpha1.TTLSpec{SecondsAfterCreation: int64Ptr(3600)},
+		},
+	}
+	policyObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(policy)
+	if err != nil {
+		t.Fatalf("Failed to convert policy to unstructured: %v", err)
+	}
+
+	var objects []runtime.Object
+	for name, age := range map[string]time.Duration{"expired-1": 2 * time.Hour, "expired-2": 2 * time.Hour, "fresh": time.Minute} {
+		cm := &unstructured.Unstructured{}
+		cm.SetAPIVersion("v1")
+		cm.SetKind("ConfigMap")
+		cm.SetNamespace("default")
+		cm.SetName(name)
+		cm.SetCreationTimestamp(metav1.NewTime(time.Now().Add(-age)))
+		objects = append(objects, cm)
+	}
+	objects = append(objects, &unstructured.Unstructured{Object: policyObj})
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			{Version: "v1", Resource: "configmaps"}:                                               "ConfigMapList",
+			{Group: "gc.kube-zen.io", Version: "v1alpha1", Resource: "garbagecollectionpolicies"}: "GarbageCollectionPolicyList",
+		},
+		objects...,
+	)
+
+	reconciler := NewGCPolicyReconcilerWithRESTMapper(
+		clientfake.NewClientBuilder().WithScheme(scheme).WithObjects(policy).Build(),
+		scheme,
+		dynamicClient,
+		nil,
+		NewStatusUpdater(dynamicClient),
+		NewEventRecorder(nil),
+		config.NewControllerConfig(),
+	)
+	core, logs := observer.New(zapcore.InfoLevel)
+	reconciler.sweepSummary.logger = &sdklog.Logger{Logger: zap.New(core)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "summary-policy", Namespace: "default"}}
+	sweepSummaries := func() []observer.LoggedEntry {
+		var summaries []observer.LoggedEntry
+		for _, entry := range logs.TakeAll() {
+			if entry.Message == "Sweep completed" {
+				summaries = append(summaries, entry)
+			}
+		}
+		return summaries
+	}
+
+	// The first interval sees all three ConfigMaps and deletes the two expired ones
+	if _, err := reconciler.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if summaries := sweepSummaries(); len(summaries) != 0 {
+		t.Fatalf("Sweep summary logged %d times before the interval ended, want none", len(summaries))
+	}
+	reconciler.sweepSummary.Flush()
+	summaries := sweepSummaries()
+	if len(summaries) != 1 {
+		t.Fatalf("Sweep summary logged %d times for one interval, want once", len(summaries))
+	}
+	fields := summaries[0].ContextMap()
+	want := map[string]int64{"policies": 1, "matched": 3, "deleted": 2, "errors": 0}
+	for key, value := range want {
+		if fields[key] != value {
+			t.Errorf("Sweep summary %s = %v, want %d", key, fields[key], value)
+		}
+	}
+	if _, ok := fields["duration"]; !ok {
+		t.Error("Sweep summary should include the duration")
+	}
+
+	// Several reconciles of the same policy in one interval are summarized once
+	for range 2 {
+		if _, err := reconciler.Reconcile(ctx, req); err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+	}
+	reconciler.sweepSummary.Flush()
+	summaries = sweepSummaries()
+	if len(summaries) != 1 {
+		t.Fatalf("Sweep summary logged %d times for one interval, want once", len(summaries))
+	}
+	if policies := summaries[0].ContextMap()["policies"]; policies != int64(1) {
+		t.Errorf("Sweep summary policies = %v, want 1 distinct policy", policies)
+	}
+
+	// An interval without reconciles logs nothing
+	reconciler.sweepSummary.Flush()
+	if summaries := sweepSummaries(); len(summaries) != 0 {
+		t.Errorf("Sweep summary logged %d times for an idle interval, want none", len(summaries))
+	}
+}
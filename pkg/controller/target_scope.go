@@ -0,0 +1,108 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/config"
+	gcerrors "github.com/kube-zen/zen-gc/pkg/errors"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+// ReasonTargetScopeMismatch is the status condition reason for a policy whose target
+// namespace cannot match the target kind's scope.
+const ReasonTargetScopeMismatch = "TargetScopeMismatch"
+
+// ErrNamespaceForClusterScopedKind indicates a namespace is set for a cluster-scoped target kind.
+var ErrNamespaceForClusterScopedKind = errors.New("targetResource.namespace is set for a cluster-scoped kind")
+
+// checkTargetScopeShared uses the RESTMapper to reject a target namespace on a
+// cluster-scoped kind, which would otherwise silently match nothing. Kinds the mapper
+// does not know are left to evaluation to report.
+func checkTargetScopeShared(mapper meta.RESTMapper, target *v1alpha1.TargetResourceSpec) error {
+	if target.Namespace == "" || target.Namespace == "*" {
+		return nil
+	}
+	if isClusterScopedTargetShared(mapper, target) {
+		return fmt.Errorf("%w: %s %s is cluster-scoped but namespace is %q; remove targetResource.namespace",
+			ErrNamespaceForClusterScopedKind, target.APIVersion, target.Kind, target.Namespace)
+	}
+	return nil
+}
+
+// isClusterScopedTargetShared reports whether the RESTMapper knows the target kind as
+// cluster-scoped. Without a mapper, or for kinds it does not know, it reports false.
+func isClusterScopedTargetShared(mapper meta.RESTMapper, target *v1alpha1.TargetResourceSpec) bool {
+	if mapper == nil {
+		return false
+	}
+	gv, err := schema.ParseGroupVersion(target.APIVersion)
+	if err != nil {
+		return false
+	}
+	mapping, err := mapper.RESTMapping(schema.GroupKind{Group: gv.Group, Kind: target.Kind}, gv.Version)
+	if err != nil {
+		return false
+	}
+	return mapping.Scope.Name() == meta.RESTScopeNameRoot
+}
+
+// resolveTargetNamespaceShared fills an empty targetResource.namespace from the controller's
+// default target namespace mode, so informers and both evaluation paths agree on it.
+// Policy mode resolves to the policy's namespace unless the kind is cluster-scoped;
+// cluster mode, and any unknown mode, resolves to "*".
+func resolveTargetNamespaceShared(mapper meta.RESTMapper, policy *v1alpha1.GarbageCollectionPolicy, mode string) {
+	target := &policy.Spec.TargetResource
+	if target.Namespace != "" {
+		return
+	}
+	if mode == config.TargetNamespaceModePolicy && policy.Namespace != "" && !isClusterScopedTargetShared(mapper, target) {
+		target.Namespace = policy.Namespace
+		return
+	}
+	target.Namespace = "*"
+}
+
+// handleTargetScopeMismatch marks a policy with a scope mismatch as Error instead of evaluating it.
+func (r *GCPolicyReconciler) handleTargetScopeMismatch(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy, err error) (ctrl.Result, error) {
+	r.logger.Warn("Policy target namespace does not match kind scope, skipping evaluation", sdklog.Operation("reconcile"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Error(err))
+	r.markPolicyError(ctx, policy, gcerrors.Wrap(err, gcerrors.TypeTargetScopeMismatch, "target scope mismatch"), ReasonTargetScopeMismatch)
+	// A spec change triggers a new reconcile; requeue in case the kind's scope changes
+	return ctrl.Result{RequeueAfter: r.getRequeueIntervalForPolicy(policy)}, nil
+}
+
+// markPolicyError records a policy that cannot be evaluated as it stands: it counts the
+// error, emits an evaluation failed event, and sets the policy's Error status.
+func (r *GCPolicyReconciler) markPolicyError(ctx context.Context, policy *v1alpha1.GarbageCollectionPolicy, gcErr *gcerrors.GCError, reason string) {
+	recordError(policy.Namespace, policy.Name, gcErr.Type)
+	if r.eventRecorder != nil {
+		r.eventRecorder.RecordEvaluationFailed(policy, gcErr)
+	}
+	if r.statusUpdater != nil {
+		if statusErr := r.statusUpdater.SetError(ctx, policy, reason, gcerrors.Format(gcErr)); statusErr != nil {
+			r.logger.Warn("Failed to set policy Error status", sdklog.Operation("update_status"), sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Error(statusErr))
+		}
+	}
+}
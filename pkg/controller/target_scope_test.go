@@ -0,0 +1,269 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/tools/cache"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/config"
+)
+
+func newScopeTestRESTMapper() meta.RESTMapper {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Namespace"}, meta.RESTScopeRoot)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"}, meta.RESTScopeRoot)
+	return mapper
+}
+
+func TestCheckTargetScopeShared(t *testing.T) {
+	mapper := newScopeTestRESTMapper()
+
+	tests := []struct {
+		name      string
+		mapper    meta.RESTMapper
+		target    v1alpha1.TargetResourceSpec
+		expectErr bool
+	}{
+		{name: "cluster-scoped kind with namespace", mapper: mapper, target: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "Namespace", Namespace: "default"}, expectErr: true},
+		{name: "grouped cluster-scoped kind with namespace", mapper: mapper, target: v1alpha1.TargetResourceSpec{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole", Namespace: "default"}, expectErr: true},
+		{name: "cluster-scoped kind without namespace", mapper: mapper, target: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "Namespace"}},
+		{name: "cluster-scoped kind with all namespaces", mapper: mapper, target: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "Namespace", Namespace: "*"}},
+		{name: "namespaced kind with namespace", mapper: mapper, target: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default"}},
+		{name: "unknown kind is not checked", mapper: mapper, target: v1alpha1.TargetResourceSpec{APIVersion: "example.com/v1", Kind: "Widget", Namespace: "default"}},
+		{name: "no mapper is not checked", mapper: nil, target: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "Namespace", Namespace: "default"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkTargetScopeShared(tt.mapper, &tt.target)
+			if tt.expectErr != errors.Is(err, ErrNamespaceForClusterScopedKind) {
+				t.Errorf("checkTargetScopeShared() error = %v, expectErr %v", err, tt.expectErr)
+			}
+		})
+	}
+}
+
+func TestHandleTargetScopeMismatch_SetsErrorUntilFixed(t *testing.T) {
+	policy := &v1alpha1.GarbageCollectionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "namespaces-policy", Namespace: "default"},
+		Spec: v1alpha1.GarbageCollectionPolicySpec{
+			TargetResource: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "Namespace", Namespace: "default"},
+			TTL:            v1alpha1.TTLSpec{SecondsAfterCreation: int64Ptr(3600)},
+		},
+	}
+	scheme := runtime.NewScheme()
+	dynamicClient := fake.NewSimpleDynamicClient(scheme)
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(policy)
+	if err != nil {
+		t.Fatalf("Failed to convert policy to unstructured: %v", err)
+	}
+	if _, err := dynamicClient.Resource(PolicyGVR).Namespace("default").Create(context.Background(), &unstructured.Unstructured{Object: obj}, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create policy: %v", err)
+	}
+	reconciler := NewGCPolicyReconcilerWithRESTMapper(
+		clientfake.NewClientBuilder().WithScheme(scheme).Build(),
+		scheme,
+		dynamicClient,
+		newScopeTestRESTMapper(),
+		NewStatusUpdater(dynamicClient),
+		NewEventRecorder(nil),
+		config.NewControllerConfig(),
+	)
+
+	getStatus := func() (string, string) {
+		t.Helper()
+		current, err := dynamicClient.Resource(PolicyGVR).Namespace("default").Get(context.Background(), policy.Name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("Failed to get policy: %v", err)
+		}
+		phase, _, _ := unstructured.NestedString(current.Object, "status", "phase")
+		conditions, _, _ := unstructured.NestedSlice(current.Object, "status", "conditions")
+		reason := ""
+		if len(conditions) > 0 {
+			reason, _, _ = unstructured.NestedString(conditions[0].(map[string]interface{}), "reason")
+		}
+		return phase, reason
+	}
+
+	scopeErr := checkTargetScopeShared(reconciler.restMapper, &policy.Spec.TargetResource)
+	if scopeErr == nil {
+		t.Fatal("expected scope mismatch for a namespaced Namespace target")
+	}
+	if _, err := reconciler.handleTargetScopeMismatch(context.Background(), policy, scopeErr); err != nil {
+		t.Fatalf("handleTargetScopeMismatch() returned error: %v", err)
+	}
+	if phase, reason := getStatus(); phase != PolicyPhaseError || reason != ReasonTargetScopeMismatch {
+		t.Errorf("status phase/reason = %s/%s, want %s/%s", phase, reason, PolicyPhaseError, ReasonTargetScopeMismatch)
+	}
+
+	// A successful evaluation after fixing the target clears the Error phase
+	if err := reconciler.statusUpdater.UpdateStatus(context.Background(), policy, 0, 0, 0, 0, 0, nil); err != nil {
+		t.Fatalf("UpdateStatus() returned error: %v", err)
+	}
+	if phase, _ := getStatus(); phase != PolicyPhaseActive {
+		t.Errorf("status phase = %s, want %s", phase, PolicyPhaseActive)
+	}
+}
+
+func TestResolveTargetNamespaceShared(t *testing.T) {
+	mapper := newScopeTestRESTMapper()
+
+	tests := []struct {
+		name     string
+		mapper   meta.RESTMapper
+		mode     string
+		target   v1alpha1.TargetResourceSpec
+		expectNS string
+	}{
+		{name: "cluster mode resolves empty to all namespaces", mapper: mapper, mode: config.TargetNamespaceModeCluster, target: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap"}, expectNS: "*"},
+		{name: "policy mode resolves empty to policy namespace", mapper: mapper, mode: config.TargetNamespaceModePolicy, target: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap"}, expectNS: "team-a"},
+		{name: "policy mode without mapper resolves to policy namespace", mapper: nil, mode: config.TargetNamespaceModePolicy, target: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap"}, expectNS: "team-a"},
+		{name: "policy mode keeps cluster-scoped kinds cluster-wide", mapper: mapper, mode: config.TargetNamespaceModePolicy, target: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "Namespace"}, expectNS: "*"},
+		{name: "unknown mode resolves to all namespaces", mapper: mapper, mode: "", target: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap"}, expectNS: "*"},
+		{name: "explicit namespace is kept", mapper: mapper, mode: config.TargetNamespaceModeCluster, target: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap", Namespace: "team-b"}, expectNS: "team-b"},
+		{name: "explicit all namespaces is kept", mapper: mapper, mode: config.TargetNamespaceModePolicy, target: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap", Namespace: "*"}, expectNS: "*"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &v1alpha1.GarbageCollectionPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "team-a"},
+				Spec:       v1alpha1.GarbageCollectionPolicySpec{TargetResource: tt.target},
+			}
+			resolveTargetNamespaceShared(tt.mapper, policy, tt.mode)
+			if got := policy.Spec.TargetResource.Namespace; got != tt.expectNS {
+				t.Errorf("resolved namespace = %q, want %q", got, tt.expectNS)
+			}
+		})
+	}
+}
+
+func TestEvaluatePolicy_DefaultTargetNamespaceMode(t *testing.T) {
+	tests := []struct {
+		mode      string
+		remaining []string
+	}{
+		{mode: config.TargetNamespaceModeCluster, remaining: nil},
+		{mode: config.TargetNamespaceModePolicy, remaining: []string{"other/cm-other"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			policy := newTestPolicy("namespace-mode-policy")
+
+			scheme := runtime.NewScheme()
+			objects := make([]runtime.Object, 0, 2)
+			for _, namespace := range []string{"default", "other"} {
+				objects = append(objects, newTestConfigMap(namespace, "cm-"+namespace, time.Now().Add(-2*time.Hour)))
+			}
+			dynamicClient := fake.NewSimpleDynamicClientWithCustomListKinds(
+				scheme,
+				map[schema.GroupVersionResource]string{
+					observeTestConfigMapGVR: "ConfigMapList",
+					observeTestPolicyGVR:    "GarbageCollectionPolicyList",
+				},
+				objects...,
+			)
+			obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(policy)
+			if err != nil {
+				t.Fatalf("Failed to convert policy to unstructured: %v", err)
+			}
+			if _, err := dynamicClient.Resource(observeTestPolicyGVR).Namespace("default").Create(ctx, &unstructured.Unstructured{Object: obj}, metav1.CreateOptions{}); err != nil {
+				t.Fatalf("Failed to create policy: %v", err)
+			}
+
+			reconciler := NewGCPolicyReconcilerWithRESTMapper(
+				clientfake.NewClientBuilder().WithScheme(scheme).Build(),
+				scheme,
+				dynamicClient,
+				newScopeTestRESTMapper(),
+				NewStatusUpdater(dynamicClient),
+				NewEventRecorder(nil),
+				config.NewControllerConfig().WithDefaultTargetNamespaceMode(tt.mode),
+			)
+			defer reconciler.cleanupResourceInformer(policy.UID)
+
+			if err := reconciler.evaluatePolicy(ctx, policy); err != nil {
+				t.Fatalf("evaluatePolicy() returned error: %v", err)
+			}
+
+			list, err := dynamicClient.Resource(observeTestConfigMapGVR).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				t.Fatalf("Failed to list configmaps: %v", err)
+			}
+			remaining := make([]string, 0, len(list.Items))
+			for i := range list.Items {
+				remaining = append(remaining, list.Items[i].GetNamespace()+"/"+list.Items[i].GetName())
+			}
+			if len(remaining) != len(tt.remaining) || (len(remaining) > 0 && remaining[0] != tt.remaining[0]) {
+				t.Errorf("remaining configmaps = %v, want %v", remaining, tt.remaining)
+			}
+		})
+	}
+}
+
+func TestEvaluatePolicyResourcesShared_DefaultTargetNamespaceMode(t *testing.T) {
+	tests := []struct {
+		mode   string
+		expect []string
+	}{
+		{mode: config.TargetNamespaceModeCluster, expect: []string{"cm-default", "cm-other"}},
+		{mode: config.TargetNamespaceModePolicy, expect: []string{"cm-default"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			informer := cache.NewSharedIndexInformer(&cache.ListWatch{}, &unstructured.Unstructured{}, 0, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			for _, namespace := range []string{"default", "other"} {
+				if err := informer.GetStore().Add(newTestConfigMap(namespace, "cm-"+namespace, time.Now().Add(-2*time.Hour))); err != nil {
+					t.Fatalf("Failed to add configmap: %v", err)
+				}
+			}
+			reconciler := setupInformerTestReconciler(t)
+			policy := newTestPolicy("namespace-mode-policy")
+
+			resolveTargetNamespaceShared(nil, policy, tt.mode)
+			result := evaluatePolicyResourcesShared(context.Background(), reconciler, policy, informer)
+
+			got := make([]string, 0, len(result.ResourcesToDelete))
+			for _, resource := range result.ResourcesToDelete {
+				got = append(got, resource.GetName())
+			}
+			sort.Strings(got)
+			if len(got) != len(tt.expect) || (len(got) > 0 && got[0] != tt.expect[0]) || (len(got) > 1 && got[1] != tt.expect[1]) {
+				t.Errorf("resources to delete = %v, want %v", got, tt.expect)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+var (
+	// ErrRangeTTLBoundInvalid indicates a ttl.range bound field is missing or not a non-negative whole number.
+	ErrRangeTTLBoundInvalid = errors.New("ttl range bound is missing or not a non-negative number of seconds")
+
+	// ErrRangeTTLInverted indicates a resource's minimum TTL exceeds its maximum.
+	ErrRangeTTLInverted = errors.New("ttl range minimum exceeds maximum")
+)
+
+// calculateRangeExpirationShared expires a resource a stable, UID-derived number of seconds
+// after creation, between the minimum and maximum read from its fields.
+func calculateRangeExpirationShared(resource *unstructured.Unstructured, rng *v1alpha1.RangeTTLSpec) (time.Time, error) {
+	minSeconds, err := rangeBoundSeconds(resource, rng.MinFieldPath)
+	if err != nil {
+		return time.Time{}, err
+	}
+	maxSeconds, err := rangeBoundSeconds(resource, rng.MaxFieldPath)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if minSeconds > maxSeconds {
+		return time.Time{}, fmt.Errorf("%w: %d > %d", ErrRangeTTLInverted, minSeconds, maxSeconds)
+	}
+	ttl := rangeTTLSeconds(resource.GetUID(), minSeconds, maxSeconds)
+	return resource.GetCreationTimestamp().Add(time.Duration(ttl) * time.Second), nil
+}
+
+// rangeTTLSeconds picks a value in [minSeconds, maxSeconds] from a hash of the UID.
+func rangeTTLSeconds(uid types.UID, minSeconds, maxSeconds int64) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(uid))
+	span := uint64(maxSeconds-minSeconds) + 1
+	return minSeconds + int64(h.Sum64()%span)
+}
+
+// rangeBoundSeconds reads a bound in seconds from an integer, whole float, or numeric string field.
+func rangeBoundSeconds(resource *unstructured.Unstructured, path string) (int64, error) {
+	value, found, err := nestedFieldNoCopy(resource.Object, path)
+	if err != nil || !found {
+		return 0, fmt.Errorf("%w: %s", ErrRangeTTLBoundInvalid, path)
+	}
+
+	var seconds int64
+	switch v := value.(type) {
+	case int64:
+		seconds = v
+	case float64:
+		if v != math.Trunc(v) || v >= math.MaxInt64 {
+			return 0, fmt.Errorf("%w: %s", ErrRangeTTLBoundInvalid, path)
+		}
+		seconds = int64(v)
+	case string:
+		seconds, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %s", ErrRangeTTLBoundInvalid, path)
+		}
+	default:
+		return 0, fmt.Errorf("%w: %s", ErrRangeTTLBoundInvalid, path)
+	}
+	if seconds < 0 {
+		return 0, fmt.Errorf("%w: %s", ErrRangeTTLBoundInvalid, path)
+	}
+	return seconds, nil
+}
@@ -1,13 +1,17 @@
 package controller
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/validation"
 	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
 )
 
@@ -186,6 +190,293 @@ func TestGCPolicyReconciler_calculateExpirationTime_FieldPathNotFound(t *testing
 	}
 }
 
+func TestCalculateExpirationTime_Earliest(t *testing.T) {
+	created := time.Now().Add(-30 * time.Minute).Truncate(time.Second)
+	newResource := func(ttlSeconds int64) *unstructured.Unstructured {
+		resource := &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"spec": map[string]interface{}{"ttlSeconds": ttlSeconds},
+			},
+		}
+		resource.SetCreationTimestamp(metav1.NewTime(created))
+		return resource
+	}
+	earliest := func(secondsAfterCreation int64) *v1alpha1.TTLSpec {
+		return &v1alpha1.TTLSpec{
+			Earliest: &v1alpha1.EarliestTTLSpec{
+				SecondsAfterCreation: int64Ptr(secondsAfterCreation),
+				Field:                &v1alpha1.TTLSpec{FieldPath: "spec.ttlSeconds"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		resource *unstructured.Unstructured
+		ttl      *v1alpha1.TTLSpec
+		expected time.Time
+	}{
+		{
+			name:     "creation rule is binding",
+			resource: newResource(7200),
+			ttl:      earliest(3600),
+			expected: created.Add(time.Hour),
+		},
+		{
+			name:     "field rule is binding",
+			resource: newResource(600),
+			ttl:      earliest(3600),
+			expected: created.Add(10 * time.Minute),
+		},
+		{
+			name:     "missing field falls back to creation rule",
+			resource: func() *unstructured.Unstructured { r := newResource(0); delete(r.Object, "spec"); return r }(),
+			ttl:      earliest(3600),
+			expected: created.Add(time.Hour),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := calculateExpirationTimeShared(tt.resource, tt.ttl)
+			if err != nil {
+				t.Fatalf("calculateExpirationTimeShared() returned error: %v", err)
+			}
+			if !got.Equal(tt.expected) {
+				t.Errorf("calculateExpirationTimeShared() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculateExpirationTime_LabelKey(t *testing.T) {
+	created := time.Now().Add(-30 * time.Minute).Truncate(time.Second)
+	newResource := func(labels map[string]string) *unstructured.Unstructured {
+		resource := &unstructured.Unstructured{Object: map[string]interface{}{}}
+		resource.SetCreationTimestamp(metav1.NewTime(created))
+		resource.SetLabels(labels)
+		return resource
+	}
+	byEnvironment := func(defaultSeconds *int64) *v1alpha1.TTLSpec {
+		return &v1alpha1.TTLSpec{
+			LabelKey: "app.kubernetes.io/environment",
+			Mappings: map[string]int64{"prod": 2592000, "dev": 86400},
+			Default:  defaultSeconds,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		resource  *unstructured.Unstructured
+		ttl       *v1alpha1.TTLSpec
+		expected  time.Time
+		expectErr bool
+	}{
+		{
+			name:     "mapped label value",
+			resource: newResource(map[string]string{"app.kubernetes.io/environment": "dev"}),
+			ttl:      byEnvironment(nil),
+			expected: created.Add(24 * time.Hour),
+		},
+		{
+			name:     "unmapped label value uses default",
+			resource: newResource(map[string]string{"app.kubernetes.io/environment": "staging"}),
+			ttl:      byEnvironment(int64Ptr(3600)),
+			expected: created.Add(time.Hour),
+		},
+		{
+			name:     "missing label uses default",
+			resource: newResource(nil),
+			ttl:      byEnvironment(int64Ptr(3600)),
+			expected: created.Add(time.Hour),
+		},
+		{
+			name:      "unmapped label value without default",
+			resource:  newResource(map[string]string{"app.kubernetes.io/environment": "staging"}),
+			ttl:       byEnvironment(nil),
+			expectErr: true,
+		},
+		{
+			name:      "missing label without default",
+			resource:  newResource(map[string]string{"environment": "prod"}),
+			ttl:       byEnvironment(nil),
+			expectErr: true,
+		},
+		{
+			name:     "earliest with a label rule",
+			resource: newResource(map[string]string{"app.kubernetes.io/environment": "prod"}),
+			ttl: &v1alpha1.TTLSpec{
+				Earliest: &v1alpha1.EarliestTTLSpec{SecondsAfterCreation: int64Ptr(3600), Field: byEnvironment(nil)},
+			},
+			expected: created.Add(time.Hour),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := calculateExpirationTimeShared(tt.resource, tt.ttl)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("calculateExpirationTimeShared() = %v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("calculateExpirationTimeShared() returned error: %v", err)
+			}
+			if !got.Equal(tt.expected) {
+				t.Errorf("calculateExpirationTimeShared() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculateExpirationTime_EarliestRelativeRuleExpired(t *testing.T) {
+	resource := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"status": map[string]interface{}{
+				"completedAt": time.Now().Add(-2 * time.Hour).Format(time.RFC3339),
+			},
+		},
+	}
+	resource.SetCreationTimestamp(metav1.NewTime(time.Now().Add(-3 * time.Hour)))
+
+	ttl := &v1alpha1.TTLSpec{
+		Earliest: &v1alpha1.EarliestTTLSpec{
+			SecondsAfterCreation: int64Ptr(7 * 24 * 3600),
+			Field:                &v1alpha1.TTLSpec{RelativeTo: "status.completedAt", SecondsAfter: int64Ptr(3600)},
+		},
+	}
+
+	got, err := calculateExpirationTimeShared(resource, ttl)
+	if err != nil {
+		t.Fatalf("calculateExpirationTimeShared() returned error: %v", err)
+	}
+	if got.After(time.Now()) {
+		t.Errorf("expected the already-passed relative rule to make the resource expire, got %v", got)
+	}
+}
+
+func TestCalculateExpirationTime_Range(t *testing.T) {
+	created := time.Now().Add(-time.Hour).Truncate(time.Second)
+	newResource := func(uid string, minTTL, maxTTL interface{}) *unstructured.Unstructured {
+		resource := &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"spec": map[string]interface{}{"minTtl": minTTL, "maxTtl": maxTTL},
+			},
+		}
+		resource.SetUID(types.UID(uid))
+		resource.SetCreationTimestamp(metav1.NewTime(created))
+		return resource
+	}
+	ttl := &v1alpha1.TTLSpec{
+		Range: &v1alpha1.RangeTTLSpec{MinFieldPath: "spec.minTtl", MaxFieldPath: "spec.maxTtl"},
+	}
+
+	// Every UID lands in range and keeps its value across evaluations
+	seen := make(map[time.Time]struct{})
+	for i := range 50 {
+		resource := newResource(fmt.Sprintf("uid-%d", i), int64(3600), int64(7200))
+		got, err := calculateExpirationTimeShared(resource, ttl)
+		if err != nil {
+			t.Fatalf("calculateExpirationTimeShared() returned error: %v", err)
+		}
+		if got.Before(created.Add(time.Hour)) || got.After(created.Add(2*time.Hour)) {
+			t.Errorf("uid-%d expires at %v, want within [%v, %v]", i, got, created.Add(time.Hour), created.Add(2*time.Hour))
+		}
+		again, _ := calculateExpirationTimeShared(resource.DeepCopy(), ttl)
+		if !again.Equal(got) {
+			t.Errorf("uid-%d expiration changed from %v to %v", i, got, again)
+		}
+		seen[got] = struct{}{}
+	}
+	if len(seen) < 2 {
+		t.Errorf("50 UIDs produced %d distinct expirations, want them spread across the range", len(seen))
+	}
+
+	// Equal bounds, JSON floats, and numeric strings are accepted
+	for _, bounds := range [][2]interface{}{{int64(600), int64(600)}, {float64(600), float64(600)}, {"600", "600"}} {
+		got, err := calculateExpirationTimeShared(newResource("uid", bounds[0], bounds[1]), ttl)
+		if err != nil {
+			t.Fatalf("bounds %v returned error: %v", bounds, err)
+		}
+		if !got.Equal(created.Add(10 * time.Minute)) {
+			t.Errorf("bounds %v expire at %v, want %v", bounds, got, created.Add(10*time.Minute))
+		}
+	}
+}
+
+func TestCalculateExpirationTime_RangeInvalid(t *testing.T) {
+	ttl := &v1alpha1.TTLSpec{
+		Range: &v1alpha1.RangeTTLSpec{MinFieldPath: "spec.minTtl", MaxFieldPath: "spec.maxTtl"},
+	}
+	tests := []struct {
+		name    string
+		spec    map[string]interface{}
+		wantErr error
+	}{
+		{name: "min above max", spec: map[string]interface{}{"minTtl": int64(7200), "maxTtl": int64(3600)}, wantErr: ErrRangeTTLInverted},
+		{name: "missing max", spec: map[string]interface{}{"minTtl": int64(3600)}, wantErr: ErrRangeTTLBoundInvalid},
+		{name: "negative min", spec: map[string]interface{}{"minTtl": int64(-1), "maxTtl": int64(3600)}, wantErr: ErrRangeTTLBoundInvalid},
+		{name: "fractional max", spec: map[string]interface{}{"minTtl": int64(1), "maxTtl": 1.5}, wantErr: ErrRangeTTLBoundInvalid},
+		{name: "non-numeric min", spec: map[string]interface{}{"minTtl": "1h", "maxTtl": int64(3600)}, wantErr: ErrRangeTTLBoundInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := &unstructured.Unstructured{Object: map[string]interface{}{"spec": tt.spec}}
+			if _, err := calculateExpirationTimeShared(resource, ttl); !errors.Is(err, tt.wantErr) {
+				t.Errorf("calculateExpirationTimeShared() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func int64Ptr(i int64) *int64 {
 	return &i
 }
+
+func TestCalculateExpirationTime_Durations(t *testing.T) {
+	created := time.Now().Add(-time.Hour).Truncate(time.Second)
+	processed := created.Add(30 * time.Minute)
+	resource := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"creationTimestamp": created.Format(time.RFC3339),
+			},
+			"status": map[string]interface{}{
+				"lastProcessedAt": processed.Format(time.RFC3339),
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		ttl      *v1alpha1.TTLSpec
+		expected time.Time
+	}{
+		{name: "durationAfterCreation in days", ttl: &v1alpha1.TTLSpec{DurationAfterCreation: "30d"}, expected: created.Add(30 * 24 * time.Hour)},
+		{name: "durationAfterCreation in weeks", ttl: &v1alpha1.TTLSpec{DurationAfterCreation: "2w"}, expected: created.Add(14 * 24 * time.Hour)},
+		{name: "durationAfter relative to a timestamp", ttl: &v1alpha1.TTLSpec{RelativeTo: "status.lastProcessedAt", DurationAfter: "72h"}, expected: processed.Add(72 * time.Hour)},
+		{name: "same as the seconds form", ttl: &v1alpha1.TTLSpec{SecondsAfterCreation: int64Ptr(7776000)}, expected: created.Add(90 * 24 * time.Hour)},
+		{name: "earliest with a duration field rule", ttl: &v1alpha1.TTLSpec{Earliest: &v1alpha1.EarliestTTLSpec{
+			SecondsAfterCreation: int64Ptr(7776000),
+			Field:                &v1alpha1.TTLSpec{RelativeTo: "status.lastProcessedAt", DurationAfter: "1d"},
+		}}, expected: processed.Add(24 * time.Hour)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := calculateExpirationTimeShared(resource, tt.ttl)
+			if err != nil {
+				t.Fatalf("calculateExpirationTimeShared() returned error: %v", err)
+			}
+			if !got.Equal(tt.expected) {
+				t.Errorf("calculateExpirationTimeShared() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	if _, err := calculateExpirationTimeShared(resource, &v1alpha1.TTLSpec{DurationAfterCreation: "30 days"}); !errors.Is(err, validation.ErrInvalidTTLDuration) {
+		t.Errorf("malformed duration error = %v, want ErrInvalidTTLDuration", err)
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// meetsUnchangedConditionShared checks if a resource has not been modified for at least
+// the condition's quiet period.
+func meetsUnchangedConditionShared(resource *unstructured.Unstructured, cond *v1alpha1.UnchangedCondition) bool {
+	return meetsUnchangedConditionAt(resource, cond, time.Now())
+}
+
+// meetsUnchangedConditionAt evaluates an unchanged condition at the given time. Resources
+// whose last modification time cannot be determined, or a condition without a duration,
+// do not match.
+func meetsUnchangedConditionAt(resource *unstructured.Unstructured, cond *v1alpha1.UnchangedCondition, now time.Time) bool {
+	if cond.ForSeconds == nil {
+		return false
+	}
+	lastModified, ok := lastModifiedTime(resource, cond.FieldPath)
+	if !ok {
+		return false
+	}
+	return now.Sub(lastModified) >= time.Duration(*cond.ForSeconds)*time.Second
+}
+
+// lastModifiedTime returns when a resource was last modified: the RFC3339 timestamp at
+// fieldPath when set, otherwise the latest managedFields time of a manager other than the
+// controller, whose own annotation patches would otherwise restart the quiet period. A
+// resource without such managedFields times has not been modified since its creation.
+func lastModifiedTime(resource *unstructured.Unstructured, fieldPath string) (time.Time, bool) {
+	if fieldPath != "" {
+		value, found, err := nestedString(resource.Object, fieldPath)
+		if err != nil || !found {
+			return time.Time{}, false
+		}
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return t, true
+	}
+
+	var latest time.Time
+	for _, entry := range resource.GetManagedFields() {
+		if entry.Manager == FieldManager {
+			continue
+		}
+		if entry.Time != nil && entry.Time.After(latest) {
+			latest = entry.Time.Time
+		}
+	}
+	if latest.IsZero() {
+		created := resource.GetCreationTimestamp()
+		if created.IsZero() {
+			return time.Time{}, false
+		}
+		latest = created.Time
+	}
+	return latest, true
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func TestMeetsUnchangedConditionAt(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	cond := &v1alpha1.UnchangedCondition{ForSeconds: int64Ptr(3600)}
+
+	tests := []struct {
+		name               string
+		created            time.Time
+		modified           []time.Time
+		controllerModified time.Time
+		want               bool
+	}{
+		{name: "stable resource", created: now.Add(-48 * time.Hour), modified: []time.Time{now.Add(-2 * time.Hour)}, want: true},
+		{name: "recently modified resource", created: now.Add(-48 * time.Hour), modified: []time.Time{now.Add(-2 * time.Hour), now.Add(-10 * time.Minute)}, want: false},
+		{name: "latest entry is not last", created: now.Add(-48 * time.Hour), modified: []time.Time{now.Add(-5 * time.Minute), now.Add(-3 * time.Hour)}, want: false},
+		{name: "unchanged for exactly the quiet period", created: now.Add(-48 * time.Hour), modified: []time.Time{now.Add(-time.Hour)}, want: true},
+		{name: "only the controller modified it recently", created: now.Add(-48 * time.Hour), modified: []time.Time{now.Add(-2 * time.Hour)}, controllerModified: now.Add(-time.Minute), want: true},
+		{name: "only the controller modified a new resource", created: now.Add(-time.Minute), controllerModified: now.Add(-time.Second), want: false},
+		{name: "no managedFields and old", created: now.Add(-2 * time.Hour), want: true},
+		{name: "no managedFields and new", created: now.Add(-time.Minute), want: false},
+		{name: "no managedFields or creationTimestamp", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := newTestConfigMap("default", "cm", tt.created)
+			for _, modified := range tt.modified {
+				resource.SetManagedFields(append(resource.GetManagedFields(), managedFieldsEntry("kubectl", modified)))
+			}
+			if !tt.controllerModified.IsZero() {
+				resource.SetManagedFields(append(resource.GetManagedFields(), managedFieldsEntry(FieldManager, tt.controllerModified)))
+			}
+			if got := meetsUnchangedConditionAt(resource, cond, now); got != tt.want {
+				t.Errorf("meetsUnchangedConditionAt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMeetsUnchangedConditionAt_FieldPath(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	cond := &v1alpha1.UnchangedCondition{ForSeconds: int64Ptr(3600), FieldPath: "status.lastUpdateTime"}
+
+	// managedFields are ignored when a field path is configured
+	stable := newTestConfigMap("default", "stable", now.Add(-48*time.Hour))
+	stable.SetManagedFields([]metav1.ManagedFieldsEntry{managedFieldsEntry("kubectl", now.Add(-time.Minute))})
+	_ = unstructured.SetNestedField(stable.Object, now.Add(-2*time.Hour).Format(time.RFC3339), "status", "lastUpdateTime")
+	if !meetsUnchangedConditionAt(stable, cond, now) {
+		t.Error("expected a resource whose last-modified field is old to match")
+	}
+
+	recent := newTestConfigMap("default", "recent", now.Add(-48*time.Hour))
+	recent.SetManagedFields([]metav1.ManagedFieldsEntry{managedFieldsEntry("kubectl", now.Add(-48*time.Hour))})
+	_ = unstructured.SetNestedField(recent.Object, now.Add(-time.Minute).Format(time.RFC3339), "status", "lastUpdateTime")
+	if meetsUnchangedConditionAt(recent, cond, now) {
+		t.Error("expected a resource whose last-modified field is recent not to match")
+	}
+
+	missing := newTestConfigMap("default", "missing", now.Add(-48*time.Hour))
+	missing.SetManagedFields([]metav1.ManagedFieldsEntry{managedFieldsEntry("kubectl", now.Add(-48*time.Hour))})
+	if meetsUnchangedConditionAt(missing, cond, now) {
+		t.Error("expected a resource without the last-modified field not to match")
+	}
+
+	invalid := newTestConfigMap("default", "invalid", now.Add(-48*time.Hour))
+	_ = unstructured.SetNestedField(invalid.Object, "yesterday", "status", "lastUpdateTime")
+	if meetsUnchangedConditionAt(invalid, cond, now) {
+		t.Error("expected a resource with an unparsable last-modified field not to match")
+	}
+}
+
+func TestMeetsConditionsShared_Unchanged(t *testing.T) {
+	conditions := &v1alpha1.ConditionsSpec{
+		Unchanged: &v1alpha1.UnchangedCondition{ForSeconds: int64Ptr(3600)},
+	}
+	resource := newTestConfigMap("default", "cm", time.Now().Add(-48*time.Hour))
+	resource.SetManagedFields([]metav1.ManagedFieldsEntry{managedFieldsEntry("kubectl", time.Now().Add(-2*time.Hour))})
+	if !meetsConditionsShared(resource, conditions) {
+		t.Error("expected a stable resource to meet the unchanged condition")
+	}
+	resource.SetManagedFields([]metav1.ManagedFieldsEntry{managedFieldsEntry("kubectl", time.Now())})
+	if meetsConditionsShared(resource, conditions) {
+		t.Error("expected a recently modified resource not to meet the unchanged condition")
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Error codes set in GCError.Type. These are part of the controller's API: they appear
+// in events, logs, status messages and the gc_errors_total error_type label, so
+// downstream tooling can switch on them. Existing values must not change.
+const (
+	// TypeUnknown is reported for errors that carry no code.
+	TypeUnknown = "unknown"
+
+	// TypeEvaluationFailed indicates that policy evaluation failed.
+	TypeEvaluationFailed = "evaluation_failed"
+
+	// TypeInformerCreationFailed indicates that the resource informer could not be created.
+	TypeInformerCreationFailed = "informer_creation_failed"
+
+	// TypeInvalidGVR indicates that the policy's target resource could not be parsed.
+	TypeInvalidGVR = "invalid_gvr"
+
+	// TypeListResourcesFailed indicates that target resources could not be listed.
+	TypeListResourcesFailed = "list_resources_failed"
+
+	// TypeInvalidLabelSelector indicates that the policy's label selector is invalid.
+	TypeInvalidLabelSelector = "invalid_label_selector"
+
+	// TypeDeletionFailed indicates that a resource could not be deleted.
+	TypeDeletionFailed = "deletion_failed"
+
+	// TypeStatusGetFailed indicates that the policy could not be read for a status update.
+	TypeStatusGetFailed = "status_get_failed"
+
+	// TypeStatusUpdateFailed indicates that the policy status could not be written.
+	TypeStatusUpdateFailed = "status_update_failed"
+
+	// TypeSnapshotFailed indicates that a pre-deletion snapshot could not be written.
+	TypeSnapshotFailed = "snapshot_failed"
+
+	// TypeTargetScopeMismatch indicates that the target namespace does not match the kind's scope.
+	TypeTargetScopeMismatch = "target_scope_mismatch"
+
+	// TypeResultWebhookFailed indicates that the evaluation result webhook could not be delivered.
+	TypeResultWebhookFailed = "result_webhook_failed"
+
+	// TypeDataDriftReferenceFailed indicates that a data drift reference object could not be read.
+	TypeDataDriftReferenceFailed = "data_drift_reference_failed"
+
+	// TypeSpecHashReferenceFailed indicates that the bad hashes ConfigMap of a specHash condition could not be read.
+	TypeSpecHashReferenceFailed = "spec_hash_reference_failed"
+
+	// TypeBackupStatusUnavailable indicates that the backup gate could not read backup status.
+	TypeBackupStatusUnavailable = "backup_status_unavailable"
+
+	// TypeMetricQueryFailed indicates that the metric threshold query could not be evaluated.
+	TypeMetricQueryFailed = "metric_query_failed"
+
+	// TypeAPIGroupNotAllowed indicates that a policy targets an API group the controller may not act on.
+	TypeAPIGroupNotAllowed = "api_group_not_allowed"
+
+	// TypeInvalidSchedule indicates that the policy's schedule cannot be parsed.
+	TypeInvalidSchedule = "invalid_schedule"
+
+	// TypeOPAURLNotAllowed indicates that a policy queries an OPA decision URL the controller does not allow.
+	TypeOPAURLNotAllowed = "opa_url_not_allowed"
+
+	// TypeResultWebhookURLNotAllowed indicates that a policy's result webhook URL is one the controller does not allow.
+	TypeResultWebhookURLNotAllowed = "result_webhook_url_not_allowed"
+)
+
+// Code returns the error code of the first GCError in err's chain, or TypeUnknown if
+// there is none or it carries no code.
+func Code(err error) string {
+	var gcErr *GCError
+	if errors.As(err, &gcErr) && gcErr != nil && gcErr.Type != "" {
+		return gcErr.Type
+	}
+	return TypeUnknown
+}
+
+// Format renders err with its code as "[code] message", the form used in events and
+// status messages so the code is always in the same place.
+func Format(err error) string {
+	if err == nil {
+		return ""
+	}
+	return fmt.Sprintf("[%s] %s", Code(err), err.Error())
+}
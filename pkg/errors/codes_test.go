@@ -0,0 +1,69 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "nil error", err: nil, want: TypeUnknown},
+		{name: "plain error", err: errUnderlying, want: TypeUnknown},
+		{name: "GCError without a code", err: WithPolicy(errUnderlying, testNS, "policy"), want: TypeUnknown},
+		{name: "GCError with a code", err: Wrap(errUnderlying, TypeDeletionFailed, "delete"), want: TypeDeletionFailed},
+		{name: "wrapped GCError", err: fmt.Errorf("outer: %w", New(TypeInvalidGVR, "bad gvr")), want: TypeInvalidGVR},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Code(tt.err); got != tt.want {
+				t.Errorf("Code() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "nil error", err: nil, want: ""},
+		{name: "plain error", err: errors.New("boom"), want: "[unknown] boom"},
+		{
+			name: "GCError with a code",
+			err:  Wrap(errUnderlying, TypeStatusUpdateFailed, "failed to update status"),
+			want: "[status_update_failed] failed to update status: underlying error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Format(tt.err); got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,373 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package query parses Lucene-style query strings into trees of field conditions.
+//
+// A query combines terms with AND, OR, NOT, and parentheses; NOT binds tighter than AND,
+// which binds tighter than OR. Terms compile to field conditions:
+//
+//	status.phase:Failed                 Equals
+//	metadata.labels.tier:"web frontend" Equals (quoted value)
+//	spec.nodeName:*                     Exists
+//	status.phase:(Failed OR Unknown)    In
+//
+// Example: status.phase:Failed AND NOT metadata.labels.keep:true
+package query
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// ErrInvalidQuery indicates a query string could not be parsed.
+var ErrInvalidQuery = errors.New("invalid query")
+
+// maxDepth bounds the nesting of parentheses and NOT operators.
+const maxDepth = 32
+
+// Op is the operator of a query node.
+type Op string
+
+const (
+	// OpAnd matches when all children match.
+	OpAnd Op = "And"
+	// OpOr matches when any child matches.
+	OpOr Op = "Or"
+	// OpNot matches when its single child does not match.
+	OpNot Op = "Not"
+	// OpField matches when its field condition matches.
+	OpField Op = "Field"
+)
+
+// Node is a node of a parsed query.
+type Node struct {
+	Op       Op
+	Children []*Node
+	Field    *v1alpha1.FieldCondition
+}
+
+// Eval evaluates the query, using match to evaluate each field condition.
+func (n *Node) Eval(match func(*v1alpha1.FieldCondition) bool) bool {
+	switch n.Op {
+	case OpAnd:
+		for _, child := range n.Children {
+			if !child.Eval(match) {
+				return false
+			}
+		}
+		return true
+	case OpOr:
+		for _, child := range n.Children {
+			if child.Eval(match) {
+				return true
+			}
+		}
+		return false
+	case OpNot:
+		return !n.Children[0].Eval(match)
+	case OpField:
+		return match(n.Field)
+	default:
+		return false
+	}
+}
+
+// Parse parses a query string into a condition tree.
+func Parse(input string) (*Node, error) {
+	tokens, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("%w: query is empty", ErrInvalidQuery)
+	}
+
+	p := &parser{tokens: tokens}
+	node, err := p.parseOr(0)
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok != nil {
+		return nil, fmt.Errorf("%w: unexpected %s at offset %d (terms must be joined with AND or OR)", ErrInvalidQuery, tok, tok.offset)
+	}
+	return node, nil
+}
+
+type tokenKind int
+
+const (
+	tokenAnd tokenKind = iota
+	tokenOr
+	tokenNot
+	tokenOpen
+	tokenClose
+	tokenTerm
+)
+
+type token struct {
+	kind   tokenKind
+	offset int
+	field  *v1alpha1.FieldCondition
+	text   string
+}
+
+func (t *token) String() string {
+	return fmt.Sprintf("%q", t.text)
+}
+
+// tokenize splits a query into operators, parentheses, and field terms.
+func tokenize(input string) ([]*token, error) {
+	var tokens []*token
+	for i := 0; i < len(input); {
+		switch c := input[i]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, &token{kind: tokenOpen, offset: i, text: "("})
+			i++
+		case c == ')':
+			tokens = append(tokens, &token{kind: tokenClose, offset: i, text: ")"})
+			i++
+		default:
+			word, end := readWord(input, i)
+			switch word {
+			case "AND":
+				tokens = append(tokens, &token{kind: tokenAnd, offset: i, text: word})
+				i = end
+				continue
+			case "OR":
+				tokens = append(tokens, &token{kind: tokenOr, offset: i, text: word})
+				i = end
+				continue
+			case "NOT":
+				tokens = append(tokens, &token{kind: tokenNot, offset: i, text: word})
+				i = end
+				continue
+			}
+			term, end, err := readTerm(input, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, term)
+			i = end
+		}
+	}
+	return tokens, nil
+}
+
+// readWord reads a bare word: everything up to whitespace, a parenthesis, a quote, or a colon.
+func readWord(input string, start int) (string, int) {
+	end := start
+	for end < len(input) && !strings.ContainsRune(" \t\n\r()\":", rune(input[end])) {
+		end++
+	}
+	return input[start:end], end
+}
+
+// readTerm reads a field:value term starting at start.
+func readTerm(input string, start int) (*token, int, error) {
+	field, i := readWord(input, start)
+	if field == "" || i >= len(input) || input[i] != ':' {
+		return nil, 0, fmt.Errorf("%w: expected field:value at offset %d", ErrInvalidQuery, start)
+	}
+	i++
+
+	cond := &v1alpha1.FieldCondition{FieldPath: field}
+	switch {
+	case i < len(input) && input[i] == '(':
+		values, end, err := readValueGroup(input, i+1)
+		if err != nil {
+			return nil, 0, err
+		}
+		cond.Operator = "In"
+		cond.Values = values
+		i = end
+	case i < len(input) && input[i] == '"':
+		value, end, err := readQuoted(input, i)
+		if err != nil {
+			return nil, 0, err
+		}
+		cond.Operator = "Equals"
+		cond.Value = value
+		i = end
+	default:
+		value, end := readWord(input, i)
+		if value == "" {
+			return nil, 0, fmt.Errorf("%w: missing value for field %q at offset %d", ErrInvalidQuery, field, start)
+		}
+		if value == "*" {
+			cond.Operator = "Exists"
+		} else {
+			cond.Operator = "Equals"
+			cond.Value = value
+		}
+		i = end
+	}
+	return &token{kind: tokenTerm, offset: start, field: cond, text: input[start:i]}, i, nil
+}
+
+// readQuoted reads a double-quoted value starting at the opening quote; \" and \\ are escapes.
+func readQuoted(input string, start int) (string, int, error) {
+	var value strings.Builder
+	for i := start + 1; i < len(input); i++ {
+		switch input[i] {
+		case '\\':
+			if i+1 < len(input) {
+				i++
+				value.WriteByte(input[i])
+			}
+		case '"':
+			return value.String(), i + 1, nil
+		default:
+			value.WriteByte(input[i])
+		}
+	}
+	return "", 0, fmt.Errorf("%w: unterminated quoted value at offset %d", ErrInvalidQuery, start)
+}
+
+// readValueGroup reads the values of field:(a OR b ...) after the opening parenthesis.
+func readValueGroup(input string, start int) ([]string, int, error) {
+	var values []string
+	expectValue := true
+	for i := start; i < len(input); {
+		switch c := input[i]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == ')':
+			if expectValue {
+				return nil, 0, fmt.Errorf("%w: expected value at offset %d", ErrInvalidQuery, i)
+			}
+			return values, i + 1, nil
+		case !expectValue:
+			if word, end := readWord(input, i); word == "OR" {
+				expectValue = true
+				i = end
+				continue
+			}
+			return nil, 0, fmt.Errorf("%w: values in a group must be joined with OR at offset %d", ErrInvalidQuery, i)
+		case c == '"':
+			value, end, err := readQuoted(input, i)
+			if err != nil {
+				return nil, 0, err
+			}
+			values = append(values, value)
+			expectValue = false
+			i = end
+		default:
+			value, end := readWord(input, i)
+			if value == "" || value == "AND" || value == "OR" || value == "NOT" {
+				return nil, 0, fmt.Errorf("%w: expected value at offset %d", ErrInvalidQuery, i)
+			}
+			values = append(values, value)
+			expectValue = false
+			i = end
+		}
+	}
+	return nil, 0, fmt.Errorf("%w: unterminated value group at offset %d", ErrInvalidQuery, start-1)
+}
+
+// parser is a recursive descent parser over tokens.
+type parser struct {
+	tokens []*token
+	pos    int
+}
+
+func (p *parser) peek() *token {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return nil
+}
+
+// parseOr parses: and (OR and)*.
+func (p *parser) parseOr(depth int) (*Node, error) {
+	first, err := p.parseAnd(depth)
+	if err != nil {
+		return nil, err
+	}
+	children := []*Node{first}
+	for tok := p.peek(); tok != nil && tok.kind == tokenOr; tok = p.peek() {
+		p.pos++
+		next, err := p.parseAnd(depth)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, next)
+	}
+	if len(children) == 1 {
+		return first, nil
+	}
+	return &Node{Op: OpOr, Children: children}, nil
+}
+
+// parseAnd parses: unary (AND unary)*.
+func (p *parser) parseAnd(depth int) (*Node, error) {
+	first, err := p.parseUnary(depth)
+	if err != nil {
+		return nil, err
+	}
+	children := []*Node{first}
+	for tok := p.peek(); tok != nil && tok.kind == tokenAnd; tok = p.peek() {
+		p.pos++
+		next, err := p.parseUnary(depth)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, next)
+	}
+	if len(children) == 1 {
+		return first, nil
+	}
+	return &Node{Op: OpAnd, Children: children}, nil
+}
+
+// parseUnary parses: NOT unary | ( or ) | term.
+func (p *parser) parseUnary(depth int) (*Node, error) {
+	if depth > maxDepth {
+		return nil, fmt.Errorf("%w: nested more than %d levels deep", ErrInvalidQuery, maxDepth)
+	}
+	tok := p.peek()
+	if tok == nil {
+		return nil, fmt.Errorf("%w: unexpected end of query", ErrInvalidQuery)
+	}
+	p.pos++
+
+	switch tok.kind {
+	case tokenNot:
+		child, err := p.parseUnary(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		return &Node{Op: OpNot, Children: []*Node{child}}, nil
+	case tokenOpen:
+		node, err := p.parseOr(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.peek(); closing == nil || closing.kind != tokenClose {
+			return nil, fmt.Errorf("%w: missing closing parenthesis for offset %d", ErrInvalidQuery, tok.offset)
+		}
+		p.pos++
+		return node, nil
+	case tokenTerm:
+		return &Node{Op: OpField, Field: tok.field}, nil
+	default:
+		return nil, fmt.Errorf("%w: unexpected %s at offset %d", ErrInvalidQuery, tok, tok.offset)
+	}
+}
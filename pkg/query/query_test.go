@@ -0,0 +1,177 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+// matchFields evaluates field conditions against a flat map of field values.
+func matchFields(fields map[string]string) func(*v1alpha1.FieldCondition) bool {
+	return func(cond *v1alpha1.FieldCondition) bool {
+		value, found := fields[cond.FieldPath]
+		if !found {
+			return false
+		}
+		switch cond.Operator {
+		case "Equals":
+			return value == cond.Value
+		case "Exists":
+			return true
+		case "In":
+			for _, v := range cond.Values {
+				if value == v {
+					return true
+				}
+			}
+		}
+		return false
+	}
+}
+
+func TestParse_Matches(t *testing.T) {
+	failedPod := map[string]string{"status.phase": "Failed", "metadata.labels.app": "web"}
+	keptPod := map[string]string{"status.phase": "Failed", "metadata.labels.keep": "true"}
+	runningPod := map[string]string{"status.phase": "Running", "spec.nodeName": "node-1"}
+
+	tests := []struct {
+		name  string
+		query string
+		want  []bool // failedPod, keptPod, runningPod
+	}{
+		{
+			name:  "single term",
+			query: "status.phase:Failed",
+			want:  []bool{true, true, false},
+		},
+		{
+			name:  "AND NOT",
+			query: "status.phase:Failed AND NOT metadata.labels.keep:true",
+			want:  []bool{true, false, false},
+		},
+		{
+			name:  "OR",
+			query: "metadata.labels.app:web OR spec.nodeName:node-1",
+			want:  []bool{true, false, true},
+		},
+		{
+			name:  "AND binds tighter than OR",
+			query: "status.phase:Running OR status.phase:Failed AND metadata.labels.app:web",
+			want:  []bool{true, false, true},
+		},
+		{
+			name:  "parentheses",
+			query: "(status.phase:Running OR status.phase:Failed) AND NOT metadata.labels.keep:true",
+			want:  []bool{true, false, true},
+		},
+		{
+			name:  "exists",
+			query: "spec.nodeName:*",
+			want:  []bool{false, false, true},
+		},
+		{
+			name:  "value group",
+			query: "status.phase:(Running OR Unknown)",
+			want:  []bool{false, false, true},
+		},
+		{
+			name:  "quoted value",
+			query: `metadata.labels.app:"web"`,
+			want:  []bool{true, false, false},
+		},
+		{
+			name:  "double negation",
+			query: "NOT NOT status.phase:Running",
+			want:  []bool{false, false, true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := Parse(tt.query)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %v", tt.query, err)
+			}
+			for i, fields := range []map[string]string{failedPod, keptPod, runningPod} {
+				if got := node.Eval(matchFields(fields)); got != tt.want[i] {
+					t.Errorf("Eval(%v) = %v, want %v", fields, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParse_CompilesToFieldConditions(t *testing.T) {
+	node, err := Parse(`status.phase:Failed AND metadata.annotations.owner:"team \"a\"" AND status.reason:(Evicted OR "Out Of Memory")`)
+	if err != nil {
+		t.Fatalf("Parse() returned error: %v", err)
+	}
+	if node.Op != OpAnd || len(node.Children) != 3 {
+		t.Fatalf("Parse() = %+v, want And of 3 terms", node)
+	}
+
+	want := []v1alpha1.FieldCondition{
+		{FieldPath: "status.phase", Operator: "Equals", Value: "Failed"},
+		{FieldPath: "metadata.annotations.owner", Operator: "Equals", Value: `team "a"`},
+		{FieldPath: "status.reason", Operator: "In", Values: []string{"Evicted", "Out Of Memory"}},
+	}
+	for i, child := range node.Children {
+		if child.Op != OpField || !reflect.DeepEqual(*child.Field, want[i]) {
+			t.Errorf("term %d = %+v, want %+v", i, child.Field, want[i])
+		}
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	deep := ""
+	for i := 0; i <= maxDepth; i++ {
+		deep += "("
+	}
+	deep += "a:b"
+
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{name: "empty", query: "   "},
+		{name: "missing value", query: "status.phase:"},
+		{name: "missing field", query: ":Failed"},
+		{name: "bare word", query: "Failed"},
+		{name: "implicit AND", query: "status.phase:Failed metadata.labels.app:web"},
+		{name: "dangling operator", query: "status.phase:Failed AND"},
+		{name: "leading operator", query: "OR status.phase:Failed"},
+		{name: "unbalanced parenthesis", query: "(status.phase:Failed"},
+		{name: "extra closing parenthesis", query: "status.phase:Failed)"},
+		{name: "unterminated quote", query: `status.phase:"Failed`},
+		{name: "empty value group", query: "status.phase:()"},
+		{name: "value group without OR", query: "status.phase:(Failed Unknown)"},
+		{name: "unterminated value group", query: "status.phase:(Failed OR"},
+		{name: "too deep", query: deep},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(tt.query); !errors.Is(err, ErrInvalidQuery) {
+				t.Errorf("Parse(%q) error = %v, want ErrInvalidQuery", tt.query, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	gcapi "github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/config"
+)
+
+// ErrAPIGroupNotAllowed indicates a policy targets an API group outside the controller's allowlist.
+var ErrAPIGroupNotAllowed = errors.New("API group is not allowed")
+
+// CheckAllowedAPIGroups checks that the policy's target resource and alsoDelete dependents
+// are in the allowed API groups, where the core group is the empty string. A nil list
+// allows every group. API versions that do not parse are left to ValidatePolicy.
+func CheckAllowedAPIGroups(spec *gcapi.GarbageCollectionPolicySpec, allowed []string) error {
+	if allowed == nil {
+		return nil
+	}
+	if err := checkAllowedAPIGroup("targetResource", spec.TargetResource.APIVersion, allowed); err != nil {
+		return err
+	}
+	for i := range spec.Behavior.AlsoDelete {
+		if err := checkAllowedAPIGroup(fmt.Sprintf("behavior.alsoDelete[%d]", i), spec.Behavior.AlsoDelete[i].APIVersion, allowed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkAllowedAPIGroup checks the API group of a single apiVersion.
+func checkAllowedAPIGroup(field, apiVersion string, allowed []string) error {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil || slices.Contains(allowed, gv.Group) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s targets %s (allowed: %s)", ErrAPIGroupNotAllowed, field, apiGroupName(gv.Group), formatAPIGroups(allowed))
+}
+
+// formatAPIGroups renders API groups for messages, naming the core group.
+func formatAPIGroups(groups []string) string {
+	names := make([]string, 0, len(groups))
+	for _, group := range groups {
+		names = append(names, apiGroupName(group))
+	}
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, ", ")
+}
+
+// apiGroupName returns the group's name, or config.CoreAPIGroupName for the core group.
+func apiGroupName(group string) string {
+	if group == "" {
+		return config.CoreAPIGroupName
+	}
+	return group
+}
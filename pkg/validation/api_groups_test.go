@@ -0,0 +1,46 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+
+	gcapi "github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func TestCheckAllowedAPIGroups(t *testing.T) {
+	jobs := gcapi.TargetResourceSpec{APIVersion: "batch/v1", Kind: "Job"}
+	configMaps := gcapi.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap"}
+	certificates := gcapi.TargetResourceSpec{APIVersion: "cert-manager.io/v1", Kind: "Certificate"}
+
+	tests := []struct {
+		name    string
+		spec    gcapi.GarbageCollectionPolicySpec
+		allowed []string
+		wantErr bool
+	}{
+		{name: "nil allows every group", spec: gcapi.GarbageCollectionPolicySpec{TargetResource: certificates}},
+		{name: "allowed group", spec: gcapi.GarbageCollectionPolicySpec{TargetResource: jobs}, allowed: []string{"batch", "apps"}},
+		{name: "core group", spec: gcapi.GarbageCollectionPolicySpec{TargetResource: configMaps}, allowed: []string{""}},
+		{name: "disallowed group", spec: gcapi.GarbageCollectionPolicySpec{TargetResource: certificates}, allowed: []string{"batch", "apps"}, wantErr: true},
+		{name: "core group not listed", spec: gcapi.GarbageCollectionPolicySpec{TargetResource: configMaps}, allowed: []string{"batch"}, wantErr: true},
+		{name: "empty list allows nothing", spec: gcapi.GarbageCollectionPolicySpec{TargetResource: jobs}, allowed: []string{}, wantErr: true},
+		{
+			name: "disallowed alsoDelete dependent",
+			spec: gcapi.GarbageCollectionPolicySpec{
+				TargetResource: jobs,
+				Behavior:       gcapi.BehaviorSpec{AlsoDelete: []gcapi.TargetResourceSpec{configMaps}},
+			},
+			allowed: []string{"batch"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckAllowedAPIGroups(&tt.spec, tt.allowed)
+			if tt.wantErr != errors.Is(err, ErrAPIGroupNotAllowed) {
+				t.Errorf("CheckAllowedAPIGroups() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// ErrInvalidTTLDuration indicates a TTL duration string cannot be parsed.
+var ErrInvalidTTLDuration = errors.New(`invalid TTL duration: expected a sequence of numbers with units ns, us, ms, s, m, h, d, or w, e.g. "72h", "30d", "2w"`)
+
+// ttlDurationUnits are the units ParseTTLDuration accepts beyond those of time.ParseDuration.
+var ttlDurationUnits = map[string]time.Duration{
+	"d": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour,
+}
+
+// ParseTTLDuration parses a Go duration string extended with d (24h) and w (7d) units,
+// e.g. "72h", "30d", "2w", or "1w3d12h". Signs are not accepted.
+func ParseTTLDuration(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidTTLDuration, s)
+	}
+
+	var total time.Duration
+	for rest := s; rest != ""; {
+		// Split off the next <number><unit> segment
+		numEnd := 0
+		for numEnd < len(rest) && (rest[numEnd] == '.' || (rest[numEnd] >= '0' && rest[numEnd] <= '9')) {
+			numEnd++
+		}
+		unitEnd := numEnd
+		for unitEnd < len(rest) && rest[unitEnd] != '.' && (rest[unitEnd] < '0' || rest[unitEnd] > '9') {
+			unitEnd++
+		}
+		if numEnd == 0 || unitEnd == numEnd {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidTTLDuration, s)
+		}
+		number, unit := rest[:numEnd], rest[numEnd:unitEnd]
+		rest = rest[unitEnd:]
+
+		var segment time.Duration
+		if scale, ok := ttlDurationUnits[unit]; ok {
+			value, err := strconv.ParseFloat(number, 64)
+			if err != nil || value*float64(scale) >= math.MaxInt64 {
+				return 0, fmt.Errorf("%w: %q", ErrInvalidTTLDuration, s)
+			}
+			segment = time.Duration(value * float64(scale))
+		} else {
+			d, err := time.ParseDuration(number + unit)
+			if err != nil {
+				return 0, fmt.Errorf("%w: %q", ErrInvalidTTLDuration, s)
+			}
+			segment = d
+		}
+		if total > math.MaxInt64-segment {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidTTLDuration, s)
+		}
+		total += segment
+	}
+	return total, nil
+}
@@ -0,0 +1,46 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseTTLDuration(t *testing.T) {
+	tests := []struct {
+		input     string
+		expected  time.Duration
+		expectErr bool
+	}{
+		{input: "72h", expected: 72 * time.Hour},
+		{input: "90s", expected: 90 * time.Second},
+		{input: "30d", expected: 30 * 24 * time.Hour},
+		{input: "2w", expected: 14 * 24 * time.Hour},
+		{input: "1w3d12h", expected: (7+3)*24*time.Hour + 12*time.Hour},
+		{input: "1.5d", expected: 36 * time.Hour},
+		{input: "1h30m", expected: 90 * time.Minute},
+		{input: "", expectErr: true},
+		{input: "30", expectErr: true},
+		{input: "d", expectErr: true},
+		{input: "30days", expectErr: true},
+		{input: "-1d", expectErr: true},
+		{input: "1y", expectErr: true},
+		{input: "1..5d", expectErr: true},
+		{input: "100000000w", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseTTLDuration(tt.input)
+			if tt.expectErr {
+				if !errors.Is(err, ErrInvalidTTLDuration) {
+					t.Errorf("ParseTTLDuration(%q) error = %v, want ErrInvalidTTLDuration", tt.input, err)
+				}
+				return
+			}
+			if err != nil || got != tt.expected {
+				t.Errorf("ParseTTLDuration(%q) = %v, %v, want %v", tt.input, got, err, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	gcapi "github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+var (
+	// ErrOPAURLNotAllowed indicates a policy queries an OPA decision URL outside the controller's allowlist.
+	ErrOPAURLNotAllowed = errors.New("opa url is not allowed")
+
+	// ErrResultWebhookURLNotAllowed indicates a policy's result webhook URL is outside the controller's allowlist.
+	ErrResultWebhookURLNotAllowed = errors.New("result webhook url is not allowed")
+)
+
+// CheckAllowedOPAURLs checks that the policy's OPA conditions, including those in anyOf
+// groups, query decision URLs in the controller's allowlist. URLs are compared exactly, and
+// an empty list allows none, since the controller sends resources to those URLs.
+func CheckAllowedOPAURLs(spec *gcapi.GarbageCollectionPolicySpec, allowed []string) error {
+	if spec.Conditions == nil {
+		return nil
+	}
+	return checkAllowedOPAURLs("conditions", spec.Conditions, allowed)
+}
+
+// checkAllowedOPAURLs checks the OPA condition of a conditions group and its anyOf groups.
+func checkAllowedOPAURLs(field string, conditions *gcapi.ConditionsSpec, allowed []string) error {
+	if conditions.OPA != nil && !slices.Contains(allowed, conditions.OPA.URL) {
+		return fmt.Errorf("%w: %s.opa.url %q is not in --opa-allowed-urls", ErrOPAURLNotAllowed, field, conditions.OPA.URL)
+	}
+	for i := range conditions.AnyOf {
+		if err := checkAllowedOPAURLs(fmt.Sprintf("%s.anyOf[%d]", field, i), &conditions.AnyOf[i], allowed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CheckAllowedResultWebhookURLs checks that the policy's result webhook URL is in the
+// controller's allowlist. URLs are compared exactly, and an empty list allows none.
+func CheckAllowedResultWebhookURLs(spec *gcapi.GarbageCollectionPolicySpec, allowed []string) error {
+	webhook := spec.Behavior.ResultWebhook
+	if webhook == nil || webhook.URL == "" || slices.Contains(allowed, webhook.URL) {
+		return nil
+	}
+	return fmt.Errorf("%w: behavior.resultWebhook.url %q is not in --result-webhook-allowed-urls", ErrResultWebhookURLNotAllowed, webhook.URL)
+}
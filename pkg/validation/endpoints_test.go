@@ -0,0 +1,69 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+
+	gcapi "github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func TestCheckAllowedOPAURLs(t *testing.T) {
+	const opaURL = "http://opa.opa:8181/v1/data/gc/allow"
+	opa := &gcapi.OPACondition{URL: opaURL}
+
+	tests := []struct {
+		name       string
+		conditions *gcapi.ConditionsSpec
+		allowed    []string
+		wantErr    bool
+	}{
+		{name: "no conditions", conditions: nil},
+		{name: "no opa condition", conditions: &gcapi.ConditionsSpec{OrphansOnly: true}},
+		{name: "allowed url", conditions: &gcapi.ConditionsSpec{OPA: opa}, allowed: []string{opaURL}},
+		{name: "empty list allows none", conditions: &gcapi.ConditionsSpec{OPA: opa}, wantErr: true},
+		{name: "url not listed", conditions: &gcapi.ConditionsSpec{OPA: opa}, allowed: []string{"http://opa.opa:8181/v1/data/gc/other"}, wantErr: true},
+		{name: "url prefix not enough", conditions: &gcapi.ConditionsSpec{OPA: opa}, allowed: []string{"http://opa.opa:8181"}, wantErr: true},
+		{
+			name:       "disallowed url in anyOf group",
+			conditions: &gcapi.ConditionsSpec{AnyOf: []gcapi.ConditionsSpec{{OrphansOnly: true}, {OPA: opa}}},
+			allowed:    []string{"http://opa.opa:8181/v1/data/gc/other"},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := &gcapi.GarbageCollectionPolicySpec{Conditions: tt.conditions}
+			err := CheckAllowedOPAURLs(spec, tt.allowed)
+			if tt.wantErr != errors.Is(err, ErrOPAURLNotAllowed) {
+				t.Errorf("CheckAllowedOPAURLs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckAllowedResultWebhookURLs(t *testing.T) {
+	const webhookURL = "https://hooks.example.com/gc"
+
+	tests := []struct {
+		name    string
+		webhook *gcapi.ResultWebhookSpec
+		allowed []string
+		wantErr bool
+	}{
+		{name: "no webhook"},
+		{name: "allowed url", webhook: &gcapi.ResultWebhookSpec{URL: webhookURL}, allowed: []string{webhookURL}},
+		{name: "empty list allows none", webhook: &gcapi.ResultWebhookSpec{URL: webhookURL}, wantErr: true},
+		{name: "url not listed", webhook: &gcapi.ResultWebhookSpec{URL: "http://169.254.169.254/latest"}, allowed: []string{webhookURL}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := &gcapi.GarbageCollectionPolicySpec{Behavior: gcapi.BehaviorSpec{ResultWebhook: tt.webhook}}
+			err := CheckAllowedResultWebhookURLs(spec, tt.allowed)
+			if tt.wantErr != errors.Is(err, ErrResultWebhookURLNotAllowed) {
+				t.Errorf("CheckAllowedResultWebhookURLs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidFieldPath indicates a field path cannot be parsed.
+var ErrInvalidFieldPath = errors.New(`invalid field path: expected dot-separated keys with optional [index], ['key'] or [key=value] segments, e.g. "spec.containers[0].image", "metadata.annotations['gc.kube-zen.io/ttl']" or "status.conditions[type=Ready].status"`)
+
+// FieldPathSegment is one step of a parsed field path: a map key, an array index when
+// IsIndex is set, or, when IsFilter is set, the first array element whose Key field equals
+// Value.
+type FieldPathSegment struct {
+	Key      string
+	Index    int
+	IsIndex  bool
+	Value    string
+	IsFilter bool
+}
+
+// ParseFieldPath parses a field path into its segments. Keys are separated by dots; a dot or
+// backslash inside a key is escaped with a backslash. Bracketed segments hold an array index,
+// e.g. "status.conditions[0].status", a single- or double-quoted key that may contain dots
+// and slashes, e.g. "metadata.annotations['gc.kube-zen.io/ttl']", or a key=value filter
+// selecting an array element, e.g. "status.conditions[type=Ready].status", whose value may
+// be quoted. Plain dotted paths parse as they always have, one key per dot.
+func ParseFieldPath(path string) ([]FieldPathSegment, error) {
+	if path == "" {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidFieldPath, path)
+	}
+
+	var segments []FieldPathSegment
+	for i := 0; i < len(path); {
+		if path[i] == '[' {
+			segment, next, ok := parseBracketSegment(path, i)
+			if !ok {
+				return nil, fmt.Errorf("%w: %q", ErrInvalidFieldPath, path)
+			}
+			segments = append(segments, segment)
+			i = next
+		} else {
+			if len(segments) > 0 {
+				// A key after another segment must follow a dot
+				if path[i] != '.' {
+					return nil, fmt.Errorf("%w: %q", ErrInvalidFieldPath, path)
+				}
+				i++
+			}
+			key, next, ok := parseKeySegment(path, i)
+			if !ok {
+				return nil, fmt.Errorf("%w: %q", ErrInvalidFieldPath, path)
+			}
+			segments = append(segments, FieldPathSegment{Key: key})
+			i = next
+		}
+
+		// A bracketed segment attaches directly to the segment before it, so "conditions.[0]" is rejected
+		if i < len(path) && path[i] == '.' && i+1 < len(path) && path[i+1] == '[' {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidFieldPath, path)
+		}
+	}
+	return segments, nil
+}
+
+// parseKeySegment reads an unquoted key starting at i, up to the next unescaped dot or
+// opening bracket, and returns the key and the index after it. Empty keys are rejected.
+func parseKeySegment(path string, i int) (string, int, bool) {
+	var key strings.Builder
+	for i < len(path) && path[i] != '.' && path[i] != '[' {
+		switch path[i] {
+		case '\\':
+			if i+1 >= len(path) {
+				return "", 0, false
+			}
+			key.WriteByte(path[i+1])
+			i += 2
+		case ']', '\'', '"':
+			return "", 0, false
+		default:
+			key.WriteByte(path[i])
+			i++
+		}
+	}
+	if key.Len() == 0 {
+		return "", 0, false
+	}
+	return key.String(), i, true
+}
+
+// parseBracketSegment reads a bracketed index, quoted key, or filter starting at the opening bracket
+// at i and returns the segment and the index after the closing bracket.
+func parseBracketSegment(path string, i int) (FieldPathSegment, int, bool) {
+	i++
+	if i >= len(path) {
+		return FieldPathSegment{}, 0, false
+	}
+
+	if quote := path[i]; quote == '\'' || quote == '"' {
+		var key strings.Builder
+		for i++; i < len(path) && path[i] != quote; i++ {
+			if path[i] == '\\' {
+				if i+1 >= len(path) {
+					return FieldPathSegment{}, 0, false
+				}
+				i++
+			}
+			key.WriteByte(path[i])
+		}
+		// Expect the closing quote followed by the closing bracket
+		if i+1 >= len(path) || path[i+1] != ']' || key.Len() == 0 {
+			return FieldPathSegment{}, 0, false
+		}
+		return FieldPathSegment{Key: key.String()}, i + 2, true
+	}
+
+	end := strings.IndexByte(path[i:], ']')
+	if end <= 0 {
+		return FieldPathSegment{}, 0, false
+	}
+	digits := path[i : i+end]
+	if key, value, ok := strings.Cut(digits, "="); ok {
+		return parseFilterSegment(key, value, i+end+1)
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return FieldPathSegment{}, 0, false
+		}
+	}
+	index, err := strconv.Atoi(digits)
+	if err != nil {
+		return FieldPathSegment{}, 0, false
+	}
+	return FieldPathSegment{Index: index, IsIndex: true}, i + end + 1, true
+}
+
+// parseFilterSegment builds the segment of a bracketed key=value filter. The key is a plain
+// field name; the value may be wrapped in single or double quotes.
+func parseFilterSegment(key, value string, next int) (FieldPathSegment, int, bool) {
+	if key == "" || strings.ContainsAny(key, `'"\`) {
+		return FieldPathSegment{}, 0, false
+	}
+	if len(value) >= 2 && (value[0] == '\'' || value[0] == '"') && value[len(value)-1] == value[0] {
+		value = value[1 : len(value)-1]
+	} else if value == "" || strings.ContainsAny(value, `'"`) {
+		return FieldPathSegment{}, 0, false
+	}
+	return FieldPathSegment{Key: key, Value: value, IsFilter: true}, next, true
+}
@@ -0,0 +1,99 @@
+package validation
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseFieldPath(t *testing.T) {
+	key := func(k string) FieldPathSegment { return FieldPathSegment{Key: k} }
+	index := func(i int) FieldPathSegment { return FieldPathSegment{Index: i, IsIndex: true} }
+	filter := func(k, v string) FieldPathSegment { return FieldPathSegment{Key: k, Value: v, IsFilter: true} }
+
+	tests := []struct {
+		name      string
+		input     string
+		expected  []FieldPathSegment
+		expectErr bool
+	}{
+		{name: "single key", input: "spec", expected: []FieldPathSegment{key("spec")}},
+		{name: "plain dotted path", input: "status.lastProcessedAt", expected: []FieldPathSegment{key("status"), key("lastProcessedAt")}},
+		{
+			name:     "array index",
+			input:    "status.conditions[0].status",
+			expected: []FieldPathSegment{key("status"), key("conditions"), index(0), key("status")},
+		},
+		{
+			name:     "single-quoted key with dots and slash",
+			input:    "metadata.annotations['gc.kube-zen.io/ttl']",
+			expected: []FieldPathSegment{key("metadata"), key("annotations"), key("gc.kube-zen.io/ttl")},
+		},
+		{
+			name:     "double-quoted key",
+			input:    `metadata.labels["app.kubernetes.io/name"]`,
+			expected: []FieldPathSegment{key("metadata"), key("labels"), key("app.kubernetes.io/name")},
+		},
+		{
+			name:     "escaped dots",
+			input:    `metadata.labels.app\.kubernetes\.io/name`,
+			expected: []FieldPathSegment{key("metadata"), key("labels"), key("app.kubernetes.io/name")},
+		},
+		{
+			name:     "escaped quote in quoted key",
+			input:    `data['it\'s']`,
+			expected: []FieldPathSegment{key("data"), key("it's")},
+		},
+		{
+			name:     "nested indices",
+			input:    "spec.matrix[1][2]",
+			expected: []FieldPathSegment{key("spec"), key("matrix"), index(1), index(2)},
+		},
+		{
+			name:     "leading index",
+			input:    "[3].name",
+			expected: []FieldPathSegment{index(3), key("name")},
+		},
+		{
+			name:     "filter",
+			input:    "status.conditions[type=Ready].lastTransitionTime",
+			expected: []FieldPathSegment{key("status"), key("conditions"), filter("type", "Ready"), key("lastTransitionTime")},
+		},
+		{
+			name:     "filter with quoted value",
+			input:    `spec.containers[name='app.v2'].image`,
+			expected: []FieldPathSegment{key("spec"), key("containers"), filter("name", "app.v2"), key("image")},
+		},
+		{name: "empty", input: "", expectErr: true},
+		{name: "leading dot", input: ".spec", expectErr: true},
+		{name: "trailing dot", input: "spec.", expectErr: true},
+		{name: "empty key", input: "spec..ttl", expectErr: true},
+		{name: "dot before bracket", input: "spec.[0]", expectErr: true},
+		{name: "key after bracket without dot", input: "spec[0]name", expectErr: true},
+		{name: "unclosed bracket", input: "spec.items[0", expectErr: true},
+		{name: "empty brackets", input: "spec.items[]", expectErr: true},
+		{name: "negative index", input: "spec.items[-1]", expectErr: true},
+		{name: "wildcard", input: "spec.items[*]", expectErr: true},
+		{name: "unclosed quote", input: "metadata.annotations['ttl]", expectErr: true},
+		{name: "empty quoted key", input: "metadata.annotations['']", expectErr: true},
+		{name: "trailing escape", input: `spec\`, expectErr: true},
+		{name: "filter without key", input: "status.conditions[=Ready]", expectErr: true},
+		{name: "filter without value", input: "status.conditions[type=]", expectErr: true},
+		{name: "filter with unbalanced quote", input: "status.conditions[type='Ready]", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFieldPath(tt.input)
+			if tt.expectErr {
+				if !errors.Is(err, ErrInvalidFieldPath) {
+					t.Errorf("ParseFieldPath(%q) error = %v, want ErrInvalidFieldPath", tt.input, err)
+				}
+				return
+			}
+			if err != nil || !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ParseFieldPath(%q) = %+v, %v, want %+v", tt.input, got, err, tt.expected)
+			}
+		})
+	}
+}
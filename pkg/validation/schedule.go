@@ -0,0 +1,140 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidActiveWindow indicates a schedule activeWindows entry cannot be parsed.
+var ErrInvalidActiveWindow = errors.New(`invalid active window: expected "<days> <HH:MM>-<HH:MM>", e.g. "Mon-Fri 22:00-06:00" or "* 01:00-05:00"`)
+
+// weekdayNames maps three-letter day names to weekdays.
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+// ActiveWindow is a recurring window starting on some days of the week. A window whose end
+// is not after its start runs past midnight, so it belongs to the day it starts on.
+type ActiveWindow struct {
+	// Days the window starts on, indexed by time.Weekday
+	Days [7]bool
+
+	// Start and End of the window, in minutes after midnight; End may be 24:00
+	Start, End int
+}
+
+// ParseActiveWindow parses a window such as "Mon-Fri 22:00-06:00": "*" or comma-separated
+// day names and ranges, then a start and end time of day.
+func ParseActiveWindow(s string) (ActiveWindow, error) {
+	var w ActiveWindow
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return w, fmt.Errorf("%w: %q", ErrInvalidActiveWindow, s)
+	}
+	if err := parseWindowDays(fields[0], &w.Days); err != nil {
+		return w, fmt.Errorf("%w: %q: %w", ErrInvalidActiveWindow, s, err)
+	}
+	start, end, ok := strings.Cut(fields[1], "-")
+	if !ok {
+		return w, fmt.Errorf("%w: %q", ErrInvalidActiveWindow, s)
+	}
+	var err error
+	if w.Start, err = parseTimeOfDay(start); err != nil || w.Start == 24*60 {
+		return w, fmt.Errorf("%w: %q: invalid start %q", ErrInvalidActiveWindow, s, start)
+	}
+	if w.End, err = parseTimeOfDay(end); err != nil {
+		return w, fmt.Errorf("%w: %q: invalid end %q", ErrInvalidActiveWindow, s, end)
+	}
+	if w.Start == w.End {
+		return w, fmt.Errorf("%w: %q: start and end are equal", ErrInvalidActiveWindow, s)
+	}
+	return w, nil
+}
+
+// parseWindowDays sets the days named by "*" or a list such as "Mon-Wed,Sat". A range may
+// wrap around the week, e.g. "Fri-Mon".
+func parseWindowDays(s string, days *[7]bool) error {
+	if s == "*" {
+		for i := range days {
+			days[i] = true
+		}
+		return nil
+	}
+	for _, part := range strings.Split(s, ",") {
+		first, last, isRange := strings.Cut(part, "-")
+		from, ok := weekdayNames[strings.ToLower(first)]
+		if !ok {
+			return fmt.Errorf("unknown day %q", first)
+		}
+		to := from
+		if isRange {
+			if to, ok = weekdayNames[strings.ToLower(last)]; !ok {
+				return fmt.Errorf("unknown day %q", last)
+			}
+		}
+		for d := from; ; d = (d + 1) % 7 {
+			days[d] = true
+			if d == to {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// parseTimeOfDay parses "HH:MM" from 00:00 to 24:00 into minutes after midnight.
+func parseTimeOfDay(s string) (int, error) {
+	hours, minutes, ok := strings.Cut(s, ":")
+	if !ok || len(hours) != 2 || len(minutes) != 2 {
+		return 0, fmt.Errorf("invalid time of day %q", s)
+	}
+	h, errH := strconv.Atoi(hours)
+	m, errM := strconv.Atoi(minutes)
+	if errH != nil || errM != nil || h < 0 || m < 0 || m > 59 || h > 24 || (h == 24 && m != 0) {
+		return 0, fmt.Errorf("invalid time of day %q", s)
+	}
+	return h*60 + m, nil
+}
+
+// Contains reports whether t, in the window's time zone, falls within the window.
+func (w ActiveWindow) Contains(t time.Time) bool {
+	minute := t.Hour()*60 + t.Minute()
+	today := t.Weekday()
+	if w.Start < w.End {
+		return w.Days[today] && minute >= w.Start && minute < w.End
+	}
+	yesterday := (today + 6) % 7
+	return (w.Days[today] && minute >= w.Start) || (w.Days[yesterday] && minute < w.End)
+}
+
+// NextStart returns the first time after t the window opens, in t's location.
+func (w ActiveWindow) NextStart(t time.Time) time.Time {
+	year, month, day := t.Date()
+	for i := 0; i <= 7; i++ {
+		start := time.Date(year, month, day+i, w.Start/60, w.Start%60, 0, 0, t.Location())
+		if w.Days[start.Weekday()] && start.After(t) {
+			return start
+		}
+	}
+	return time.Time{}
+}
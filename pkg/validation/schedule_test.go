@@ -0,0 +1,123 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseActiveWindow(t *testing.T) {
+	tests := []struct {
+		input     string
+		days      []time.Weekday
+		expectErr bool
+	}{
+		{input: "* 01:00-05:00", days: []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}},
+		{input: "Mon-Fri 22:00-06:00", days: []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}},
+		{input: "sat,SUN 00:00-24:00", days: []time.Weekday{time.Saturday, time.Sunday}},
+		{input: "Fri-Mon 12:00-13:30", days: []time.Weekday{time.Friday, time.Saturday, time.Sunday, time.Monday}},
+		{input: "Wed,Fri-Sat 08:00-09:00", days: []time.Weekday{time.Wednesday, time.Friday, time.Saturday}},
+		{input: "", expectErr: true},
+		{input: "Mon", expectErr: true},
+		{input: "Mon 22:00", expectErr: true},
+		{input: "Mon 22:00-06:00 extra", expectErr: true},
+		{input: "Monday 22:00-06:00", expectErr: true},
+		{input: "Mon- 22:00-06:00", expectErr: true},
+		{input: "Mon 2:00-06:00", expectErr: true},
+		{input: "Mon 22:60-23:00", expectErr: true},
+		{input: "Mon 24:00-06:00", expectErr: true},
+		{input: "Mon 22:00-24:30", expectErr: true},
+		{input: "Mon 22:00-22:00", expectErr: true},
+		{input: "0 22 * * 1-5", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseActiveWindow(tt.input)
+			if tt.expectErr {
+				if !errors.Is(err, ErrInvalidActiveWindow) {
+					t.Errorf("ParseActiveWindow(%q) error = %v, want ErrInvalidActiveWindow", tt.input, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseActiveWindow(%q) returned error: %v", tt.input, err)
+			}
+			var want [7]bool
+			for _, d := range tt.days {
+				want[d] = true
+			}
+			if got.Days != want {
+				t.Errorf("ParseActiveWindow(%q) days = %v, want %v", tt.input, got.Days, want)
+			}
+		})
+	}
+}
+
+func TestActiveWindow_Contains(t *testing.T) {
+	// 2026-03-06 is a Friday
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, time.March, day, hour, minute, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		window   string
+		at       time.Time
+		expected bool
+	}{
+		{window: "Fri 09:00-17:00", at: at(6, 9, 0), expected: true},
+		{window: "Fri 09:00-17:00", at: at(6, 8, 59)},
+		{window: "Fri 09:00-17:00", at: at(6, 16, 59), expected: true},
+		{window: "Fri 09:00-17:00", at: at(6, 17, 0)},
+		{window: "Fri 09:00-17:00", at: at(7, 10, 0)},
+		{window: "Fri 22:00-02:00", at: at(6, 23, 30), expected: true},
+		{window: "Fri 22:00-02:00", at: at(7, 1, 59), expected: true},
+		{window: "Fri 22:00-02:00", at: at(7, 2, 0)},
+		{window: "Fri 22:00-02:00", at: at(6, 1, 0)},
+		{window: "Sat 00:00-24:00", at: at(7, 23, 59), expected: true},
+		{window: "Sat 00:00-24:00", at: at(8, 0, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.window+" at "+tt.at.Format("Mon 15:04"), func(t *testing.T) {
+			window, err := ParseActiveWindow(tt.window)
+			if err != nil {
+				t.Fatalf("ParseActiveWindow(%q) returned error: %v", tt.window, err)
+			}
+			if got := window.Contains(tt.at); got != tt.expected {
+				t.Errorf("Contains() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestActiveWindow_NextStart(t *testing.T) {
+	window, err := ParseActiveWindow("Mon,Wed 22:00-06:00")
+	if err != nil {
+		t.Fatalf("ParseActiveWindow() returned error: %v", err)
+	}
+	// Friday noon opens next on Monday night; Monday at 22:00 sharp opens next on Wednesday
+	friday := time.Date(2026, time.March, 6, 12, 0, 0, 0, time.UTC)
+	if got, want := window.NextStart(friday), time.Date(2026, time.March, 9, 22, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("NextStart(Friday) = %v, want %v", got, want)
+	}
+	monday := time.Date(2026, time.March, 9, 22, 0, 0, 0, time.UTC)
+	if got, want := window.NextStart(monday), time.Date(2026, time.March, 11, 22, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("NextStart(Monday 22:00) = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+// ErrInvalidSemverRange indicates a semver range cannot be parsed.
+var ErrInvalidSemverRange = errors.New(`invalid semver range: expected comparators <, <=, >, >=, =, or != each followed by a version, separated by commas or spaces, e.g. "<2.0.0" or ">=1.0.0, <2.0.0"`)
+
+// semverOperators are the comparison operators of a semver range, longest first so that
+// "<=" is not read as "<".
+var semverOperators = []string{"<=", ">=", "!=", "<", ">", "="}
+
+// SemverComparator is one comparison of a semver range, e.g. "<2.0.0".
+type SemverComparator struct {
+	Operator string
+	Version  *version.Version
+}
+
+// SemverRange is a list of comparators a version must all satisfy.
+type SemverRange []SemverComparator
+
+// ParseSemver parses a semantic version such as "1.2.3", "v1.2.3", or "1.2.3-rc.1+build.5".
+// Partial versions such as "1.2" are rejected.
+func ParseSemver(s string) (*version.Version, error) {
+	return version.ParseSemantic(strings.TrimSpace(s))
+}
+
+// ParseSemverRange parses a semver range: comparators separated by commas or spaces, all of
+// which must hold, e.g. ">=1.0.0, <2.0.0". A version without an operator must equal it, and
+// an operator may be separated from its version by spaces, e.g. "< 2.0.0".
+func ParseSemverRange(s string) (SemverRange, error) {
+	tokens := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	})
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidSemverRange, s)
+	}
+
+	var r SemverRange
+	for i := 0; i < len(tokens); i++ {
+		operator, rest := splitSemverOperator(tokens[i])
+		if rest == "" {
+			// An operator on its own applies to the next token
+			if operator == "" || i+1 == len(tokens) {
+				return nil, fmt.Errorf("%w: %q", ErrInvalidSemverRange, s)
+			}
+			i++
+			rest = tokens[i]
+		}
+		v, err := ParseSemver(rest)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %w", ErrInvalidSemverRange, s, err)
+		}
+		if operator == "" {
+			operator = "="
+		}
+		r = append(r, SemverComparator{Operator: operator, Version: v})
+	}
+	return r, nil
+}
+
+// splitSemverOperator splits a leading comparison operator off token.
+func splitSemverOperator(token string) (operator, rest string) {
+	for _, op := range semverOperators {
+		if strings.HasPrefix(token, op) {
+			return op, token[len(op):]
+		}
+	}
+	return "", token
+}
+
+// Contains reports whether v satisfies every comparator of the range. Build metadata is
+// ignored and a pre-release sorts before its release, so "2.0.0-rc.1" is "<2.0.0".
+func (r SemverRange) Contains(v *version.Version) bool {
+	for _, c := range r {
+		var ok bool
+		switch c.Operator {
+		case "<":
+			ok = v.LessThan(c.Version)
+		case "<=":
+			ok = !v.GreaterThan(c.Version)
+		case ">":
+			ok = v.GreaterThan(c.Version)
+		case ">=":
+			ok = v.AtLeast(c.Version)
+		case "=":
+			ok = v.EqualTo(c.Version)
+		case "!=":
+			ok = !v.EqualTo(c.Version)
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,84 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseSemverRange(t *testing.T) {
+	tests := []struct {
+		input       string
+		comparators int
+		expectErr   bool
+	}{
+		{input: "<2.0.0", comparators: 1},
+		{input: ">=1.0.0, <2.0.0", comparators: 2},
+		{input: ">=1.0.0 <2.0.0", comparators: 2},
+		{input: "< 2.0.0", comparators: 1},
+		{input: "v1.2.3", comparators: 1},
+		{input: "!=1.2.3-rc.1", comparators: 1},
+		{input: "", expectErr: true},
+		{input: " , ", expectErr: true},
+		{input: "<", expectErr: true},
+		{input: "<2.0", expectErr: true},
+		{input: "~2.0.0", expectErr: true},
+		{input: "^1.2.3", expectErr: true},
+		{input: "<<2.0.0", expectErr: true},
+		{input: "1.0.0 - 2.0.0", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseSemverRange(tt.input)
+			if tt.expectErr {
+				if !errors.Is(err, ErrInvalidSemverRange) {
+					t.Errorf("ParseSemverRange(%q) error = %v, want ErrInvalidSemverRange", tt.input, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseSemverRange(%q) returned error: %v", tt.input, err)
+			}
+			if len(got) != tt.comparators {
+				t.Errorf("ParseSemverRange(%q) = %d comparators, want %d", tt.input, len(got), tt.comparators)
+			}
+		})
+	}
+}
+
+func TestSemverRange_Contains(t *testing.T) {
+	tests := []struct {
+		semverRange string
+		version     string
+		expected    bool
+	}{
+		{semverRange: "<2.0.0", version: "1.9.9", expected: true},
+		{semverRange: "<2.0.0", version: "v1.0.0", expected: true},
+		{semverRange: "<2.0.0", version: "2.0.0", expected: false},
+		{semverRange: "<2.0.0", version: "2.0.0-rc.1", expected: true},
+		{semverRange: "<=2.0.0", version: "2.0.0+build.7", expected: true},
+		{semverRange: ">1.0.0", version: "1.0.0", expected: false},
+		{semverRange: ">=1.0.0, <2.0.0", version: "1.4.2", expected: true},
+		{semverRange: ">=1.0.0, <2.0.0", version: "0.9.0", expected: false},
+		{semverRange: ">=1.0.0, <2.0.0", version: "2.1.0", expected: false},
+		{semverRange: "=1.2.3", version: "1.2.3", expected: true},
+		{semverRange: "1.2.3", version: "1.2.4", expected: false},
+		{semverRange: "!=1.2.3", version: "1.2.4", expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.semverRange+" "+tt.version, func(t *testing.T) {
+			r, err := ParseSemverRange(tt.semverRange)
+			if err != nil {
+				t.Fatalf("ParseSemverRange(%q) returned error: %v", tt.semverRange, err)
+			}
+			v, err := ParseSemver(tt.version)
+			if err != nil {
+				t.Fatalf("ParseSemver(%q) returned error: %v", tt.version, err)
+			}
+			if got := r.Contains(v); got != tt.expected {
+				t.Errorf("%q contains %q = %v, want %v", tt.semverRange, tt.version, got, tt.expected)
+			}
+		})
+	}
+}
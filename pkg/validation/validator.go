@@ -1,14 +1,24 @@
 package validation
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
+	"net/url"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/jmespath/go-jmespath"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation"
 
 	gcapi "github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/query"
 )
 
 var (
@@ -24,18 +34,88 @@ var (
 	// ErrInvalidTTLMapping indicates invalid TTL mapping value.
 	ErrInvalidTTLMapping = errors.New("invalid TTL mapping: value must be positive")
 
+	// ErrTTLFieldPathAndLabelKey indicates ttl.fieldPath and ttl.labelKey are both set.
+	ErrTTLFieldPathAndLabelKey = errors.New("ttl cannot set both fieldPath and labelKey")
+
+	// ErrTTLLabelKeyMappingsRequired indicates ttl.labelKey is set without mappings.
+	ErrTTLLabelKeyMappingsRequired = errors.New("ttl labelKey requires mappings")
+
+	// ErrInvalidTTLLabelKey indicates ttl.labelKey is not a valid label key.
+	ErrInvalidTTLLabelKey = errors.New("invalid ttl labelKey")
+
+	// ErrEarliestTTLExclusive indicates ttl.earliest is combined with other TTL options.
+	ErrEarliestTTLExclusive = errors.New("ttl earliest cannot be combined with other TTL options")
+
+	// ErrEarliestTTLCreationRequired indicates ttl.earliest needs a positive secondsAfterCreation.
+	ErrEarliestTTLCreationRequired = errors.New("ttl earliest requires a positive secondsAfterCreation")
+
+	// ErrEarliestTTLFieldRequired indicates ttl.earliest needs a field-based rule.
+	ErrEarliestTTLFieldRequired = errors.New("ttl earliest requires a field rule with fieldPath, labelKey or relativeTo/secondsAfter")
+
+	// ErrRangeTTLExclusive indicates ttl.range is combined with other TTL options.
+	ErrRangeTTLExclusive = errors.New("ttl range cannot be combined with other TTL options")
+
+	// ErrRangeTTLFieldsRequired indicates ttl.range needs both bound field paths.
+	ErrRangeTTLFieldsRequired = errors.New("ttl range requires minFieldPath and maxFieldPath")
+
+	// ErrTTLSecondsAndDuration indicates the seconds and duration forms of the same TTL are both set.
+	ErrTTLSecondsAndDuration = errors.New("ttl cannot set both the seconds and duration form of the same value")
+
+	// ErrTTLDurationTooShort indicates a TTL duration is shorter than one second.
+	ErrTTLDurationTooShort = errors.New("ttl duration must be at least 1s")
+
 	// ErrMaxDeletionsPerSecondNegative indicates maxDeletionsPerSecond must be non-negative.
 	ErrMaxDeletionsPerSecondNegative = errors.New("maxDeletionsPerSecond must be non-negative")
 
+	// ErrMaxDeletionsPerRunNegative indicates maxDeletionsPerRun must be non-negative.
+	ErrMaxDeletionsPerRunNegative = errors.New("maxDeletionsPerRun must be non-negative")
+
+	// ErrMaxAPICallsNegative indicates maxApiCalls must be non-negative.
+	ErrMaxAPICallsNegative = errors.New("maxApiCalls must be non-negative")
+
 	// ErrBatchSizeNegative indicates batchSize must be non-negative.
 	ErrBatchSizeNegative = errors.New("batchSize must be non-negative")
 
+	// ErrBatchIntervalNegative indicates batchInterval must be non-negative.
+	ErrBatchIntervalNegative = errors.New("batchInterval must be non-negative")
+
 	// ErrInvalidPropagationPolicy indicates invalid propagationPolicy value.
 	ErrInvalidPropagationPolicy = errors.New("invalid propagationPolicy")
 
+	// ErrPropagationByKindKindRequired indicates a propagationByKind entry has an empty kind.
+	ErrPropagationByKindKindRequired = errors.New("propagationByKind kind must not be empty")
+
 	// ErrGracePeriodSecondsNegative indicates gracePeriodSeconds must be non-negative.
 	ErrGracePeriodSecondsNegative = errors.New("gracePeriodSeconds must be non-negative")
 
+	// ErrDeletionNoticeSecondsNegative indicates deletionNoticeSeconds must be non-negative.
+	ErrDeletionNoticeSecondsNegative = errors.New("deletionNoticeSeconds must be non-negative")
+
+	// ErrInvalidFinalizer indicates behavior.finalizer is not a qualified name.
+	ErrInvalidFinalizer = errors.New("invalid finalizer")
+
+	// ErrSnapshotRetentionNegative indicates snapshotRetention must be non-negative.
+	ErrSnapshotRetentionNegative = errors.New("snapshotRetention must be non-negative")
+
+	// ErrResultWebhookURLRequired indicates the resultWebhook URL is required.
+	ErrResultWebhookURLRequired = errors.New("resultWebhook url is required")
+
+	// ErrInvalidResultWebhookURL indicates the resultWebhook URL is not a valid http(s) URL.
+	ErrInvalidResultWebhookURL = errors.New("invalid resultWebhook url: must be an absolute http or https URL")
+
+	// ErrResultWebhookTimeoutNegative indicates resultWebhook timeoutSeconds is negative.
+	ErrResultWebhookTimeoutNegative = errors.New("resultWebhook timeoutSeconds must be non-negative")
+
+	// ErrUnknownDeletionReason indicates allowedReasons contains an unknown deletion reason.
+	ErrUnknownDeletionReason = errors.New("unknown deletion reason in allowedReasons")
+
+	// ErrAlsoDeleteSelectorRequired indicates an alsoDelete entry has no selector.
+	ErrAlsoDeleteSelectorRequired = errors.New("alsoDelete entries require labelSelector, labelSelectors, or fieldSelector")
+
+	// ErrAlsoDeleteWithPreconditions indicates alsoDelete is combined with preconditions, whose
+	// parent delete can be rejected after the dependents are gone.
+	ErrAlsoDeleteWithPreconditions = errors.New("alsoDelete cannot be combined with preconditions")
+
 	// ErrInvalidNamespace indicates invalid namespace format.
 	ErrInvalidNamespace = errors.New("invalid namespace: must be a valid DNS-1123 label, '*' for all namespaces, or empty")
 
@@ -48,6 +128,18 @@ var (
 	// ErrInvalidLabelKey indicates invalid label key format.
 	ErrInvalidLabelKey = errors.New("invalid label key")
 
+	// ErrTooManyVirtualLabelAnnotations indicates too many virtualLabelAnnotations are listed.
+	ErrTooManyVirtualLabelAnnotations = errors.New("too many virtualLabelAnnotations")
+
+	// ErrInvalidVirtualLabelAnnotation indicates a virtualLabelAnnotations entry is not a valid label key.
+	ErrInvalidVirtualLabelAnnotation = errors.New("invalid virtualLabelAnnotations key")
+
+	// ErrEmptyUID indicates a uids entry is empty.
+	ErrEmptyUID = errors.New("uids entries must not be empty")
+
+	// ErrInvalidExcludeNamespace indicates an excludeNamespaces entry is neither a namespace name nor a glob.
+	ErrInvalidExcludeNamespace = errors.New(`invalid excludeNamespaces entry: must be a DNS-1123 label or a glob using "*", e.g. "kube-*"`)
+
 	// ErrInvalidLabelValue indicates invalid label value format.
 	ErrInvalidLabelValue = errors.New("invalid label value")
 
@@ -65,8 +157,203 @@ var (
 
 	// ErrInvalidLabelExpressionValue indicates invalid label expression value format.
 	ErrInvalidLabelExpressionValue = errors.New("invalid label expression value")
+
+	// ErrOPAURLRequired indicates the OPA condition URL is required.
+	ErrOPAURLRequired = errors.New("opa url is required")
+
+	// ErrInvalidOPAURL indicates the OPA condition URL is not a valid http(s) URL.
+	ErrInvalidOPAURL = errors.New("invalid opa url: must be an absolute http or https URL")
+
+	// ErrOPASecondsNegative indicates OPA timeout or cache TTL is negative.
+	ErrOPASecondsNegative = errors.New("opa timeoutSeconds and cacheTTLSeconds must be non-negative")
+
+	// ErrLabelCountPrefixRequired indicates the labelCount prefix is required.
+	ErrLabelCountPrefixRequired = errors.New("labelCount prefix is required")
+
+	// ErrLabelCountThresholdRequired indicates the labelCount threshold is required and non-negative.
+	ErrLabelCountThresholdRequired = errors.New("labelCount threshold is required and must be non-negative")
+
+	// ErrInvalidCountTrend indicates an unknown countTrend trend.
+	ErrInvalidCountTrend = errors.New("invalid countTrend trend")
+
+	// ErrInvalidCountTrendRuns indicates countTrend runs is out of range.
+	ErrInvalidCountTrendRuns = errors.New("countTrend runs must be between 2 and 20")
+
+	// ErrSpecHashFieldsRequired indicates specHash fields are required.
+	ErrSpecHashFieldsRequired = errors.New("specHash fields are required")
+
+	// ErrSpecHashSourceRequired indicates specHash needs badHashes or badHashesConfigMap.
+	ErrSpecHashSourceRequired = errors.New("specHash requires badHashes or badHashesConfigMap")
+
+	// ErrInvalidSpecHash indicates a specHash bad hash is not a SHA-256 hex digest.
+	ErrInvalidSpecHash = errors.New("invalid specHash bad hash: must be a SHA-256 hex digest")
+
+	// ErrInvalidSpecHashConfigMap indicates the specHash badHashesConfigMap name or key is invalid.
+	ErrInvalidSpecHashConfigMap = errors.New("invalid specHash badHashesConfigMap")
+
+	// ErrDataDriftSourceRequired indicates dataDrift needs exactly one of expectedHash or reference.
+	ErrDataDriftSourceRequired = errors.New("dataDrift requires exactly one of expectedHash or reference")
+
+	// ErrInvalidDataDriftHash indicates the dataDrift expectedHash is not a SHA-256 hex digest.
+	ErrInvalidDataDriftHash = errors.New("invalid dataDrift expectedHash: must be a SHA-256 hex digest")
+
+	// ErrDataDriftReferenceNameRequired indicates the dataDrift reference has no name.
+	ErrDataDriftReferenceNameRequired = errors.New("dataDrift reference name is required")
+
+	// ErrStuckTerminatingDurationRequired indicates stuckTerminating olderThanSeconds is missing or not positive.
+	ErrStuckTerminatingDurationRequired = errors.New("stuckTerminating olderThanSeconds is required and must be positive")
+
+	// ErrInvalidStuckTerminatingFinalizer indicates a stuckTerminating removeFinalizers entry is not a qualified name.
+	ErrInvalidStuckTerminatingFinalizer = errors.New("invalid stuckTerminating removeFinalizers entry")
+
+	// ErrOwnerChainRootKindRequired indicates the ownerChain rootKind is required.
+	ErrOwnerChainRootKindRequired = errors.New("ownerChain rootKind is required")
+
+	// ErrInvalidOwnerChainDepth indicates the ownerChain depth is out of range.
+	ErrInvalidOwnerChainDepth = errors.New("ownerChain depth must be between 1 and 5")
+
+	// ErrPhaseAlwaysExcluded indicates every phase condition entry is also in notPhase, so
+	// nothing can match.
+	ErrPhaseAlwaysExcluded = errors.New("every phase is also excluded by notPhase")
+
+	// ErrDanglingOwnerOrphansOnly indicates danglingOwner and orphansOnly can never both match.
+	ErrDanglingOwnerOrphansOnly = errors.New("danglingOwner cannot be combined with orphansOnly")
+
+	// ErrDateFieldPathRequired indicates a date condition fieldPath is required.
+	ErrDateFieldPathRequired = errors.New("date condition fieldPath is required")
+
+	// ErrInvalidDateLayout indicates a date condition layout is missing or has no date/time elements.
+	ErrInvalidDateLayout = errors.New("date condition layout is required and must be a Go time layout")
+
+	// ErrInvalidDateOperator indicates an unknown date condition operator.
+	ErrInvalidDateOperator = errors.New("invalid date condition operator")
+
+	// ErrInvalidDateReference indicates an unknown date condition reference.
+	ErrInvalidDateReference = errors.New("invalid date condition reference")
+
+	// ErrDateAgeSecondsNegative indicates a date condition ageSeconds is negative.
+	ErrDateAgeSecondsNegative = errors.New("date condition ageSeconds must be non-negative")
+
+	// ErrInvalidDateTimeZone indicates a date condition timeZone cannot be loaded.
+	ErrInvalidDateTimeZone = errors.New("invalid date condition timeZone")
+
+	// ErrInvalidScheduleTimeZone indicates a schedule timeZone cannot be loaded.
+	ErrInvalidScheduleTimeZone = errors.New("invalid schedule timeZone")
+
+	// ErrArrayLengthFieldPathRequired indicates an arrayLengths condition fieldPath is required.
+	ErrArrayLengthFieldPathRequired = errors.New("arrayLengths condition fieldPath is required")
+
+	// ErrInvalidArrayLengthOperator indicates an unknown arrayLengths condition operator.
+	ErrInvalidArrayLengthOperator = errors.New("invalid arrayLengths condition operator")
+
+	// ErrArrayLengthValueNegative indicates an arrayLengths condition value is negative.
+	ErrArrayLengthValueNegative = errors.New("arrayLengths condition value must be non-negative")
+
+	// ErrSemverSourceRequired indicates a semver condition sets neither or both of labelKey and fieldPath.
+	ErrSemverSourceRequired = errors.New("semver condition requires exactly one of labelKey and fieldPath")
+
+	// ErrInvalidSemverLabelKey indicates a semver condition labelKey is not a valid label key.
+	ErrInvalidSemverLabelKey = errors.New("invalid semver condition labelKey")
+
+	// ErrInvalidCreatorAnnotation indicates createdByDefaultServiceAccount creatorAnnotation is not a valid annotation key.
+	ErrInvalidCreatorAnnotation = errors.New("invalid createdByDefaultServiceAccount creatorAnnotation")
+
+	// ErrBackupGateReferenceRequired indicates backupGate is missing its backup status reference.
+	ErrBackupGateReferenceRequired = errors.New("backupGate namespace, name, and fieldPath are required")
+
+	// ErrBackupGateCacheTTLNegative indicates backupGate cacheTTLSeconds is negative.
+	ErrBackupGateCacheTTLNegative = errors.New("backupGate cacheTTLSeconds must be non-negative")
+
+	// ErrMetricThresholdURLRequired indicates the metricThreshold URL is missing.
+	ErrMetricThresholdURLRequired = errors.New("metricThreshold url is required")
+
+	// ErrInvalidMetricThresholdURL indicates the metricThreshold URL is not a valid http(s) URL.
+	ErrInvalidMetricThresholdURL = errors.New("invalid metricThreshold url: must be an absolute http or https URL")
+
+	// ErrMetricThresholdQueryRequired indicates the metricThreshold query is missing.
+	ErrMetricThresholdQueryRequired = errors.New("metricThreshold query is required")
+
+	// ErrInvalidMetricThresholdOperator indicates an invalid metricThreshold operator.
+	ErrInvalidMetricThresholdOperator = errors.New("invalid metricThreshold operator")
+
+	// ErrInvalidMetricThresholdValue indicates the metricThreshold value is not a finite number.
+	ErrInvalidMetricThresholdValue = errors.New("invalid metricThreshold value: must be a decimal number")
+
+	// ErrMetricThresholdSecondsNegative indicates metricThreshold timeout or cache TTL is negative.
+	ErrMetricThresholdSecondsNegative = errors.New("metricThreshold timeoutSeconds and cacheTTLSeconds must be non-negative")
+
+	// ErrMissingLabelKeyRequired indicates missingLabel has no valid label key.
+	ErrMissingLabelKeyRequired = errors.New("missingLabel requires a valid label key")
+
+	// ErrMissingLabelAgeRequired indicates missingLabel has no positive olderThanSeconds.
+	ErrMissingLabelAgeRequired = errors.New("missingLabel olderThanSeconds must be greater than 0")
+
+	// ErrUnchangedDurationRequired indicates unchanged has no positive forSeconds.
+	ErrUnchangedDurationRequired = errors.New("unchanged forSeconds is required and must be greater than 0")
+
+	// ErrInvalidFieldComparisonValue indicates a field condition compares against a value that is
+	// neither a number nor an RFC3339 timestamp.
+	ErrInvalidFieldComparisonValue = errors.New("field condition value must be a number or an RFC3339 timestamp for GreaterThan, GreaterThanOrEqual, LessThan, and LessThanOrEqual")
+
+	// ErrInvalidLabelComparisonValue indicates a label condition compares against a value that is
+	// not a number.
+	ErrInvalidLabelComparisonValue = errors.New("label condition value must be a number for GreaterThan, GreaterThanOrEqual, LessThan, and LessThanOrEqual")
+
+	// ErrConditionsNestingTooDeep indicates anyOf groups nest deeper than MaxConditionsNestingDepth.
+	ErrConditionsNestingTooDeep = errors.New("conditions anyOf groups nest too deeply")
+
+	// ErrConditionNotAllowedInAnyOf indicates an anyOf group holds a condition that only applies at the top level.
+	ErrConditionNotAllowedInAnyOf = errors.New("condition is not allowed inside anyOf")
+
+	// ErrDedupKeyRequired indicates dedup has neither keyLabels nor keyFields.
+	ErrDedupKeyRequired = errors.New("dedup requires at least one of keyLabels or keyFields")
+
+	// ErrInvalidDedupKeyLabel indicates a dedup keyLabels entry is not a valid label key.
+	ErrInvalidDedupKeyLabel = errors.New("invalid dedup keyLabels key")
+
+	// ErrInvalidDedupScope indicates an unknown dedup scope.
+	ErrInvalidDedupScope = errors.New("invalid dedup scope")
+
+	// ErrRetentionKeepLastInvalid indicates retention keepLast is not positive.
+	ErrRetentionKeepLastInvalid = errors.New("retention keepLast must be at least 1")
+
+	// ErrInvalidMissingFields indicates an unknown missingFields mode.
+	ErrInvalidMissingFields = errors.New("invalid missingFields")
+
+	// ErrInvalidJMESPath indicates the jmespath condition does not parse.
+	ErrInvalidJMESPath = errors.New("invalid jmespath expression")
+
+	// ErrInvalidQuery indicates the query condition does not parse.
+	ErrInvalidQuery = query.ErrInvalidQuery
+
+	// ErrInvalidMinRemaining indicates minRemaining value is negative or not a percentage between 0% and 100%.
+	ErrInvalidMinRemaining = errors.New("minRemaining value must be a non-negative integer or a percentage between 0% and 100%")
+
+	// ErrInvalidMinRemainingKeyLabel indicates a minRemaining keyLabels entry is not a valid label key.
+	ErrInvalidMinRemainingKeyLabel = errors.New("invalid minRemaining keyLabels key")
+
+	// ErrInvalidDeletionOrder indicates an unknown deletionOrder value.
+	ErrInvalidDeletionOrder = errors.New("invalid deletionOrder")
+
+	// ErrNamespacesPerRunNegative indicates namespacesPerRun must be non-negative.
+	ErrNamespacesPerRunNegative = errors.New("namespacesPerRun must be non-negative")
+
+	// ErrNamespacesPerRunNotClusterWide indicates namespacesPerRun is set on a policy that
+	// targets a single namespace.
+	ErrNamespacesPerRunNotClusterWide = errors.New("namespacesPerRun requires a cluster-wide targetResource.namespace (\"*\" or empty)")
+
+	// ErrNamespacesPerRunClusterDedup indicates namespacesPerRun is combined with cluster-scoped
+	// dedup, whose groups span namespaces that are not evaluated together.
+	ErrNamespacesPerRunClusterDedup = errors.New("namespacesPerRun cannot be combined with dedup scope Cluster")
 )
 
+// MaxVirtualLabelAnnotations is the maximum number of annotation keys projected as virtual labels.
+const MaxVirtualLabelAnnotations = 10
+
+// MaxConditionsNestingDepth is the maximum number of conditions levels, counting the top
+// level, that anyOf groups may nest.
+const MaxConditionsNestingDepth = 5
+
 // ValidatePolicy validates a GarbageCollectionPolicy.
 func ValidatePolicy(policy *gcapi.GarbageCollectionPolicy) error {
 	// Validate target resource
@@ -79,11 +366,99 @@ func ValidatePolicy(policy *gcapi.GarbageCollectionPolicy) error {
 		return fmt.Errorf("invalid ttl: %w", err)
 	}
 
+	// Validate conditions
+	if policy.Spec.Conditions != nil {
+		if err := validateConditions(policy.Spec.Conditions); err != nil {
+			return fmt.Errorf("invalid conditions: %w", err)
+		}
+	}
+
+	// Validate missing field treatment
+	if mode := policy.Spec.MissingFields; mode != "" && mode != "Skip" && mode != "Default" {
+		return fmt.Errorf("%w: %q (must be Skip or Default)", ErrInvalidMissingFields, mode)
+	}
+
+	// Validate dedup
+	if policy.Spec.Dedup != nil {
+		if err := validateDedup(policy.Spec.Dedup); err != nil {
+			return fmt.Errorf("invalid dedup: %w", err)
+		}
+	}
+
+	// Validate retention
+	if policy.Spec.Retention != nil {
+		if err := validateRetention(policy.Spec.Retention); err != nil {
+			return fmt.Errorf("invalid retention: %w", err)
+		}
+	}
+
+	// Validate schedule
+	if policy.Spec.Schedule != nil {
+		if err := validateSchedule(policy.Spec.Schedule); err != nil {
+			return fmt.Errorf("invalid schedule: %w", err)
+		}
+	}
+
 	// Validate behavior
 	if err := validateBehavior(&policy.Spec.Behavior); err != nil {
 		return fmt.Errorf("invalid behavior: %w", err)
 	}
 
+	// Validate namespace sampling against the target and dedup
+	if err := validateNamespacesPerRun(&policy.Spec); err != nil {
+		return fmt.Errorf("invalid behavior: %w", err)
+	}
+
+	return nil
+}
+
+// validateNamespacesPerRun validates that namespace sampling is only used where every
+// namespace's resources can be evaluated on their own.
+func validateNamespacesPerRun(spec *gcapi.GarbageCollectionPolicySpec) error {
+	if spec.Behavior.NamespacesPerRun == 0 {
+		return nil
+	}
+	if namespace := spec.TargetResource.Namespace; namespace != "" && namespace != "*" {
+		return fmt.Errorf("%w, got %q", ErrNamespacesPerRunNotClusterWide, namespace)
+	}
+	if spec.Dedup != nil && spec.Dedup.Scope == "Cluster" {
+		return fmt.Errorf("%w", ErrNamespacesPerRunClusterDedup)
+	}
+	return nil
+}
+
+// validateRetention validates the retention specification.
+func validateRetention(retention *gcapi.RetentionSpec) error {
+	if retention.KeepLast < 1 {
+		return fmt.Errorf("%w", ErrRetentionKeepLastInvalid)
+	}
+	for i, field := range retention.GroupBy {
+		if _, err := ParseFieldPath(field); err != nil {
+			return fmt.Errorf("groupBy[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// validateDedup validates the dedup specification.
+func validateDedup(dedup *gcapi.DedupSpec) error {
+	if len(dedup.KeyLabels) == 0 && len(dedup.KeyFields) == 0 {
+		return fmt.Errorf("%w", ErrDedupKeyRequired)
+	}
+	for _, key := range dedup.KeyLabels {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("%w %q: %v", ErrInvalidDedupKeyLabel, key, errs)
+		}
+	}
+	for _, field := range dedup.KeyFields {
+		if strings.TrimSpace(field) == "" {
+			return fmt.Errorf("%w", ErrDedupKeyRequired)
+		}
+	}
+	if dedup.Scope != "" && dedup.Scope != "Namespace" && dedup.Scope != "Cluster" {
+		return fmt.Errorf("%w: %q (must be Namespace or Cluster)", ErrInvalidDedupScope, dedup.Scope)
+	}
+
 	return nil
 }
 
@@ -133,6 +508,36 @@ func validateTargetResource(target *gcapi.TargetResourceSpec) error {
 		}
 	}
 
+	// Validate each of the any-of LabelSelectors
+	for i := range target.LabelSelectors {
+		if err := validateLabelSelector(&target.LabelSelectors[i]); err != nil {
+			return fmt.Errorf("invalid labelSelectors[%d]: %w", i, err)
+		}
+	}
+
+	// Virtual label annotations are bounded and must be usable as label keys
+	if len(target.VirtualLabelAnnotations) > MaxVirtualLabelAnnotations {
+		return fmt.Errorf("%w: %d (max %d)", ErrTooManyVirtualLabelAnnotations, len(target.VirtualLabelAnnotations), MaxVirtualLabelAnnotations)
+	}
+	for _, key := range target.VirtualLabelAnnotations {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("%w %q: %v", ErrInvalidVirtualLabelAnnotation, key, errs)
+		}
+	}
+
+	// An empty uids list places no restriction, but an empty entry matches nothing
+	for i, uid := range target.UIDs {
+		if uid == "" {
+			return fmt.Errorf("%w: uids[%d]", ErrEmptyUID, i)
+		}
+	}
+
+	for i, pattern := range target.ExcludeNamespaces {
+		if err := ValidateNamespacePattern(pattern); err != nil {
+			return fmt.Errorf("excludeNamespaces[%d]: %w", i, err)
+		}
+	}
+
 	return nil
 }
 
@@ -163,6 +568,19 @@ func validateNamespace(namespace string) error {
 	return nil
 }
 
+// ValidateNamespacePattern validates a namespace name or a glob where "*" matches any run of
+// characters, e.g. "kube-*". With each "*" standing for a letter, the pattern must be a
+// valid DNS-1123 label.
+func ValidateNamespacePattern(pattern string) error {
+	if pattern == "" {
+		return fmt.Errorf("%w: %q", ErrInvalidExcludeNamespace, pattern)
+	}
+	if errs := validation.IsDNS1123Label(strings.ReplaceAll(pattern, "*", "x")); len(errs) > 0 {
+		return fmt.Errorf("%w: %q: %v", ErrInvalidExcludeNamespace, pattern, errs)
+	}
+	return nil
+}
+
 // validateLabelSelector validates a label selector.
 func validateLabelSelector(selector *metav1.LabelSelector) error {
 	// Nil selector is valid (means no selector)
@@ -271,18 +689,45 @@ func isValidVersion(version string) bool {
 
 // validateTTL validates the TTL specification.
 func validateTTL(ttl *gcapi.TTLSpec) error {
+	if ttl.Earliest != nil {
+		return validateEarliestTTL(ttl)
+	}
+	if ttl.Range != nil {
+		return validateRangeTTL(ttl)
+	}
+
+	if err := validateTTLDurations(ttl); err != nil {
+		return err
+	}
+
 	// At least one TTL option must be specified
 	hasTTL := false
 
-	if ttl.SecondsAfterCreation != nil && *ttl.SecondsAfterCreation > 0 {
+	if (ttl.SecondsAfterCreation != nil && *ttl.SecondsAfterCreation > 0) || ttl.DurationAfterCreation != "" {
 		hasTTL = true
 	}
 
 	if ttl.FieldPath != "" {
+		if _, err := ParseFieldPath(ttl.FieldPath); err != nil {
+			return err
+		}
 		hasTTL = true
 	}
 
-	if ttl.RelativeTo != "" && ttl.SecondsAfter != nil && *ttl.SecondsAfter > 0 {
+	if ttl.LabelKey != "" {
+		if err := validateTTLLabelKey(ttl); err != nil {
+			return err
+		}
+		hasTTL = true
+	}
+
+	if ttl.RelativeTo != "" {
+		if _, err := ParseFieldPath(ttl.RelativeTo); err != nil {
+			return err
+		}
+	}
+
+	if ttl.RelativeTo != "" && ((ttl.SecondsAfter != nil && *ttl.SecondsAfter > 0) || ttl.DurationAfter != "") {
 		hasTTL = true
 	}
 
@@ -290,8 +735,8 @@ func validateTTL(ttl *gcapi.TTLSpec) error {
 		return fmt.Errorf("%w", ErrNoTTLOptionSpecified)
 	}
 
-	// Validate mappings if fieldPath is specified
-	if ttl.FieldPath != "" && len(ttl.Mappings) > 0 {
+	// Validate mappings if fieldPath or labelKey is specified
+	if (ttl.FieldPath != "" || ttl.LabelKey != "") && len(ttl.Mappings) > 0 {
 		// Mappings are optional, but if specified, they should be valid
 		for key, value := range ttl.Mappings {
 			if value <= 0 {
@@ -303,24 +748,579 @@ func validateTTL(ttl *gcapi.TTLSpec) error {
 	return nil
 }
 
+// validateTTLLabelKey validates a label-based mapped TTL, which reads mappings from a label
+// instead of fieldPath. Label values are strings, so the TTL comes from mappings or default.
+func validateTTLLabelKey(ttl *gcapi.TTLSpec) error {
+	if ttl.FieldPath != "" {
+		return fmt.Errorf("%w", ErrTTLFieldPathAndLabelKey)
+	}
+	if errs := validation.IsQualifiedName(ttl.LabelKey); len(errs) > 0 {
+		return fmt.Errorf("%w %q: %v", ErrInvalidTTLLabelKey, ttl.LabelKey, errs)
+	}
+	if len(ttl.Mappings) == 0 {
+		return fmt.Errorf("%w", ErrTTLLabelKeyMappingsRequired)
+	}
+	return nil
+}
+
+// validateTTLDurations validates the duration forms of the fixed and relative TTLs, which
+// replace their seconds forms rather than combining with them.
+func validateTTLDurations(ttl *gcapi.TTLSpec) error {
+	if (ttl.SecondsAfterCreation != nil && ttl.DurationAfterCreation != "") ||
+		(ttl.SecondsAfter != nil && ttl.DurationAfter != "") {
+		return fmt.Errorf("%w", ErrTTLSecondsAndDuration)
+	}
+	for _, duration := range []string{ttl.DurationAfterCreation, ttl.DurationAfter} {
+		if duration == "" {
+			continue
+		}
+		d, err := ParseTTLDuration(duration)
+		if err != nil {
+			return err
+		}
+		if d < time.Second {
+			return fmt.Errorf("%w: %q", ErrTTLDurationTooShort, duration)
+		}
+	}
+	return nil
+}
+
+// validateEarliestTTL validates the earliest-of TTL option, which needs both sub-rules.
+func validateEarliestTTL(ttl *gcapi.TTLSpec) error {
+	if ttl.SecondsAfterCreation != nil || ttl.DurationAfterCreation != "" || ttl.FieldPath != "" || ttl.LabelKey != "" || len(ttl.Mappings) > 0 ||
+		ttl.Default != nil || ttl.RelativeTo != "" || ttl.SecondsAfter != nil || ttl.DurationAfter != "" || ttl.Range != nil {
+		return fmt.Errorf("%w", ErrEarliestTTLExclusive)
+	}
+
+	earliest := ttl.Earliest
+	if earliest.SecondsAfterCreation == nil || *earliest.SecondsAfterCreation <= 0 {
+		return fmt.Errorf("%w", ErrEarliestTTLCreationRequired)
+	}
+
+	field := earliest.Field
+	if field == nil || field.SecondsAfterCreation != nil || field.DurationAfterCreation != "" || field.Earliest != nil || field.Range != nil ||
+		(field.FieldPath == "" && field.LabelKey == "" && field.RelativeTo == "") {
+		return fmt.Errorf("%w", ErrEarliestTTLFieldRequired)
+	}
+	if err := validateTTL(field); err != nil {
+		return fmt.Errorf("invalid earliest field rule: %w", err)
+	}
+
+	return nil
+}
+
+// validateRangeTTL validates the per-resource range TTL option. Whether a resource's
+// minimum is at most its maximum can only be checked at evaluation, where resources with
+// an inverted range get no TTL.
+func validateRangeTTL(ttl *gcapi.TTLSpec) error {
+	if ttl.SecondsAfterCreation != nil || ttl.DurationAfterCreation != "" || ttl.FieldPath != "" || ttl.LabelKey != "" || len(ttl.Mappings) > 0 ||
+		ttl.Default != nil || ttl.RelativeTo != "" || ttl.SecondsAfter != nil || ttl.DurationAfter != "" {
+		return fmt.Errorf("%w", ErrRangeTTLExclusive)
+	}
+	if ttl.Range.MinFieldPath == "" || ttl.Range.MaxFieldPath == "" {
+		return fmt.Errorf("%w", ErrRangeTTLFieldsRequired)
+	}
+	for _, path := range []string{ttl.Range.MinFieldPath, ttl.Range.MaxFieldPath} {
+		if _, err := ParseFieldPath(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateConditions validates the conditions specification.
+func validateConditions(conditions *gcapi.ConditionsSpec) error {
+	return validateConditionsAtDepth(conditions, 1)
+}
+
+// validateConditionsAtDepth validates a conditions level and its anyOf groups, where depth
+// is 1 for the top level. Conditions evaluated once per policy, or that drive an action
+// beyond deletion, are only honored at the top level, so groups may not hold them.
+func validateConditionsAtDepth(conditions *gcapi.ConditionsSpec, depth int) error {
+	if depth > MaxConditionsNestingDepth {
+		return fmt.Errorf("%w: maximum depth is %d", ErrConditionsNestingTooDeep, MaxConditionsNestingDepth)
+	}
+	if depth > 1 {
+		if name := topLevelOnlyCondition(conditions); name != "" {
+			return fmt.Errorf("%w: %s", ErrConditionNotAllowedInAnyOf, name)
+		}
+	}
+
+	if len(conditions.Phase) > 0 && !slices.ContainsFunc(conditions.Phase, func(phase string) bool {
+		return !slices.Contains(conditions.NotPhase, phase)
+	}) {
+		return fmt.Errorf("%w: phase %v, notPhase %v", ErrPhaseAlwaysExcluded, conditions.Phase, conditions.NotPhase)
+	}
+
+	for i := range conditions.HasLabels {
+		if err := validateLabelCondition(&conditions.HasLabels[i]); err != nil {
+			return fmt.Errorf("invalid hasLabels[%d]: %w", i, err)
+		}
+	}
+
+	for i := range conditions.And {
+		if err := validateFieldCondition(&conditions.And[i]); err != nil {
+			return fmt.Errorf("invalid and[%d]: %w", i, err)
+		}
+	}
+	for i := range conditions.Or {
+		if err := validateFieldCondition(&conditions.Or[i]); err != nil {
+			return fmt.Errorf("invalid or[%d]: %w", i, err)
+		}
+	}
+
+	for i := range conditions.AnyOf {
+		if err := validateConditionsAtDepth(&conditions.AnyOf[i], depth+1); err != nil {
+			return fmt.Errorf("invalid anyOf[%d]: %w", i, err)
+		}
+	}
+
+	if conditions.OPA != nil {
+		if err := validateOPACondition(conditions.OPA); err != nil {
+			return fmt.Errorf("invalid opa: %w", err)
+		}
+	}
+
+	if conditions.LabelCount != nil {
+		if conditions.LabelCount.Prefix == "" {
+			return fmt.Errorf("%w", ErrLabelCountPrefixRequired)
+		}
+		if conditions.LabelCount.Threshold == nil || *conditions.LabelCount.Threshold < 0 {
+			return fmt.Errorf("%w", ErrLabelCountThresholdRequired)
+		}
+	}
+
+	if conditions.CountTrend != nil {
+		if err := validateCountTrendCondition(conditions.CountTrend); err != nil {
+			return fmt.Errorf("invalid countTrend: %w", err)
+		}
+	}
+
+	if conditions.SpecHash != nil {
+		if err := validateSpecHashCondition(conditions.SpecHash); err != nil {
+			return fmt.Errorf("invalid specHash: %w", err)
+		}
+	}
+
+	if conditions.DataDrift != nil {
+		if err := validateDataDriftCondition(conditions.DataDrift); err != nil {
+			return fmt.Errorf("invalid dataDrift: %w", err)
+		}
+	}
+
+	if conditions.OwnerChain != nil {
+		if conditions.OwnerChain.RootKind == "" {
+			return fmt.Errorf("%w", ErrOwnerChainRootKindRequired)
+		}
+		if conditions.OwnerChain.Depth < 1 || conditions.OwnerChain.Depth > 5 {
+			return fmt.Errorf("%w: %d", ErrInvalidOwnerChainDepth, conditions.OwnerChain.Depth)
+		}
+	}
+
+	if conditions.DanglingOwner && conditions.OrphansOnly {
+		return fmt.Errorf("%w", ErrDanglingOwnerOrphansOnly)
+	}
+
+	if conditions.StuckTerminating != nil {
+		if err := validateStuckTerminatingCondition(conditions.StuckTerminating); err != nil {
+			return fmt.Errorf("invalid stuckTerminating: %w", err)
+		}
+	}
+
+	if conditions.MissingLabel != nil {
+		if err := validateMissingLabelCondition(conditions.MissingLabel); err != nil {
+			return fmt.Errorf("invalid missingLabel: %w", err)
+		}
+	}
+
+	if conditions.Unchanged != nil {
+		if err := validateUnchangedCondition(conditions.Unchanged); err != nil {
+			return fmt.Errorf("invalid unchanged: %w", err)
+		}
+	}
+
+	for i := range conditions.Dates {
+		if err := validateDateCondition(&conditions.Dates[i]); err != nil {
+			return fmt.Errorf("invalid dates[%d]: %w", i, err)
+		}
+	}
+
+	for i := range conditions.ArrayLengths {
+		if err := validateArrayLengthCondition(&conditions.ArrayLengths[i]); err != nil {
+			return fmt.Errorf("invalid arrayLengths[%d]: %w", i, err)
+		}
+	}
+
+	for i := range conditions.Semver {
+		if err := validateSemverCondition(&conditions.Semver[i]); err != nil {
+			return fmt.Errorf("invalid semver[%d]: %w", i, err)
+		}
+	}
+
+	if creator := conditions.CreatedByDefaultServiceAccount; creator != nil && creator.CreatorAnnotation != "" {
+		if errs := validation.IsQualifiedName(creator.CreatorAnnotation); len(errs) > 0 {
+			return fmt.Errorf("%w %q: %v", ErrInvalidCreatorAnnotation, creator.CreatorAnnotation, errs)
+		}
+	}
+
+	if conditions.BackupGate != nil {
+		if err := validateBackupGateCondition(conditions.BackupGate); err != nil {
+			return fmt.Errorf("invalid backupGate: %w", err)
+		}
+	}
+
+	if conditions.MetricThreshold != nil {
+		if err := validateMetricThresholdCondition(conditions.MetricThreshold); err != nil {
+			return fmt.Errorf("invalid metricThreshold: %w", err)
+		}
+	}
+
+	if conditions.JMESPath != "" {
+		if _, err := jmespath.Compile(conditions.JMESPath); err != nil {
+			return fmt.Errorf("%w %q: %v", ErrInvalidJMESPath, conditions.JMESPath, err)
+		}
+	}
+
+	if conditions.Query != "" {
+		if _, err := query.Parse(conditions.Query); err != nil {
+			return fmt.Errorf("query %q: %w", conditions.Query, err)
+		}
+	}
+
+	return nil
+}
+
+// validateFieldCondition validates a field condition. Its fieldPath must parse, and
+// comparison operators need a value the controller can order fields against: a finite
+// number or an RFC3339 timestamp.
+func validateFieldCondition(cond *gcapi.FieldCondition) error {
+	if _, err := ParseFieldPath(cond.FieldPath); err != nil {
+		return err
+	}
+	switch cond.Operator {
+	case "GreaterThan", "GreaterThanOrEqual", "LessThan", "LessThanOrEqual":
+	default:
+		return nil
+	}
+	if number, err := strconv.ParseFloat(cond.Value, 64); err == nil && !math.IsNaN(number) && !math.IsInf(number, 0) {
+		return nil
+	}
+	if _, err := time.Parse(time.RFC3339, cond.Value); err == nil {
+		return nil
+	}
+	return fmt.Errorf("%w: %s %s %q", ErrInvalidFieldComparisonValue, cond.FieldPath, cond.Operator, cond.Value)
+}
+
+// validateLabelCondition validates a label condition. Comparison operators read label values
+// as numbers, so they need a finite number to compare against.
+func validateLabelCondition(cond *gcapi.LabelCondition) error {
+	switch cond.Operator {
+	case "GreaterThan", "GreaterThanOrEqual", "LessThan", "LessThanOrEqual":
+	default:
+		return nil
+	}
+	if number, err := strconv.ParseFloat(cond.Value, 64); err == nil && !math.IsNaN(number) && !math.IsInf(number, 0) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s %s %q", ErrInvalidLabelComparisonValue, cond.Key, cond.Operator, cond.Value)
+}
+
+// topLevelOnlyCondition returns the name of the first set condition that may only appear
+// at the top level of a policy's conditions, or "" if there is none.
+func topLevelOnlyCondition(conditions *gcapi.ConditionsSpec) string {
+	switch {
+	case conditions.CountTrend != nil:
+		return "countTrend"
+	case conditions.BackupGate != nil:
+		return "backupGate"
+	case conditions.MetricThreshold != nil:
+		return "metricThreshold"
+	case conditions.DataDrift != nil:
+		return "dataDrift"
+	case conditions.OwnerChain != nil:
+		return "ownerChain"
+	case conditions.DanglingOwner:
+		return "danglingOwner"
+	case conditions.StuckTerminating != nil:
+		return "stuckTerminating"
+	}
+	return ""
+}
+
+// validateBackupGateCondition validates a backup gate condition.
+func validateBackupGateCondition(gate *gcapi.BackupGateCondition) error {
+	if gate.Namespace == "" || gate.Name == "" || gate.FieldPath == "" {
+		return fmt.Errorf("%w", ErrBackupGateReferenceRequired)
+	}
+	if gate.CacheTTLSeconds != nil && *gate.CacheTTLSeconds < 0 {
+		return fmt.Errorf("%w", ErrBackupGateCacheTTLNegative)
+	}
+
+	return nil
+}
+
+// validateMetricThresholdCondition validates a metric threshold condition.
+func validateMetricThresholdCondition(cond *gcapi.MetricThresholdCondition) error {
+	if cond.URL == "" {
+		return fmt.Errorf("%w", ErrMetricThresholdURLRequired)
+	}
+	u, err := url.Parse(cond.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: %q", ErrInvalidMetricThresholdURL, cond.URL)
+	}
+	if strings.TrimSpace(cond.Query) == "" {
+		return fmt.Errorf("%w", ErrMetricThresholdQueryRequired)
+	}
+	switch cond.Operator {
+	case "GreaterThan", "GreaterThanOrEqual", "LessThan", "LessThanOrEqual":
+	default:
+		return fmt.Errorf("%w: %q (must be GreaterThan, GreaterThanOrEqual, LessThan, or LessThanOrEqual)", ErrInvalidMetricThresholdOperator, cond.Operator)
+	}
+	value, err := strconv.ParseFloat(cond.Value, 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		return fmt.Errorf("%w: %q", ErrInvalidMetricThresholdValue, cond.Value)
+	}
+	if (cond.TimeoutSeconds != nil && *cond.TimeoutSeconds < 0) ||
+		(cond.CacheTTLSeconds != nil && *cond.CacheTTLSeconds < 0) {
+		return fmt.Errorf("%w", ErrMetricThresholdSecondsNegative)
+	}
+
+	return nil
+}
+
+// validateDateCondition validates a date field condition.
+func validateDateCondition(cond *gcapi.DateCondition) error {
+	if cond.FieldPath == "" {
+		return fmt.Errorf("%w", ErrDateFieldPathRequired)
+	}
+	// A layout without any date/time element formats to itself
+	if cond.Layout == "" || time.Date(2009, 11, 17, 20, 34, 58, 0, time.UTC).Format(cond.Layout) == cond.Layout {
+		return fmt.Errorf("%w: %q", ErrInvalidDateLayout, cond.Layout)
+	}
+	if cond.Operator != "OlderThan" && cond.Operator != "NewerThan" {
+		return fmt.Errorf("%w: %q (must be OlderThan or NewerThan)", ErrInvalidDateOperator, cond.Operator)
+	}
+	if cond.Reference != "" && cond.Reference != "Now" && cond.Reference != "Today" {
+		return fmt.Errorf("%w: %q (must be Now or Today)", ErrInvalidDateReference, cond.Reference)
+	}
+	if cond.AgeSeconds != nil && *cond.AgeSeconds < 0 {
+		return fmt.Errorf("%w", ErrDateAgeSecondsNegative)
+	}
+	if cond.TimeZone != "" {
+		if _, err := time.LoadLocation(cond.TimeZone); err != nil {
+			return fmt.Errorf("%w: %q", ErrInvalidDateTimeZone, cond.TimeZone)
+		}
+	}
+
+	return nil
+}
+
+// validateArrayLengthCondition validates an array length condition.
+func validateArrayLengthCondition(cond *gcapi.ArrayLengthCondition) error {
+	if cond.FieldPath == "" {
+		return fmt.Errorf("%w", ErrArrayLengthFieldPathRequired)
+	}
+	switch cond.Operator {
+	case "Equals", "NotEquals", "GreaterThan", "GreaterThanOrEqual", "LessThan", "LessThanOrEqual":
+	default:
+		return fmt.Errorf("%w: %q (must be Equals, NotEquals, GreaterThan, GreaterThanOrEqual, LessThan, or LessThanOrEqual)", ErrInvalidArrayLengthOperator, cond.Operator)
+	}
+	if cond.Value < 0 {
+		return fmt.Errorf("%w", ErrArrayLengthValueNegative)
+	}
+
+	return nil
+}
+
+// validateSemverCondition validates a semver range condition.
+func validateSemverCondition(cond *gcapi.SemverCondition) error {
+	if (cond.LabelKey == "") == (cond.FieldPath == "") {
+		return fmt.Errorf("%w", ErrSemverSourceRequired)
+	}
+	if cond.LabelKey != "" {
+		if errs := validation.IsQualifiedName(cond.LabelKey); len(errs) > 0 {
+			return fmt.Errorf("%w %q: %v", ErrInvalidSemverLabelKey, cond.LabelKey, errs)
+		}
+	}
+	if cond.FieldPath != "" {
+		if _, err := ParseFieldPath(cond.FieldPath); err != nil {
+			return err
+		}
+	}
+	if _, err := ParseSemverRange(cond.Range); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateOPACondition validates an OPA decision condition.
+func validateOPACondition(opa *gcapi.OPACondition) error {
+	if opa.URL == "" {
+		return fmt.Errorf("%w", ErrOPAURLRequired)
+	}
+	u, err := url.Parse(opa.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: %q", ErrInvalidOPAURL, opa.URL)
+	}
+	if (opa.TimeoutSeconds != nil && *opa.TimeoutSeconds < 0) ||
+		(opa.CacheTTLSeconds != nil && *opa.CacheTTLSeconds < 0) {
+		return fmt.Errorf("%w", ErrOPASecondsNegative)
+	}
+
+	return nil
+}
+
+// validateCountTrendCondition validates a count trend condition.
+func validateCountTrendCondition(trend *gcapi.CountTrendCondition) error {
+	validTrends := map[string]bool{
+		"Increasing":    true,
+		"Decreasing":    true,
+		"Stable":        true,
+		"NonDecreasing": true,
+		"NonIncreasing": true,
+	}
+	if !validTrends[trend.Trend] {
+		return fmt.Errorf("%w: %q (must be Increasing, Decreasing, Stable, NonDecreasing, or NonIncreasing)", ErrInvalidCountTrend, trend.Trend)
+	}
+	if trend.Runs != 0 && (trend.Runs < 2 || trend.Runs > 20) {
+		return fmt.Errorf("%w: %d", ErrInvalidCountTrendRuns, trend.Runs)
+	}
+
+	return nil
+}
+
+// validateSpecHashCondition validates a spec hash condition.
+func validateSpecHashCondition(specHash *gcapi.SpecHashCondition) error {
+	if len(specHash.Fields) == 0 {
+		return fmt.Errorf("%w", ErrSpecHashFieldsRequired)
+	}
+	for _, field := range specHash.Fields {
+		if field == "" {
+			return fmt.Errorf("%w", ErrSpecHashFieldsRequired)
+		}
+	}
+
+	if len(specHash.BadHashes) == 0 && specHash.BadHashesConfigMap == nil {
+		return fmt.Errorf("%w", ErrSpecHashSourceRequired)
+	}
+	for _, hash := range specHash.BadHashes {
+		hash = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(hash)), "sha256:")
+		if decoded, err := hex.DecodeString(hash); err != nil || len(decoded) != sha256.Size {
+			return fmt.Errorf("%w: %q", ErrInvalidSpecHash, hash)
+		}
+	}
+	if ref := specHash.BadHashesConfigMap; ref != nil {
+		if errs := validation.IsDNS1123Subdomain(ref.Name); len(errs) > 0 {
+			return fmt.Errorf("%w: name %q: %s", ErrInvalidSpecHashConfigMap, ref.Name, strings.Join(errs, "; "))
+		}
+		if ref.Key != "" {
+			if errs := validation.IsConfigMapKey(ref.Key); len(errs) > 0 {
+				return fmt.Errorf("%w: key %q: %s", ErrInvalidSpecHashConfigMap, ref.Key, strings.Join(errs, "; "))
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateDataDriftCondition validates a data drift condition.
+func validateDataDriftCondition(dataDrift *gcapi.DataDriftCondition) error {
+	if (dataDrift.ExpectedHash == "") == (dataDrift.Reference == nil) {
+		return fmt.Errorf("%w", ErrDataDriftSourceRequired)
+	}
+	if dataDrift.ExpectedHash != "" {
+		hash := strings.TrimPrefix(strings.ToLower(strings.TrimSpace(dataDrift.ExpectedHash)), "sha256:")
+		if decoded, err := hex.DecodeString(hash); err != nil || len(decoded) != sha256.Size {
+			return fmt.Errorf("%w: %q", ErrInvalidDataDriftHash, dataDrift.ExpectedHash)
+		}
+	}
+	if dataDrift.Reference != nil && dataDrift.Reference.Name == "" {
+		return fmt.Errorf("%w", ErrDataDriftReferenceNameRequired)
+	}
+	return nil
+}
+
+// validateStuckTerminatingCondition validates a stuck terminating condition.
+func validateStuckTerminatingCondition(stuck *gcapi.StuckTerminatingCondition) error {
+	if stuck.OlderThanSeconds == nil || *stuck.OlderThanSeconds <= 0 {
+		return fmt.Errorf("%w", ErrStuckTerminatingDurationRequired)
+	}
+	for _, finalizer := range stuck.RemoveFinalizers {
+		if errs := validation.IsQualifiedName(finalizer); len(errs) > 0 {
+			return fmt.Errorf("%w %q: %v", ErrInvalidStuckTerminatingFinalizer, finalizer, errs)
+		}
+	}
+	return nil
+}
+
+// validateMissingLabelCondition validates a missing label condition.
+func validateMissingLabelCondition(cond *gcapi.MissingLabelCondition) error {
+	if cond.Key == "" {
+		return fmt.Errorf("%w", ErrMissingLabelKeyRequired)
+	}
+	if errs := validation.IsQualifiedName(cond.Key); len(errs) > 0 {
+		return fmt.Errorf("%w: %q: %v", ErrMissingLabelKeyRequired, cond.Key, errs)
+	}
+	if cond.OlderThanSeconds == nil || *cond.OlderThanSeconds <= 0 {
+		return fmt.Errorf("%w", ErrMissingLabelAgeRequired)
+	}
+	return nil
+}
+
+// validateUnchangedCondition validates an unchanged condition.
+func validateUnchangedCondition(cond *gcapi.UnchangedCondition) error {
+	if cond.ForSeconds == nil || *cond.ForSeconds <= 0 {
+		return fmt.Errorf("%w", ErrUnchangedDurationRequired)
+	}
+	if cond.FieldPath != "" {
+		if _, err := ParseFieldPath(cond.FieldPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // validateBehavior validates the behavior specification.
 func validateBehavior(behavior *gcapi.BehaviorSpec) error {
 	if behavior.MaxDeletionsPerSecond < 0 {
 		return fmt.Errorf("%w", ErrMaxDeletionsPerSecondNegative)
 	}
 
+	if behavior.MaxDeletionsPerRun < 0 {
+		return fmt.Errorf("%w", ErrMaxDeletionsPerRunNegative)
+	}
+
+	if behavior.MaxAPICalls < 0 {
+		return fmt.Errorf("%w", ErrMaxAPICallsNegative)
+	}
+
+	if behavior.NamespacesPerRun < 0 {
+		return fmt.Errorf("%w", ErrNamespacesPerRunNegative)
+	}
+
 	if behavior.BatchSize < 0 {
 		return fmt.Errorf("%w", ErrBatchSizeNegative)
 	}
 
-	if behavior.PropagationPolicy != "" {
-		validPolicies := map[string]bool{
-			"Foreground": true,
-			"Background": true,
-			"Orphan":     true,
+	if behavior.BatchInterval != nil && behavior.BatchInterval.Duration < 0 {
+		return fmt.Errorf("%w", ErrBatchIntervalNegative)
+	}
+
+	validPolicies := map[string]bool{
+		"Foreground": true,
+		"Background": true,
+		"Orphan":     true,
+	}
+	if behavior.PropagationPolicy != "" && !validPolicies[behavior.PropagationPolicy] {
+		return fmt.Errorf("%w: %s (must be Foreground, Background, or Orphan)", ErrInvalidPropagationPolicy, behavior.PropagationPolicy)
+	}
+	for kind, propagation := range behavior.PropagationByKind {
+		if kind == "" {
+			return fmt.Errorf("%w", ErrPropagationByKindKindRequired)
 		}
-		if !validPolicies[behavior.PropagationPolicy] {
-			return fmt.Errorf("%w: %s (must be Foreground, Background, or Orphan)", ErrInvalidPropagationPolicy, behavior.PropagationPolicy)
+		if !validPolicies[propagation] {
+			return fmt.Errorf("%w: propagationByKind[%s]: %s (must be Foreground, Background, or Orphan)", ErrInvalidPropagationPolicy, kind, propagation)
 		}
 	}
 
@@ -328,5 +1328,139 @@ func validateBehavior(behavior *gcapi.BehaviorSpec) error {
 		return fmt.Errorf("%w", ErrGracePeriodSecondsNegative)
 	}
 
+	if behavior.DeletionNoticeSeconds != nil && *behavior.DeletionNoticeSeconds < 0 {
+		return fmt.Errorf("%w", ErrDeletionNoticeSecondsNegative)
+	}
+
+	if behavior.Finalizer != "" {
+		if errs := validation.IsQualifiedName(behavior.Finalizer); len(errs) > 0 {
+			return fmt.Errorf("%w %q: %v", ErrInvalidFinalizer, behavior.Finalizer, errs)
+		}
+	}
+
+	if behavior.SnapshotRetention < 0 {
+		return fmt.Errorf("%w", ErrSnapshotRetentionNegative)
+	}
+
+	if behavior.ResultWebhook != nil {
+		if err := validateResultWebhook(behavior.ResultWebhook); err != nil {
+			return err
+		}
+	}
+
+	if len(behavior.AlsoDelete) > 0 && behavior.Preconditions {
+		return fmt.Errorf("%w", ErrAlsoDeleteWithPreconditions)
+	}
+	for i := range behavior.AlsoDelete {
+		if err := validateAlsoDelete(&behavior.AlsoDelete[i]); err != nil {
+			return fmt.Errorf("alsoDelete[%d]: %w", i, err)
+		}
+	}
+
+	if behavior.MinRemaining != nil {
+		if err := validateMinRemaining(behavior.MinRemaining); err != nil {
+			return err
+		}
+	}
+
+	switch behavior.DeletionOrder {
+	case "", "OldestFirst", "NewestFirst", "ReverseDependency":
+	default:
+		return fmt.Errorf("%w: %q (must be OldestFirst, NewestFirst, or ReverseDependency)", ErrInvalidDeletionOrder, behavior.DeletionOrder)
+	}
+
+	knownReasons := map[string]bool{
+		"ttl_expired": true,
+	}
+	for _, reason := range behavior.AllowedReasons {
+		if !knownReasons[reason] {
+			return fmt.Errorf("%w: %q (must be ttl_expired)", ErrUnknownDeletionReason, reason)
+		}
+	}
+
+	return nil
+}
+
+// validateMinRemaining validates the minimum-remaining guard.
+func validateMinRemaining(spec *gcapi.MinRemainingSpec) error {
+	if spec.Value.Type == intstr.String {
+		percent, ok := strings.CutSuffix(spec.Value.StrVal, "%")
+		value, err := strconv.Atoi(percent)
+		if !ok || err != nil || value < 0 || value > 100 {
+			return fmt.Errorf("%w: %q", ErrInvalidMinRemaining, spec.Value.StrVal)
+		}
+	} else if spec.Value.IntVal < 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidMinRemaining, spec.Value.IntVal)
+	}
+	for _, key := range spec.KeyLabels {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("%w %q: %v", ErrInvalidMinRemainingKeyLabel, key, errs)
+		}
+	}
+	if spec.Scope != "" && spec.Scope != "Namespace" && spec.Scope != "Cluster" {
+		return fmt.Errorf("%w: %q (must be Namespace or Cluster)", ErrInvalidDedupScope, spec.Scope)
+	}
+	return nil
+}
+
+// validateAlsoDelete validates a dependent spec. Parent placeholders are substituted with a
+// sample name so selector values and namespaces are checked in their resolved form.
+func validateAlsoDelete(dependent *gcapi.TargetResourceSpec) error {
+	if dependent.LabelSelector == nil && len(dependent.LabelSelectors) == 0 &&
+		(dependent.FieldSelector == nil || len(dependent.FieldSelector.MatchFields) == 0) {
+		return fmt.Errorf("%w", ErrAlsoDeleteSelectorRequired)
+	}
+
+	resolved := dependent.DeepCopy()
+	replacer := strings.NewReplacer(gcapi.ParentNamePlaceholder, "parent", gcapi.ParentNamespacePlaceholder, "parent")
+	resolved.Namespace = replacer.Replace(resolved.Namespace)
+	resolveSelector := func(selector *metav1.LabelSelector) {
+		for key, value := range selector.MatchLabels {
+			selector.MatchLabels[key] = replacer.Replace(value)
+		}
+		for i := range selector.MatchExpressions {
+			for j, value := range selector.MatchExpressions[i].Values {
+				selector.MatchExpressions[i].Values[j] = replacer.Replace(value)
+			}
+		}
+	}
+	if resolved.LabelSelector != nil {
+		resolveSelector(resolved.LabelSelector)
+	}
+	for i := range resolved.LabelSelectors {
+		resolveSelector(&resolved.LabelSelectors[i])
+	}
+	return validateTargetResource(resolved)
+}
+
+// validateResultWebhook validates the post-evaluation result webhook.
+func validateResultWebhook(webhook *gcapi.ResultWebhookSpec) error {
+	if webhook.URL == "" {
+		return fmt.Errorf("%w", ErrResultWebhookURLRequired)
+	}
+	u, err := url.Parse(webhook.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: %q", ErrInvalidResultWebhookURL, webhook.URL)
+	}
+	if webhook.TimeoutSeconds != nil && *webhook.TimeoutSeconds < 0 {
+		return fmt.Errorf("%w", ErrResultWebhookTimeoutNegative)
+	}
+
+	return nil
+}
+
+// validateSchedule validates a schedule's time zone and active windows.
+func validateSchedule(schedule *gcapi.ScheduleSpec) error {
+	if schedule.TimeZone != "" {
+		if _, err := time.LoadLocation(schedule.TimeZone); err != nil {
+			return fmt.Errorf("%w: %q", ErrInvalidScheduleTimeZone, schedule.TimeZone)
+		}
+	}
+	for i, window := range schedule.ActiveWindows {
+		if _, err := ParseActiveWindow(window); err != nil {
+			return fmt.Errorf("activeWindows[%d]: %w", i, err)
+		}
+	}
+
 	return nil
 }
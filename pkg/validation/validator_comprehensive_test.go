@@ -2,8 +2,10 @@ package validation
 
 import (
 	"testing"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
 )
@@ -52,6 +54,112 @@ func TestValidateTargetResource(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			name: "valid labelSelectors",
+			target: &v1alpha1.TargetResourceSpec{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+				LabelSelectors: []metav1.LabelSelector{
+					{MatchLabels: map[string]string{"app": "foo"}},
+					{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: metav1.LabelSelectorOpExists}}},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid selector in labelSelectors",
+			target: &v1alpha1.TargetResourceSpec{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+				LabelSelectors: []metav1.LabelSelector{
+					{MatchLabels: map[string]string{"app": "foo"}},
+					{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: metav1.LabelSelectorOpIn}}},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "valid virtualLabelAnnotations",
+			target: &v1alpha1.TargetResourceSpec{
+				APIVersion:              "v1",
+				Kind:                    "ConfigMap",
+				LabelSelector:           &metav1.LabelSelector{MatchLabels: map[string]string{"example.com/team": "payments"}},
+				VirtualLabelAnnotations: []string{"example.com/team"},
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid virtualLabelAnnotations key",
+			target: &v1alpha1.TargetResourceSpec{
+				APIVersion:              "v1",
+				Kind:                    "ConfigMap",
+				VirtualLabelAnnotations: []string{"not a key"},
+			},
+			expectError: true,
+		},
+		{
+			name: "too many virtualLabelAnnotations",
+			target: &v1alpha1.TargetResourceSpec{
+				APIVersion:              "v1",
+				Kind:                    "ConfigMap",
+				VirtualLabelAnnotations: []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"},
+			},
+			expectError: true,
+		},
+		{
+			name: "valid uids",
+			target: &v1alpha1.TargetResourceSpec{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+				UIDs:       []string{"uid-1", "uid-2"},
+			},
+			expectError: false,
+		},
+		{
+			name: "empty uids entry",
+			target: &v1alpha1.TargetResourceSpec{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+				UIDs:       []string{"uid-1", ""},
+			},
+			expectError: true,
+		},
+		{
+			name: "valid excludeNamespaces",
+			target: &v1alpha1.TargetResourceSpec{
+				APIVersion:        "v1",
+				Kind:              "ConfigMap",
+				ExcludeNamespaces: []string{"kube-system", "kube-*", "*-system", "*"},
+			},
+			expectError: false,
+		},
+		{
+			name: "excludeNamespaces entry with invalid characters",
+			target: &v1alpha1.TargetResourceSpec{
+				APIVersion:        "v1",
+				Kind:              "ConfigMap",
+				ExcludeNamespaces: []string{"Kube_System"},
+			},
+			expectError: true,
+		},
+		{
+			name: "excludeNamespaces entry with unsupported glob syntax",
+			target: &v1alpha1.TargetResourceSpec{
+				APIVersion:        "v1",
+				Kind:              "ConfigMap",
+				ExcludeNamespaces: []string{"kube-[ab]"},
+			},
+			expectError: true,
+		},
+		{
+			name: "empty excludeNamespaces entry",
+			target: &v1alpha1.TargetResourceSpec{
+				APIVersion:        "v1",
+				Kind:              "ConfigMap",
+				ExcludeNamespaces: []string{""},
+			},
+			expectError: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -179,6 +287,191 @@ func TestValidateTTL(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "labelKey with mappings",
+			ttl: &v1alpha1.TTLSpec{
+				LabelKey: "environment",
+				Mappings: map[string]int64{"prod": 2592000, "dev": 86400},
+				Default:  int64Ptr(604800),
+			},
+			expectError: false,
+		},
+		{
+			name: "labelKey and fieldPath",
+			ttl: &v1alpha1.TTLSpec{
+				FieldPath: "spec.severity",
+				LabelKey:  "environment",
+				Mappings:  map[string]int64{"prod": 2592000},
+			},
+			expectError: true,
+		},
+		{
+			name: "labelKey without mappings",
+			ttl: &v1alpha1.TTLSpec{
+				LabelKey: "environment",
+				Default:  int64Ptr(604800),
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid labelKey",
+			ttl: &v1alpha1.TTLSpec{
+				LabelKey: "not a key",
+				Mappings: map[string]int64{"prod": 2592000},
+			},
+			expectError: true,
+		},
+		{
+			name: "labelKey with invalid mapping value",
+			ttl: &v1alpha1.TTLSpec{
+				LabelKey: "environment",
+				Mappings: map[string]int64{"prod": 0},
+			},
+			expectError: true,
+		},
+		{
+			name: "valid earliest TTL",
+			ttl: &v1alpha1.TTLSpec{
+				Earliest: &v1alpha1.EarliestTTLSpec{
+					SecondsAfterCreation: int64Ptr(604800),
+					Field:                &v1alpha1.TTLSpec{FieldPath: "spec.ttlSeconds"},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "earliest TTL without creation rule",
+			ttl: &v1alpha1.TTLSpec{
+				Earliest: &v1alpha1.EarliestTTLSpec{
+					Field: &v1alpha1.TTLSpec{FieldPath: "spec.ttlSeconds"},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "earliest TTL without field rule",
+			ttl: &v1alpha1.TTLSpec{
+				Earliest: &v1alpha1.EarliestTTLSpec{SecondsAfterCreation: int64Ptr(604800)},
+			},
+			expectError: true,
+		},
+		{
+			name: "earliest TTL with creation-based field rule",
+			ttl: &v1alpha1.TTLSpec{
+				Earliest: &v1alpha1.EarliestTTLSpec{
+					SecondsAfterCreation: int64Ptr(604800),
+					Field:                &v1alpha1.TTLSpec{SecondsAfterCreation: int64Ptr(3600)},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "earliest TTL combined with another option",
+			ttl: &v1alpha1.TTLSpec{
+				SecondsAfterCreation: int64Ptr(3600),
+				Earliest: &v1alpha1.EarliestTTLSpec{
+					SecondsAfterCreation: int64Ptr(604800),
+					Field:                &v1alpha1.TTLSpec{FieldPath: "spec.ttlSeconds"},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "valid range TTL",
+			ttl: &v1alpha1.TTLSpec{
+				Range: &v1alpha1.RangeTTLSpec{MinFieldPath: "spec.minTtl", MaxFieldPath: "spec.maxTtl"},
+			},
+			expectError: false,
+		},
+		{
+			name: "range TTL missing maxFieldPath",
+			ttl: &v1alpha1.TTLSpec{
+				Range: &v1alpha1.RangeTTLSpec{MinFieldPath: "spec.minTtl"},
+			},
+			expectError: true,
+		},
+		{
+			name: "range TTL combined with another option",
+			ttl: &v1alpha1.TTLSpec{
+				SecondsAfterCreation: int64Ptr(3600),
+				Range:                &v1alpha1.RangeTTLSpec{MinFieldPath: "spec.minTtl", MaxFieldPath: "spec.maxTtl"},
+			},
+			expectError: true,
+		},
+		{
+			name: "range TTL as earliest field rule",
+			ttl: &v1alpha1.TTLSpec{
+				Earliest: &v1alpha1.EarliestTTLSpec{
+					SecondsAfterCreation: int64Ptr(604800),
+					Field: &v1alpha1.TTLSpec{
+						FieldPath: "spec.ttlSeconds",
+						Range:     &v1alpha1.RangeTTLSpec{MinFieldPath: "spec.minTtl", MaxFieldPath: "spec.maxTtl"},
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name:        "valid durationAfterCreation",
+			ttl:         &v1alpha1.TTLSpec{DurationAfterCreation: "30d"},
+			expectError: false,
+		},
+		{
+			name:        "valid durationAfter",
+			ttl:         &v1alpha1.TTLSpec{RelativeTo: "status.lastProcessedAt", DurationAfter: "2w"},
+			expectError: false,
+		},
+		{
+			name:        "malformed durationAfterCreation",
+			ttl:         &v1alpha1.TTLSpec{DurationAfterCreation: "30 days"},
+			expectError: true,
+		},
+		{
+			name:        "durationAfterCreation below one second",
+			ttl:         &v1alpha1.TTLSpec{DurationAfterCreation: "500ms"},
+			expectError: true,
+		},
+		{
+			name:        "secondsAfterCreation and durationAfterCreation",
+			ttl:         &v1alpha1.TTLSpec{SecondsAfterCreation: int64Ptr(3600), DurationAfterCreation: "1h"},
+			expectError: true,
+		},
+		{
+			name:        "secondsAfter and durationAfter",
+			ttl:         &v1alpha1.TTLSpec{RelativeTo: "status.lastProcessedAt", SecondsAfter: int64Ptr(3600), DurationAfter: "1h"},
+			expectError: true,
+		},
+		{
+			name: "durationAfterCreation combined with range",
+			ttl: &v1alpha1.TTLSpec{
+				DurationAfterCreation: "1d",
+				Range:                 &v1alpha1.RangeTTLSpec{MinFieldPath: "spec.minTtl", MaxFieldPath: "spec.maxTtl"},
+			},
+			expectError: true,
+		},
+		{
+			name: "fieldPath with quoted annotation key",
+			ttl: &v1alpha1.TTLSpec{
+				FieldPath: "metadata.annotations['gc.kube-zen.io/ttl']",
+				Mappings:  map[string]int64{"short": 3600},
+			},
+			expectError: false,
+		},
+		{
+			name:        "malformed fieldPath",
+			ttl:         &v1alpha1.TTLSpec{FieldPath: "spec.containers[0"},
+			expectError: true,
+		},
+		{
+			name:        "malformed relativeTo",
+			ttl:         &v1alpha1.TTLSpec{RelativeTo: "status..lastProcessedAt", SecondsAfter: int64Ptr(3600)},
+			expectError: true,
+		},
+		{
+			name:        "malformed range bound",
+			ttl:         &v1alpha1.TTLSpec{Range: &v1alpha1.RangeTTLSpec{MinFieldPath: "spec.minTtl", MaxFieldPath: "spec.maxTtl["}},
+			expectError: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -227,6 +520,55 @@ func TestValidateBehavior(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			name: "valid finalizer",
+			behavior: &v1alpha1.BehaviorSpec{
+				Finalizer: "cleanup.example.com/archive",
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid finalizer",
+			behavior: &v1alpha1.BehaviorSpec{
+				Finalizer: "not a finalizer",
+			},
+			expectError: true,
+		},
+		{
+			name: "negative deletionNoticeSeconds",
+			behavior: &v1alpha1.BehaviorSpec{
+				DeletionNoticeSeconds: int64Ptr(-1),
+			},
+			expectError: true,
+		},
+		{
+			name: "negative batchInterval",
+			behavior: &v1alpha1.BehaviorSpec{
+				BatchInterval: &metav1.Duration{Duration: -time.Second},
+			},
+			expectError: true,
+		},
+		{
+			name: "negative maxDeletionsPerRun",
+			behavior: &v1alpha1.BehaviorSpec{
+				MaxDeletionsPerRun: -1,
+			},
+			expectError: true,
+		},
+		{
+			name: "negative maxApiCalls",
+			behavior: &v1alpha1.BehaviorSpec{
+				MaxAPICalls: -1,
+			},
+			expectError: true,
+		},
+		{
+			name: "negative namespacesPerRun",
+			behavior: &v1alpha1.BehaviorSpec{
+				NamespacesPerRun: -1,
+			},
+			expectError: true,
+		},
 		{
 			name: "zero maxDeletionsPerSecond (valid)",
 			behavior: &v1alpha1.BehaviorSpec{
@@ -276,6 +618,28 @@ func TestValidateBehavior(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "valid propagationByKind",
+			behavior: &v1alpha1.BehaviorSpec{
+				PropagationPolicy: "Background",
+				PropagationByKind: map[string]string{"Job": "Foreground", "ReplicaSet": "Orphan"},
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid propagationByKind value",
+			behavior: &v1alpha1.BehaviorSpec{
+				PropagationByKind: map[string]string{"Job": "Cascade"},
+			},
+			expectError: true,
+		},
+		{
+			name: "propagationByKind with empty kind",
+			behavior: &v1alpha1.BehaviorSpec{
+				PropagationByKind: map[string]string{"": "Orphan"},
+			},
+			expectError: true,
+		},
 		{
 			name: "negative gracePeriodSeconds",
 			behavior: &v1alpha1.BehaviorSpec{
@@ -297,30 +661,967 @@ func TestValidateBehavior(t *testing.T) {
 			},
 			expectError: false,
 		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			err := validateBehavior(tt.behavior)
-			if tt.expectError {
-				if err == nil {
-					t.Errorf("validateBehavior() expected error but got none")
-				}
-			} else {
-				if err != nil {
-					t.Errorf("validateBehavior() returned error: %v", err)
-				}
-			}
-		})
-	}
-}
-
-func TestValidatePolicy_Comprehensive(t *testing.T) {
-	tests := []struct {
-		name        string
-		policy      *v1alpha1.GarbageCollectionPolicy
-		expectError bool
-	}{
+		{
+			name: "snapshot with retention (valid)",
+			behavior: &v1alpha1.BehaviorSpec{
+				Snapshot:          true,
+				SnapshotRetention: 10,
+			},
+			expectError: false,
+		},
+		{
+			name: "negative snapshotRetention",
+			behavior: &v1alpha1.BehaviorSpec{
+				SnapshotRetention: -1,
+			},
+			expectError: true,
+		},
+		{
+			name: "valid resultWebhook",
+			behavior: &v1alpha1.BehaviorSpec{
+				ResultWebhook: &v1alpha1.ResultWebhookSpec{URL: "https://hooks.example.com/gc", TimeoutSeconds: int64Ptr(3)},
+			},
+			expectError: false,
+		},
+		{
+			name: "resultWebhook without url",
+			behavior: &v1alpha1.BehaviorSpec{
+				ResultWebhook: &v1alpha1.ResultWebhookSpec{},
+			},
+			expectError: true,
+		},
+		{
+			name: "resultWebhook with non-http url",
+			behavior: &v1alpha1.BehaviorSpec{
+				ResultWebhook: &v1alpha1.ResultWebhookSpec{URL: "ftp://hooks.example.com/gc"},
+			},
+			expectError: true,
+		},
+		{
+			name: "resultWebhook with negative timeout",
+			behavior: &v1alpha1.BehaviorSpec{
+				ResultWebhook: &v1alpha1.ResultWebhookSpec{URL: "http://hooks.example.com/gc", TimeoutSeconds: int64Ptr(-1)},
+			},
+			expectError: true,
+		},
+		{
+			name: "known allowedReasons",
+			behavior: &v1alpha1.BehaviorSpec{
+				AllowedReasons: []string{"ttl_expired"},
+			},
+			expectError: false,
+		},
+		{
+			name: "unknown allowedReasons",
+			behavior: &v1alpha1.BehaviorSpec{
+				AllowedReasons: []string{"ttl_expired", "size_exceeded"},
+			},
+			expectError: true,
+		},
+		{
+			name: "alsoDelete with parent placeholder",
+			behavior: &v1alpha1.BehaviorSpec{
+				AlsoDelete: []v1alpha1.TargetResourceSpec{{
+					APIVersion:    "v1",
+					Kind:          "Secret",
+					LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"example.com/parent": v1alpha1.ParentNamePlaceholder}},
+				}},
+			},
+			expectError: false,
+		},
+		{
+			name: "alsoDelete without selector",
+			behavior: &v1alpha1.BehaviorSpec{
+				AlsoDelete: []v1alpha1.TargetResourceSpec{{APIVersion: "v1", Kind: "Secret"}},
+			},
+			expectError: true,
+		},
+		{
+			name: "alsoDelete with preconditions",
+			behavior: &v1alpha1.BehaviorSpec{
+				Preconditions: true,
+				AlsoDelete: []v1alpha1.TargetResourceSpec{{
+					APIVersion:    "v1",
+					Kind:          "Secret",
+					LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"example.com/parent": v1alpha1.ParentNamePlaceholder}},
+				}},
+			},
+			expectError: true,
+		},
+		{
+			name: "alsoDelete without kind",
+			behavior: &v1alpha1.BehaviorSpec{
+				AlsoDelete: []v1alpha1.TargetResourceSpec{{
+					APIVersion:    "v1",
+					LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+				}},
+			},
+			expectError: true,
+		},
+		{
+			name: "minRemaining absolute",
+			behavior: &v1alpha1.BehaviorSpec{
+				MinRemaining: &v1alpha1.MinRemainingSpec{Value: intstr.FromInt32(2), KeyLabels: []string{"app"}},
+			},
+			expectError: false,
+		},
+		{
+			name: "minRemaining percentage",
+			behavior: &v1alpha1.BehaviorSpec{
+				MinRemaining: &v1alpha1.MinRemainingSpec{Value: intstr.FromString("50%"), Scope: "Cluster"},
+			},
+			expectError: false,
+		},
+		{
+			name: "minRemaining negative",
+			behavior: &v1alpha1.BehaviorSpec{
+				MinRemaining: &v1alpha1.MinRemainingSpec{Value: intstr.FromInt32(-1)},
+			},
+			expectError: true,
+		},
+		{
+			name: "minRemaining percentage over 100",
+			behavior: &v1alpha1.BehaviorSpec{
+				MinRemaining: &v1alpha1.MinRemainingSpec{Value: intstr.FromString("150%")},
+			},
+			expectError: true,
+		},
+		{
+			name: "minRemaining string without percent",
+			behavior: &v1alpha1.BehaviorSpec{
+				MinRemaining: &v1alpha1.MinRemainingSpec{Value: intstr.FromString("three")},
+			},
+			expectError: true,
+		},
+		{
+			name: "minRemaining invalid scope",
+			behavior: &v1alpha1.BehaviorSpec{
+				MinRemaining: &v1alpha1.MinRemainingSpec{Value: intstr.FromInt32(1), Scope: "Node"},
+			},
+			expectError: true,
+		},
+		{
+			name:        "reverse dependency deletion order",
+			behavior:    &v1alpha1.BehaviorSpec{DeletionOrder: "ReverseDependency"},
+			expectError: false,
+		},
+		{
+			name:        "oldest first deletion order",
+			behavior:    &v1alpha1.BehaviorSpec{DeletionOrder: "OldestFirst"},
+			expectError: false,
+		},
+		{
+			name:        "newest first deletion order",
+			behavior:    &v1alpha1.BehaviorSpec{DeletionOrder: "NewestFirst"},
+			expectError: false,
+		},
+		{
+			name:        "unknown deletion order",
+			behavior:    &v1alpha1.BehaviorSpec{DeletionOrder: "Random"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateBehavior(tt.behavior)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("validateBehavior() expected error but got none")
+				}
+			} else {
+				if err != nil {
+					t.Errorf("validateBehavior() returned error: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateConditions(t *testing.T) {
+	tests := []struct {
+		name        string
+		conditions  *v1alpha1.ConditionsSpec
+		expectError bool
+	}{
+		{
+			name:        "empty conditions (valid)",
+			conditions:  &v1alpha1.ConditionsSpec{},
+			expectError: false,
+		},
+		{
+			name:        "notPhase alone",
+			conditions:  &v1alpha1.ConditionsSpec{NotPhase: []string{"Running"}},
+			expectError: false,
+		},
+		{
+			name:        "phase partly overlapping notPhase",
+			conditions:  &v1alpha1.ConditionsSpec{Phase: []string{"Succeeded", "Failed"}, NotPhase: []string{"Failed"}},
+			expectError: false,
+		},
+		{
+			name:        "every phase excluded by notPhase",
+			conditions:  &v1alpha1.ConditionsSpec{Phase: []string{"Failed"}, NotPhase: []string{"Failed", "Running"}},
+			expectError: true,
+		},
+		{
+			name:        "every phase excluded within anyOf",
+			conditions:  &v1alpha1.ConditionsSpec{AnyOf: []v1alpha1.ConditionsSpec{{Phase: []string{"Running"}, NotPhase: []string{"Running"}}}},
+			expectError: true,
+		},
+		{
+			name: "valid opa condition",
+			conditions: &v1alpha1.ConditionsSpec{
+				OPA: &v1alpha1.OPACondition{
+					URL:             "http://opa.opa:8181/v1/data/gc/allow",
+					TimeoutSeconds:  int64Ptr(2),
+					CacheTTLSeconds: int64Ptr(0),
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "opa condition missing url",
+			conditions: &v1alpha1.ConditionsSpec{
+				OPA: &v1alpha1.OPACondition{},
+			},
+			expectError: true,
+		},
+		{
+			name: "opa condition with non-http url",
+			conditions: &v1alpha1.ConditionsSpec{
+				OPA: &v1alpha1.OPACondition{URL: "ftp://opa/v1/data/gc/allow"},
+			},
+			expectError: true,
+		},
+		{
+			name: "opa condition with negative timeout",
+			conditions: &v1alpha1.ConditionsSpec{
+				OPA: &v1alpha1.OPACondition{
+					URL:            "https://opa.example.com/v1/data/gc/allow",
+					TimeoutSeconds: int64Ptr(-1),
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "valid labelCount condition",
+			conditions: &v1alpha1.ConditionsSpec{
+				LabelCount: &v1alpha1.LabelCountCondition{Prefix: "revision.example.com/", Threshold: int32Ptr(10)},
+			},
+			expectError: false,
+		},
+		{
+			name: "labelCount condition missing prefix",
+			conditions: &v1alpha1.ConditionsSpec{
+				LabelCount: &v1alpha1.LabelCountCondition{Threshold: int32Ptr(10)},
+			},
+			expectError: true,
+		},
+		{
+			name: "labelCount condition missing threshold",
+			conditions: &v1alpha1.ConditionsSpec{
+				LabelCount: &v1alpha1.LabelCountCondition{Prefix: "revision.example.com/"},
+			},
+			expectError: true,
+		},
+		{
+			name: "labelCount condition with negative threshold",
+			conditions: &v1alpha1.ConditionsSpec{
+				LabelCount: &v1alpha1.LabelCountCondition{Prefix: "revision.example.com/", Threshold: int32Ptr(-1)},
+			},
+			expectError: true,
+		},
+		{
+			name: "valid countTrend condition",
+			conditions: &v1alpha1.ConditionsSpec{
+				CountTrend: &v1alpha1.CountTrendCondition{Trend: "NonDecreasing", Runs: 3},
+			},
+			expectError: false,
+		},
+		{
+			name: "countTrend condition with default runs",
+			conditions: &v1alpha1.ConditionsSpec{
+				CountTrend: &v1alpha1.CountTrendCondition{Trend: "Stable"},
+			},
+			expectError: false,
+		},
+		{
+			name: "countTrend condition with unknown trend",
+			conditions: &v1alpha1.ConditionsSpec{
+				CountTrend: &v1alpha1.CountTrendCondition{Trend: "Sideways"},
+			},
+			expectError: true,
+		},
+		{
+			name: "countTrend condition with too few runs",
+			conditions: &v1alpha1.ConditionsSpec{
+				CountTrend: &v1alpha1.CountTrendCondition{Trend: "Increasing", Runs: 1},
+			},
+			expectError: true,
+		},
+		{
+			name: "countTrend condition with too many runs",
+			conditions: &v1alpha1.ConditionsSpec{
+				CountTrend: &v1alpha1.CountTrendCondition{Trend: "Increasing", Runs: 21},
+			},
+			expectError: true,
+		},
+		{
+			name: "valid specHash condition with inline hashes",
+			conditions: &v1alpha1.ConditionsSpec{
+				SpecHash: &v1alpha1.SpecHashCondition{
+					Fields:    []string{"spec.template"},
+					BadHashes: []string{"sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "valid specHash condition with hash ConfigMap",
+			conditions: &v1alpha1.ConditionsSpec{
+				SpecHash: &v1alpha1.SpecHashCondition{
+					Fields:             []string{"spec.template"},
+					BadHashesConfigMap: &v1alpha1.BadHashesConfigMapRef{Name: "bad-hashes", Key: "hashes"},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "specHash condition missing fields",
+			conditions: &v1alpha1.ConditionsSpec{
+				SpecHash: &v1alpha1.SpecHashCondition{BadHashes: []string{"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"}},
+			},
+			expectError: true,
+		},
+		{
+			name: "specHash condition missing hash source",
+			conditions: &v1alpha1.ConditionsSpec{
+				SpecHash: &v1alpha1.SpecHashCondition{Fields: []string{"spec.template"}},
+			},
+			expectError: true,
+		},
+		{
+			name: "specHash condition with malformed hash",
+			conditions: &v1alpha1.ConditionsSpec{
+				SpecHash: &v1alpha1.SpecHashCondition{
+					Fields:    []string{"spec.template"},
+					BadHashes: []string{"not-a-hash"},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "specHash condition with invalid ConfigMap name",
+			conditions: &v1alpha1.ConditionsSpec{
+				SpecHash: &v1alpha1.SpecHashCondition{
+					Fields:             []string{"spec.template"},
+					BadHashesConfigMap: &v1alpha1.BadHashesConfigMapRef{Name: "/etc/zen-gc/bad-hashes"},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "specHash condition with invalid ConfigMap key",
+			conditions: &v1alpha1.ConditionsSpec{
+				SpecHash: &v1alpha1.SpecHashCondition{
+					Fields:             []string{"spec.template"},
+					BadHashesConfigMap: &v1alpha1.BadHashesConfigMapRef{Name: "bad-hashes", Key: "../hashes"},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "valid dataDrift condition with expected hash",
+			conditions: &v1alpha1.ConditionsSpec{
+				DataDrift: &v1alpha1.DataDriftCondition{ExpectedHash: "sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"},
+			},
+			expectError: false,
+		},
+		{
+			name: "valid dataDrift condition with reference",
+			conditions: &v1alpha1.ConditionsSpec{
+				DataDrift: &v1alpha1.DataDriftCondition{Reference: &v1alpha1.DataDriftReference{Name: "golden", Namespace: "platform"}},
+			},
+			expectError: false,
+		},
+		{
+			name: "dataDrift condition without expected hash or reference",
+			conditions: &v1alpha1.ConditionsSpec{
+				DataDrift: &v1alpha1.DataDriftCondition{},
+			},
+			expectError: true,
+		},
+		{
+			name: "dataDrift condition with both expected hash and reference",
+			conditions: &v1alpha1.ConditionsSpec{
+				DataDrift: &v1alpha1.DataDriftCondition{
+					ExpectedHash: "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+					Reference:    &v1alpha1.DataDriftReference{Name: "golden"},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "dataDrift condition with malformed hash",
+			conditions: &v1alpha1.ConditionsSpec{
+				DataDrift: &v1alpha1.DataDriftCondition{ExpectedHash: "abc"},
+			},
+			expectError: true,
+		},
+		{
+			name: "dataDrift condition with unnamed reference",
+			conditions: &v1alpha1.ConditionsSpec{
+				DataDrift: &v1alpha1.DataDriftCondition{Reference: &v1alpha1.DataDriftReference{}},
+			},
+			expectError: true,
+		},
+		{
+			name: "valid ownerChain condition",
+			conditions: &v1alpha1.ConditionsSpec{
+				OwnerChain: &v1alpha1.OwnerChainCondition{RootKind: "CronJob", Depth: 2},
+			},
+			expectError: false,
+		},
+		{
+			name: "ownerChain condition without rootKind",
+			conditions: &v1alpha1.ConditionsSpec{
+				OwnerChain: &v1alpha1.OwnerChainCondition{Depth: 2},
+			},
+			expectError: true,
+		},
+		{
+			name: "ownerChain condition without depth",
+			conditions: &v1alpha1.ConditionsSpec{
+				OwnerChain: &v1alpha1.OwnerChainCondition{RootKind: "CronJob"},
+			},
+			expectError: true,
+		},
+		{
+			name: "ownerChain condition too deep",
+			conditions: &v1alpha1.ConditionsSpec{
+				OwnerChain: &v1alpha1.OwnerChainCondition{RootKind: "CronJob", Depth: 6},
+			},
+			expectError: true,
+		},
+		{
+			name: "valid danglingOwner condition",
+			conditions: &v1alpha1.ConditionsSpec{
+				DanglingOwner: true,
+			},
+			expectError: false,
+		},
+		{
+			name: "danglingOwner with orphansOnly",
+			conditions: &v1alpha1.ConditionsSpec{
+				DanglingOwner: true,
+				OrphansOnly:   true,
+			},
+			expectError: true,
+		},
+		{
+			name: "danglingOwner in anyOf",
+			conditions: &v1alpha1.ConditionsSpec{
+				AnyOf: []v1alpha1.ConditionsSpec{{DanglingOwner: true}},
+			},
+			expectError: true,
+		},
+		{
+			name: "valid stuckTerminating condition",
+			conditions: &v1alpha1.ConditionsSpec{
+				StuckTerminating: &v1alpha1.StuckTerminatingCondition{
+					OlderThanSeconds: int64Ptr(3600),
+					RemoveFinalizers: []string{"example.com/cleanup", "foregroundDeletion"},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "stuckTerminating condition without duration",
+			conditions: &v1alpha1.ConditionsSpec{
+				StuckTerminating: &v1alpha1.StuckTerminatingCondition{},
+			},
+			expectError: true,
+		},
+		{
+			name: "stuckTerminating condition with zero duration",
+			conditions: &v1alpha1.ConditionsSpec{
+				StuckTerminating: &v1alpha1.StuckTerminatingCondition{OlderThanSeconds: int64Ptr(0)},
+			},
+			expectError: true,
+		},
+		{
+			name: "stuckTerminating condition with invalid finalizer",
+			conditions: &v1alpha1.ConditionsSpec{
+				StuckTerminating: &v1alpha1.StuckTerminatingCondition{
+					OlderThanSeconds: int64Ptr(3600),
+					RemoveFinalizers: []string{"not a finalizer"},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "valid array length condition",
+			conditions: &v1alpha1.ConditionsSpec{
+				ArrayLengths: []v1alpha1.ArrayLengthCondition{{FieldPath: "status.conditions", Operator: "GreaterThanOrEqual", Value: 0}},
+			},
+			expectError: false,
+		},
+		{
+			name: "array length condition without fieldPath",
+			conditions: &v1alpha1.ConditionsSpec{
+				ArrayLengths: []v1alpha1.ArrayLengthCondition{{Operator: "Equals", Value: 1}},
+			},
+			expectError: true,
+		},
+		{
+			name: "array length condition without operator",
+			conditions: &v1alpha1.ConditionsSpec{
+				ArrayLengths: []v1alpha1.ArrayLengthCondition{{FieldPath: "spec.rules", Value: 1}},
+			},
+			expectError: true,
+		},
+		{
+			name: "array length condition with unknown operator",
+			conditions: &v1alpha1.ConditionsSpec{
+				ArrayLengths: []v1alpha1.ArrayLengthCondition{{FieldPath: "spec.rules", Operator: "Gt", Value: 1}},
+			},
+			expectError: true,
+		},
+		{
+			name: "array length condition with negative value",
+			conditions: &v1alpha1.ConditionsSpec{
+				ArrayLengths: []v1alpha1.ArrayLengthCondition{{FieldPath: "spec.rules", Operator: "LessThan", Value: -1}},
+			},
+			expectError: true,
+		},
+		{
+			name: "valid semver label condition",
+			conditions: &v1alpha1.ConditionsSpec{
+				Semver: []v1alpha1.SemverCondition{{LabelKey: "app.kubernetes.io/version", Range: "<2.0.0"}},
+			},
+			expectError: false,
+		},
+		{
+			name: "valid semver field condition with compound range",
+			conditions: &v1alpha1.ConditionsSpec{
+				Semver: []v1alpha1.SemverCondition{{FieldPath: "spec.version", Range: ">=1.0.0, <2.0.0"}},
+			},
+			expectError: false,
+		},
+		{
+			name: "semver condition without label or field",
+			conditions: &v1alpha1.ConditionsSpec{
+				Semver: []v1alpha1.SemverCondition{{Range: "<2.0.0"}},
+			},
+			expectError: true,
+		},
+		{
+			name: "semver condition with both label and field",
+			conditions: &v1alpha1.ConditionsSpec{
+				Semver: []v1alpha1.SemverCondition{{LabelKey: "version", FieldPath: "spec.version", Range: "<2.0.0"}},
+			},
+			expectError: true,
+		},
+		{
+			name: "semver condition without range",
+			conditions: &v1alpha1.ConditionsSpec{
+				Semver: []v1alpha1.SemverCondition{{LabelKey: "version"}},
+			},
+			expectError: true,
+		},
+		{
+			name: "semver condition with invalid range",
+			conditions: &v1alpha1.ConditionsSpec{
+				Semver: []v1alpha1.SemverCondition{{LabelKey: "version", Range: "~2.0"}},
+			},
+			expectError: true,
+		},
+		{
+			name: "semver condition with partial version",
+			conditions: &v1alpha1.ConditionsSpec{
+				Semver: []v1alpha1.SemverCondition{{LabelKey: "version", Range: "<2.0"}},
+			},
+			expectError: true,
+		},
+		{
+			name: "semver condition with dangling operator",
+			conditions: &v1alpha1.ConditionsSpec{
+				Semver: []v1alpha1.SemverCondition{{LabelKey: "version", Range: ">=1.0.0 <"}},
+			},
+			expectError: true,
+		},
+		{
+			name: "valid date condition",
+			conditions: &v1alpha1.ConditionsSpec{
+				Dates: []v1alpha1.DateCondition{{FieldPath: "spec.reportDate", Layout: "02/01/2006", Operator: "OlderThan", Reference: "Today", AgeSeconds: int64Ptr(86400), TimeZone: "Europe/Berlin"}},
+			},
+			expectError: false,
+		},
+		{
+			name: "date condition without fieldPath",
+			conditions: &v1alpha1.ConditionsSpec{
+				Dates: []v1alpha1.DateCondition{{Layout: "2006-01-02", Operator: "OlderThan"}},
+			},
+			expectError: true,
+		},
+		{
+			name: "date condition without layout",
+			conditions: &v1alpha1.ConditionsSpec{
+				Dates: []v1alpha1.DateCondition{{FieldPath: "spec.reportDate", Operator: "OlderThan"}},
+			},
+			expectError: true,
+		},
+		{
+			name: "date condition with layout lacking date elements",
+			conditions: &v1alpha1.ConditionsSpec{
+				Dates: []v1alpha1.DateCondition{{FieldPath: "spec.reportDate", Layout: "yyyy-mm-dd", Operator: "OlderThan"}},
+			},
+			expectError: true,
+		},
+		{
+			name: "date condition with invalid operator",
+			conditions: &v1alpha1.ConditionsSpec{
+				Dates: []v1alpha1.DateCondition{{FieldPath: "spec.reportDate", Layout: "2006-01-02", Operator: "Before"}},
+			},
+			expectError: true,
+		},
+		{
+			name: "date condition with invalid reference",
+			conditions: &v1alpha1.ConditionsSpec{
+				Dates: []v1alpha1.DateCondition{{FieldPath: "spec.reportDate", Layout: "2006-01-02", Operator: "NewerThan", Reference: "Tomorrow"}},
+			},
+			expectError: true,
+		},
+		{
+			name: "date condition with negative age",
+			conditions: &v1alpha1.ConditionsSpec{
+				Dates: []v1alpha1.DateCondition{{FieldPath: "spec.reportDate", Layout: "2006-01-02", Operator: "OlderThan", AgeSeconds: int64Ptr(-1)}},
+			},
+			expectError: true,
+		},
+		{
+			name: "date condition with unknown time zone",
+			conditions: &v1alpha1.ConditionsSpec{
+				Dates: []v1alpha1.DateCondition{{FieldPath: "spec.reportDate", Layout: "2006-01-02", Operator: "OlderThan", TimeZone: "Mars/Olympus"}},
+			},
+			expectError: true,
+		},
+		{
+			name: "createdByDefaultServiceAccount with defaults",
+			conditions: &v1alpha1.ConditionsSpec{
+				CreatedByDefaultServiceAccount: &v1alpha1.CreatorCondition{UseManagedFields: true},
+			},
+			expectError: false,
+		},
+		{
+			name: "createdByDefaultServiceAccount with invalid annotation",
+			conditions: &v1alpha1.ConditionsSpec{
+				CreatedByDefaultServiceAccount: &v1alpha1.CreatorCondition{CreatorAnnotation: "not a key"},
+			},
+			expectError: true,
+		},
+		{
+			name: "valid backupGate",
+			conditions: &v1alpha1.ConditionsSpec{
+				BackupGate: &v1alpha1.BackupGateCondition{Namespace: "velero", Name: "backup-status", FieldPath: "data.lastSuccessfulBackup"},
+			},
+			expectError: false,
+		},
+		{
+			name: "backupGate without reference",
+			conditions: &v1alpha1.ConditionsSpec{
+				BackupGate: &v1alpha1.BackupGateCondition{Namespace: "velero", FieldPath: "data.lastSuccessfulBackup"},
+			},
+			expectError: true,
+		},
+		{
+			name: "backupGate with negative cacheTTLSeconds",
+			conditions: &v1alpha1.ConditionsSpec{
+				BackupGate: &v1alpha1.BackupGateCondition{Namespace: "velero", Name: "backup-status", FieldPath: "data.lastSuccessfulBackup", CacheTTLSeconds: int64Ptr(-1)},
+			},
+			expectError: true,
+		},
+		{
+			name: "valid metricThreshold",
+			conditions: &v1alpha1.ConditionsSpec{
+				MetricThreshold: &v1alpha1.MetricThresholdCondition{URL: "http://prometheus.monitoring:9090", Query: "avg(node_memory_usage_ratio)", Operator: "GreaterThan", Value: "0.8"},
+			},
+			expectError: false,
+		},
+		{
+			name: "metricThreshold with invalid url",
+			conditions: &v1alpha1.ConditionsSpec{
+				MetricThreshold: &v1alpha1.MetricThresholdCondition{URL: "prometheus:9090", Query: "up", Operator: "GreaterThan", Value: "0"},
+			},
+			expectError: true,
+		},
+		{
+			name: "metricThreshold without query",
+			conditions: &v1alpha1.ConditionsSpec{
+				MetricThreshold: &v1alpha1.MetricThresholdCondition{URL: "http://prometheus:9090", Operator: "GreaterThan", Value: "0"},
+			},
+			expectError: true,
+		},
+		{
+			name: "metricThreshold with unknown operator",
+			conditions: &v1alpha1.ConditionsSpec{
+				MetricThreshold: &v1alpha1.MetricThresholdCondition{URL: "http://prometheus:9090", Query: "up", Operator: "Equals", Value: "0"},
+			},
+			expectError: true,
+		},
+		{
+			name: "metricThreshold with non-numeric value",
+			conditions: &v1alpha1.ConditionsSpec{
+				MetricThreshold: &v1alpha1.MetricThresholdCondition{URL: "http://prometheus:9090", Query: "up", Operator: "LessThan", Value: "80%"},
+			},
+			expectError: true,
+		},
+		{
+			name: "metricThreshold with negative timeoutSeconds",
+			conditions: &v1alpha1.ConditionsSpec{
+				MetricThreshold: &v1alpha1.MetricThresholdCondition{URL: "http://prometheus:9090", Query: "up", Operator: "LessThan", Value: "1", TimeoutSeconds: int64Ptr(-1)},
+			},
+			expectError: true,
+		},
+		{
+			name: "valid jmespath",
+			conditions: &v1alpha1.ConditionsSpec{
+				JMESPath: "status.containerStatuses[?restartCount > `5`]",
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid jmespath",
+			conditions: &v1alpha1.ConditionsSpec{
+				JMESPath: "status.[",
+			},
+			expectError: true,
+		},
+		{
+			name: "valid query",
+			conditions: &v1alpha1.ConditionsSpec{
+				Query: "status.phase:(Failed OR Unknown) AND NOT metadata.labels.keep:true",
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid query",
+			conditions: &v1alpha1.ConditionsSpec{
+				Query: "status.phase:Failed AND",
+			},
+			expectError: true,
+		},
+		{
+			name: "valid missingLabel",
+			conditions: &v1alpha1.ConditionsSpec{
+				MissingLabel: &v1alpha1.MissingLabelCondition{Key: "example.com/team", OlderThanSeconds: int64Ptr(86400)},
+			},
+			expectError: false,
+		},
+		{
+			name: "missingLabel without key",
+			conditions: &v1alpha1.ConditionsSpec{
+				MissingLabel: &v1alpha1.MissingLabelCondition{OlderThanSeconds: int64Ptr(86400)},
+			},
+			expectError: true,
+		},
+		{
+			name: "missingLabel with invalid key",
+			conditions: &v1alpha1.ConditionsSpec{
+				MissingLabel: &v1alpha1.MissingLabelCondition{Key: "team name", OlderThanSeconds: int64Ptr(86400)},
+			},
+			expectError: true,
+		},
+		{
+			name: "missingLabel without age",
+			conditions: &v1alpha1.ConditionsSpec{
+				MissingLabel: &v1alpha1.MissingLabelCondition{Key: "team"},
+			},
+			expectError: true,
+		},
+		{
+			name: "missingLabel with zero age",
+			conditions: &v1alpha1.ConditionsSpec{
+				MissingLabel: &v1alpha1.MissingLabelCondition{Key: "team", OlderThanSeconds: int64Ptr(0)},
+			},
+			expectError: true,
+		},
+		{
+			name: "numeric label comparison",
+			conditions: &v1alpha1.ConditionsSpec{
+				HasLabels: []v1alpha1.LabelCondition{{Key: "revision", Operator: "LessThan", Value: "37"}},
+			},
+			expectError: false,
+		},
+		{
+			name: "non-numeric label comparison value",
+			conditions: &v1alpha1.ConditionsSpec{
+				HasLabels: []v1alpha1.LabelCondition{{Key: "revision", Operator: "GreaterThanOrEqual", Value: "latest"}},
+			},
+			expectError: true,
+		},
+		{
+			name: "non-numeric label comparison value in anyOf",
+			conditions: &v1alpha1.ConditionsSpec{
+				AnyOf: []v1alpha1.ConditionsSpec{{HasLabels: []v1alpha1.LabelCondition{{Key: "revision", Operator: "LessThan", Value: "Inf"}}}},
+			},
+			expectError: true,
+		},
+		{
+			name: "valid unchanged",
+			conditions: &v1alpha1.ConditionsSpec{
+				Unchanged: &v1alpha1.UnchangedCondition{ForSeconds: int64Ptr(3600)},
+			},
+			expectError: false,
+		},
+		{
+			name: "valid unchanged with fieldPath",
+			conditions: &v1alpha1.ConditionsSpec{
+				Unchanged: &v1alpha1.UnchangedCondition{ForSeconds: int64Ptr(3600), FieldPath: "status.lastUpdateTime"},
+			},
+			expectError: false,
+		},
+		{
+			name: "unchanged without duration",
+			conditions: &v1alpha1.ConditionsSpec{
+				Unchanged: &v1alpha1.UnchangedCondition{},
+			},
+			expectError: true,
+		},
+		{
+			name: "unchanged with zero duration",
+			conditions: &v1alpha1.ConditionsSpec{
+				Unchanged: &v1alpha1.UnchangedCondition{ForSeconds: int64Ptr(0)},
+			},
+			expectError: true,
+		},
+		{
+			name: "unchanged with malformed fieldPath",
+			conditions: &v1alpha1.ConditionsSpec{
+				Unchanged: &v1alpha1.UnchangedCondition{ForSeconds: int64Ptr(3600), FieldPath: "status..lastUpdateTime"},
+			},
+			expectError: true,
+		},
+		{
+			name: "numeric comparison value",
+			conditions: &v1alpha1.ConditionsSpec{
+				And: []v1alpha1.FieldCondition{{FieldPath: "spec.replicas", Operator: "LessThanOrEqual", Value: "0"}},
+			},
+			expectError: false,
+		},
+		{
+			name: "timestamp comparison value",
+			conditions: &v1alpha1.ConditionsSpec{
+				Or: []v1alpha1.FieldCondition{{FieldPath: "status.completionTime", Operator: "LessThan", Value: "2026-01-01T00:00:00Z"}},
+			},
+			expectError: false,
+		},
+		{
+			name: "non-numeric comparison value",
+			conditions: &v1alpha1.ConditionsSpec{
+				And: []v1alpha1.FieldCondition{{FieldPath: "spec.replicas", Operator: "GreaterThan", Value: "many"}},
+			},
+			expectError: true,
+		},
+		{
+			name: "non-numeric comparison value in anyOf",
+			conditions: &v1alpha1.ConditionsSpec{
+				AnyOf: []v1alpha1.ConditionsSpec{{Or: []v1alpha1.FieldCondition{{FieldPath: "spec.replicas", Operator: "LessThan", Value: "NaN"}}}},
+			},
+			expectError: true,
+		},
+		{
+			name: "array index fieldPath",
+			conditions: &v1alpha1.ConditionsSpec{
+				And: []v1alpha1.FieldCondition{{FieldPath: "status.conditions[0].status", Operator: "Equals", Value: "True"}},
+			},
+			expectError: false,
+		},
+		{
+			name: "malformed fieldPath",
+			conditions: &v1alpha1.ConditionsSpec{
+				And: []v1alpha1.FieldCondition{{FieldPath: "status.conditions[x].status", Operator: "Equals", Value: "True"}},
+			},
+			expectError: true,
+		},
+		{
+			name: "string operator value is not parsed",
+			conditions: &v1alpha1.ConditionsSpec{
+				And: []v1alpha1.FieldCondition{{FieldPath: "spec.name", Operator: "Equals", Value: "many"}},
+			},
+			expectError: false,
+		},
+		{
+			name: "valid nested anyOf",
+			conditions: &v1alpha1.ConditionsSpec{
+				Phase: []string{"Succeeded"},
+				AnyOf: []v1alpha1.ConditionsSpec{
+					{Or: []v1alpha1.FieldCondition{{FieldPath: "spec.nodeName", Operator: "Exists"}}},
+					{AnyOf: []v1alpha1.ConditionsSpec{{Phase: []string{"Failed"}}}},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name:        "anyOf at maximum depth",
+			conditions:  nestedAnyOf(MaxConditionsNestingDepth),
+			expectError: false,
+		},
+		{
+			name:        "anyOf nested too deeply",
+			conditions:  nestedAnyOf(MaxConditionsNestingDepth + 1),
+			expectError: true,
+		},
+		{
+			name: "anyOf with invalid nested condition",
+			conditions: &v1alpha1.ConditionsSpec{
+				AnyOf: []v1alpha1.ConditionsSpec{{JMESPath: "status.["}},
+			},
+			expectError: true,
+		},
+		{
+			name: "anyOf with top-level-only condition",
+			conditions: &v1alpha1.ConditionsSpec{
+				AnyOf: []v1alpha1.ConditionsSpec{{
+					MetricThreshold: &v1alpha1.MetricThresholdCondition{URL: "http://prometheus:9090", Query: "up", Operator: "LessThan", Value: "1"},
+				}},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateConditions(tt.conditions)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("validateConditions() expected error but got none")
+				}
+			} else {
+				if err != nil {
+					t.Errorf("validateConditions() returned error: %v", err)
+				}
+			}
+		})
+	}
+}
+
+// nestedAnyOf returns conditions whose anyOf groups nest to the given depth, counting the top level.
+func nestedAnyOf(depth int) *v1alpha1.ConditionsSpec {
+	conditions := &v1alpha1.ConditionsSpec{Phase: []string{"Failed"}}
+	for i := 1; i < depth; i++ {
+		conditions = &v1alpha1.ConditionsSpec{AnyOf: []v1alpha1.ConditionsSpec{*conditions}}
+	}
+	return conditions
+}
+
+func TestValidatePolicy_Comprehensive(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      *v1alpha1.GarbageCollectionPolicy
+		expectError bool
+	}{
 		{
 			name: "valid policy with all fields",
 			policy: &v1alpha1.GarbageCollectionPolicy{
@@ -356,6 +1657,30 @@ func TestValidatePolicy_Comprehensive(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "valid missingFields",
+			policy: &v1alpha1.GarbageCollectionPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Namespace: "default"},
+				Spec: v1alpha1.GarbageCollectionPolicySpec{
+					TargetResource: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap"},
+					TTL:            v1alpha1.TTLSpec{FieldPath: "spec.ttlSeconds"},
+					MissingFields:  "Default",
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid missingFields",
+			policy: &v1alpha1.GarbageCollectionPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Namespace: "default"},
+				Spec: v1alpha1.GarbageCollectionPolicySpec{
+					TargetResource: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap"},
+					TTL:            v1alpha1.TTLSpec{FieldPath: "spec.ttlSeconds"},
+					MissingFields:  "Ignore",
+				},
+			},
+			expectError: true,
+		},
 		{
 			name: "valid policy with relative TTL",
 			policy: &v1alpha1.GarbageCollectionPolicy{
@@ -418,4 +1743,117 @@ func TestValidatePolicy_Comprehensive(t *testing.T) {
 	}
 }
 
+func TestValidateDedup(t *testing.T) {
+	tests := []struct {
+		name        string
+		dedup       *v1alpha1.DedupSpec
+		expectError bool
+	}{
+		{name: "label key", dedup: &v1alpha1.DedupSpec{KeyLabels: []string{"app"}}, expectError: false},
+		{name: "field key with cluster scope", dedup: &v1alpha1.DedupSpec{KeyFields: []string{"spec.image"}, Scope: "Cluster"}, expectError: false},
+		{name: "no key", dedup: &v1alpha1.DedupSpec{Scope: "Cluster"}, expectError: true},
+		{name: "empty key field", dedup: &v1alpha1.DedupSpec{KeyFields: []string{" "}}, expectError: true},
+		{name: "invalid key label", dedup: &v1alpha1.DedupSpec{KeyLabels: []string{"not a key"}}, expectError: true},
+		{name: "invalid scope", dedup: &v1alpha1.DedupSpec{KeyLabels: []string{"app"}, Scope: "Global"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDedup(tt.dedup)
+			if tt.expectError && err == nil {
+				t.Errorf("validateDedup() expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("validateDedup() returned error: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateRetention(t *testing.T) {
+	tests := []struct {
+		name        string
+		retention   *v1alpha1.RetentionSpec
+		expectError bool
+	}{
+		{name: "keep last without groupBy", retention: &v1alpha1.RetentionSpec{KeepLast: 3}, expectError: false},
+		{name: "group by label", retention: &v1alpha1.RetentionSpec{KeepLast: 1, GroupBy: []string{"metadata.labels['app']"}}, expectError: false},
+		{name: "group by owner", retention: &v1alpha1.RetentionSpec{KeepLast: 1, GroupBy: []string{"metadata.ownerReferences[0].uid"}}, expectError: false},
+		{name: "zero keep last", retention: &v1alpha1.RetentionSpec{KeepLast: 0}, expectError: true},
+		{name: "invalid groupBy path", retention: &v1alpha1.RetentionSpec{KeepLast: 1, GroupBy: []string{"metadata.labels["}}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRetention(tt.retention)
+			if tt.expectError && err == nil {
+				t.Errorf("validateRetention() expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("validateRetention() returned error: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateSchedule(t *testing.T) {
+	tests := []struct {
+		name        string
+		schedule    *v1alpha1.ScheduleSpec
+		expectError bool
+	}{
+		{name: "activeAfter only", schedule: &v1alpha1.ScheduleSpec{ActiveAfter: &metav1.Time{}}, expectError: false},
+		{name: "windows in a time zone", schedule: &v1alpha1.ScheduleSpec{ActiveWindows: []string{"Mon-Fri 22:00-06:00", "Sat,Sun 00:00-24:00"}, TimeZone: "Europe/Berlin"}, expectError: false},
+		{name: "invalid window", schedule: &v1alpha1.ScheduleSpec{ActiveWindows: []string{"Mon-Fri 22:00-06:00", "weekends"}}, expectError: true},
+		{name: "unknown time zone", schedule: &v1alpha1.ScheduleSpec{ActiveWindows: []string{"* 01:00-05:00"}, TimeZone: "Mars/Olympus"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSchedule(tt.schedule)
+			if tt.expectError && err == nil {
+				t.Errorf("validateSchedule() expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("validateSchedule() returned error: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateNamespacesPerRun(t *testing.T) {
+	clusterDedup := &v1alpha1.DedupSpec{KeyLabels: []string{"app"}, Scope: "Cluster"}
+	tests := []struct {
+		name        string
+		namespace   string
+		dedup       *v1alpha1.DedupSpec
+		perRun      int
+		expectError bool
+	}{
+		{name: "unset on a namespaced target", namespace: "default", perRun: 0, expectError: false},
+		{name: "all namespaces", namespace: "*", perRun: 10, expectError: false},
+		{name: "empty namespace", namespace: "", perRun: 10, expectError: false},
+		{name: "single namespace", namespace: "default", perRun: 10, expectError: true},
+		{name: "namespace-scoped dedup", namespace: "*", dedup: &v1alpha1.DedupSpec{KeyLabels: []string{"app"}}, perRun: 10, expectError: false},
+		{name: "cluster-scoped dedup", namespace: "*", dedup: clusterDedup, perRun: 10, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := &v1alpha1.GarbageCollectionPolicySpec{
+				TargetResource: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap", Namespace: tt.namespace},
+				Dedup:          tt.dedup,
+				Behavior:       v1alpha1.BehaviorSpec{NamespacesPerRun: tt.perRun},
+			}
+			err := validateNamespacesPerRun(spec)
+			if tt.expectError && err == nil {
+				t.Errorf("validateNamespacesPerRun() expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("validateNamespacesPerRun() returned error: %v", err)
+			}
+		})
+	}
+}
+
 // int64Ptr helper is defined in validator_test.go (same package)
@@ -137,3 +137,7 @@ func TestValidatePolicy(t *testing.T) {
 func int64Ptr(i int64) *int64 {
 	return &i
 }
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrWebhookCertUnavailable indicates the webhook's TLS certificate or key file is missing
+	// or cannot be parsed.
+	ErrWebhookCertUnavailable = errors.New("webhook TLS certificate unavailable")
+
+	// ErrWebhookCertExpired indicates the webhook's TLS certificate is outside its validity period.
+	ErrWebhookCertExpired = errors.New("webhook TLS certificate is not valid at the current time")
+)
+
+// certReadiness checks the webhook's TLS certificate files for readiness. The certificate is
+// parsed once and again only when either file changes, so each probe is a stat and a clock
+// comparison.
+type certReadiness struct {
+	certFile string
+	keyFile  string
+	now      func() time.Time
+
+	mu         sync.Mutex
+	certStat   os.FileInfo
+	keyStat    os.FileInfo
+	notBefore  time.Time
+	notAfter   time.Time
+	parseError error
+}
+
+// newCertReadiness creates a certReadiness for a certificate and key file pair.
+func newCertReadiness(certFile, keyFile string) *certReadiness {
+	return &certReadiness{certFile: certFile, keyFile: keyFile, now: time.Now}
+}
+
+// Check returns an error wrapping ErrWebhookCertUnavailable if either file is missing or the
+// pair cannot be parsed, or ErrWebhookCertExpired if the certificate is not currently valid.
+func (c *certReadiness) Check() error {
+	certStat, err := os.Stat(c.certFile)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrWebhookCertUnavailable, err)
+	}
+	keyStat, err := os.Stat(c.keyFile)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrWebhookCertUnavailable, err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !sameFile(c.certStat, certStat) || !sameFile(c.keyStat, keyStat) {
+		c.certStat, c.keyStat = certStat, keyStat
+		c.notBefore, c.notAfter, c.parseError = parseCertValidity(c.certFile, c.keyFile)
+	}
+	if c.parseError != nil {
+		return c.parseError
+	}
+	if now := c.now(); now.Before(c.notBefore) || now.After(c.notAfter) {
+		return fmt.Errorf("%w: valid from %s to %s", ErrWebhookCertExpired, c.notBefore.Format(time.RFC3339), c.notAfter.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// sameFile reports whether a file is unchanged since it was last parsed.
+func sameFile(parsed, current os.FileInfo) bool {
+	return parsed != nil && parsed.ModTime().Equal(current.ModTime()) && parsed.Size() == current.Size()
+}
+
+// parseCertValidity loads a certificate and key pair, as the TLS listener does, and returns
+// the validity period of its leaf certificate.
+func parseCertValidity(certFile, keyFile string) (time.Time, time.Time, error) {
+	pair, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: %w", ErrWebhookCertUnavailable, err)
+	}
+	leaf, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: %w", ErrWebhookCertUnavailable, err)
+	}
+	return leaf.NotBefore, leaf.NotAfter, nil
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeTestCert writes a self-signed certificate valid from notBefore to notAfter, and its
+// key, to dir, returning their paths.
+func writeTestCert(t *testing.T, dir string, notBefore, notAfter time.Time) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "gc-webhook.test"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		DNSNames:     []string{"gc-webhook.test"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("Failed to marshal key: %v", err)
+	}
+
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("Failed to write certificate: %v", err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatalf("Failed to write key: %v", err)
+	}
+	return certFile, keyFile
+}
+
+func TestCertReadiness_Check(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T, dir string) (string, string)
+		wantErr error
+	}{
+		{
+			name: "valid certificate",
+			setup: func(t *testing.T, dir string) (string, string) {
+				return writeTestCert(t, dir, now.Add(-time.Hour), now.Add(time.Hour))
+			},
+		},
+		{
+			name: "expired certificate",
+			setup: func(t *testing.T, dir string) (string, string) {
+				return writeTestCert(t, dir, now.Add(-2*time.Hour), now.Add(-time.Hour))
+			},
+			wantErr: ErrWebhookCertExpired,
+		},
+		{
+			name: "missing certificate",
+			setup: func(t *testing.T, dir string) (string, string) {
+				certFile, keyFile := writeTestCert(t, dir, now.Add(-time.Hour), now.Add(time.Hour))
+				if err := os.Remove(certFile); err != nil {
+					t.Fatalf("Failed to remove certificate: %v", err)
+				}
+				return certFile, keyFile
+			},
+			wantErr: ErrWebhookCertUnavailable,
+		},
+		{
+			name: "unparseable certificate",
+			setup: func(t *testing.T, dir string) (string, string) {
+				certFile, keyFile := writeTestCert(t, dir, now.Add(-time.Hour), now.Add(time.Hour))
+				if err := os.WriteFile(certFile, []byte("not a certificate"), 0o600); err != nil {
+					t.Fatalf("Failed to write certificate: %v", err)
+				}
+				return certFile, keyFile
+			},
+			wantErr: ErrWebhookCertUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			certFile, keyFile := tt.setup(t, t.TempDir())
+			err := newCertReadiness(certFile, keyFile).Check()
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("Check() returned error: %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("Check() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCertReadiness_RechecksOnEachProbe(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+	certFile, keyFile := writeTestCert(t, dir, now.Add(-time.Hour), now.Add(time.Hour))
+	readiness := newCertReadiness(certFile, keyFile)
+	if err := readiness.Check(); err != nil {
+		t.Fatalf("Check() returned error: %v", err)
+	}
+
+	// The parsed certificate expires without the files changing
+	readiness.now = func() time.Time { return now.Add(2 * time.Hour) }
+	if err := readiness.Check(); !errors.Is(err, ErrWebhookCertExpired) {
+		t.Errorf("Check() after expiry = %v, want %v", err, ErrWebhookCertExpired)
+	}
+
+	// A rotated certificate is parsed again
+	writeTestCert(t, dir, now.Add(time.Hour), now.Add(3*time.Hour))
+	later := now.Add(time.Minute)
+	for _, file := range []string{certFile, keyFile} {
+		if err := os.Chtimes(file, later, later); err != nil {
+			t.Fatalf("Failed to touch %s: %v", file, err)
+		}
+	}
+	if err := readiness.Check(); err != nil {
+		t.Errorf("Check() after rotation returned error: %v", err)
+	}
+}
+
+func TestWebhookServer_handleReadyz(t *testing.T) {
+	now := time.Now()
+	validCert, validKey := writeTestCert(t, t.TempDir(), now.Add(-time.Hour), now.Add(time.Hour))
+	expiredCert, expiredKey := writeTestCert(t, t.TempDir(), now.Add(-2*time.Hour), now.Add(-time.Hour))
+
+	tests := []struct {
+		name     string
+		certFile string
+		keyFile  string
+		wantCode int
+	}{
+		{name: "valid certificate", certFile: validCert, keyFile: validKey, wantCode: http.StatusOK},
+		{name: "expired certificate", certFile: expiredCert, keyFile: expiredKey, wantCode: http.StatusServiceUnavailable},
+		{name: "no certificate configured", wantCode: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, err := NewWebhookServer(":0", tt.certFile, tt.keyFile)
+			if err != nil {
+				t.Fatalf("NewWebhookServer() returned error: %v", err)
+			}
+			w := httptest.NewRecorder()
+			server.server.Handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+			if w.Code != tt.wantCode {
+				t.Errorf("GET /readyz = %d (%s), want %d", w.Code, w.Body.String(), tt.wantCode)
+			}
+		})
+	}
+}
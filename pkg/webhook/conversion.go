@@ -0,0 +1,143 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+var (
+	// ErrConversionRequestMissing indicates a ConversionReview without a request.
+	ErrConversionRequestMissing = errors.New("conversion review has no request")
+
+	// ErrUnsupportedConversionVersion indicates a conversion from or to a version that is not served.
+	ErrUnsupportedConversionVersion = errors.New("unsupported GarbageCollectionPolicy version")
+)
+
+// conversionVersions returns a new, empty policy of each served apiVersion. Every version
+// converts through the hub, so adding a version means adding it here and implementing its
+// ConvertTo and ConvertFrom methods against the hub.
+var conversionVersions = map[string]func() conversion.Convertible{
+	v1alpha1.SchemeGroupVersion.String(): func() conversion.Convertible { return &v1alpha1.GarbageCollectionPolicy{} },
+}
+
+// newConversionHub returns an empty policy of the hub version.
+func newConversionHub() conversion.Hub {
+	return &v1alpha1.GarbageCollectionPolicy{}
+}
+
+// handleConvert handles apiextensions.k8s.io/v1 ConversionReview requests, converting
+// GarbageCollectionPolicies between served versions for the API server.
+func (ws *WebhookServer) handleConvert(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	logger := sdklog.NewLogger("zen-gc-webhook")
+	var review apiextensionsv1.ConversionReview
+	if err := json.NewDecoder(r.Body).Decode(&review); err != nil {
+		logger.Error(err, "Failed to decode conversion review")
+		http.Error(w, fmt.Sprintf("Failed to decode request: %v", err), http.StatusBadRequest)
+		return
+	}
+	if review.Request == nil {
+		http.Error(w, ErrConversionRequestMissing.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := apiextensionsv1.ConversionReview{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "apiextensions.k8s.io/v1",
+			Kind:       "ConversionReview",
+		},
+		Response: &apiextensionsv1.ConversionResponse{
+			UID: review.Request.UID,
+		},
+	}
+
+	converted, err := convertObjects(review.Request.Objects, review.Request.DesiredAPIVersion)
+	if err != nil {
+		logger.Debug("Policy conversion failed", sdklog.String("desiredAPIVersion", review.Request.DesiredAPIVersion), sdklog.String("error", err.Error()))
+		response.Response.Result = metav1.Status{
+			Status:  metav1.StatusFailure,
+			Message: err.Error(),
+		}
+	} else {
+		response.Response.ConvertedObjects = converted
+		response.Response.Result = metav1.Status{Status: metav1.StatusSuccess}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Error(err, "Failed to encode conversion review response")
+		http.Error(w, fmt.Sprintf("Failed to encode response: %v", err), http.StatusInternalServerError)
+		return
+	}
+}
+
+// convertObjects converts each object to the desired apiVersion. The API server expects
+// all objects converted or none, so the first failure fails the whole request.
+func convertObjects(objects []runtime.RawExtension, desiredAPIVersion string) ([]runtime.RawExtension, error) {
+	newDesired, ok := conversionVersions[desiredAPIVersion]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedConversionVersion, desiredAPIVersion)
+	}
+
+	converted := make([]runtime.RawExtension, 0, len(objects))
+	for i := range objects {
+		var typeMeta metav1.TypeMeta
+		if err := json.Unmarshal(objects[i].Raw, &typeMeta); err != nil {
+			return nil, fmt.Errorf("failed to decode object %d: %w", i, err)
+		}
+		newSrc, ok := conversionVersions[typeMeta.APIVersion]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrUnsupportedConversionVersion, typeMeta.APIVersion)
+		}
+
+		src := newSrc()
+		if err := json.Unmarshal(objects[i].Raw, src); err != nil {
+			return nil, fmt.Errorf("failed to decode %s object %d: %w", typeMeta.APIVersion, i, err)
+		}
+		hub := newConversionHub()
+		if err := src.ConvertTo(hub); err != nil {
+			return nil, fmt.Errorf("failed to convert object %d from %s: %w", i, typeMeta.APIVersion, err)
+		}
+		dst := newDesired()
+		if err := dst.ConvertFrom(hub); err != nil {
+			return nil, fmt.Errorf("failed to convert object %d to %s: %w", i, desiredAPIVersion, err)
+		}
+
+		raw, err := json.Marshal(dst)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode object %d: %w", i, err)
+		}
+		converted = append(converted, runtime.RawExtension{Raw: raw})
+	}
+	return converted, nil
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func conversionTestPolicy() *v1alpha1.GarbageCollectionPolicy {
+	seconds := int64(3600)
+	return &v1alpha1.GarbageCollectionPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			Kind:       "GarbageCollectionPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "cleanup-temp-configmaps",
+			Namespace:   "default",
+			Labels:      map[string]string{"team": "platform"},
+			Annotations: map[string]string{"gc.kube-zen.io/owner": "sre"},
+			Generation:  3,
+		},
+		Spec: v1alpha1.GarbageCollectionPolicySpec{
+			TargetResource: v1alpha1.TargetResourceSpec{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+				Namespace:  "default",
+				LabelSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"temp": "true"},
+				},
+			},
+			TTL: v1alpha1.TTLSpec{SecondsAfterCreation: &seconds},
+			Conditions: &v1alpha1.ConditionsSpec{
+				And: []v1alpha1.FieldCondition{{FieldPath: "status.conditions[0].status", Operator: "Equals", Value: "False"}},
+			},
+			Behavior: v1alpha1.BehaviorSpec{DryRun: true, BatchSize: 10},
+		},
+		Status: v1alpha1.GarbageCollectionPolicyStatus{
+			Phase:            "Active",
+			ResourcesMatched: 5,
+		},
+	}
+}
+
+func TestGarbageCollectionPolicy_ConvertRoundTrip(t *testing.T) {
+	original := conversionTestPolicy()
+
+	hub := &v1alpha1.GarbageCollectionPolicy{}
+	if err := original.DeepCopy().ConvertTo(hub); err != nil {
+		t.Fatalf("ConvertTo() returned error: %v", err)
+	}
+	roundTripped := &v1alpha1.GarbageCollectionPolicy{}
+	if err := roundTripped.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom() returned error: %v", err)
+	}
+
+	if !equality.Semantic.DeepEqual(original, roundTripped) {
+		t.Errorf("Expected round trip through the hub to preserve the policy, got %+v", roundTripped)
+	}
+}
+
+func postConversionReview(t *testing.T, server *WebhookServer, review apiextensionsv1.ConversionReview) *apiextensionsv1.ConversionReview {
+	t.Helper()
+	body, err := json.Marshal(review)
+	if err != nil {
+		t.Fatalf("Failed to marshal conversion review: %v", err)
+	}
+	req := httptest.NewRequest(http.MethodPost, "/convert", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	server.server.Handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	var response apiextensionsv1.ConversionReview
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Failed to decode conversion response: %v", err)
+	}
+	if response.Response == nil {
+		t.Fatal("Expected a conversion response")
+	}
+	return &response
+}
+
+func TestWebhookServer_handleConvert(t *testing.T) {
+	server, err := NewWebhookServer(":0", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create webhook server: %v", err)
+	}
+
+	original := conversionTestPolicy()
+	raw, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("Failed to marshal policy: %v", err)
+	}
+
+	response := postConversionReview(t, server, apiextensionsv1.ConversionReview{
+		Request: &apiextensionsv1.ConversionRequest{
+			UID:               "convert-uid",
+			DesiredAPIVersion: v1alpha1.SchemeGroupVersion.String(),
+			Objects:           []runtime.RawExtension{{Raw: raw}, {Raw: raw}},
+		},
+	})
+
+	if response.Response.UID != "convert-uid" {
+		t.Errorf("Expected response UID convert-uid, got %q", response.Response.UID)
+	}
+	if response.Response.Result.Status != metav1.StatusSuccess {
+		t.Fatalf("Expected conversion to succeed, got %+v", response.Response.Result)
+	}
+	if len(response.Response.ConvertedObjects) != 2 {
+		t.Fatalf("Expected 2 converted objects, got %d", len(response.Response.ConvertedObjects))
+	}
+	for i, object := range response.Response.ConvertedObjects {
+		converted := &v1alpha1.GarbageCollectionPolicy{}
+		if err := json.Unmarshal(object.Raw, converted); err != nil {
+			t.Fatalf("Failed to decode converted object %d: %v", i, err)
+		}
+		if !equality.Semantic.DeepEqual(original, converted) {
+			t.Errorf("Expected converted object %d to equal the original, got %+v", i, converted)
+		}
+	}
+}
+
+func TestWebhookServer_handleConvert_UnsupportedVersion(t *testing.T) {
+	server, err := NewWebhookServer(":0", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create webhook server: %v", err)
+	}
+
+	raw, err := json.Marshal(conversionTestPolicy())
+	if err != nil {
+		t.Fatalf("Failed to marshal policy: %v", err)
+	}
+	otherVersion := []byte(`{"apiVersion":"gc.kube-zen.io/v2","kind":"GarbageCollectionPolicy","metadata":{"name":"p"}}`)
+
+	tests := []struct {
+		name    string
+		desired string
+		objects []runtime.RawExtension
+	}{
+		{name: "unknown desired version", desired: "gc.kube-zen.io/v1beta1", objects: []runtime.RawExtension{{Raw: raw}}},
+		{name: "unknown source version", desired: v1alpha1.SchemeGroupVersion.String(), objects: []runtime.RawExtension{{Raw: otherVersion}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := postConversionReview(t, server, apiextensionsv1.ConversionReview{
+				Request: &apiextensionsv1.ConversionRequest{UID: "uid", DesiredAPIVersion: tt.desired, Objects: tt.objects},
+			})
+			if response.Response.Result.Status != metav1.StatusFailure {
+				t.Errorf("Expected conversion to fail, got %+v", response.Response.Result)
+			}
+			if len(response.Response.ConvertedObjects) != 0 {
+				t.Errorf("Expected no converted objects on failure, got %d", len(response.Response.ConvertedObjects))
+			}
+		})
+	}
+}
+
+func TestWebhookServer_handleConvert_InvalidRequests(t *testing.T) {
+	server, err := NewWebhookServer(":0", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create webhook server: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		method       string
+		body         string
+		expectedCode int
+	}{
+		{name: "wrong method", method: http.MethodGet, body: "", expectedCode: http.StatusMethodNotAllowed},
+		{name: "invalid JSON", method: http.MethodPost, body: "{", expectedCode: http.StatusBadRequest},
+		{name: "missing request", method: http.MethodPost, body: `{"apiVersion":"apiextensions.k8s.io/v1","kind":"ConversionReview"}`, expectedCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/convert", bytes.NewReader([]byte(tt.body)))
+			w := httptest.NewRecorder()
+			server.server.Handler.ServeHTTP(w, req)
+			if w.Code != tt.expectedCode {
+				t.Errorf("Expected status %d, got %d", tt.expectedCode, w.Code)
+			}
+		})
+	}
+}
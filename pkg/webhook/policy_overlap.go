@@ -0,0 +1,288 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
+)
+
+// ErrPolicyOverlap indicates a policy targets resources an existing policy also targets, so
+// which of them acts on a resource matched by both depends on evaluation order.
+var ErrPolicyOverlap = errors.New("policy targets resources another policy also targets")
+
+// policyOverlapListTimeout bounds listing the existing policies during an admission.
+const policyOverlapListTimeout = 5 * time.Second
+
+// checkPolicyOverlap compares the policy's target with those of the existing policies. An
+// overlap is a warning, or denies the policy if enforced, unless the policy carries the
+// allow-overlap annotation. Listing failures never deny.
+func (ws *WebhookServer) checkPolicyOverlap(policy *v1alpha1.GarbageCollectionPolicy) ([]string, error) {
+	if ws.policyReader == nil || policy.Annotations[v1alpha1.AllowOverlapAnnotation] == "true" {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), policyOverlapListTimeout)
+	defer cancel()
+	var existing v1alpha1.GarbageCollectionPolicyList
+	if err := ws.policyReader.List(ctx, &existing); err != nil {
+		sdklog.NewLogger("zen-gc-webhook").Warn("Could not list policies to check for overlapping targets", sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Error(err))
+		return nil, nil
+	}
+
+	overlapping := overlappingPolicies(policy, existing.Items)
+	if len(overlapping) == 0 {
+		return nil, nil
+	}
+	if ws.denyPolicyOverlap {
+		names := make([]string, 0, len(overlapping))
+		for _, other := range overlapping {
+			names = append(names, other.Namespace+"/"+other.Name)
+		}
+		return nil, fmt.Errorf("%w: %s (set the %s annotation to \"true\" to allow it)", ErrPolicyOverlap, strings.Join(names, ", "), v1alpha1.AllowOverlapAnnotation)
+	}
+	warnings := make([]string, 0, len(overlapping))
+	for _, other := range overlapping {
+		warnings = append(warnings, overlapWarning(policy, other))
+	}
+	return warnings, nil
+}
+
+// overlapWarning describes an overlap with another policy, noting a differing dryRun as
+// the other policy may then delete what this one only reports, or the reverse.
+func overlapWarning(policy, other *v1alpha1.GarbageCollectionPolicy) string {
+	msg := fmt.Sprintf("policy %s/%s also targets some of these resources", other.Namespace, other.Name)
+	if other.Spec.Behavior.DryRun != policy.Spec.Behavior.DryRun {
+		msg += fmt.Sprintf(" with dryRun=%t", other.Spec.Behavior.DryRun)
+	}
+	return msg + "; which policy acts on a resource matched by both is undefined"
+}
+
+// overlappingPolicies returns the existing policies, other than the policy itself and
+// policies being deleted, whose targets may match a resource the policy's target matches.
+func overlappingPolicies(policy *v1alpha1.GarbageCollectionPolicy, existing []v1alpha1.GarbageCollectionPolicy) []*v1alpha1.GarbageCollectionPolicy {
+	var overlapping []*v1alpha1.GarbageCollectionPolicy
+	for i := range existing {
+		other := &existing[i]
+		if (other.Namespace == policy.Namespace && other.Name == policy.Name) || other.DeletionTimestamp != nil {
+			continue
+		}
+		if targetsOverlap(&policy.Spec.TargetResource, &other.Spec.TargetResource) {
+			overlapping = append(overlapping, other)
+		}
+	}
+	slices.SortFunc(overlapping, func(a, b *v1alpha1.GarbageCollectionPolicy) int {
+		return strings.Compare(a.Namespace+"/"+a.Name, b.Namespace+"/"+b.Name)
+	})
+	return overlapping
+}
+
+// targetsOverlap reports whether two targets may match a common resource. Targets are only
+// reported disjoint when provably so: different kinds, namespaces, UIDs or field values, or
+// label selectors no set of labels satisfies together. An empty namespace counts as all
+// namespaces, as whether it resolves to the policy's own depends on the kind's scope.
+func targetsOverlap(a, b *v1alpha1.TargetResourceSpec) bool {
+	return sameGroupKind(a, b) &&
+		namespacesOverlap(a, b) &&
+		uidsOverlap(a.UIDs, b.UIDs) &&
+		fieldSelectorsOverlap(a.FieldSelector, b.FieldSelector) &&
+		labelSelectorsOverlap(a, b)
+}
+
+// sameGroupKind reports whether two targets name the same kind, in any version of its group.
+func sameGroupKind(a, b *v1alpha1.TargetResourceSpec) bool {
+	if a.Kind != b.Kind {
+		return false
+	}
+	gvA, errA := schema.ParseGroupVersion(a.APIVersion)
+	gvB, errB := schema.ParseGroupVersion(b.APIVersion)
+	if errA != nil || errB != nil {
+		return a.APIVersion == b.APIVersion
+	}
+	return gvA.Group == gvB.Group
+}
+
+// namespacesOverlap reports whether two targets may share a namespace. Exclusions are only
+// weighed against a specific namespace.
+func namespacesOverlap(a, b *v1alpha1.TargetResourceSpec) bool {
+	nsA, nsB := specificNamespace(a.Namespace), specificNamespace(b.Namespace)
+	if nsA != "" && nsB != "" && nsA != nsB {
+		return false
+	}
+	namespace := nsA
+	if namespace == "" {
+		namespace = nsB
+	}
+	return namespace == "" || (!namespaceExcluded(namespace, a.ExcludeNamespaces) && !namespaceExcluded(namespace, b.ExcludeNamespaces))
+}
+
+// specificNamespace returns the target namespace, or "" if it covers all namespaces.
+func specificNamespace(namespace string) string {
+	if namespace == "*" {
+		return ""
+	}
+	return namespace
+}
+
+// namespaceExcluded reports whether a namespace matches any of the names or globs.
+func namespaceExcluded(namespace string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if matched, err := path.Match(pattern, namespace); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// uidsOverlap reports whether two UID restrictions admit a common UID. Empty means no
+// restriction.
+func uidsOverlap(a, b []string) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return true
+	}
+	for _, uid := range a {
+		if slices.Contains(b, uid) {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldSelectorsOverlap reports whether two field selectors admit a common resource, i.e.
+// do not require different values of the same field.
+func fieldSelectorsOverlap(a, b *v1alpha1.FieldSelectorSpec) bool {
+	if a == nil || b == nil {
+		return true
+	}
+	for field, value := range a.MatchFields {
+		if other, ok := b.MatchFields[field]; ok && other != value {
+			return false
+		}
+	}
+	return true
+}
+
+// labelSelectorsOverlap reports whether some set of labels satisfies the label selectors of
+// both targets.
+func labelSelectorsOverlap(a, b *v1alpha1.TargetResourceSpec) bool {
+	for _, requirementsA := range selectorAlternatives(a) {
+		for _, requirementsB := range selectorAlternatives(b) {
+			if requirementsSatisfiable(append(slices.Clone(requirementsA), requirementsB...)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// selectorAlternatives returns a target's label selectors as alternative sets of
+// requirements, one per labelSelectors entry combined with labelSelector.
+func selectorAlternatives(target *v1alpha1.TargetResourceSpec) [][]metav1.LabelSelectorRequirement {
+	base := selectorRequirements(target.LabelSelector)
+	if len(target.LabelSelectors) == 0 {
+		return [][]metav1.LabelSelectorRequirement{base}
+	}
+	alternatives := make([][]metav1.LabelSelectorRequirement, 0, len(target.LabelSelectors))
+	for i := range target.LabelSelectors {
+		alternatives = append(alternatives, append(slices.Clone(base), selectorRequirements(&target.LabelSelectors[i])...))
+	}
+	return alternatives
+}
+
+// selectorRequirements returns a label selector's matchLabels and matchExpressions as
+// requirements.
+func selectorRequirements(selector *metav1.LabelSelector) []metav1.LabelSelectorRequirement {
+	if selector == nil {
+		return nil
+	}
+	requirements := slices.Clone(selector.MatchExpressions)
+	for key, value := range selector.MatchLabels {
+		requirements = append(requirements, metav1.LabelSelectorRequirement{Key: key, Operator: metav1.LabelSelectorOpIn, Values: []string{value}})
+	}
+	return requirements
+}
+
+// labelConstraint is what a set of requirements demands of one label key.
+type labelConstraint struct {
+	// allowed are the values the label may take; nil while any value is allowed.
+	allowed   map[string]struct{}
+	forbidden map[string]struct{}
+	present   bool
+	absent    bool
+}
+
+// requirementsSatisfiable reports whether some set of labels satisfies all requirements.
+func requirementsSatisfiable(requirements []metav1.LabelSelectorRequirement) bool {
+	constraints := make(map[string]*labelConstraint)
+	for _, requirement := range requirements {
+		constraint, ok := constraints[requirement.Key]
+		if !ok {
+			constraint = &labelConstraint{forbidden: make(map[string]struct{})}
+			constraints[requirement.Key] = constraint
+		}
+		switch requirement.Operator {
+		case metav1.LabelSelectorOpIn:
+			constraint.present = true
+			values := make(map[string]struct{}, len(requirement.Values))
+			for _, value := range requirement.Values {
+				if _, allowed := constraint.allowed[value]; constraint.allowed == nil || allowed {
+					values[value] = struct{}{}
+				}
+			}
+			constraint.allowed = values
+		case metav1.LabelSelectorOpNotIn:
+			for _, value := range requirement.Values {
+				constraint.forbidden[value] = struct{}{}
+			}
+		case metav1.LabelSelectorOpExists:
+			constraint.present = true
+		case metav1.LabelSelectorOpDoesNotExist:
+			constraint.absent = true
+		}
+	}
+
+	for _, constraint := range constraints {
+		if constraint.present && constraint.absent {
+			return false
+		}
+		if constraint.allowed != nil && !hasValueOutside(constraint.allowed, constraint.forbidden) {
+			return false
+		}
+	}
+	return true
+}
+
+// hasValueOutside reports whether any of values is not in excluded.
+func hasValueOutside(values, excluded map[string]struct{}) bool {
+	for value := range values {
+		if _, ok := excluded[value]; !ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"errors"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func TestTargetsOverlap(t *testing.T) {
+	configMaps := func(namespace string) v1alpha1.TargetResourceSpec {
+		return v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap", Namespace: namespace}
+	}
+	withLabels := func(target v1alpha1.TargetResourceSpec, selector *metav1.LabelSelector) v1alpha1.TargetResourceSpec {
+		target.LabelSelector = selector
+		return target
+	}
+
+	tests := []struct {
+		name    string
+		a, b    v1alpha1.TargetResourceSpec
+		overlap bool
+	}{
+		{name: "same namespace", a: configMaps("team-a"), b: configMaps("team-a"), overlap: true},
+		{name: "different namespaces", a: configMaps("team-a"), b: configMaps("team-b")},
+		{name: "all namespaces", a: configMaps("*"), b: configMaps("team-b"), overlap: true},
+		{name: "empty namespace counts as all", a: configMaps(""), b: configMaps("team-b"), overlap: true},
+		{
+			name: "namespace excluded by the other",
+			a:    v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap", Namespace: "*", ExcludeNamespaces: []string{"kube-*"}},
+			b:    configMaps("kube-system"),
+		},
+		{name: "different kinds", a: configMaps("team-a"), b: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "Secret", Namespace: "team-a"}},
+		{
+			name:    "other version of the same group",
+			a:       v1alpha1.TargetResourceSpec{APIVersion: "batch/v1", Kind: "Job"},
+			b:       v1alpha1.TargetResourceSpec{APIVersion: "batch/v1beta1", Kind: "Job"},
+			overlap: true,
+		},
+		{
+			name: "different groups",
+			a:    v1alpha1.TargetResourceSpec{APIVersion: "apps/v1", Kind: "Deployment"},
+			b:    v1alpha1.TargetResourceSpec{APIVersion: "example.com/v1", Kind: "Deployment"},
+		},
+		{
+			name:    "selector and no selector",
+			a:       withLabels(configMaps("team-a"), &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}),
+			b:       configMaps("team-a"),
+			overlap: true,
+		},
+		{
+			name: "different label values",
+			a:    withLabels(configMaps("team-a"), &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}),
+			b:    withLabels(configMaps("team-a"), &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}),
+		},
+		{
+			name:    "different label keys",
+			a:       withLabels(configMaps("team-a"), &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}),
+			b:       withLabels(configMaps("team-a"), &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "cache"}}),
+			overlap: true,
+		},
+		{
+			name: "In sets without a common value",
+			a: withLabels(configMaps("team-a"), &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "env", Operator: metav1.LabelSelectorOpIn, Values: []string{"dev", "test"}},
+			}}),
+			b: withLabels(configMaps("team-a"), &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "env", Operator: metav1.LabelSelectorOpIn, Values: []string{"prod"}},
+			}}),
+		},
+		{
+			name: "NotIn excluding every allowed value",
+			a:    withLabels(configMaps("team-a"), &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}),
+			b: withLabels(configMaps("team-a"), &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "env", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"prod"}},
+			}}),
+		},
+		{
+			name: "Exists and DoesNotExist",
+			a: withLabels(configMaps("team-a"), &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "keep", Operator: metav1.LabelSelectorOpExists},
+			}}),
+			b: withLabels(configMaps("team-a"), &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "keep", Operator: metav1.LabelSelectorOpDoesNotExist},
+			}}),
+		},
+		{
+			name: "any labelSelectors alternative overlapping",
+			a: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap", LabelSelectors: []metav1.LabelSelector{
+				{MatchLabels: map[string]string{"app": "web"}},
+				{MatchLabels: map[string]string{"app": "db"}},
+			}},
+			b:       withLabels(configMaps(""), &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}),
+			overlap: true,
+		},
+		{
+			name: "disjoint UIDs",
+			a:    v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap", UIDs: []string{"uid-1"}},
+			b:    v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap", UIDs: []string{"uid-2"}},
+		},
+		{
+			name: "different field values",
+			a:    v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "Pod", FieldSelector: &v1alpha1.FieldSelectorSpec{MatchFields: map[string]string{"status.phase": "Succeeded"}}},
+			b:    v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "Pod", FieldSelector: &v1alpha1.FieldSelectorSpec{MatchFields: map[string]string{"status.phase": "Failed"}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := targetsOverlap(&tt.a, &tt.b); got != tt.overlap {
+				t.Errorf("targetsOverlap() = %v, want %v", got, tt.overlap)
+			}
+			if got := targetsOverlap(&tt.b, &tt.a); got != tt.overlap {
+				t.Errorf("targetsOverlap() reversed = %v, want %v", got, tt.overlap)
+			}
+		})
+	}
+}
+
+func TestWebhookServer_validatePolicy_PolicyOverlap(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add scheme: %v", err)
+	}
+	existing := newTestPolicy("existing")
+	existing.Spec.TargetResource.Namespace = "team-a"
+	reader := clientfake.NewClientBuilder().WithScheme(scheme).WithObjects(existing).Build()
+	incoming := newTestPolicy("new")
+	incoming.Spec.TargetResource.Namespace = "team-a"
+	incoming.Spec.Behavior.DryRun = true
+	allowed := incoming.DeepCopy()
+	allowed.Annotations = map[string]string{v1alpha1.AllowOverlapAnnotation: "true"}
+
+	tests := []struct {
+		name         string
+		deny         bool
+		operation    admissionv1.Operation
+		policy       *v1alpha1.GarbageCollectionPolicy
+		expectDenied bool
+		expectWarned bool
+	}{
+		{name: "overlap warns by default", operation: admissionv1.Create, policy: incoming, expectWarned: true},
+		{name: "overlap is denied when enforced", deny: true, operation: admissionv1.Create, policy: incoming, expectDenied: true},
+		{name: "annotation allows the overlap", deny: true, operation: admissionv1.Create, policy: allowed},
+		{name: "a policy does not overlap itself", deny: true, operation: admissionv1.Update, policy: existing},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, err := NewWebhookServer(":0", "", "")
+			if err != nil {
+				t.Fatalf("Failed to create webhook server: %v", err)
+			}
+			server.SetPolicyOverlapCheck(reader, tt.deny)
+
+			req := &admissionv1.AdmissionRequest{
+				Operation: tt.operation,
+				Namespace: "default",
+				Object:    runtime.RawExtension{Raw: marshalPolicy(t, tt.policy)},
+			}
+			if tt.operation == admissionv1.Update {
+				old := tt.policy.DeepCopy()
+				old.Spec.TargetResource.Namespace = "team-b"
+				req.OldObject = runtime.RawExtension{Raw: marshalPolicy(t, old)}
+			}
+			warnings, err := server.validatePolicy(req)
+			if tt.expectDenied != errors.Is(err, ErrPolicyOverlap) || (!tt.expectDenied && err != nil) {
+				t.Errorf("validatePolicy() error = %v, want denied = %v", err, tt.expectDenied)
+			}
+			if tt.expectWarned != (len(warnings) > 0) {
+				t.Errorf("validatePolicy() warnings = %v, want warned = %v", warnings, tt.expectWarned)
+			}
+		})
+	}
+}
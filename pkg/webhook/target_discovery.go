@@ -0,0 +1,118 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/config"
+)
+
+// ErrTargetKindNotServed indicates the cluster does not serve a policy's target kind, e.g.
+// because of a typo or an uninstalled CRD. Such a policy would match nothing.
+var ErrTargetKindNotServed = errors.New("target kind is not served by the cluster")
+
+// TargetDiscovery checks policy target kinds against the kinds the cluster serves, caching
+// the kinds of each API version for a TTL so admissions do not each query discovery.
+type TargetDiscovery struct {
+	client discovery.DiscoveryInterface
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu    sync.Mutex
+	kinds map[string]servedKinds
+}
+
+// servedKinds are the kinds an API version serves, as last discovered. A nil set means the
+// API version is not served at all.
+type servedKinds struct {
+	kinds     map[string]struct{}
+	fetchedAt time.Time
+}
+
+// NewTargetDiscovery creates a TargetDiscovery caching results for ttl
+// (config.DefaultTargetDiscoveryTTL if not positive).
+func NewTargetDiscovery(client discovery.DiscoveryInterface, ttl time.Duration) *TargetDiscovery {
+	if ttl <= 0 {
+		ttl = config.DefaultTargetDiscoveryTTL
+	}
+	return &TargetDiscovery{
+		client: client,
+		ttl:    ttl,
+		now:    time.Now,
+		kinds:  make(map[string]servedKinds),
+	}
+}
+
+// CheckTarget returns an error wrapping ErrTargetKindNotServed if the cluster does not serve
+// the policy's target kind. Other errors mean discovery could not answer.
+func (d *TargetDiscovery) CheckTarget(spec *v1alpha1.GarbageCollectionPolicySpec) error {
+	target := &spec.TargetResource
+	gv, err := schema.ParseGroupVersion(target.APIVersion)
+	if err != nil {
+		return fmt.Errorf("invalid targetResource.apiVersion %q: %w", target.APIVersion, err)
+	}
+	kinds, err := d.servedKinds(gv.String())
+	if err != nil {
+		return err
+	}
+	if kinds == nil {
+		return fmt.Errorf("%w: apiVersion %s is not served (is the CRD installed?)", ErrTargetKindNotServed, target.APIVersion)
+	}
+	if _, ok := kinds[target.Kind]; !ok {
+		return fmt.Errorf("%w: kind %s is not served by apiVersion %s", ErrTargetKindNotServed, target.Kind, target.APIVersion)
+	}
+	return nil
+}
+
+// servedKinds returns the kinds groupVersion serves, nil if it is not served, from the
+// cache while fresh. Discovery errors other than NotFound are not cached.
+func (d *TargetDiscovery) servedKinds(groupVersion string) (map[string]struct{}, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	if cached, ok := d.kinds[groupVersion]; ok && now.Sub(cached.fetchedAt) < d.ttl {
+		return cached.kinds, nil
+	}
+
+	resources, err := d.client.ServerResourcesForGroupVersion(groupVersion)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to discover %s: %w", groupVersion, err)
+	}
+	var kinds map[string]struct{}
+	if err == nil {
+		kinds = make(map[string]struct{}, len(resources.APIResources))
+		for i := range resources.APIResources {
+			// Subresources such as deployments/scale report their parent's kind
+			if !strings.Contains(resources.APIResources[i].Name, "/") {
+				kinds[resources.APIResources[i].Kind] = struct{}{}
+			}
+		}
+	}
+	d.kinds[groupVersion] = servedKinds{kinds: kinds, fetchedAt: now}
+	return kinds, nil
+}
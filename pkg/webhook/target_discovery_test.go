@@ -0,0 +1,162 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+func newFakeDiscovery() *fakediscovery.FakeDiscovery {
+	fake := &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{}}
+	fake.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{{Name: "configmaps", Kind: "ConfigMap"}},
+		},
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "deployments", Kind: "Deployment"},
+				{Name: "deployments/scale", Kind: "Scale"},
+			},
+		},
+	}
+	return fake
+}
+
+func TestTargetDiscovery_CheckTarget(t *testing.T) {
+	targetDiscovery := NewTargetDiscovery(newFakeDiscovery(), time.Minute)
+
+	tests := []struct {
+		name       string
+		apiVersion string
+		kind       string
+		served     bool
+	}{
+		{name: "core kind", apiVersion: "v1", kind: "ConfigMap", served: true},
+		{name: "group kind", apiVersion: "apps/v1", kind: "Deployment", served: true},
+		{name: "typo in kind", apiVersion: "v1", kind: "ConfigMaps"},
+		{name: "subresource kind", apiVersion: "apps/v1", kind: "Scale"},
+		{name: "uninstalled CRD", apiVersion: "cert-manager.io/v1", kind: "Certificate"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := targetDiscovery.CheckTarget(&v1alpha1.GarbageCollectionPolicySpec{
+				TargetResource: v1alpha1.TargetResourceSpec{APIVersion: tt.apiVersion, Kind: tt.kind},
+			})
+			if tt.served && err != nil {
+				t.Errorf("CheckTarget() returned error: %v", err)
+			}
+			if !tt.served && !errors.Is(err, ErrTargetKindNotServed) {
+				t.Errorf("CheckTarget() error = %v, want %v", err, ErrTargetKindNotServed)
+			}
+		})
+	}
+}
+
+func TestTargetDiscovery_CachesForTTL(t *testing.T) {
+	fake := newFakeDiscovery()
+	targetDiscovery := NewTargetDiscovery(fake, time.Minute)
+	now := time.Now()
+	targetDiscovery.now = func() time.Time { return now }
+	spec := &newTestPolicy("test-policy").Spec
+
+	for range 3 {
+		if err := targetDiscovery.CheckTarget(spec); err != nil {
+			t.Fatalf("CheckTarget() returned error: %v", err)
+		}
+	}
+	if n := len(fake.Actions()); n != 1 {
+		t.Errorf("%d discovery calls within the TTL, want 1", n)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if err := targetDiscovery.CheckTarget(spec); err != nil {
+		t.Fatalf("CheckTarget() returned error: %v", err)
+	}
+	if n := len(fake.Actions()); n != 2 {
+		t.Errorf("%d discovery calls after the TTL, want 2", n)
+	}
+}
+
+func TestWebhookServer_validatePolicy_TargetDiscovery(t *testing.T) {
+	tests := []struct {
+		name         string
+		enforce      bool
+		kind         string
+		expectErr    bool
+		expectWarned bool
+	}{
+		{name: "served kind", kind: "ConfigMap"},
+		{name: "unserved kind warns by default", kind: "ConfigMaps", expectWarned: true},
+		{name: "unserved kind is denied when enforced", enforce: true, kind: "ConfigMaps", expectErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, err := NewWebhookServer(":0", "", "")
+			if err != nil {
+				t.Fatalf("Failed to create webhook server: %v", err)
+			}
+			server.SetTargetDiscovery(NewTargetDiscovery(newFakeDiscovery(), 0), tt.enforce)
+			policy := newTestPolicy("test-policy")
+			policy.Spec.TargetResource.Kind = tt.kind
+
+			warnings, err := server.validatePolicy(&admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				Object:    runtime.RawExtension{Raw: marshalPolicy(t, policy)},
+			})
+			if tt.expectErr != errors.Is(err, ErrTargetKindNotServed) || (!tt.expectErr && err != nil) {
+				t.Errorf("validatePolicy() error = %v, want denied = %v", err, tt.expectErr)
+			}
+			if tt.expectWarned != (len(warnings) > 0) {
+				t.Errorf("validatePolicy() warnings = %v, want warned = %v", warnings, tt.expectWarned)
+			}
+		})
+	}
+}
+
+func TestWebhookServer_validatePolicy_TargetDiscoveryUnavailable(t *testing.T) {
+	fake := newFakeDiscovery()
+	fake.PrependReactor("get", "resource", func(clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("connection refused")
+	})
+	server, err := NewWebhookServer(":0", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create webhook server: %v", err)
+	}
+	server.SetTargetDiscovery(NewTargetDiscovery(fake, 0), true)
+	policy := newTestPolicy("test-policy")
+	policy.Spec.TargetResource.Kind = "ConfigMaps"
+
+	warnings, err := server.validatePolicy(&admissionv1.AdmissionRequest{
+		Operation: admissionv1.Create,
+		Object:    runtime.RawExtension{Raw: marshalPolicy(t, policy)},
+	})
+	if err != nil || len(warnings) != 0 {
+		t.Errorf("validatePolicy() = %v, %v, want the policy admitted while discovery is unavailable", warnings, err)
+	}
+}
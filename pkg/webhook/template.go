@@ -0,0 +1,249 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+var (
+	// ErrInvalidPolicyTemplate indicates a policy template file is malformed.
+	ErrInvalidPolicyTemplate = errors.New("invalid policy template")
+
+	// ErrUnknownPolicyTemplate indicates a policy names a template that is not loaded.
+	ErrUnknownPolicyTemplate = errors.New("unknown policy template")
+
+	// ErrPolicyTemplateSpecSet indicates a templated policy also sets its own spec.
+	ErrPolicyTemplateSpecSet = errors.New("spec must be empty when " + v1alpha1.PolicyTemplateAnnotation + " is set")
+
+	// ErrMissingTemplateParameter indicates a required template parameter has no annotation.
+	ErrMissingTemplateParameter = errors.New("missing required template parameter")
+
+	// ErrUnknownTemplateParameter indicates a parameter annotation the template does not declare.
+	ErrUnknownTemplateParameter = errors.New("unknown template parameter")
+)
+
+// templateParamPattern matches a valid template parameter name.
+var templateParamPattern = regexp.MustCompile(`^[a-z]([a-z0-9-]{0,46}[a-z0-9])?$`)
+
+// templatePlaceholderPattern matches a ${name} placeholder. Placeholders whose name is not a
+// valid parameter name, such as ${parent.name} in alsoDelete, are left as they are.
+var templatePlaceholderPattern = regexp.MustCompile(`\$\{([a-z]([a-z0-9-]{0,46}[a-z0-9])?)\}`)
+
+// PolicyTemplate is a parameterized policy spec that policies can be expanded from.
+type PolicyTemplate struct {
+	// Name policies refer to in the template annotation
+	Name string `json:"name"`
+
+	// Parameters the spec may reference as ${name} placeholders
+	Parameters []PolicyTemplateParameter `json:"parameters,omitempty"`
+
+	// Spec in GarbageCollectionPolicySpec form. Placeholders are substituted in string values only.
+	Spec map[string]interface{} `json:"spec"`
+}
+
+// PolicyTemplateParameter declares a template parameter.
+type PolicyTemplateParameter struct {
+	// Name of the parameter, a lowercase DNS label of at most 48 characters
+	Name string `json:"name"`
+
+	// Whether a policy must set the parameter
+	Required bool `json:"required,omitempty"`
+
+	// Value used when an optional parameter is not set
+	Default string `json:"default,omitempty"`
+}
+
+// LoadPolicyTemplates reads every .yaml, .yml, and .json file in dir as a policy template.
+// Files are read in name order and template names must be unique.
+func LoadPolicyTemplates(dir string) (map[string]*PolicyTemplate, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy template directory: %w", err)
+	}
+
+	templates := make(map[string]*PolicyTemplate)
+	for _, entry := range entries {
+		switch filepath.Ext(entry.Name()) {
+		case ".yaml", ".yml", ".json":
+		default:
+			continue
+		}
+		if entry.IsDir() {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read policy template %s: %w", entry.Name(), err)
+		}
+		tmpl, err := parsePolicyTemplate(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", entry.Name(), err)
+		}
+		if _, exists := templates[tmpl.Name]; exists {
+			return nil, fmt.Errorf("%w: %s: duplicate template name %q", ErrInvalidPolicyTemplate, entry.Name(), tmpl.Name)
+		}
+		templates[tmpl.Name] = tmpl
+	}
+	return templates, nil
+}
+
+// parsePolicyTemplate parses and checks a single policy template.
+func parsePolicyTemplate(data []byte) (*PolicyTemplate, error) {
+	var tmpl PolicyTemplate
+	if err := yaml.UnmarshalStrict(data, &tmpl); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPolicyTemplate, err)
+	}
+	if tmpl.Name == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidPolicyTemplate)
+	}
+	if len(tmpl.Spec) == 0 {
+		return nil, fmt.Errorf("%w: %s: spec is required", ErrInvalidPolicyTemplate, tmpl.Name)
+	}
+
+	declared := make(map[string]bool, len(tmpl.Parameters))
+	for _, param := range tmpl.Parameters {
+		if !templateParamPattern.MatchString(param.Name) {
+			return nil, fmt.Errorf("%w: %s: invalid parameter name %q", ErrInvalidPolicyTemplate, tmpl.Name, param.Name)
+		}
+		if declared[param.Name] {
+			return nil, fmt.Errorf("%w: %s: duplicate parameter %q", ErrInvalidPolicyTemplate, tmpl.Name, param.Name)
+		}
+		declared[param.Name] = true
+	}
+
+	undeclaredSet := make(map[string]bool)
+	walkTemplateStrings(tmpl.Spec, func(s string) string {
+		for _, match := range templatePlaceholderPattern.FindAllStringSubmatch(s, -1) {
+			if !declared[match[1]] {
+				undeclaredSet[match[1]] = true
+			}
+		}
+		return s
+	})
+	if len(undeclaredSet) > 0 {
+		undeclared := make([]string, 0, len(undeclaredSet))
+		for name := range undeclaredSet {
+			undeclared = append(undeclared, name)
+		}
+		sort.Strings(undeclared)
+		return nil, fmt.Errorf("%w: %s: undeclared parameters %s", ErrInvalidPolicyTemplate, tmpl.Name, strings.Join(undeclared, ", "))
+	}
+
+	return &tmpl, nil
+}
+
+// Expand returns the template's spec with parameters substituted from the policy's
+// parameter annotations, along with the same spec decoded. Unknown parameter annotations
+// and missing required parameters are errors. The result does not depend on map order.
+func (t *PolicyTemplate) Expand(annotations map[string]string) (map[string]interface{}, *v1alpha1.GarbageCollectionPolicySpec, error) {
+	values := make(map[string]string, len(t.Parameters))
+	for _, param := range t.Parameters {
+		values[param.Name] = param.Default
+	}
+
+	var unknown []string
+	for key := range annotations {
+		name, ok := strings.CutPrefix(key, v1alpha1.PolicyTemplateParamAnnotationPrefix)
+		if !ok {
+			continue
+		}
+		if _, declared := values[name]; !declared {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, nil, fmt.Errorf("%w for template %s: %s", ErrUnknownTemplateParameter, t.Name, strings.Join(unknown, ", "))
+	}
+
+	var missing []string
+	for _, param := range t.Parameters {
+		value, ok := annotations[v1alpha1.PolicyTemplateParamAnnotationPrefix+param.Name]
+		if ok {
+			values[param.Name] = value
+		} else if param.Required {
+			missing = append(missing, param.Name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, nil, fmt.Errorf("%w for template %s: %s", ErrMissingTemplateParameter, t.Name, strings.Join(missing, ", "))
+	}
+
+	// Round trip through JSON for a deep copy the substitution can modify in place
+	raw, err := json.Marshal(t.Spec)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to copy template %s: %w", t.Name, err)
+	}
+	var expanded map[string]interface{}
+	if err := json.Unmarshal(raw, &expanded); err != nil {
+		return nil, nil, fmt.Errorf("failed to copy template %s: %w", t.Name, err)
+	}
+	walkTemplateStrings(expanded, func(s string) string {
+		return templatePlaceholderPattern.ReplaceAllStringFunc(s, func(placeholder string) string {
+			return values[placeholder[2:len(placeholder)-1]]
+		})
+	})
+
+	raw, err = json.Marshal(expanded)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode expanded template %s: %w", t.Name, err)
+	}
+	var spec v1alpha1.GarbageCollectionPolicySpec
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&spec); err != nil {
+		return nil, nil, fmt.Errorf("%w: %s: expanded spec does not decode: %v", ErrInvalidPolicyTemplate, t.Name, err)
+	}
+
+	return expanded, &spec, nil
+}
+
+// walkTemplateStrings replaces every string value nested in value with fn's result.
+// Map keys are left as they are.
+func walkTemplateStrings(value interface{}, fn func(string) string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if s, ok := item.(string); ok {
+				v[key] = fn(s)
+				continue
+			}
+			walkTemplateStrings(item, fn)
+		}
+	case []interface{}:
+		for i, item := range v {
+			if s, ok := item.(string); ok {
+				v[i] = fn(s)
+				continue
+			}
+			walkTemplateStrings(item, fn)
+		}
+	}
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2025 Kube-ZEN Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+)
+
+const testPolicyTemplate = `
+name: expired-jobs
+parameters:
+  - name: namespace
+    required: true
+  - name: ttl
+    default: 7d
+  - name: team
+spec:
+  targetResource:
+    apiVersion: batch/v1
+    kind: Job
+    namespace: ${namespace}
+    labelSelector:
+      matchLabels:
+        team: ${team}
+  ttl:
+    durationAfterCreation: ${ttl}
+  behavior:
+    dryRun: true
+    batchSize: 20
+`
+
+func mustParsePolicyTemplate(t *testing.T, data string) *PolicyTemplate {
+	t.Helper()
+	tmpl, err := parsePolicyTemplate([]byte(data))
+	if err != nil {
+		t.Fatalf("parsePolicyTemplate() returned error: %v", err)
+	}
+	return tmpl
+}
+
+func TestPolicyTemplate_Expand(t *testing.T) {
+	tmpl := mustParsePolicyTemplate(t, testPolicyTemplate)
+
+	expanded, spec, err := tmpl.Expand(map[string]string{
+		v1alpha1.PolicyTemplateParamAnnotationPrefix + "namespace": "ci",
+		v1alpha1.PolicyTemplateParamAnnotationPrefix + "team":      "build",
+		"unrelated": "ignored",
+	})
+	if err != nil {
+		t.Fatalf("Expand() returned error: %v", err)
+	}
+
+	want := v1alpha1.GarbageCollectionPolicySpec{
+		TargetResource: v1alpha1.TargetResourceSpec{
+			APIVersion:    "batch/v1",
+			Kind:          "Job",
+			Namespace:     "ci",
+			LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "build"}},
+		},
+		TTL:      v1alpha1.TTLSpec{DurationAfterCreation: "7d"},
+		Behavior: v1alpha1.BehaviorSpec{DryRun: true, BatchSize: 20},
+	}
+	if !reflect.DeepEqual(*spec, want) {
+		t.Errorf("Expand() spec = %+v, want %+v", *spec, want)
+	}
+	if got := expanded["targetResource"].(map[string]interface{})["namespace"]; got != "ci" {
+		t.Errorf("expanded targetResource.namespace = %v, want ci", got)
+	}
+
+	// The template itself is left unexpanded for the next policy
+	if got := tmpl.Spec["targetResource"].(map[string]interface{})["namespace"]; got != "${namespace}" {
+		t.Errorf("template targetResource.namespace = %v, want ${namespace}", got)
+	}
+}
+
+func TestPolicyTemplate_Expand_Errors(t *testing.T) {
+	tmpl := mustParsePolicyTemplate(t, testPolicyTemplate)
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		wantErr     error
+	}{
+		{
+			name:        "missing required parameter",
+			annotations: map[string]string{v1alpha1.PolicyTemplateParamAnnotationPrefix + "ttl": "1d"},
+			wantErr:     ErrMissingTemplateParameter,
+		},
+		{
+			name: "unknown parameter",
+			annotations: map[string]string{
+				v1alpha1.PolicyTemplateParamAnnotationPrefix + "namespace": "ci",
+				v1alpha1.PolicyTemplateParamAnnotationPrefix + "tll":       "1d",
+			},
+			wantErr: ErrUnknownTemplateParameter,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := tmpl.Expand(tt.annotations); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expand() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParsePolicyTemplate_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "missing name", data: "spec:\n  targetResource:\n    kind: Job\n"},
+		{name: "missing spec", data: "name: empty\n"},
+		{name: "undeclared placeholder", data: "name: t\nspec:\n  targetResource:\n    namespace: ${namespace}\n"},
+		{name: "invalid parameter name", data: "name: t\nparameters:\n  - name: Team\nspec:\n  paused: true\n"},
+		{name: "duplicate parameter", data: "name: t\nparameters:\n  - name: a\n  - name: a\nspec:\n  paused: true\n"},
+		{name: "unknown field", data: "name: t\nparams: []\nspec:\n  paused: true\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := parsePolicyTemplate([]byte(tt.data)); !errors.Is(err, ErrInvalidPolicyTemplate) {
+				t.Errorf("parsePolicyTemplate() error = %v, want %v", err, ErrInvalidPolicyTemplate)
+			}
+		})
+	}
+}
+
+func TestLoadPolicyTemplates(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "jobs.yaml"), []byte(testPolicyTemplate), 0o600); err != nil {
+		t.Fatalf("Failed to write template: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a template"), 0o600); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	templates, err := LoadPolicyTemplates(dir)
+	if err != nil {
+		t.Fatalf("LoadPolicyTemplates() returned error: %v", err)
+	}
+	if len(templates) != 1 || templates["expired-jobs"] == nil {
+		t.Fatalf("LoadPolicyTemplates() = %v, want only expired-jobs", templates)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "jobs-copy.yml"), []byte(testPolicyTemplate), 0o600); err != nil {
+		t.Fatalf("Failed to write template: %v", err)
+	}
+	if _, err := LoadPolicyTemplates(dir); !errors.Is(err, ErrInvalidPolicyTemplate) {
+		t.Errorf("LoadPolicyTemplates() with duplicate names error = %v, want %v", err, ErrInvalidPolicyTemplate)
+	}
+}
+
+func TestWebhookServer_mutatePolicy_Template(t *testing.T) {
+	server, err := NewWebhookServer(":0", "", "")
+	if err != nil {
+		t.Fatalf("NewWebhookServer() returned error: %v", err)
+	}
+	server.SetPolicyTemplates(map[string]*PolicyTemplate{"expired-jobs": mustParsePolicyTemplate(t, testPolicyTemplate)})
+
+	newRequest := func(annotations map[string]string, spec v1alpha1.GarbageCollectionPolicySpec) *admissionv1.AdmissionRequest {
+		return &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Object: runtime.RawExtension{
+				Raw: marshalPolicy(t, &v1alpha1.GarbageCollectionPolicy{
+					ObjectMeta: metav1.ObjectMeta{Name: "jobs", Namespace: "ci", Annotations: annotations},
+					Spec:       spec,
+				}),
+			},
+		}
+	}
+
+	t.Run("expands template and defaults the result", func(t *testing.T) {
+		patches, err := server.mutatePolicy(newRequest(map[string]string{
+			v1alpha1.PolicyTemplateAnnotation:                          "expired-jobs",
+			v1alpha1.PolicyTemplateParamAnnotationPrefix + "namespace": "ci",
+		}, v1alpha1.GarbageCollectionPolicySpec{}))
+		if err != nil {
+			t.Fatalf("mutatePolicy() returned error: %v", err)
+		}
+
+		paths := make([]string, 0, len(patches))
+		for _, patch := range patches {
+			paths = append(paths, patch.Path)
+		}
+		wantPaths := []string{"/spec", "/spec/behavior/maxDeletionsPerSecond", "/spec/behavior/propagationPolicy"}
+		if !reflect.DeepEqual(paths, wantPaths) {
+			t.Errorf("patch paths = %v, want %v", paths, wantPaths)
+		}
+		if got := mutationWarnings(patches); !reflect.DeepEqual(got, []string{
+			"expanded spec from policy template",
+			"defaulted maxDeletionsPerSecond=10, propagationPolicy=Background",
+		}) {
+			t.Errorf("mutationWarnings() = %q", got)
+		}
+	})
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		spec        v1alpha1.GarbageCollectionPolicySpec
+		wantErr     error
+	}{
+		{
+			name:        "unknown template",
+			annotations: map[string]string{v1alpha1.PolicyTemplateAnnotation: "missing"},
+			wantErr:     ErrUnknownPolicyTemplate,
+		},
+		{
+			name:        "missing required parameter",
+			annotations: map[string]string{v1alpha1.PolicyTemplateAnnotation: "expired-jobs"},
+			wantErr:     ErrMissingTemplateParameter,
+		},
+		{
+			name: "spec set alongside template",
+			annotations: map[string]string{
+				v1alpha1.PolicyTemplateAnnotation:                          "expired-jobs",
+				v1alpha1.PolicyTemplateParamAnnotationPrefix + "namespace": "ci",
+			},
+			spec:    v1alpha1.GarbageCollectionPolicySpec{Paused: true},
+			wantErr: ErrPolicyTemplateSpecSet,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := server.mutatePolicy(newRequest(tt.annotations, tt.spec)); !errors.Is(err, tt.wantErr) {
+				t.Errorf("mutatePolicy() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("rejects an invalid expanded policy", func(t *testing.T) {
+		_, err := server.mutatePolicy(newRequest(map[string]string{
+			v1alpha1.PolicyTemplateAnnotation:                          "expired-jobs",
+			v1alpha1.PolicyTemplateParamAnnotationPrefix + "namespace": "ci",
+			v1alpha1.PolicyTemplateParamAnnotationPrefix + "ttl":       "soon",
+		}, v1alpha1.GarbageCollectionPolicySpec{}))
+		if err == nil {
+			t.Error("mutatePolicy() expected error for an invalid ttl, got nil")
+		}
+	})
+}
@@ -14,7 +14,8 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-// Package webhook provides HTTP server for validating and mutating admission webhooks.
+// Package webhook provides HTTP server for validating and mutating admission webhooks
+// and CRD conversion.
 package webhook
 
 import (
@@ -23,14 +24,22 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"path"
+	"sort"
+	"strings"
 	"time"
 
+	"gomodules.xyz/jsonpatch/v2"
+	mergepatch "gopkg.in/evanphx/json-patch.v4"
 	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/config"
 	"github.com/kube-zen/zen-gc/pkg/validation"
 	sdklog "github.com/kube-zen/zen-sdk/pkg/logging"
 )
@@ -41,6 +50,15 @@ var (
 
 	// ErrUnexpectedObjectType indicates an unexpected object type was encountered.
 	ErrUnexpectedObjectType = errors.New("expected GarbageCollectionPolicy")
+
+	// ErrDryRunImpactNotObserved indicates dryRun was disabled before an impact was observed for the current spec.
+	ErrDryRunImpactNotObserved = errors.New("cannot disable dryRun: no dry-run impact observed for the current spec yet")
+
+	// ErrDryRunImpactNotAcknowledged indicates dryRun was disabled without acknowledging the last observed impact.
+	ErrDryRunImpactNotAcknowledged = errors.New("cannot disable dryRun: " + v1alpha1.DryRunAcknowledgedAnnotation + " annotation must equal status.dryRunImpact.hash")
+
+	// ErrDryRunPromotionChangesSpec indicates dryRun was disabled together with other spec changes.
+	ErrDryRunPromotionChangesSpec = errors.New("cannot disable dryRun together with other spec changes")
 )
 
 func init() {
@@ -55,6 +73,36 @@ func init() {
 //nolint:revive // Renaming would be a breaking change
 type WebhookServer struct {
 	server *http.Server
+
+	// defaultTargetNamespaceMode mirrors the controller's resolution of an empty target namespace.
+	defaultTargetNamespaceMode string
+
+	// templates are the policy templates created policies can be expanded from, by name.
+	templates map[string]*PolicyTemplate
+
+	// allowedAPIGroups mirrors the controller's API group allowlist; nil allows every group.
+	allowedAPIGroups []string
+
+	// allowedOPAURLs mirrors the controller's OPA decision URL allowlist; nil allows none.
+	allowedOPAURLs []string
+
+	// allowedResultWebhookURLs mirrors the controller's result webhook URL allowlist; nil allows none.
+	allowedResultWebhookURLs []string
+
+	// targetDiscovery checks that target kinds are served; nil skips the check.
+	targetDiscovery *TargetDiscovery
+
+	// enforceTargetDiscovery denies, rather than warns about, policies whose target kind is not served.
+	enforceTargetDiscovery bool
+
+	// policyReader lists existing policies for the overlap check; nil skips the check.
+	policyReader client.Reader
+
+	// denyPolicyOverlap denies, rather than warns about, policies overlapping existing ones.
+	denyPolicyOverlap bool
+
+	// certReadiness checks the TLS certificate files for /readyz; nil when none are configured.
+	certReadiness *certReadiness
 }
 
 // NewServer creates a new webhook server.
@@ -63,6 +111,9 @@ type WebhookServer struct {
 func NewWebhookServer(addr, certFile, keyFile string) (*WebhookServer, error) {
 	mux := http.NewServeMux()
 	ws := &WebhookServer{}
+	if certFile != "" && keyFile != "" {
+		ws.certReadiness = newCertReadiness(certFile, keyFile)
+	}
 
 	// Register validation endpoint
 	mux.HandleFunc("/validate-gc-policy", ws.handleValidate)
@@ -70,12 +121,18 @@ func NewWebhookServer(addr, certFile, keyFile string) (*WebhookServer, error) {
 	// Register mutation endpoint
 	mux.HandleFunc("/mutate-gc-policy", ws.handleMutate)
 
+	// Register CRD conversion endpoint
+	mux.HandleFunc("/convert", ws.handleConvert)
+
 	// Health check endpoint for webhook
 	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		_, _ = w.Write([]byte("OK"))
 	})
 
+	// Readiness endpoint, failing while the TLS certificate is missing, unparseable, or expired
+	mux.HandleFunc("/readyz", ws.handleReadyz)
+
 	ws.server = &http.Server{
 		Addr:         addr,
 		Handler:      mux,
@@ -87,6 +144,78 @@ func NewWebhookServer(addr, certFile, keyFile string) (*WebhookServer, error) {
 	return ws, nil
 }
 
+// SetDefaultTargetNamespaceMode sets the controller's default target namespace mode.
+// Only the cluster mode defaults an empty namespace to "*" at admission; in policy mode
+// it is left empty for the controller to resolve, as only it knows the kind's scope.
+func (ws *WebhookServer) SetDefaultTargetNamespaceMode(mode string) {
+	ws.defaultTargetNamespaceMode = mode
+}
+
+// SetPolicyTemplates sets the policy templates that policies naming one in the
+// template annotation are expanded from at creation.
+func (ws *WebhookServer) SetPolicyTemplates(templates map[string]*PolicyTemplate) {
+	ws.templates = templates
+}
+
+// SetAllowedAPIGroups sets the controller's API group allowlist, so policies the controller
+// would refuse to act on are rejected at admission. Nil allows every group.
+func (ws *WebhookServer) SetAllowedAPIGroups(groups []string) {
+	ws.allowedAPIGroups = groups
+}
+
+// SetAllowedOPAURLs sets the controller's OPA decision URL allowlist, so policies whose opa
+// conditions the controller would refuse to query are rejected at admission. Nil allows none.
+func (ws *WebhookServer) SetAllowedOPAURLs(urls []string) {
+	ws.allowedOPAURLs = urls
+}
+
+// SetAllowedResultWebhookURLs sets the controller's result webhook URL allowlist, so policies
+// whose result webhook the controller would refuse to deliver are rejected at admission. Nil
+// allows none.
+func (ws *WebhookServer) SetAllowedResultWebhookURLs(urls []string) {
+	ws.allowedResultWebhookURLs = urls
+}
+
+// SetTargetDiscovery sets the discovery check that policy target kinds are served. Policies
+// failing it are admitted with a warning, or denied if enforce is set. Nil skips the check.
+func (ws *WebhookServer) SetTargetDiscovery(targetDiscovery *TargetDiscovery, enforce bool) {
+	ws.targetDiscovery = targetDiscovery
+	ws.enforceTargetDiscovery = enforce
+}
+
+// SetPolicyOverlapCheck sets the reader existing policies are listed with, so policies
+// whose target overlaps an existing policy's are admitted with a warning, or denied if deny
+// is set and the policy lacks the allow-overlap annotation. Nil skips the check.
+func (ws *WebhookServer) SetPolicyOverlapCheck(reader client.Reader, deny bool) {
+	ws.policyReader = reader
+	ws.denyPolicyOverlap = deny
+}
+
+// CheckCertificate returns an error if the configured TLS certificate files are missing,
+// cannot be parsed, or hold a certificate outside its validity period. Without configured
+// files there is nothing to check.
+func (ws *WebhookServer) CheckCertificate() error {
+	if ws.certReadiness == nil {
+		return nil
+	}
+	return ws.certReadiness.Check()
+}
+
+// handleReadyz reports the webhook ready while its TLS certificate is usable, so a pod whose
+// mounted certificate rotated badly stops receiving traffic.
+func (ws *WebhookServer) handleReadyz(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := ws.CheckCertificate(); err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("OK"))
+}
+
 // Start starts the webhook server without TLS (for testing).
 func (ws *WebhookServer) Start(ctx context.Context) error {
 	logger := sdklog.NewLogger("zen-gc-webhook")
@@ -159,7 +288,9 @@ func (ws *WebhookServer) handleValidate(w http.ResponseWriter, r *http.Request)
 	}
 
 	// Validate the policy
-	if err := ws.validatePolicy(review.Request); err != nil {
+	warnings, err := ws.validatePolicy(review.Request)
+	response.Response.Warnings = warnings
+	if err != nil {
 		logger.Debug("Policy validation failed", sdklog.String("error", err.Error()))
 		response.Response.Allowed = false
 		response.Response.Result = &metav1.Status{
@@ -180,11 +311,12 @@ func (ws *WebhookServer) handleValidate(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// validatePolicy validates a GarbageCollectionPolicy from an admission request.
-func (ws *WebhookServer) validatePolicy(req *admissionv1.AdmissionRequest) error {
+// validatePolicy validates a GarbageCollectionPolicy from an admission request, returning
+// admission warnings for problems that do not deny it.
+func (ws *WebhookServer) validatePolicy(req *admissionv1.AdmissionRequest) ([]string, error) {
 	// Only validate CREATE and UPDATE operations
 	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
-		return nil
+		return nil, nil
 	}
 
 	// Deserialize the object
@@ -196,17 +328,102 @@ func (ws *WebhookServer) validatePolicy(req *admissionv1.AdmissionRequest) error
 
 	obj, _, err := decoder.Decode(rawObj.Raw, nil, &policy)
 	if err != nil {
-		return fmt.Errorf("failed to decode GarbageCollectionPolicy: %w", err)
+		return nil, fmt.Errorf("failed to decode GarbageCollectionPolicy: %w", err)
 	}
 
 	policyObj, ok := obj.(*v1alpha1.GarbageCollectionPolicy)
 	if !ok {
-		return fmt.Errorf("%w, got %T", ErrUnexpectedObjectType, obj)
+		return nil, fmt.Errorf("%w, got %T", ErrUnexpectedObjectType, obj)
 	}
 
 	// Validate the policy using the validation package
 	if err := validation.ValidatePolicy(policyObj); err != nil {
-		return fmt.Errorf("policy validation failed: %w", err)
+		return nil, fmt.Errorf("policy validation failed: %w", err)
+	}
+
+	var oldPolicy *v1alpha1.GarbageCollectionPolicy
+	if req.Operation == admissionv1.Update {
+		oldPolicy = &v1alpha1.GarbageCollectionPolicy{}
+		if _, _, err := decoder.Decode(req.OldObject.Raw, nil, oldPolicy); err != nil {
+			return nil, fmt.Errorf("failed to decode old GarbageCollectionPolicy: %w", err)
+		}
+		if err := validateDryRunPromotion(oldPolicy, policyObj); err != nil {
+			return nil, err
+		}
+	}
+
+	// Updates that keep the spec, e.g. removing a finalizer, stay allowed after the
+	// allowlist changes or a CRD is removed so existing policies can still be cleaned up
+	if oldPolicy != nil && equality.Semantic.DeepEqual(oldPolicy.Spec, policyObj.Spec) {
+		return nil, nil
+	}
+	if err := validation.CheckAllowedAPIGroups(&policyObj.Spec, ws.allowedAPIGroups); err != nil {
+		return nil, err
+	}
+	if err := validation.CheckAllowedOPAURLs(&policyObj.Spec, ws.allowedOPAURLs); err != nil {
+		return nil, err
+	}
+	if err := validation.CheckAllowedResultWebhookURLs(&policyObj.Spec, ws.allowedResultWebhookURLs); err != nil {
+		return nil, err
+	}
+	warnings, err := ws.checkTargetServed(policyObj)
+	if err != nil {
+		return nil, err
+	}
+
+	// The object of a create may leave its namespace to the request
+	if policyObj.Namespace == "" {
+		policyObj.Namespace = req.Namespace
+	}
+	overlapWarnings, err := ws.checkPolicyOverlap(policyObj)
+	if err != nil {
+		return nil, err
+	}
+	return append(warnings, overlapWarnings...), nil
+}
+
+// checkTargetServed checks that the cluster serves the policy's target kind. A kind that is
+// not served is a warning, or denies the policy if enforced; discovery failures never deny,
+// as the webhook must not block policies while discovery is unavailable.
+func (ws *WebhookServer) checkTargetServed(policy *v1alpha1.GarbageCollectionPolicy) ([]string, error) {
+	if ws.targetDiscovery == nil {
+		return nil, nil
+	}
+	err := ws.targetDiscovery.CheckTarget(&policy.Spec)
+	switch {
+	case err == nil:
+		return nil, nil
+	case !errors.Is(err, ErrTargetKindNotServed):
+		sdklog.NewLogger("zen-gc-webhook").Warn("Could not check that the policy target kind is served", sdklog.String("policy", fmt.Sprintf("%s/%s", policy.Namespace, policy.Name)), sdklog.Error(err))
+		return nil, nil
+	case ws.enforceTargetDiscovery:
+		return nil, err
+	default:
+		return []string{err.Error() + "; the policy will match nothing until it is served"}, nil
+	}
+}
+
+// validateDryRunPromotion rejects arming a dry-run policy unless the update acknowledges
+// the impact last observed for the unchanged spec, so the impact is reviewed before arming.
+func validateDryRunPromotion(oldPolicy, newPolicy *v1alpha1.GarbageCollectionPolicy) error {
+	if !oldPolicy.Spec.Behavior.DryRun || newPolicy.Spec.Behavior.DryRun {
+		return nil
+	}
+
+	// Only the dryRun flag may change, otherwise the observed impact no longer applies
+	armed := newPolicy.Spec.DeepCopy()
+	armed.Behavior.DryRun = true
+	if !equality.Semantic.DeepEqual(armed, &oldPolicy.Spec) {
+		return ErrDryRunPromotionChangesSpec
+	}
+
+	impact := oldPolicy.Status.DryRunImpact
+	if impact == nil || impact.ObservedGeneration != oldPolicy.Generation {
+		return ErrDryRunImpactNotObserved
+	}
+
+	if newPolicy.Annotations[v1alpha1.DryRunAcknowledgedAnnotation] != impact.Hash {
+		return fmt.Errorf("%w (current hash %s, %d resources would be deleted)", ErrDryRunImpactNotAcknowledged, impact.Hash, impact.WouldDelete)
 	}
 
 	return nil
@@ -265,6 +482,7 @@ func (ws *WebhookServer) handleMutate(w http.ResponseWriter, r *http.Request) {
 					pt := admissionv1.PatchTypeJSONPatch
 					return &pt
 				}()
+				response.Response.Warnings = mutationWarnings(patches)
 				logger.Debug("Policy mutation succeeded", sdklog.Int("patches", len(patches)))
 			}
 		} else {
@@ -282,7 +500,7 @@ func (ws *WebhookServer) handleMutate(w http.ResponseWriter, r *http.Request) {
 }
 
 // mutatePolicy mutates a GarbageCollectionPolicy to set default values.
-func (ws *WebhookServer) mutatePolicy(req *admissionv1.AdmissionRequest) ([]map[string]interface{}, error) {
+func (ws *WebhookServer) mutatePolicy(req *admissionv1.AdmissionRequest) ([]jsonpatch.Operation, error) {
 	// Only mutate CREATE operations
 	if req.Operation != admissionv1.Create {
 		return nil, nil
@@ -302,63 +520,150 @@ func (ws *WebhookServer) mutatePolicy(req *admissionv1.AdmissionRequest) ([]map[
 		return nil, fmt.Errorf("%w, got %T", ErrUnexpectedObjectType, obj)
 	}
 
-	// Collect patches for default values
-	var patches []map[string]interface{}
-
-	// Ensure behavior spec exists
-	behaviorPath := "/spec/behavior"
-	hasBehavior := policyObj.Spec.Behavior.MaxDeletionsPerSecond != 0 ||
-		policyObj.Spec.Behavior.BatchSize != 0 ||
-		policyObj.Spec.Behavior.DryRun ||
-		policyObj.Spec.Behavior.Finalizer != "" ||
-		policyObj.Spec.Behavior.PropagationPolicy != "" ||
-		policyObj.Spec.Behavior.GracePeriodSeconds != nil
-
-	// Set default behavior values if not specified
-	if !hasBehavior {
-		// Create behavior object with defaults
-		patches = append(patches, map[string]interface{}{
-			"op":   "add",
-			"path": behaviorPath,
-			"value": map[string]interface{}{
-				"maxDeletionsPerSecond": 10,
-				"batchSize":             50,
-				"propagationPolicy":     "Background",
-			},
-		})
-	} else {
-		// Set individual defaults if behavior exists but fields are missing
-		if policyObj.Spec.Behavior.MaxDeletionsPerSecond == 0 {
-			patches = append(patches, map[string]interface{}{
-				"op":    "add",
-				"path":  behaviorPath + "/maxDeletionsPerSecond",
-				"value": 10,
-			})
-		}
-		if policyObj.Spec.Behavior.BatchSize == 0 {
-			patches = append(patches, map[string]interface{}{
-				"op":    "add",
-				"path":  behaviorPath + "/batchSize",
-				"value": 50,
-			})
+	original := req.Object.Raw
+	var patches []jsonpatch.Operation
+
+	// Expand a templated policy first so defaults apply to the expanded spec
+	if templateName := policyObj.Annotations[v1alpha1.PolicyTemplateAnnotation]; templateName != "" {
+		expanded, err := ws.expandPolicyTemplate(policyObj, templateName)
+		if err != nil {
+			return nil, err
 		}
-		if policyObj.Spec.Behavior.PropagationPolicy == "" {
-			patches = append(patches, map[string]interface{}{
-				"op":    "add",
-				"path":  behaviorPath + "/propagationPolicy",
-				"value": "Background",
-			})
+		patches = append(patches, jsonpatch.NewOperation("add", "/spec", expanded))
+		if original, err = replaceSpec(original, expanded); err != nil {
+			return nil, err
 		}
 	}
 
+	defaults, err := ws.defaultingPatch(policyObj, original)
+	if err != nil {
+		return nil, err
+	}
+	return append(patches, defaults...), nil
+}
+
+// applyPolicyDefaults sets the defaults of the fields a policy leaves unset.
+func (ws *WebhookServer) applyPolicyDefaults(policy *v1alpha1.GarbageCollectionPolicy) {
+	behavior := &policy.Spec.Behavior
+	if behavior.MaxDeletionsPerSecond == 0 {
+		behavior.MaxDeletionsPerSecond = 10
+	}
+	if behavior.BatchSize == 0 {
+		behavior.BatchSize = 50
+	}
+	if behavior.PropagationPolicy == "" {
+		behavior.PropagationPolicy = "Background"
+	}
+
 	// Set default namespace to "*" if not specified (for cluster-wide policies)
-	if policyObj.Spec.TargetResource.Namespace == "" {
-		patches = append(patches, map[string]interface{}{
-			"op":    "add",
-			"path":  "/spec/targetResource/namespace",
-			"value": "*",
-		})
+	if policy.Spec.TargetResource.Namespace == "" && ws.defaultTargetNamespaceMode != config.TargetNamespaceModePolicy {
+		policy.Spec.TargetResource.Namespace = "*"
 	}
+}
+
+// defaultingPatch returns the JSON patch that applies a policy's defaults to its raw object.
+// The defaults are taken as a merge patch between the policy and a defaulted copy, which
+// creates whatever parent objects the raw object lacks, so the resulting operations are
+// valid whichever fields are present, and empty once the defaults are in place.
+func (ws *WebhookServer) defaultingPatch(policy *v1alpha1.GarbageCollectionPolicy, raw []byte) ([]jsonpatch.Operation, error) {
+	defaulted := policy.DeepCopy()
+	ws.applyPolicyDefaults(defaulted)
 
+	before, err := json.Marshal(policy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal policy: %w", err)
+	}
+	after, err := json.Marshal(defaulted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal defaulted policy: %w", err)
+	}
+	defaults, err := mergepatch.CreateMergePatch(before, after)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute defaults: %w", err)
+	}
+	mutated, err := mergepatch.MergePatch(raw, defaults)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply defaults: %w", err)
+	}
+
+	patches, err := jsonpatch.CreatePatch(raw, mutated)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute patch: %w", err)
+	}
+	sort.Sort(jsonpatch.ByPath(patches))
 	return patches, nil
 }
+
+// replaceSpec returns a raw object with its spec replaced, as the template patch does.
+func replaceSpec(raw []byte, spec map[string]interface{}) ([]byte, error) {
+	var object map[string]interface{}
+	if err := json.Unmarshal(raw, &object); err != nil {
+		return nil, fmt.Errorf("failed to decode policy: %w", err)
+	}
+	object["spec"] = spec
+	return json.Marshal(object)
+}
+
+// expandPolicyTemplate replaces the policy's empty spec with the named template expanded
+// from the policy's parameter annotations, and validates the result. It returns the
+// expanded spec to patch in.
+func (ws *WebhookServer) expandPolicyTemplate(policy *v1alpha1.GarbageCollectionPolicy, name string) (map[string]interface{}, error) {
+	tmpl, ok := ws.templates[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownPolicyTemplate, name)
+	}
+	if !equality.Semantic.DeepEqual(policy.Spec, v1alpha1.GarbageCollectionPolicySpec{}) {
+		return nil, ErrPolicyTemplateSpecSet
+	}
+
+	expanded, spec, err := tmpl.Expand(policy.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	policy.Spec = *spec
+	if err := validation.ValidatePolicy(policy); err != nil {
+		return nil, fmt.Errorf("template %s expanded to an invalid policy: %w", name, err)
+	}
+
+	return expanded, nil
+}
+
+// mutationWarnings renders the admission warnings for mutation patches: one for an
+// expanded template and one summarizing applied defaults.
+func mutationWarnings(patches []jsonpatch.Operation) []string {
+	var warnings []string
+	defaults := make([]jsonpatch.Operation, 0, len(patches))
+	for _, patch := range patches {
+		if patch.Path == "/spec" {
+			warnings = append(warnings, "expanded spec from policy template")
+			continue
+		}
+		defaults = append(defaults, patch)
+	}
+	if len(defaults) > 0 {
+		warnings = append(warnings, summarizeDefaults(defaults))
+	}
+	return warnings
+}
+
+// summarizeDefaults renders the defaults applied by mutation patches as a single admission
+// warning, e.g. "defaulted batchSize=50, namespace=*", so users see them at apply time.
+// Object values are expanded into their fields in sorted order.
+func summarizeDefaults(patches []jsonpatch.Operation) string {
+	defaults := make([]string, 0, len(patches))
+	for _, patch := range patches {
+		if fields, ok := patch.Value.(map[string]interface{}); ok {
+			keys := make([]string, 0, len(fields))
+			for key := range fields {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				defaults = append(defaults, fmt.Sprintf("%s=%v", key, fields[key]))
+			}
+			continue
+		}
+		defaults = append(defaults, fmt.Sprintf("%s=%v", path.Base(patch.Path), patch.Value))
+	}
+	return "defaulted " + strings.Join(defaults, ", ")
+}
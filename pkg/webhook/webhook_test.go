@@ -24,14 +24,18 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"reflect"
 	"testing"
 	"time"
 
+	mergepatch "gopkg.in/evanphx/json-patch.v4"
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/kube-zen/zen-gc/pkg/api/v1alpha1"
+	"github.com/kube-zen/zen-gc/pkg/config"
+	"github.com/kube-zen/zen-gc/pkg/validation"
 )
 
 func TestWebhookServer_handleValidate(t *testing.T) {
@@ -386,6 +390,101 @@ func TestWebhookServer_handleMutate(t *testing.T) {
 	}
 }
 
+func TestWebhookServer_handleMutate_WarnsAppliedDefaults(t *testing.T) {
+	server, err := NewWebhookServer(":0", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create webhook server: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		behavior v1alpha1.BehaviorSpec
+		want     []string
+	}{
+		{
+			name: "all defaults",
+			want: []string{"defaulted batchSize=50, maxDeletionsPerSecond=10, propagationPolicy=Background, namespace=*"},
+		},
+		{
+			name:     "only missing fields",
+			behavior: v1alpha1.BehaviorSpec{MaxDeletionsPerSecond: 20, BatchSize: 100},
+			want:     []string{"defaulted propagationPolicy=Background, namespace=*"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			review := admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					UID:       "test-uid",
+					Operation: admissionv1.Create,
+					Object: runtime.RawExtension{
+						Raw: marshalPolicy(t, &v1alpha1.GarbageCollectionPolicy{
+							ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Namespace: "default"},
+							Spec: v1alpha1.GarbageCollectionPolicySpec{
+								TargetResource: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap"},
+								Behavior:       tt.behavior,
+							},
+						}),
+					},
+				},
+			}
+			reqBody, err := json.Marshal(review)
+			if err != nil {
+				t.Fatalf("Failed to marshal request: %v", err)
+			}
+
+			w := httptest.NewRecorder()
+			server.handleMutate(w, httptest.NewRequest(http.MethodPost, "/mutate-gc-policy", bytes.NewBuffer(reqBody)))
+
+			var response admissionv1.AdmissionReview
+			if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+				t.Fatalf("Failed to unmarshal response: %v", err)
+			}
+			if !reflect.DeepEqual(response.Response.Warnings, tt.want) {
+				t.Errorf("Warnings = %q, want %q", response.Response.Warnings, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebhookServer_handleMutate_NoWarningsWithoutDefaults(t *testing.T) {
+	server, err := NewWebhookServer(":0", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create webhook server: %v", err)
+	}
+
+	review := admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			UID:       "test-uid",
+			Operation: admissionv1.Update,
+			Object: runtime.RawExtension{
+				Raw: marshalPolicy(t, &v1alpha1.GarbageCollectionPolicy{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Namespace: "default"},
+					Spec: v1alpha1.GarbageCollectionPolicySpec{
+						TargetResource: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap"},
+					},
+				}),
+			},
+		},
+	}
+	reqBody, err := json.Marshal(review)
+	if err != nil {
+		t.Fatalf("Failed to marshal request: %v", err)
+	}
+
+	w := httptest.NewRecorder()
+	server.handleMutate(w, httptest.NewRequest(http.MethodPost, "/mutate-gc-policy", bytes.NewBuffer(reqBody)))
+
+	var response admissionv1.AdmissionReview
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if len(response.Response.Warnings) != 0 {
+		t.Errorf("Warnings = %q, want none", response.Response.Warnings)
+	}
+}
+
 func TestWebhookServer_mutatePolicy(t *testing.T) {
 	server, err := NewWebhookServer(":0", "", "")
 	if err != nil {
@@ -417,7 +516,7 @@ func TestWebhookServer_mutatePolicy(t *testing.T) {
 					}),
 				},
 			},
-			expectedPatches: 2, // behavior defaults + namespace default
+			expectedPatches: 4, // three defaults into the empty behavior object + namespace default
 			expectError:     false,
 		},
 		{
@@ -538,8 +637,150 @@ func TestWebhookServer_mutatePolicy_WithNamespaceSet(t *testing.T) {
 	}
 
 	// Should add behavior defaults but not namespace (already set)
-	if len(patches) != 1 { // Only behavior defaults
-		t.Errorf("Expected 1 patch (behavior), got %d", len(patches))
+	if len(patches) != 3 { // Only behavior defaults
+		t.Errorf("Expected 3 patches (behavior), got %d", len(patches))
+	}
+}
+
+func TestWebhookServer_mutatePolicy_PartialSpecs(t *testing.T) {
+	server, err := NewWebhookServer(":0", "", "")
+	if err != nil {
+		t.Fatalf("NewWebhookServer() returned error: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		spec      string
+		wantPaths []string
+		wantKept  map[string]interface{}
+	}{
+		{
+			name:      "no behavior",
+			spec:      `{"targetResource":{"apiVersion":"v1","kind":"ConfigMap"}}`,
+			wantPaths: []string{"/spec/behavior", "/spec/targetResource/namespace"},
+		},
+		{
+			name: "behavior with only dryRun",
+			spec: `{"targetResource":{"apiVersion":"v1","kind":"ConfigMap","namespace":"apps"},"behavior":{"dryRun":true}}`,
+			wantPaths: []string{
+				"/spec/behavior/batchSize",
+				"/spec/behavior/maxDeletionsPerSecond",
+				"/spec/behavior/propagationPolicy",
+			},
+			wantKept: map[string]interface{}{"dryRun": true},
+		},
+		{
+			name:      "behavior with fields the defaults do not cover",
+			spec:      `{"targetResource":{"apiVersion":"v1","kind":"ConfigMap","namespace":"apps"},"behavior":{"preconditions":true,"batchSize":5,"maxDeletionsPerSecond":2}}`,
+			wantPaths: []string{"/spec/behavior/propagationPolicy"},
+			wantKept:  map[string]interface{}{"preconditions": true, "batchSize": float64(5), "maxDeletionsPerSecond": float64(2)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := []byte(`{"apiVersion":"gc.kube-zen.io/v1alpha1","kind":"GarbageCollectionPolicy","metadata":{"name":"partial","namespace":"default"},"spec":` + tt.spec + `}`)
+			request := &admissionv1.AdmissionRequest{Operation: admissionv1.Create, Object: runtime.RawExtension{Raw: raw}}
+
+			patches, err := server.mutatePolicy(request)
+			if err != nil {
+				t.Fatalf("mutatePolicy() returned error: %v", err)
+			}
+			paths := make([]string, 0, len(patches))
+			for _, patch := range patches {
+				paths = append(paths, patch.Path)
+			}
+			if !reflect.DeepEqual(paths, tt.wantPaths) {
+				t.Errorf("patch paths = %v, want %v", paths, tt.wantPaths)
+			}
+
+			// The patch applies to the object as sent, keeping the fields already set
+			patchBytes, err := json.Marshal(patches)
+			if err != nil {
+				t.Fatalf("Failed to marshal patches: %v", err)
+			}
+			decoded, err := mergepatch.DecodePatch(patchBytes)
+			if err != nil {
+				t.Fatalf("Failed to decode patch: %v", err)
+			}
+			mutated, err := decoded.Apply(raw)
+			if err != nil {
+				t.Fatalf("Patch does not apply to the admitted object: %v", err)
+			}
+			var object struct {
+				Spec struct {
+					Behavior map[string]interface{} `json:"behavior"`
+				} `json:"spec"`
+			}
+			if err := json.Unmarshal(mutated, &object); err != nil {
+				t.Fatalf("Failed to decode mutated policy: %v", err)
+			}
+			if object.Spec.Behavior["propagationPolicy"] != "Background" {
+				t.Errorf("behavior = %v, want propagationPolicy defaulted", object.Spec.Behavior)
+			}
+			for key, value := range tt.wantKept {
+				if object.Spec.Behavior[key] != value {
+					t.Errorf("behavior.%s = %v, want %v kept", key, object.Spec.Behavior[key], value)
+				}
+			}
+
+			// Mutating the defaulted policy again changes nothing
+			request.Object.Raw = mutated
+			if again, err := server.mutatePolicy(request); err != nil || len(again) != 0 {
+				t.Errorf("mutatePolicy() on the defaulted policy = %v, %v, want no patches", again, err)
+			}
+		})
+	}
+}
+
+func TestWebhookServer_mutatePolicy_DefaultTargetNamespaceMode(t *testing.T) {
+	tests := []struct {
+		name          string
+		mode          string
+		wantNamespace bool
+	}{
+		{name: "unset mode defaults to cluster-wide", mode: "", wantNamespace: true},
+		{name: "cluster mode defaults to cluster-wide", mode: config.TargetNamespaceModeCluster, wantNamespace: true},
+		{name: "policy mode leaves namespace to the controller", mode: config.TargetNamespaceModePolicy, wantNamespace: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, err := NewWebhookServer(":0", "", "")
+			if err != nil {
+				t.Fatalf("NewWebhookServer() returned error: %v", err)
+			}
+			server.SetDefaultTargetNamespaceMode(tt.mode)
+
+			request := &admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				Object: runtime.RawExtension{
+					Raw: marshalPolicy(t, &v1alpha1.GarbageCollectionPolicy{
+						ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Namespace: "default"},
+						Spec: v1alpha1.GarbageCollectionPolicySpec{
+							TargetResource: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap"},
+						},
+					}),
+				},
+			}
+
+			patches, err := server.mutatePolicy(request)
+			if err != nil {
+				t.Fatalf("mutatePolicy() returned error: %v", err)
+			}
+			gotNamespace := false
+			for _, patch := range patches {
+				if patch.Path == "/spec/targetResource/namespace" {
+					gotNamespace = true
+					if patch.Value != "*" {
+						t.Errorf("Expected namespace default \"*\", got %v", patch.Value)
+					}
+				}
+			}
+			if gotNamespace != tt.wantNamespace {
+				t.Errorf("Expected namespace patch=%v, got %v", tt.wantNamespace, gotNamespace)
+			}
+		})
 	}
 }
 
@@ -644,3 +885,214 @@ func TestWebhookServer_StartTLS_InvalidCertFile(t *testing.T) {
 		t.Error("StartTLS() should return error with invalid cert file")
 	}
 }
+
+func TestWebhookServer_validatePolicy_DryRunPromotion(t *testing.T) {
+	server, err := NewWebhookServer(":0", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create webhook server: %v", err)
+	}
+
+	const impactHash = "sha256:0123abcd"
+	newDryRunPolicy := func(dryRun bool) *v1alpha1.GarbageCollectionPolicy {
+		return &v1alpha1.GarbageCollectionPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Namespace: "default", Generation: 3},
+			Spec: v1alpha1.GarbageCollectionPolicySpec{
+				TargetResource: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap"},
+				TTL:            v1alpha1.TTLSpec{SecondsAfterCreation: int64Ptr(3600)},
+				Behavior:       v1alpha1.BehaviorSpec{DryRun: dryRun},
+			},
+		}
+	}
+	observed := func(policy *v1alpha1.GarbageCollectionPolicy, generation int64) *v1alpha1.GarbageCollectionPolicy {
+		policy.Status.DryRunImpact = &v1alpha1.DryRunImpact{WouldDelete: 12, Hash: impactHash, ObservedGeneration: generation}
+		return policy
+	}
+	acknowledged := func(policy *v1alpha1.GarbageCollectionPolicy, hash string) *v1alpha1.GarbageCollectionPolicy {
+		policy.Annotations = map[string]string{v1alpha1.DryRunAcknowledgedAnnotation: hash}
+		return policy
+	}
+
+	tests := []struct {
+		name      string
+		oldPolicy *v1alpha1.GarbageCollectionPolicy
+		newPolicy *v1alpha1.GarbageCollectionPolicy
+		expectErr error
+	}{
+		{
+			name:      "acknowledged impact allows arming",
+			oldPolicy: observed(newDryRunPolicy(true), 3),
+			newPolicy: acknowledged(newDryRunPolicy(false), impactHash),
+		},
+		{
+			name:      "stale acknowledgement is rejected",
+			oldPolicy: observed(newDryRunPolicy(true), 3),
+			newPolicy: acknowledged(newDryRunPolicy(false), "sha256:ffff"),
+			expectErr: ErrDryRunImpactNotAcknowledged,
+		},
+		{
+			name:      "absent acknowledgement is rejected",
+			oldPolicy: observed(newDryRunPolicy(true), 3),
+			newPolicy: newDryRunPolicy(false),
+			expectErr: ErrDryRunImpactNotAcknowledged,
+		},
+		{
+			name:      "no observed impact is rejected",
+			oldPolicy: newDryRunPolicy(true),
+			newPolicy: acknowledged(newDryRunPolicy(false), impactHash),
+			expectErr: ErrDryRunImpactNotObserved,
+		},
+		{
+			name:      "impact from an older generation is rejected",
+			oldPolicy: observed(newDryRunPolicy(true), 2),
+			newPolicy: acknowledged(newDryRunPolicy(false), impactHash),
+			expectErr: ErrDryRunImpactNotObserved,
+		},
+		{
+			name:      "other spec changes are rejected",
+			oldPolicy: observed(newDryRunPolicy(true), 3),
+			newPolicy: func() *v1alpha1.GarbageCollectionPolicy {
+				policy := acknowledged(newDryRunPolicy(false), impactHash)
+				policy.Spec.TTL.SecondsAfterCreation = int64Ptr(60)
+				return policy
+			}(),
+			expectErr: ErrDryRunPromotionChangesSpec,
+		},
+		{
+			name:      "updates that keep dry run need no acknowledgement",
+			oldPolicy: newDryRunPolicy(true),
+			newPolicy: newDryRunPolicy(true),
+		},
+		{
+			name:      "enabling dry run needs no acknowledgement",
+			oldPolicy: newDryRunPolicy(false),
+			newPolicy: newDryRunPolicy(true),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := server.validatePolicy(&admissionv1.AdmissionRequest{
+				Operation: admissionv1.Update,
+				Object:    runtime.RawExtension{Raw: marshalPolicy(t, tt.newPolicy)},
+				OldObject: runtime.RawExtension{Raw: marshalPolicy(t, tt.oldPolicy)},
+			})
+			if tt.expectErr == nil {
+				if err != nil {
+					t.Errorf("validatePolicy() returned error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.expectErr) {
+				t.Errorf("validatePolicy() error = %v, want %v", err, tt.expectErr)
+			}
+		})
+	}
+}
+
+func TestWebhookServer_validatePolicy_AllowedAPIGroups(t *testing.T) {
+	server, err := NewWebhookServer(":0", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create webhook server: %v", err)
+	}
+	server.SetAllowedAPIGroups([]string{"batch", "apps"})
+
+	newPolicy := func(apiVersion, kind string, ttl int64) *v1alpha1.GarbageCollectionPolicy {
+		return &v1alpha1.GarbageCollectionPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Namespace: "default"},
+			Spec: v1alpha1.GarbageCollectionPolicySpec{
+				TargetResource: v1alpha1.TargetResourceSpec{APIVersion: apiVersion, Kind: kind},
+				TTL:            v1alpha1.TTLSpec{SecondsAfterCreation: int64Ptr(ttl)},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		operation admissionv1.Operation
+		oldPolicy *v1alpha1.GarbageCollectionPolicy
+		newPolicy *v1alpha1.GarbageCollectionPolicy
+		expectErr bool
+	}{
+		{
+			name:      "allowed group is admitted",
+			operation: admissionv1.Create,
+			newPolicy: newPolicy("batch/v1", "Job", 3600),
+		},
+		{
+			name:      "disallowed group is rejected",
+			operation: admissionv1.Create,
+			newPolicy: newPolicy("v1", "ConfigMap", 3600),
+			expectErr: true,
+		},
+		{
+			name:      "spec change to a disallowed group is rejected",
+			operation: admissionv1.Update,
+			oldPolicy: newPolicy("v1", "ConfigMap", 3600),
+			newPolicy: newPolicy("v1", "ConfigMap", 7200),
+			expectErr: true,
+		},
+		{
+			name:      "update keeping the spec is admitted",
+			operation: admissionv1.Update,
+			oldPolicy: newPolicy("v1", "ConfigMap", 3600),
+			newPolicy: newPolicy("v1", "ConfigMap", 3600),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &admissionv1.AdmissionRequest{
+				Operation: tt.operation,
+				Object:    runtime.RawExtension{Raw: marshalPolicy(t, tt.newPolicy)},
+			}
+			if tt.oldPolicy != nil {
+				req.OldObject = runtime.RawExtension{Raw: marshalPolicy(t, tt.oldPolicy)}
+			}
+			_, err := server.validatePolicy(req)
+			if tt.expectErr && !errors.Is(err, validation.ErrAPIGroupNotAllowed) {
+				t.Errorf("validatePolicy() error = %v, want %v", err, validation.ErrAPIGroupNotAllowed)
+			}
+			if !tt.expectErr && err != nil {
+				t.Errorf("validatePolicy() returned error: %v", err)
+			}
+		})
+	}
+}
+
+func TestWebhookServer_validatePolicy_AllowedOPAURLs(t *testing.T) {
+	const opaURL = "http://opa.opa:8181/v1/data/gc/allow"
+	server, err := NewWebhookServer(":0", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create webhook server: %v", err)
+	}
+
+	newPolicy := func(url string) *v1alpha1.GarbageCollectionPolicy {
+		return &v1alpha1.GarbageCollectionPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Namespace: "default"},
+			Spec: v1alpha1.GarbageCollectionPolicySpec{
+				TargetResource: v1alpha1.TargetResourceSpec{APIVersion: "v1", Kind: "ConfigMap"},
+				TTL:            v1alpha1.TTLSpec{SecondsAfterCreation: int64Ptr(3600)},
+				Conditions:     &v1alpha1.ConditionsSpec{OPA: &v1alpha1.OPACondition{URL: url}},
+			},
+		}
+	}
+	validate := func(policy *v1alpha1.GarbageCollectionPolicy) error {
+		_, err := server.validatePolicy(&admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Object:    runtime.RawExtension{Raw: marshalPolicy(t, policy)},
+		})
+		return err
+	}
+
+	if err := validate(newPolicy(opaURL)); !errors.Is(err, validation.ErrOPAURLNotAllowed) {
+		t.Errorf("validatePolicy() without an allowlist error = %v, want %v", err, validation.ErrOPAURLNotAllowed)
+	}
+
+	server.SetAllowedOPAURLs([]string{opaURL})
+	if err := validate(newPolicy(opaURL)); err != nil {
+		t.Errorf("validatePolicy() returned error for an allowed URL: %v", err)
+	}
+	if err := validate(newPolicy("http://attacker.example/collect")); !errors.Is(err, validation.ErrOPAURLNotAllowed) {
+		t.Errorf("validatePolicy() error = %v, want %v", err, validation.ErrOPAURLNotAllowed)
+	}
+}